@@ -21,6 +21,182 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Compares a field of the listed records with values given as text:
+// numbers, true/false, or dates as YYYY-MM-DD or RFC 3339 times.
+type FilterCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // eq (default), ne, lt, lte, gt, gte, in, not_in, contains, starts_with, is_null, not_null
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`     // in/not_in take several, is_null/not_null none, the others one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterCondition) Reset() {
+	*x = FilterCondition{}
+	mi := &file_api_proto_crm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterCondition) ProtoMessage() {}
+
+func (x *FilterCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterCondition.ProtoReflect.Descriptor instead.
+func (*FilterCondition) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FilterCondition) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FilterCondition) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *FilterCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Combines conditions and nested groups; an empty group matches everything.
+type FilterGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logic         string                 `protobuf:"bytes,1,opt,name=logic,proto3" json:"logic,omitempty"` // and (default) or or
+	Conditions    []*FilterCondition     `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Groups        []*FilterGroup         `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterGroup) Reset() {
+	*x = FilterGroup{}
+	mi := &file_api_proto_crm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterGroup) ProtoMessage() {}
+
+func (x *FilterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterGroup.ProtoReflect.Descriptor instead.
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FilterGroup) GetLogic() string {
+	if x != nil {
+		return x.Logic
+	}
+	return ""
+}
+
+func (x *FilterGroup) GetConditions() []*FilterCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *FilterGroup) GetGroups() []*FilterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Records with equal sort values are ordered by id.
+type SortField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Descending    bool                   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	mi := &file_api_proto_crm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SortField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortField) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
 // Activity Messages
 type Activity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -39,7 +215,7 @@ type Activity struct {
 
 func (x *Activity) Reset() {
 	*x = Activity{}
-	mi := &file_api_proto_crm_proto_msgTypes[0]
+	mi := &file_api_proto_crm_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51,7 +227,7 @@ func (x *Activity) String() string {
 func (*Activity) ProtoMessage() {}
 
 func (x *Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[0]
+	mi := &file_api_proto_crm_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -64,7 +240,7 @@ func (x *Activity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Activity.ProtoReflect.Descriptor instead.
 func (*Activity) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Activity) GetId() uint32 {
@@ -139,7 +315,7 @@ type CreateActivityRequest struct {
 
 func (x *CreateActivityRequest) Reset() {
 	*x = CreateActivityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[1]
+	mi := &file_api_proto_crm_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -151,7 +327,7 @@ func (x *CreateActivityRequest) String() string {
 func (*CreateActivityRequest) ProtoMessage() {}
 
 func (x *CreateActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[1]
+	mi := &file_api_proto_crm_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +340,7 @@ func (x *CreateActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityRequest.ProtoReflect.Descriptor instead.
 func (*CreateActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateActivityRequest) GetActivity() *Activity {
@@ -183,7 +359,7 @@ type CreateActivityResponse struct {
 
 func (x *CreateActivityResponse) Reset() {
 	*x = CreateActivityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[2]
+	mi := &file_api_proto_crm_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +371,7 @@ func (x *CreateActivityResponse) String() string {
 func (*CreateActivityResponse) ProtoMessage() {}
 
 func (x *CreateActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[2]
+	mi := &file_api_proto_crm_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +384,7 @@ func (x *CreateActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityResponse.ProtoReflect.Descriptor instead.
 func (*CreateActivityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateActivityResponse) GetActivity() *Activity {
@@ -227,7 +403,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[3]
+	mi := &file_api_proto_crm_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +415,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[3]
+	mi := &file_api_proto_crm_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +428,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetActivityRequest) GetId() uint32 {
@@ -271,7 +447,7 @@ type GetActivityResponse struct {
 
 func (x *GetActivityResponse) Reset() {
 	*x = GetActivityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[4]
+	mi := &file_api_proto_crm_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +459,7 @@ func (x *GetActivityResponse) String() string {
 func (*GetActivityResponse) ProtoMessage() {}
 
 func (x *GetActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[4]
+	mi := &file_api_proto_crm_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +472,7 @@ func (x *GetActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityResponse.ProtoReflect.Descriptor instead.
 func (*GetActivityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetActivityResponse) GetActivity() *Activity {
@@ -315,7 +491,7 @@ type UpdateActivityRequest struct {
 
 func (x *UpdateActivityRequest) Reset() {
 	*x = UpdateActivityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[5]
+	mi := &file_api_proto_crm_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +503,7 @@ func (x *UpdateActivityRequest) String() string {
 func (*UpdateActivityRequest) ProtoMessage() {}
 
 func (x *UpdateActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[5]
+	mi := &file_api_proto_crm_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +516,7 @@ func (x *UpdateActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateActivityRequest.ProtoReflect.Descriptor instead.
 func (*UpdateActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateActivityRequest) GetActivity() *Activity {
@@ -359,7 +535,7 @@ type UpdateActivityResponse struct {
 
 func (x *UpdateActivityResponse) Reset() {
 	*x = UpdateActivityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[6]
+	mi := &file_api_proto_crm_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +547,7 @@ func (x *UpdateActivityResponse) String() string {
 func (*UpdateActivityResponse) ProtoMessage() {}
 
 func (x *UpdateActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[6]
+	mi := &file_api_proto_crm_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +560,7 @@ func (x *UpdateActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateActivityResponse.ProtoReflect.Descriptor instead.
 func (*UpdateActivityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateActivityResponse) GetActivity() *Activity {
@@ -403,7 +579,7 @@ type DeleteActivityRequest struct {
 
 func (x *DeleteActivityRequest) Reset() {
 	*x = DeleteActivityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[7]
+	mi := &file_api_proto_crm_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +591,7 @@ func (x *DeleteActivityRequest) String() string {
 func (*DeleteActivityRequest) ProtoMessage() {}
 
 func (x *DeleteActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[7]
+	mi := &file_api_proto_crm_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +604,7 @@ func (x *DeleteActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityRequest.ProtoReflect.Descriptor instead.
 func (*DeleteActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteActivityRequest) GetId() uint32 {
@@ -447,7 +623,7 @@ type DeleteActivityResponse struct {
 
 func (x *DeleteActivityResponse) Reset() {
 	*x = DeleteActivityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[8]
+	mi := &file_api_proto_crm_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +635,7 @@ func (x *DeleteActivityResponse) String() string {
 func (*DeleteActivityResponse) ProtoMessage() {}
 
 func (x *DeleteActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[8]
+	mi := &file_api_proto_crm_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +648,7 @@ func (x *DeleteActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityResponse.ProtoReflect.Descriptor instead.
 func (*DeleteActivityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteActivityResponse) GetSuccess() bool {
@@ -483,19 +659,23 @@ func (x *DeleteActivityResponse) GetSuccess() bool {
 }
 
 type ListActivitiesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageNumber    uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	Ascending     bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
-	ContactId     uint32                 `protobuf:"varint,5,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"` // Optional filter by Contact
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Ascending         bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	ContactId         uint32                 `protobuf:"varint,5,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"` // Optional filter by Contact
+	Filter            *FilterGroup           `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,7,rep,name=sort,proto3" json:"sort,omitempty"`                            // overrides sort_by/ascending
+	PageToken         string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,9,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListActivitiesRequest) Reset() {
 	*x = ListActivitiesRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[9]
+	mi := &file_api_proto_crm_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +687,7 @@ func (x *ListActivitiesRequest) String() string {
 func (*ListActivitiesRequest) ProtoMessage() {}
 
 func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[9]
+	mi := &file_api_proto_crm_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +700,7 @@ func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListActivitiesRequest) GetPageNumber() uint32 {
@@ -558,16 +738,47 @@ func (x *ListActivitiesRequest) GetContactId() uint32 {
 	return 0
 }
 
+func (x *ListActivitiesRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListActivitiesRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListActivitiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListActivitiesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
 type ListActivitiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Activities    []*Activity            `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListActivitiesResponse) Reset() {
 	*x = ListActivitiesResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[10]
+	mi := &file_api_proto_crm_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +790,7 @@ func (x *ListActivitiesResponse) String() string {
 func (*ListActivitiesResponse) ProtoMessage() {}
 
 func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[10]
+	mi := &file_api_proto_crm_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +803,7 @@ func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListActivitiesResponse) GetActivities() []*Activity {
@@ -602,6 +813,27 @@ func (x *ListActivitiesResponse) GetActivities() []*Activity {
 	return nil
 }
 
+func (x *ListActivitiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListActivitiesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListActivitiesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // Task Messages
 type Task struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -620,7 +852,7 @@ type Task struct {
 
 func (x *Task) Reset() {
 	*x = Task{}
-	mi := &file_api_proto_crm_proto_msgTypes[11]
+	mi := &file_api_proto_crm_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -632,7 +864,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[11]
+	mi := &file_api_proto_crm_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +877,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Task) GetId() uint32 {
@@ -720,7 +952,7 @@ type CreateTaskRequest struct {
 
 func (x *CreateTaskRequest) Reset() {
 	*x = CreateTaskRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[12]
+	mi := &file_api_proto_crm_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +964,7 @@ func (x *CreateTaskRequest) String() string {
 func (*CreateTaskRequest) ProtoMessage() {}
 
 func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[12]
+	mi := &file_api_proto_crm_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +977,7 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
 func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateTaskRequest) GetTask() *Task {
@@ -764,7 +996,7 @@ type CreateTaskResponse struct {
 
 func (x *CreateTaskResponse) Reset() {
 	*x = CreateTaskResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[13]
+	mi := &file_api_proto_crm_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -776,7 +1008,7 @@ func (x *CreateTaskResponse) String() string {
 func (*CreateTaskResponse) ProtoMessage() {}
 
 func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[13]
+	mi := &file_api_proto_crm_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +1021,7 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
 func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateTaskResponse) GetTask() *Task {
@@ -808,7 +1040,7 @@ type GetTaskRequest struct {
 
 func (x *GetTaskRequest) Reset() {
 	*x = GetTaskRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[14]
+	mi := &file_api_proto_crm_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -820,7 +1052,7 @@ func (x *GetTaskRequest) String() string {
 func (*GetTaskRequest) ProtoMessage() {}
 
 func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[14]
+	mi := &file_api_proto_crm_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +1065,7 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetTaskRequest) GetId() uint32 {
@@ -852,7 +1084,7 @@ type GetTaskResponse struct {
 
 func (x *GetTaskResponse) Reset() {
 	*x = GetTaskResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[15]
+	mi := &file_api_proto_crm_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +1096,7 @@ func (x *GetTaskResponse) String() string {
 func (*GetTaskResponse) ProtoMessage() {}
 
 func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[15]
+	mi := &file_api_proto_crm_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1109,7 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
 func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetTaskResponse) GetTask() *Task {
@@ -896,7 +1128,7 @@ type UpdateTaskRequest struct {
 
 func (x *UpdateTaskRequest) Reset() {
 	*x = UpdateTaskRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[16]
+	mi := &file_api_proto_crm_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -908,7 +1140,7 @@ func (x *UpdateTaskRequest) String() string {
 func (*UpdateTaskRequest) ProtoMessage() {}
 
 func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[16]
+	mi := &file_api_proto_crm_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1153,7 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateTaskRequest) GetTask() *Task {
@@ -940,7 +1172,7 @@ type UpdateTaskResponse struct {
 
 func (x *UpdateTaskResponse) Reset() {
 	*x = UpdateTaskResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[17]
+	mi := &file_api_proto_crm_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1184,7 @@ func (x *UpdateTaskResponse) String() string {
 func (*UpdateTaskResponse) ProtoMessage() {}
 
 func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[17]
+	mi := &file_api_proto_crm_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1197,7 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateTaskResponse) GetTask() *Task {
@@ -984,7 +1216,7 @@ type DeleteTaskRequest struct {
 
 func (x *DeleteTaskRequest) Reset() {
 	*x = DeleteTaskRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[18]
+	mi := &file_api_proto_crm_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -996,7 +1228,7 @@ func (x *DeleteTaskRequest) String() string {
 func (*DeleteTaskRequest) ProtoMessage() {}
 
 func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[18]
+	mi := &file_api_proto_crm_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1241,7 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteTaskRequest) GetId() uint32 {
@@ -1028,7 +1260,7 @@ type DeleteTaskResponse struct {
 
 func (x *DeleteTaskResponse) Reset() {
 	*x = DeleteTaskResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[19]
+	mi := &file_api_proto_crm_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1040,7 +1272,7 @@ func (x *DeleteTaskResponse) String() string {
 func (*DeleteTaskResponse) ProtoMessage() {}
 
 func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[19]
+	mi := &file_api_proto_crm_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +1285,7 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteTaskResponse) GetSuccess() bool {
@@ -1064,19 +1296,23 @@ func (x *DeleteTaskResponse) GetSuccess() bool {
 }
 
 type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageNumber    uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	Ascending     bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
-	ActivityId    uint32                 `protobuf:"varint,5,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // Optional filter by Activity
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Ascending         bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	ActivityId        uint32                 `protobuf:"varint,5,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // Optional filter by Activity
+	Filter            *FilterGroup           `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,7,rep,name=sort,proto3" json:"sort,omitempty"`                            // overrides sort_by/ascending
+	PageToken         string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,9,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest) Reset() {
 	*x = ListTasksRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[20]
+	mi := &file_api_proto_crm_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1324,7 @@ func (x *ListTasksRequest) String() string {
 func (*ListTasksRequest) ProtoMessage() {}
 
 func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[20]
+	mi := &file_api_proto_crm_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1337,7 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListTasksRequest) GetPageNumber() uint32 {
@@ -1139,16 +1375,47 @@ func (x *ListTasksRequest) GetActivityId() uint32 {
 	return 0
 }
 
+func (x *ListTasksRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksResponse) Reset() {
 	*x = ListTasksResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[21]
+	mi := &file_api_proto_crm_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1160,7 +1427,7 @@ func (x *ListTasksResponse) String() string {
 func (*ListTasksResponse) ProtoMessage() {}
 
 func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[21]
+	mi := &file_api_proto_crm_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1440,7 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
 func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListTasksResponse) GetTasks() []*Task {
@@ -1183,6 +1450,27 @@ func (x *ListTasksResponse) GetTasks() []*Task {
 	return nil
 }
 
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTasksResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListTasksResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // -------------------- Contact Messages --------------------
 type Contact struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -1211,7 +1499,7 @@ type Contact struct {
 
 func (x *Contact) Reset() {
 	*x = Contact{}
-	mi := &file_api_proto_crm_proto_msgTypes[22]
+	mi := &file_api_proto_crm_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1511,7 @@ func (x *Contact) String() string {
 func (*Contact) ProtoMessage() {}
 
 func (x *Contact) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[22]
+	mi := &file_api_proto_crm_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1524,7 @@ func (x *Contact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Contact.ProtoReflect.Descriptor instead.
 func (*Contact) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Contact) GetId() uint32 {
@@ -1381,7 +1669,7 @@ type CreateContactRequest struct {
 
 func (x *CreateContactRequest) Reset() {
 	*x = CreateContactRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[23]
+	mi := &file_api_proto_crm_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1393,7 +1681,7 @@ func (x *CreateContactRequest) String() string {
 func (*CreateContactRequest) ProtoMessage() {}
 
 func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[23]
+	mi := &file_api_proto_crm_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,7 +1694,7 @@ func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateContactRequest.ProtoReflect.Descriptor instead.
 func (*CreateContactRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateContactRequest) GetContact() *Contact {
@@ -1425,7 +1713,7 @@ type CreateContactResponse struct {
 
 func (x *CreateContactResponse) Reset() {
 	*x = CreateContactResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[24]
+	mi := &file_api_proto_crm_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1437,7 +1725,7 @@ func (x *CreateContactResponse) String() string {
 func (*CreateContactResponse) ProtoMessage() {}
 
 func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[24]
+	mi := &file_api_proto_crm_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,7 +1738,7 @@ func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateContactResponse.ProtoReflect.Descriptor instead.
 func (*CreateContactResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateContactResponse) GetContact() *Contact {
@@ -1461,15 +1749,16 @@ func (x *CreateContactResponse) GetContact() *Contact {
 }
 
 type GetContactRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeOpportunities bool                   `protobuf:"varint,2,opt,name=include_opportunities,json=includeOpportunities,proto3" json:"include_opportunities,omitempty"` // also return the opportunities the contact has roles on
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetContactRequest) Reset() {
 	*x = GetContactRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[25]
+	mi := &file_api_proto_crm_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1481,7 +1770,7 @@ func (x *GetContactRequest) String() string {
 func (*GetContactRequest) ProtoMessage() {}
 
 func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[25]
+	mi := &file_api_proto_crm_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1494,7 +1783,7 @@ func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
 func (*GetContactRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetContactRequest) GetId() uint32 {
@@ -1504,16 +1793,77 @@ func (x *GetContactRequest) GetId() uint32 {
 	return 0
 }
 
+func (x *GetContactRequest) GetIncludeOpportunities() bool {
+	if x != nil {
+		return x.IncludeOpportunities
+	}
+	return false
+}
+
+// An opportunity a contact is involved in, with the contact's roles on it.
+type ContactOpportunity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactOpportunity) Reset() {
+	*x = ContactOpportunity{}
+	mi := &file_api_proto_crm_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactOpportunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactOpportunity) ProtoMessage() {}
+
+func (x *ContactOpportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactOpportunity.ProtoReflect.Descriptor instead.
+func (*ContactOpportunity) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ContactOpportunity) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+func (x *ContactOpportunity) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 type GetContactResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	Opportunities []*ContactOpportunity  `protobuf:"bytes,2,rep,name=opportunities,proto3" json:"opportunities,omitempty"` // most recent first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetContactResponse) Reset() {
 	*x = GetContactResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[26]
+	mi := &file_api_proto_crm_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1525,7 +1875,7 @@ func (x *GetContactResponse) String() string {
 func (*GetContactResponse) ProtoMessage() {}
 
 func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[26]
+	mi := &file_api_proto_crm_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +1888,7 @@ func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
 func (*GetContactResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetContactResponse) GetContact() *Contact {
@@ -1548,6 +1898,13 @@ func (x *GetContactResponse) GetContact() *Contact {
 	return nil
 }
 
+func (x *GetContactResponse) GetOpportunities() []*ContactOpportunity {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
 type UpdateContactRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
@@ -1557,7 +1914,7 @@ type UpdateContactRequest struct {
 
 func (x *UpdateContactRequest) Reset() {
 	*x = UpdateContactRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[27]
+	mi := &file_api_proto_crm_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +1926,7 @@ func (x *UpdateContactRequest) String() string {
 func (*UpdateContactRequest) ProtoMessage() {}
 
 func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[27]
+	mi := &file_api_proto_crm_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +1939,7 @@ func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
 func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateContactRequest) GetContact() *Contact {
@@ -1601,7 +1958,7 @@ type UpdateContactResponse struct {
 
 func (x *UpdateContactResponse) Reset() {
 	*x = UpdateContactResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[28]
+	mi := &file_api_proto_crm_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +1970,7 @@ func (x *UpdateContactResponse) String() string {
 func (*UpdateContactResponse) ProtoMessage() {}
 
 func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[28]
+	mi := &file_api_proto_crm_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +1983,7 @@ func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
 func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateContactResponse) GetContact() *Contact {
@@ -1645,7 +2002,7 @@ type DeleteContactRequest struct {
 
 func (x *DeleteContactRequest) Reset() {
 	*x = DeleteContactRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[29]
+	mi := &file_api_proto_crm_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1657,7 +2014,7 @@ func (x *DeleteContactRequest) String() string {
 func (*DeleteContactRequest) ProtoMessage() {}
 
 func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[29]
+	mi := &file_api_proto_crm_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,7 +2027,7 @@ func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
 func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DeleteContactRequest) GetId() uint32 {
@@ -1689,7 +2046,7 @@ type DeleteContactResponse struct {
 
 func (x *DeleteContactResponse) Reset() {
 	*x = DeleteContactResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[30]
+	mi := &file_api_proto_crm_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +2058,7 @@ func (x *DeleteContactResponse) String() string {
 func (*DeleteContactResponse) ProtoMessage() {}
 
 func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[30]
+	mi := &file_api_proto_crm_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2071,7 @@ func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteContactResponse.ProtoReflect.Descriptor instead.
 func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteContactResponse) GetSuccess() bool {
@@ -1725,18 +2082,22 @@ func (x *DeleteContactResponse) GetSuccess() bool {
 }
 
 type ListContactsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageNumber    uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	Ascending     bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Ascending         bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                            // overrides sort_by/ascending
+	PageToken         string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListContactsRequest) Reset() {
 	*x = ListContactsRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[31]
+	mi := &file_api_proto_crm_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1748,7 +2109,7 @@ func (x *ListContactsRequest) String() string {
 func (*ListContactsRequest) ProtoMessage() {}
 
 func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[31]
+	mi := &file_api_proto_crm_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,7 +2122,7 @@ func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
 func (*ListContactsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListContactsRequest) GetPageNumber() uint32 {
@@ -1792,16 +2153,47 @@ func (x *ListContactsRequest) GetAscending() bool {
 	return false
 }
 
+func (x *ListContactsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListContactsRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListContactsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListContactsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
 type ListContactsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListContactsResponse) Reset() {
 	*x = ListContactsResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[32]
+	mi := &file_api_proto_crm_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1813,7 +2205,7 @@ func (x *ListContactsResponse) String() string {
 func (*ListContactsResponse) ProtoMessage() {}
 
 func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[32]
+	mi := &file_api_proto_crm_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,7 +2218,7 @@ func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
 func (*ListContactsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{32}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListContactsResponse) GetContacts() []*Contact {
@@ -1836,6 +2228,27 @@ func (x *ListContactsResponse) GetContacts() []*Contact {
 	return nil
 }
 
+func (x *ListContactsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListContactsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListContactsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // -------------------- Company Messages --------------------
 type Company struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -1860,7 +2273,7 @@ type Company struct {
 
 func (x *Company) Reset() {
 	*x = Company{}
-	mi := &file_api_proto_crm_proto_msgTypes[33]
+	mi := &file_api_proto_crm_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1872,7 +2285,7 @@ func (x *Company) String() string {
 func (*Company) ProtoMessage() {}
 
 func (x *Company) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[33]
+	mi := &file_api_proto_crm_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,7 +2298,7 @@ func (x *Company) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Company.ProtoReflect.Descriptor instead.
 func (*Company) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{33}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Company) GetId() uint32 {
@@ -2002,7 +2415,7 @@ type CreateCompanyRequest struct {
 
 func (x *CreateCompanyRequest) Reset() {
 	*x = CreateCompanyRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[34]
+	mi := &file_api_proto_crm_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2014,7 +2427,7 @@ func (x *CreateCompanyRequest) String() string {
 func (*CreateCompanyRequest) ProtoMessage() {}
 
 func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[34]
+	mi := &file_api_proto_crm_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,7 +2440,7 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
 func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{34}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateCompanyRequest) GetCompany() *Company {
@@ -2046,7 +2459,7 @@ type CreateCompanyResponse struct {
 
 func (x *CreateCompanyResponse) Reset() {
 	*x = CreateCompanyResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[35]
+	mi := &file_api_proto_crm_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2058,7 +2471,7 @@ func (x *CreateCompanyResponse) String() string {
 func (*CreateCompanyResponse) ProtoMessage() {}
 
 func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[35]
+	mi := &file_api_proto_crm_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,7 +2484,7 @@ func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
 func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{35}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateCompanyResponse) GetCompany() *Company {
@@ -2082,15 +2495,16 @@ func (x *CreateCompanyResponse) GetCompany() *Company {
 }
 
 type GetCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeOpportunities bool                   `protobuf:"varint,2,opt,name=include_opportunities,json=includeOpportunities,proto3" json:"include_opportunities,omitempty"` // also return the opportunities of the company
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetCompanyRequest) Reset() {
 	*x = GetCompanyRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[36]
+	mi := &file_api_proto_crm_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2102,7 +2516,7 @@ func (x *GetCompanyRequest) String() string {
 func (*GetCompanyRequest) ProtoMessage() {}
 
 func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[36]
+	mi := &file_api_proto_crm_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,7 +2529,7 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
 func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{36}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetCompanyRequest) GetId() uint32 {
@@ -2125,16 +2539,24 @@ func (x *GetCompanyRequest) GetId() uint32 {
 	return 0
 }
 
+func (x *GetCompanyRequest) GetIncludeOpportunities() bool {
+	if x != nil {
+		return x.IncludeOpportunities
+	}
+	return false
+}
+
 type GetCompanyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Opportunities []*Opportunity         `protobuf:"bytes,2,rep,name=opportunities,proto3" json:"opportunities,omitempty"` // with the company as account, most recent first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCompanyResponse) Reset() {
 	*x = GetCompanyResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[37]
+	mi := &file_api_proto_crm_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2146,7 +2568,7 @@ func (x *GetCompanyResponse) String() string {
 func (*GetCompanyResponse) ProtoMessage() {}
 
 func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[37]
+	mi := &file_api_proto_crm_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2159,7 +2581,7 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
 func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{37}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetCompanyResponse) GetCompany() *Company {
@@ -2169,6 +2591,13 @@ func (x *GetCompanyResponse) GetCompany() *Company {
 	return nil
 }
 
+func (x *GetCompanyResponse) GetOpportunities() []*Opportunity {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
 type UpdateCompanyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
@@ -2178,7 +2607,7 @@ type UpdateCompanyRequest struct {
 
 func (x *UpdateCompanyRequest) Reset() {
 	*x = UpdateCompanyRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[38]
+	mi := &file_api_proto_crm_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2190,7 +2619,7 @@ func (x *UpdateCompanyRequest) String() string {
 func (*UpdateCompanyRequest) ProtoMessage() {}
 
 func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[38]
+	mi := &file_api_proto_crm_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,7 +2632,7 @@ func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{38}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateCompanyRequest) GetCompany() *Company {
@@ -2222,7 +2651,7 @@ type UpdateCompanyResponse struct {
 
 func (x *UpdateCompanyResponse) Reset() {
 	*x = UpdateCompanyResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[39]
+	mi := &file_api_proto_crm_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2234,7 +2663,7 @@ func (x *UpdateCompanyResponse) String() string {
 func (*UpdateCompanyResponse) ProtoMessage() {}
 
 func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[39]
+	mi := &file_api_proto_crm_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,7 +2676,7 @@ func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCompanyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{39}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateCompanyResponse) GetCompany() *Company {
@@ -2266,7 +2695,7 @@ type DeleteCompanyRequest struct {
 
 func (x *DeleteCompanyRequest) Reset() {
 	*x = DeleteCompanyRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[40]
+	mi := &file_api_proto_crm_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2278,7 +2707,7 @@ func (x *DeleteCompanyRequest) String() string {
 func (*DeleteCompanyRequest) ProtoMessage() {}
 
 func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[40]
+	mi := &file_api_proto_crm_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2291,7 +2720,7 @@ func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{40}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *DeleteCompanyRequest) GetId() uint32 {
@@ -2310,7 +2739,7 @@ type DeleteCompanyResponse struct {
 
 func (x *DeleteCompanyResponse) Reset() {
 	*x = DeleteCompanyResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[41]
+	mi := &file_api_proto_crm_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2322,7 +2751,7 @@ func (x *DeleteCompanyResponse) String() string {
 func (*DeleteCompanyResponse) ProtoMessage() {}
 
 func (x *DeleteCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[41]
+	mi := &file_api_proto_crm_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,7 +2764,7 @@ func (x *DeleteCompanyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCompanyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{41}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *DeleteCompanyResponse) GetSuccess() bool {
@@ -2346,19 +2775,23 @@ func (x *DeleteCompanyResponse) GetSuccess() bool {
 }
 
 type ListCompaniesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Filter by current user’s org
-	PageNumber     uint32                 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize       uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SortBy         string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	Ascending      bool                   `protobuf:"varint,5,opt,name=ascending,proto3" json:"ascending,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Filter by current user’s org
+	PageNumber        uint32                 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Ascending         bool                   `protobuf:"varint,5,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,7,rep,name=sort,proto3" json:"sort,omitempty"`                            // overrides sort_by/ascending
+	PageToken         string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,9,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListCompaniesRequest) Reset() {
 	*x = ListCompaniesRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[42]
+	mi := &file_api_proto_crm_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2370,7 +2803,7 @@ func (x *ListCompaniesRequest) String() string {
 func (*ListCompaniesRequest) ProtoMessage() {}
 
 func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[42]
+	mi := &file_api_proto_crm_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2383,7 +2816,7 @@ func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
 func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{42}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ListCompaniesRequest) GetOrganizationId() uint32 {
@@ -2421,16 +2854,47 @@ func (x *ListCompaniesRequest) GetAscending() bool {
 	return false
 }
 
+func (x *ListCompaniesRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCompaniesRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListCompaniesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCompaniesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
 type ListCompaniesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCompaniesResponse) Reset() {
 	*x = ListCompaniesResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[43]
+	mi := &file_api_proto_crm_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2442,7 +2906,7 @@ func (x *ListCompaniesResponse) String() string {
 func (*ListCompaniesResponse) ProtoMessage() {}
 
 func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[43]
+	mi := &file_api_proto_crm_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2455,7 +2919,7 @@ func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
 func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{43}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListCompaniesResponse) GetCompanies() []*Company {
@@ -2465,25 +2929,58 @@ func (x *ListCompaniesResponse) GetCompanies() []*Company {
 	return nil
 }
 
+func (x *ListCompaniesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCompaniesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListCompaniesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type Lead struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName      string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName       string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Phone          string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	AssignedTo     uint32                 `protobuf:"varint,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
-	OrganizationId uint32                 `protobuf:"varint,8,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName              string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName               string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email                  string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone                  string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Status                 string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedTo             uint32                 `protobuf:"varint,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	OrganizationId         uint32                 `protobuf:"varint,8,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CreatedAt              string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ConvertedAt            string                 `protobuf:"bytes,11,opt,name=converted_at,json=convertedAt,proto3" json:"converted_at,omitempty"`
+	ConvertedContactId     uint32                 `protobuf:"varint,12,opt,name=converted_contact_id,json=convertedContactId,proto3" json:"converted_contact_id,omitempty"`
+	ConvertedCompanyId     uint32                 `protobuf:"varint,13,opt,name=converted_company_id,json=convertedCompanyId,proto3" json:"converted_company_id,omitempty"`
+	ConvertedOpportunityId uint32                 `protobuf:"varint,14,opt,name=converted_opportunity_id,json=convertedOpportunityId,proto3" json:"converted_opportunity_id,omitempty"`
+	StatusEnteredAt        string                 `protobuf:"bytes,15,opt,name=status_entered_at,json=statusEnteredAt,proto3" json:"status_entered_at,omitempty"`
+	Score                  int32                  `protobuf:"varint,16,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreUpdatedAt         string                 `protobuf:"bytes,17,opt,name=score_updated_at,json=scoreUpdatedAt,proto3" json:"score_updated_at,omitempty"`
+	Country                string                 `protobuf:"bytes,18,opt,name=country,proto3" json:"country,omitempty"`
+	State                  string                 `protobuf:"bytes,19,opt,name=state,proto3" json:"state,omitempty"`
+	CompanyId              uint32                 `protobuf:"varint,20,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"` // used with country and state for assignment rules
+	AssignedAt             string                 `protobuf:"bytes,21,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	AssignmentReason       string                 `protobuf:"bytes,22,opt,name=assignment_reason,json=assignmentReason,proto3" json:"assignment_reason,omitempty"` // why assigned_to was picked
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Lead) Reset() {
 	*x = Lead{}
-	mi := &file_api_proto_crm_proto_msgTypes[44]
+	mi := &file_api_proto_crm_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2495,7 +2992,7 @@ func (x *Lead) String() string {
 func (*Lead) ProtoMessage() {}
 
 func (x *Lead) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[44]
+	mi := &file_api_proto_crm_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +3005,7 @@ func (x *Lead) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Lead.ProtoReflect.Descriptor instead.
 func (*Lead) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{44}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *Lead) GetId() uint32 {
@@ -2581,6 +3078,90 @@ func (x *Lead) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Lead) GetConvertedAt() string {
+	if x != nil {
+		return x.ConvertedAt
+	}
+	return ""
+}
+
+func (x *Lead) GetConvertedContactId() uint32 {
+	if x != nil {
+		return x.ConvertedContactId
+	}
+	return 0
+}
+
+func (x *Lead) GetConvertedCompanyId() uint32 {
+	if x != nil {
+		return x.ConvertedCompanyId
+	}
+	return 0
+}
+
+func (x *Lead) GetConvertedOpportunityId() uint32 {
+	if x != nil {
+		return x.ConvertedOpportunityId
+	}
+	return 0
+}
+
+func (x *Lead) GetStatusEnteredAt() string {
+	if x != nil {
+		return x.StatusEnteredAt
+	}
+	return ""
+}
+
+func (x *Lead) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Lead) GetScoreUpdatedAt() string {
+	if x != nil {
+		return x.ScoreUpdatedAt
+	}
+	return ""
+}
+
+func (x *Lead) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Lead) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Lead) GetCompanyId() uint32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *Lead) GetAssignedAt() string {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return ""
+}
+
+func (x *Lead) GetAssignmentReason() string {
+	if x != nil {
+		return x.AssignmentReason
+	}
+	return ""
+}
+
 type CreateLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
@@ -2590,7 +3171,7 @@ type CreateLeadRequest struct {
 
 func (x *CreateLeadRequest) Reset() {
 	*x = CreateLeadRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[45]
+	mi := &file_api_proto_crm_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2602,7 +3183,7 @@ func (x *CreateLeadRequest) String() string {
 func (*CreateLeadRequest) ProtoMessage() {}
 
 func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[45]
+	mi := &file_api_proto_crm_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,7 +3196,7 @@ func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeadRequest.ProtoReflect.Descriptor instead.
 func (*CreateLeadRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{45}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CreateLeadRequest) GetLead() *Lead {
@@ -2634,7 +3215,7 @@ type CreateLeadResponse struct {
 
 func (x *CreateLeadResponse) Reset() {
 	*x = CreateLeadResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[46]
+	mi := &file_api_proto_crm_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2646,7 +3227,7 @@ func (x *CreateLeadResponse) String() string {
 func (*CreateLeadResponse) ProtoMessage() {}
 
 func (x *CreateLeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[46]
+	mi := &file_api_proto_crm_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,7 +3240,7 @@ func (x *CreateLeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeadResponse.ProtoReflect.Descriptor instead.
 func (*CreateLeadResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{46}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CreateLeadResponse) GetLead() *Lead {
@@ -2678,7 +3259,7 @@ type GetLeadRequest struct {
 
 func (x *GetLeadRequest) Reset() {
 	*x = GetLeadRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[47]
+	mi := &file_api_proto_crm_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2690,7 +3271,7 @@ func (x *GetLeadRequest) String() string {
 func (*GetLeadRequest) ProtoMessage() {}
 
 func (x *GetLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[47]
+	mi := &file_api_proto_crm_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,7 +3284,7 @@ func (x *GetLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeadRequest.ProtoReflect.Descriptor instead.
 func (*GetLeadRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{47}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetLeadRequest) GetId() uint32 {
@@ -2722,7 +3303,7 @@ type GetLeadResponse struct {
 
 func (x *GetLeadResponse) Reset() {
 	*x = GetLeadResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[48]
+	mi := &file_api_proto_crm_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2734,7 +3315,7 @@ func (x *GetLeadResponse) String() string {
 func (*GetLeadResponse) ProtoMessage() {}
 
 func (x *GetLeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[48]
+	mi := &file_api_proto_crm_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,7 +3328,7 @@ func (x *GetLeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeadResponse.ProtoReflect.Descriptor instead.
 func (*GetLeadResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{48}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetLeadResponse) GetLead() *Lead {
@@ -2766,7 +3347,7 @@ type UpdateLeadRequest struct {
 
 func (x *UpdateLeadRequest) Reset() {
 	*x = UpdateLeadRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[49]
+	mi := &file_api_proto_crm_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2778,7 +3359,7 @@ func (x *UpdateLeadRequest) String() string {
 func (*UpdateLeadRequest) ProtoMessage() {}
 
 func (x *UpdateLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[49]
+	mi := &file_api_proto_crm_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3372,7 @@ func (x *UpdateLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeadRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLeadRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{49}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdateLeadRequest) GetLead() *Lead {
@@ -2810,7 +3391,7 @@ type UpdateLeadResponse struct {
 
 func (x *UpdateLeadResponse) Reset() {
 	*x = UpdateLeadResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[50]
+	mi := &file_api_proto_crm_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2822,7 +3403,7 @@ func (x *UpdateLeadResponse) String() string {
 func (*UpdateLeadResponse) ProtoMessage() {}
 
 func (x *UpdateLeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[50]
+	mi := &file_api_proto_crm_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,7 +3416,7 @@ func (x *UpdateLeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeadResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLeadResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{50}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdateLeadResponse) GetLead() *Lead {
@@ -2854,7 +3435,7 @@ type DeleteLeadRequest struct {
 
 func (x *DeleteLeadRequest) Reset() {
 	*x = DeleteLeadRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[51]
+	mi := &file_api_proto_crm_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2866,7 +3447,7 @@ func (x *DeleteLeadRequest) String() string {
 func (*DeleteLeadRequest) ProtoMessage() {}
 
 func (x *DeleteLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[51]
+	mi := &file_api_proto_crm_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2879,7 +3460,7 @@ func (x *DeleteLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLeadRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLeadRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{51}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DeleteLeadRequest) GetId() uint32 {
@@ -2898,7 +3479,7 @@ type DeleteLeadResponse struct {
 
 func (x *DeleteLeadResponse) Reset() {
 	*x = DeleteLeadResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[52]
+	mi := &file_api_proto_crm_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2910,7 +3491,7 @@ func (x *DeleteLeadResponse) String() string {
 func (*DeleteLeadResponse) ProtoMessage() {}
 
 func (x *DeleteLeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[52]
+	mi := &file_api_proto_crm_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +3504,7 @@ func (x *DeleteLeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLeadResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLeadResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{52}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DeleteLeadResponse) GetSuccess() bool {
@@ -2934,14 +3515,22 @@ func (x *DeleteLeadResponse) GetSuccess() bool {
 }
 
 type GetAllLeadsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // any filterable field; created_at by default
+	Ascending         bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                            // overrides sort_by/ascending
+	PageToken         string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetAllLeadsRequest) Reset() {
 	*x = GetAllLeadsRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[53]
+	mi := &file_api_proto_crm_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2953,7 +3542,7 @@ func (x *GetAllLeadsRequest) String() string {
 func (*GetAllLeadsRequest) ProtoMessage() {}
 
 func (x *GetAllLeadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[53]
+	mi := &file_api_proto_crm_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,75 +3555,90 @@ func (x *GetAllLeadsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllLeadsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllLeadsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{53}
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{57}
 }
 
-type GetAllLeadsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Leads         []*Lead                `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAllLeadsRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
 }
 
-func (x *GetAllLeadsResponse) Reset() {
-	*x = GetAllLeadsResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetAllLeadsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *GetAllLeadsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetAllLeadsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
 }
 
-func (*GetAllLeadsResponse) ProtoMessage() {}
-
-func (x *GetAllLeadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[54]
+func (x *GetAllLeadsRequest) GetAscending() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ascending
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GetAllLeadsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllLeadsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{54}
+func (x *GetAllLeadsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (x *GetAllLeadsResponse) GetLeads() []*Lead {
+func (x *GetAllLeadsRequest) GetSort() []*SortField {
 	if x != nil {
-		return x.Leads
+		return x.Sort
 	}
 	return nil
 }
 
-type GetLeadByEmailRequest struct {
+func (x *GetAllLeadsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllLeadsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type GetAllLeadsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Leads         []*Lead                `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeadByEmailRequest) Reset() {
-	*x = GetLeadByEmailRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[55]
+func (x *GetAllLeadsResponse) Reset() {
+	*x = GetAllLeadsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeadByEmailRequest) String() string {
+func (x *GetAllLeadsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeadByEmailRequest) ProtoMessage() {}
+func (*GetAllLeadsResponse) ProtoMessage() {}
 
-func (x *GetLeadByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[55]
+func (x *GetAllLeadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3045,95 +3649,68 @@ func (x *GetLeadByEmailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeadByEmailRequest.ProtoReflect.Descriptor instead.
-func (*GetLeadByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use GetAllLeadsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllLeadsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetLeadByEmailRequest) GetEmail() string {
+func (x *GetAllLeadsResponse) GetLeads() []*Lead {
 	if x != nil {
-		return x.Email
+		return x.Leads
 	}
-	return ""
-}
-
-type GetLeadByEmailResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetLeadByEmailResponse) Reset() {
-	*x = GetLeadByEmailResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetLeadByEmailResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GetLeadByEmailResponse) ProtoMessage() {}
-
-func (x *GetLeadByEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[56]
+func (x *GetAllLeadsResponse) GetNextPageToken() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NextPageToken
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLeadByEmailResponse.ProtoReflect.Descriptor instead.
-func (*GetLeadByEmailResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{56}
+func (x *GetAllLeadsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
 }
 
-func (x *GetLeadByEmailResponse) GetLead() *Lead {
+func (x *GetAllLeadsResponse) GetTotalCount() uint32 {
 	if x != nil {
-		return x.Lead
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-type Opportunity struct {
+// Converts a lead in one transaction. Contact fields left empty are taken
+// from the lead. Set company to create a company, or company_id to link an
+// existing one; set opportunity to create an opportunity for the lead.
+type ConvertLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Stage         string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	CloseDate     string                 `protobuf:"bytes,6,opt,name=close_date,json=closeDate,proto3" json:"close_date,omitempty"`
-	Probability   float64                `protobuf:"fixed64,7,opt,name=probability,proto3" json:"probability,omitempty"`
-	LeadId        uint32                 `protobuf:"varint,8,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
-	AccountId     uint32                 `protobuf:"varint,9,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	OwnerId       uint32                 `protobuf:"varint,10,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	Company       *Company               `protobuf:"bytes,3,opt,name=company,proto3" json:"company,omitempty"`
+	CompanyId     uint32                 `protobuf:"varint,4,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Opportunity   *Opportunity           `protobuf:"bytes,5,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Opportunity) Reset() {
-	*x = Opportunity{}
-	mi := &file_api_proto_crm_proto_msgTypes[57]
+func (x *ConvertLeadRequest) Reset() {
+	*x = ConvertLeadRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Opportunity) String() string {
+func (x *ConvertLeadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Opportunity) ProtoMessage() {}
+func (*ConvertLeadRequest) ProtoMessage() {}
 
-func (x *Opportunity) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[57]
+func (x *ConvertLeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3144,117 +3721,138 @@ func (x *Opportunity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Opportunity.ProtoReflect.Descriptor instead.
-func (*Opportunity) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ConvertLeadRequest.ProtoReflect.Descriptor instead.
+func (*ConvertLeadRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Opportunity) GetId() uint32 {
+func (x *ConvertLeadRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Opportunity) GetName() string {
+func (x *ConvertLeadRequest) GetContact() *Contact {
 	if x != nil {
-		return x.Name
+		return x.Contact
 	}
-	return ""
+	return nil
 }
 
-func (x *Opportunity) GetDescription() string {
+func (x *ConvertLeadRequest) GetCompany() *Company {
 	if x != nil {
-		return x.Description
+		return x.Company
 	}
-	return ""
+	return nil
 }
 
-func (x *Opportunity) GetStage() string {
+func (x *ConvertLeadRequest) GetCompanyId() uint32 {
 	if x != nil {
-		return x.Stage
+		return x.CompanyId
 	}
-	return ""
+	return 0
 }
 
-func (x *Opportunity) GetAmount() float64 {
+func (x *ConvertLeadRequest) GetOpportunity() *Opportunity {
 	if x != nil {
-		return x.Amount
+		return x.Opportunity
 	}
-	return 0
+	return nil
 }
 
-func (x *Opportunity) GetCloseDate() string {
-	if x != nil {
-		return x.CloseDate
-	}
-	return ""
+type ConvertLeadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	Company       *Company               `protobuf:"bytes,3,opt,name=company,proto3" json:"company,omitempty"`
+	Opportunity   *Opportunity           `protobuf:"bytes,4,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Opportunity) GetProbability() float64 {
-	if x != nil {
-		return x.Probability
-	}
-	return 0
+func (x *ConvertLeadResponse) Reset() {
+	*x = ConvertLeadResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Opportunity) GetLeadId() uint32 {
+func (x *ConvertLeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertLeadResponse) ProtoMessage() {}
+
+func (x *ConvertLeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[60]
 	if x != nil {
-		return x.LeadId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Opportunity) GetAccountId() uint32 {
+// Deprecated: Use ConvertLeadResponse.ProtoReflect.Descriptor instead.
+func (*ConvertLeadResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ConvertLeadResponse) GetLead() *Lead {
 	if x != nil {
-		return x.AccountId
+		return x.Lead
 	}
-	return 0
+	return nil
 }
 
-func (x *Opportunity) GetOwnerId() uint32 {
+func (x *ConvertLeadResponse) GetContact() *Contact {
 	if x != nil {
-		return x.OwnerId
+		return x.Contact
 	}
-	return 0
+	return nil
 }
 
-func (x *Opportunity) GetCreatedAt() string {
+func (x *ConvertLeadResponse) GetCompany() *Company {
 	if x != nil {
-		return x.CreatedAt
+		return x.Company
 	}
-	return ""
+	return nil
 }
 
-func (x *Opportunity) GetUpdatedAt() string {
+func (x *ConvertLeadResponse) GetOpportunity() *Opportunity {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Opportunity
 	}
-	return ""
+	return nil
 }
 
-type CreateOpportunityRequest struct {
+type LeadStatusDefinition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Initial       bool                   `protobuf:"varint,2,opt,name=initial,proto3" json:"initial,omitempty"` // leads may be created with this status
+	Closed        bool                   `protobuf:"varint,3,opt,name=closed,proto3" json:"closed,omitempty"`   // leads in this status are no longer worked
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOpportunityRequest) Reset() {
-	*x = CreateOpportunityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[58]
+func (x *LeadStatusDefinition) Reset() {
+	*x = LeadStatusDefinition{}
+	mi := &file_api_proto_crm_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOpportunityRequest) String() string {
+func (x *LeadStatusDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOpportunityRequest) ProtoMessage() {}
+func (*LeadStatusDefinition) ProtoMessage() {}
 
-func (x *CreateOpportunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[58]
+func (x *LeadStatusDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3265,40 +3863,55 @@ func (x *CreateOpportunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOpportunityRequest.ProtoReflect.Descriptor instead.
-func (*CreateOpportunityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use LeadStatusDefinition.ProtoReflect.Descriptor instead.
+func (*LeadStatusDefinition) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CreateOpportunityRequest) GetOpportunity() *Opportunity {
+func (x *LeadStatusDefinition) GetName() string {
 	if x != nil {
-		return x.Opportunity
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateOpportunityResponse struct {
+func (x *LeadStatusDefinition) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+func (x *LeadStatusDefinition) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+type LeadStatusTransition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOpportunityResponse) Reset() {
-	*x = CreateOpportunityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[59]
+func (x *LeadStatusTransition) Reset() {
+	*x = LeadStatusTransition{}
+	mi := &file_api_proto_crm_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOpportunityResponse) String() string {
+func (x *LeadStatusTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOpportunityResponse) ProtoMessage() {}
+func (*LeadStatusTransition) ProtoMessage() {}
 
-func (x *CreateOpportunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[59]
+func (x *LeadStatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3309,40 +3922,50 @@ func (x *CreateOpportunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOpportunityResponse.ProtoReflect.Descriptor instead.
-func (*CreateOpportunityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use LeadStatusTransition.ProtoReflect.Descriptor instead.
+func (*LeadStatusTransition) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *CreateOpportunityResponse) GetOpportunity() *Opportunity {
+func (x *LeadStatusTransition) GetFrom() string {
 	if x != nil {
-		return x.Opportunity
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-type GetOpportunityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LeadStatusTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
 }
 
-func (x *GetOpportunityRequest) Reset() {
-	*x = GetOpportunityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[60]
+type LeadWorkflow struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	OrganizationId uint32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Statuses       []*LeadStatusDefinition `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions    []*LeadStatusTransition `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	Default        bool                    `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"` // the organization uses the built-in workflow
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeadWorkflow) Reset() {
+	*x = LeadWorkflow{}
+	mi := &file_api_proto_crm_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpportunityRequest) String() string {
+func (x *LeadWorkflow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpportunityRequest) ProtoMessage() {}
+func (*LeadWorkflow) ProtoMessage() {}
 
-func (x *GetOpportunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[60]
+func (x *LeadWorkflow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3353,40 +3976,214 @@ func (x *GetOpportunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpportunityRequest.ProtoReflect.Descriptor instead.
-func (*GetOpportunityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use LeadWorkflow.ProtoReflect.Descriptor instead.
+func (*LeadWorkflow) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetOpportunityRequest) GetId() uint32 {
+func (x *LeadWorkflow) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *LeadWorkflow) GetStatuses() []*LeadStatusDefinition {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *LeadWorkflow) GetTransitions() []*LeadStatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *LeadWorkflow) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+type GetLeadWorkflowRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeadWorkflowRequest) Reset() {
+	*x = GetLeadWorkflowRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeadWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeadWorkflowRequest) ProtoMessage() {}
+
+func (x *GetLeadWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeadWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*GetLeadWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetLeadWorkflowRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+// Replaces the organization's workflow. Statuses are listed in display
+// order and must include Converted. With no statuses and no transitions
+// the organization reverts to the default workflow.
+type SetLeadWorkflowRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	OrganizationId uint32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Statuses       []*LeadStatusDefinition `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions    []*LeadStatusTransition `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetLeadWorkflowRequest) Reset() {
+	*x = SetLeadWorkflowRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLeadWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLeadWorkflowRequest) ProtoMessage() {}
+
+func (x *SetLeadWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLeadWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*SetLeadWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetLeadWorkflowRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *SetLeadWorkflowRequest) GetStatuses() []*LeadStatusDefinition {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SetLeadWorkflowRequest) GetTransitions() []*LeadStatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type GetLeadStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeadStatusHistoryRequest) Reset() {
+	*x = GetLeadStatusHistoryRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeadStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeadStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetLeadStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeadStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLeadStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetLeadStatusHistoryRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetOpportunityResponse struct {
+type LeadStatusChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // empty for the status the lead was created with
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	EnteredAt     string                 `protobuf:"bytes,3,opt,name=entered_at,json=enteredAt,proto3" json:"entered_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpportunityResponse) Reset() {
-	*x = GetOpportunityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[61]
+func (x *LeadStatusChange) Reset() {
+	*x = LeadStatusChange{}
+	mi := &file_api_proto_crm_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpportunityResponse) String() string {
+func (x *LeadStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpportunityResponse) ProtoMessage() {}
+func (*LeadStatusChange) ProtoMessage() {}
 
-func (x *GetOpportunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[61]
+func (x *LeadStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3397,40 +4194,54 @@ func (x *GetOpportunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpportunityResponse.ProtoReflect.Descriptor instead.
-func (*GetOpportunityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use LeadStatusChange.ProtoReflect.Descriptor instead.
+func (*LeadStatusChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetOpportunityResponse) GetOpportunity() *Opportunity {
+func (x *LeadStatusChange) GetFromStatus() string {
 	if x != nil {
-		return x.Opportunity
+		return x.FromStatus
 	}
-	return nil
+	return ""
 }
 
-type UpdateOpportunityRequest struct {
+func (x *LeadStatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *LeadStatusChange) GetEnteredAt() string {
+	if x != nil {
+		return x.EnteredAt
+	}
+	return ""
+}
+
+type GetLeadStatusHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	History       []*LeadStatusChange    `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOpportunityRequest) Reset() {
-	*x = UpdateOpportunityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[62]
+func (x *GetLeadStatusHistoryResponse) Reset() {
+	*x = GetLeadStatusHistoryResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOpportunityRequest) String() string {
+func (x *GetLeadStatusHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOpportunityRequest) ProtoMessage() {}
+func (*GetLeadStatusHistoryResponse) ProtoMessage() {}
 
-func (x *UpdateOpportunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[62]
+func (x *GetLeadStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3441,40 +4252,40 @@ func (x *UpdateOpportunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOpportunityRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOpportunityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetLeadStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLeadStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateOpportunityRequest) GetOpportunity() *Opportunity {
+func (x *GetLeadStatusHistoryResponse) GetHistory() []*LeadStatusChange {
 	if x != nil {
-		return x.Opportunity
+		return x.History
 	}
 	return nil
 }
 
-type UpdateOpportunityResponse struct {
+type GetLeadByEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOpportunityResponse) Reset() {
-	*x = UpdateOpportunityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[63]
+func (x *GetLeadByEmailRequest) Reset() {
+	*x = GetLeadByEmailRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOpportunityResponse) String() string {
+func (x *GetLeadByEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOpportunityResponse) ProtoMessage() {}
+func (*GetLeadByEmailRequest) ProtoMessage() {}
 
-func (x *UpdateOpportunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[63]
+func (x *GetLeadByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3485,128 +4296,12159 @@ func (x *UpdateOpportunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOpportunityResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOpportunityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use GetLeadByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetLeadByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateOpportunityResponse) GetOpportunity() *Opportunity {
+func (x *GetLeadByEmailRequest) GetEmail() string {
 	if x != nil {
-		return x.Opportunity
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type DeleteOpportunityRequest struct {
+type GetLeadByEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOpportunityRequest) Reset() {
-	*x = DeleteOpportunityRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[64]
+func (x *GetLeadByEmailResponse) Reset() {
+	*x = GetLeadByEmailResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeadByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeadByEmailResponse) ProtoMessage() {}
+
+func (x *GetLeadByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeadByEmailResponse.ProtoReflect.Descriptor instead.
+func (*GetLeadByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetLeadByEmailResponse) GetLead() *Lead {
+	if x != nil {
+		return x.Lead
+	}
+	return nil
+}
+
+// A lead's score is the sum of the weights of the enabled rules it matches.
+// rule_type is one of:
+//
+//	field          - field (first_name, last_name, email, email_domain, phone,
+//	                 status, assigned_to, organization_id) compared with value
+//	                 using equals, not_equals, contains, starts_with,
+//	                 ends_with, is_set or is_not_set, ignoring case
+//	activity_count - activities on the contact the lead was converted to,
+//	                 compared with value using equals, gt, gte, lt or lte
+//	company_domain - the lead's email domain is a known company's website
+type ScoringRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // 0 applies to every organization
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType       string                 `protobuf:"bytes,4,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	Field          string                 `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Operator       string                 `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value          string                 `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	Weight         int32                  `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"` // may be negative
+	Enabled        bool                   `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScoringRule) Reset() {
+	*x = ScoringRule{}
+	mi := &file_api_proto_crm_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOpportunityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScoringRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoringRule) ProtoMessage() {}
+
+func (x *ScoringRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoringRule.ProtoReflect.Descriptor instead.
+func (*ScoringRule) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ScoringRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScoringRule) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ScoringRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScoringRule) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *ScoringRule) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ScoringRule) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ScoringRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ScoringRule) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ScoringRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ScoringRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ScoringRule) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateScoringRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *ScoringRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScoringRuleRequest) Reset() {
+	*x = CreateScoringRuleRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScoringRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScoringRuleRequest) ProtoMessage() {}
+
+func (x *CreateScoringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScoringRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScoringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateScoringRuleRequest) GetRule() *ScoringRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateScoringRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *ScoringRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScoringRuleRequest) Reset() {
+	*x = UpdateScoringRuleRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScoringRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScoringRuleRequest) ProtoMessage() {}
+
+func (x *UpdateScoringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScoringRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScoringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateScoringRuleRequest) GetRule() *ScoringRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteScoringRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScoringRuleRequest) Reset() {
+	*x = DeleteScoringRuleRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScoringRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScoringRuleRequest) ProtoMessage() {}
+
+func (x *DeleteScoringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScoringRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScoringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteScoringRuleRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteScoringRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScoringRuleResponse) Reset() {
+	*x = DeleteScoringRuleResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScoringRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScoringRuleResponse) ProtoMessage() {}
+
+func (x *DeleteScoringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScoringRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScoringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteScoringRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListScoringRulesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrganizationId    uint32                 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional filter by Organization
+	Filter            *FilterGroup           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListScoringRulesRequest) Reset() {
+	*x = ListScoringRulesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScoringRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScoringRulesRequest) ProtoMessage() {}
+
+func (x *ListScoringRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScoringRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListScoringRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListScoringRulesRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListScoringRulesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScoringRulesRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ListScoringRulesRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListScoringRulesRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListScoringRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListScoringRulesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListScoringRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ScoringRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScoringRulesResponse) Reset() {
+	*x = ListScoringRulesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScoringRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScoringRulesResponse) ProtoMessage() {}
+
+func (x *ListScoringRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScoringRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListScoringRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListScoringRulesResponse) GetRules() []*ScoringRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListScoringRulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListScoringRulesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListScoringRulesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RecomputeLeadScoresRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // 0 rescores every lead
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecomputeLeadScoresRequest) Reset() {
+	*x = RecomputeLeadScoresRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeLeadScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeLeadScoresRequest) ProtoMessage() {}
+
+func (x *RecomputeLeadScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeLeadScoresRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeLeadScoresRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RecomputeLeadScoresRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type RecomputeLeadScoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scored        uint32                 `protobuf:"varint,1,opt,name=scored,proto3" json:"scored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeLeadScoresResponse) Reset() {
+	*x = RecomputeLeadScoresResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeLeadScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeLeadScoresResponse) ProtoMessage() {}
+
+func (x *RecomputeLeadScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeLeadScoresResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeLeadScoresResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RecomputeLeadScoresResponse) GetScored() uint32 {
+	if x != nil {
+		return x.Scored
+	}
+	return 0
+}
+
+type SalesTeamMember struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Active         bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	LastAssignedAt string                 `protobuf:"bytes,3,opt,name=last_assigned_at,json=lastAssignedAt,proto3" json:"last_assigned_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SalesTeamMember) Reset() {
+	*x = SalesTeamMember{}
+	mi := &file_api_proto_crm_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesTeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesTeamMember) ProtoMessage() {}
+
+func (x *SalesTeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesTeamMember.ProtoReflect.Descriptor instead.
+func (*SalesTeamMember) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SalesTeamMember) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SalesTeamMember) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SalesTeamMember) GetLastAssignedAt() string {
+	if x != nil {
+		return x.LastAssignedAt
+	}
+	return ""
+}
+
+type SalesTeam struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Members        []*SalesTeamMember     `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SalesTeam) Reset() {
+	*x = SalesTeam{}
+	mi := &file_api_proto_crm_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesTeam) ProtoMessage() {}
+
+func (x *SalesTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesTeam.ProtoReflect.Descriptor instead.
+func (*SalesTeam) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SalesTeam) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SalesTeam) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *SalesTeam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SalesTeam) GetMembers() []*SalesTeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SalesTeam) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSalesTeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSalesTeamRequest) Reset() {
+	*x = CreateSalesTeamRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSalesTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSalesTeamRequest) ProtoMessage() {}
+
+func (x *CreateSalesTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSalesTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateSalesTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateSalesTeamRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *CreateSalesTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListSalesTeamsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSalesTeamsRequest) Reset() {
+	*x = ListSalesTeamsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSalesTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSalesTeamsRequest) ProtoMessage() {}
+
+func (x *ListSalesTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSalesTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListSalesTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListSalesTeamsRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type ListSalesTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*SalesTeam           `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSalesTeamsResponse) Reset() {
+	*x = ListSalesTeamsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSalesTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSalesTeamsResponse) ProtoMessage() {}
+
+func (x *ListSalesTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSalesTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListSalesTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListSalesTeamsResponse) GetTeams() []*SalesTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type DeleteSalesTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSalesTeamRequest) Reset() {
+	*x = DeleteSalesTeamRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSalesTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSalesTeamRequest) ProtoMessage() {}
+
+func (x *DeleteSalesTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSalesTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSalesTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteSalesTeamRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSalesTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSalesTeamResponse) Reset() {
+	*x = DeleteSalesTeamResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSalesTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSalesTeamResponse) ProtoMessage() {}
+
+func (x *DeleteSalesTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSalesTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSalesTeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteSalesTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetSalesTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        uint32                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"` // inactive members receive no leads
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSalesTeamMemberRequest) Reset() {
+	*x = SetSalesTeamMemberRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSalesTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSalesTeamMemberRequest) ProtoMessage() {}
+
+func (x *SetSalesTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSalesTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*SetSalesTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetSalesTeamMemberRequest) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *SetSalesTeamMemberRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetSalesTeamMemberRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type RemoveSalesTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        uint32                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSalesTeamMemberRequest) Reset() {
+	*x = RemoveSalesTeamMemberRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSalesTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSalesTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveSalesTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSalesTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSalesTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RemoveSalesTeamMemberRequest) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *RemoveSalesTeamMemberRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveSalesTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSalesTeamMemberResponse) Reset() {
+	*x = RemoveSalesTeamMemberResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSalesTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSalesTeamMemberResponse) ProtoMessage() {}
+
+func (x *RemoveSalesTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSalesTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSalesTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RemoveSalesTeamMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Country, state and industry narrow the leads a rule applies to; empty
+// ones match any lead. A lead's country and state fall back to those of its
+// company, which also provides the industry. strategy is round_robin or
+// least_loaded (fewest open leads).
+type AssignmentRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Priority       int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"` // lower runs first
+	Strategy       string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	TeamId         uint32                 `protobuf:"varint,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Country        string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	State          string                 `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+	Industry       string                 `protobuf:"bytes,9,opt,name=industry,proto3" json:"industry,omitempty"`
+	Enabled        bool                   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignmentRule) Reset() {
+	*x = AssignmentRule{}
+	mi := &file_api_proto_crm_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentRule) ProtoMessage() {}
+
+func (x *AssignmentRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentRule.ProtoReflect.Descriptor instead.
+func (*AssignmentRule) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AssignmentRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignmentRule) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *AssignmentRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssignmentRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *AssignmentRule) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AssignmentRule) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *AssignmentRule) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AssignmentRule) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AssignmentRule) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *AssignmentRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AssignmentRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AssignmentRule) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateAssignmentRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AssignmentRule        `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentRuleRequest) Reset() {
+	*x = CreateAssignmentRuleRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRuleRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateAssignmentRuleRequest) GetRule() *AssignmentRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAssignmentRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AssignmentRule        `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentRuleRequest) Reset() {
+	*x = UpdateAssignmentRuleRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateAssignmentRuleRequest) GetRule() *AssignmentRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteAssignmentRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentRuleRequest) Reset() {
+	*x = DeleteAssignmentRuleRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteAssignmentRuleRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAssignmentRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentRuleResponse) Reset() {
+	*x = DeleteAssignmentRuleResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAssignmentRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteAssignmentRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAssignmentRulesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAssignmentRulesRequest) Reset() {
+	*x = ListAssignmentRulesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentRulesRequest) ProtoMessage() {}
+
+func (x *ListAssignmentRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListAssignmentRulesRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type ListAssignmentRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AssignmentRule      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentRulesResponse) Reset() {
+	*x = ListAssignmentRulesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentRulesResponse) ProtoMessage() {}
+
+func (x *ListAssignmentRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListAssignmentRulesResponse) GetRules() []*AssignmentRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type AssignLeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeadId        uint32                 `protobuf:"varint,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignLeadRequest) Reset() {
+	*x = AssignLeadRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignLeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignLeadRequest) ProtoMessage() {}
+
+func (x *AssignLeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignLeadRequest.ProtoReflect.Descriptor instead.
+func (*AssignLeadRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AssignLeadRequest) GetLeadId() uint32 {
+	if x != nil {
+		return x.LeadId
+	}
+	return 0
+}
+
+type AssignLeadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignLeadResponse) Reset() {
+	*x = AssignLeadResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignLeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignLeadResponse) ProtoMessage() {}
+
+func (x *AssignLeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignLeadResponse.ProtoReflect.Descriptor instead.
+func (*AssignLeadResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AssignLeadResponse) GetLead() *Lead {
+	if x != nil {
+		return x.Lead
+	}
+	return nil
+}
+
+type FindDuplicatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EntityType     string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`              // "contact" or "lead"
+	OrganizationId uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional filter, leads only
+	MaxClusters    uint32                 `protobuf:"varint,3,opt,name=max_clusters,json=maxClusters,proto3" json:"max_clusters,omitempty"`          // 0 returns every cluster
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FindDuplicatesRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *FindDuplicatesRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *FindDuplicatesRequest) GetMaxClusters() uint32 {
+	if x != nil {
+		return x.MaxClusters
+	}
+	return 0
+}
+
+type DuplicateCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_api_proto_crm_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCandidate) ProtoMessage() {}
+
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DuplicateCandidate) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DuplicateCandidate) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *DuplicateCandidate) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *DuplicateCandidate) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DuplicateCandidate) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+// reasons are email_case, plus_addressing, phone and similar_name.
+type DuplicateMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdA           uint32                 `protobuf:"varint,1,opt,name=id_a,json=idA,proto3" json:"id_a,omitempty"`
+	IdB           uint32                 `protobuf:"varint,2,opt,name=id_b,json=idB,proto3" json:"id_b,omitempty"`
+	Reasons       []string               `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"` // 0-1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMatch) Reset() {
+	*x = DuplicateMatch{}
+	mi := &file_api_proto_crm_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMatch) ProtoMessage() {}
+
+func (x *DuplicateMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMatch.ProtoReflect.Descriptor instead.
+func (*DuplicateMatch) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DuplicateMatch) GetIdA() uint32 {
+	if x != nil {
+		return x.IdA
+	}
+	return 0
+}
+
+func (x *DuplicateMatch) GetIdB() uint32 {
+	if x != nil {
+		return x.IdB
+	}
+	return 0
+}
+
+func (x *DuplicateMatch) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *DuplicateMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type DuplicateCluster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*DuplicateCandidate  `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Matches       []*DuplicateMatch      `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"` // of the strongest match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCluster) Reset() {
+	*x = DuplicateCluster{}
+	mi := &file_api_proto_crm_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCluster) ProtoMessage() {}
+
+func (x *DuplicateCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCluster.ProtoReflect.Descriptor instead.
+func (*DuplicateCluster) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DuplicateCluster) GetRecords() []*DuplicateCandidate {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *DuplicateCluster) GetMatches() []*DuplicateMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *DuplicateCluster) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type FindDuplicatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*DuplicateCluster    `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *FindDuplicatesResponse) GetClusters() []*DuplicateCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// Merges merged_ids into survivor_id and deletes them. Empty survivor fields
+// are filled from the merged records in order; related records move to the
+// survivor.
+type MergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SurvivorId    uint32                 `protobuf:"varint,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedIds     []uint32               `protobuf:"varint,2,rep,packed,name=merged_ids,json=mergedIds,proto3" json:"merged_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRequest) Reset() {
+	*x = MergeRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequest) ProtoMessage() {}
+
+func (x *MergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequest.ProtoReflect.Descriptor instead.
+func (*MergeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *MergeRequest) GetSurvivorId() uint32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *MergeRequest) GetMergedIds() []uint32 {
+	if x != nil {
+		return x.MergedIds
+	}
+	return nil
+}
+
+type MergeAudit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType    string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	SurvivorId    uint32                 `protobuf:"varint,3,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	MergedId      uint32                 `protobuf:"varint,4,opt,name=merged_id,json=mergedId,proto3" json:"merged_id,omitempty"`
+	MergedRecord  string                 `protobuf:"bytes,5,opt,name=merged_record,json=mergedRecord,proto3" json:"merged_record,omitempty"`                                                                           // JSON of the merged record before deletion
+	FilledFields  map[string]string      `protobuf:"bytes,6,rep,name=filled_fields,json=filledFields,proto3" json:"filled_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // survivor fields taken from it
+	Repointed     map[string]int64       `protobuf:"bytes,7,rep,name=repointed,proto3" json:"repointed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                          // related rows moved, by table
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAudit) Reset() {
+	*x = MergeAudit{}
+	mi := &file_api_proto_crm_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAudit) ProtoMessage() {}
+
+func (x *MergeAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAudit.ProtoReflect.Descriptor instead.
+func (*MergeAudit) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *MergeAudit) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergeAudit) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *MergeAudit) GetSurvivorId() uint32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *MergeAudit) GetMergedId() uint32 {
+	if x != nil {
+		return x.MergedId
+	}
+	return 0
+}
+
+func (x *MergeAudit) GetMergedRecord() string {
+	if x != nil {
+		return x.MergedRecord
+	}
+	return ""
+}
+
+func (x *MergeAudit) GetFilledFields() map[string]string {
+	if x != nil {
+		return x.FilledFields
+	}
+	return nil
+}
+
+func (x *MergeAudit) GetRepointed() map[string]int64 {
+	if x != nil {
+		return x.Repointed
+	}
+	return nil
+}
+
+func (x *MergeAudit) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type MergeContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	Audits        []*MergeAudit          `protobuf:"bytes,2,rep,name=audits,proto3" json:"audits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeContactsResponse) Reset() {
+	*x = MergeContactsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeContactsResponse) ProtoMessage() {}
+
+func (x *MergeContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeContactsResponse.ProtoReflect.Descriptor instead.
+func (*MergeContactsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MergeContactsResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *MergeContactsResponse) GetAudits() []*MergeAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type MergeLeadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
+	Audits        []*MergeAudit          `protobuf:"bytes,2,rep,name=audits,proto3" json:"audits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeLeadsResponse) Reset() {
+	*x = MergeLeadsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeLeadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeLeadsResponse) ProtoMessage() {}
+
+func (x *MergeLeadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeLeadsResponse.ProtoReflect.Descriptor instead.
+func (*MergeLeadsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *MergeLeadsResponse) GetLead() *Lead {
+	if x != nil {
+		return x.Lead
+	}
+	return nil
+}
+
+func (x *MergeLeadsResponse) GetAudits() []*MergeAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type ListMergeAuditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	SurvivorId    uint32                 `protobuf:"varint,2,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMergeAuditsRequest) Reset() {
+	*x = ListMergeAuditsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMergeAuditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMergeAuditsRequest) ProtoMessage() {}
+
+func (x *ListMergeAuditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMergeAuditsRequest.ProtoReflect.Descriptor instead.
+func (*ListMergeAuditsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListMergeAuditsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListMergeAuditsRequest) GetSurvivorId() uint32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+type ListMergeAuditsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audits        []*MergeAudit          `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMergeAuditsResponse) Reset() {
+	*x = ListMergeAuditsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMergeAuditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMergeAuditsResponse) ProtoMessage() {}
+
+func (x *ListMergeAuditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMergeAuditsResponse.ProtoReflect.Descriptor instead.
+func (*ListMergeAuditsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListMergeAuditsResponse) GetAudits() []*MergeAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type Opportunity struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Stage                 string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	Amount                float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CloseDate             string                 `protobuf:"bytes,6,opt,name=close_date,json=closeDate,proto3" json:"close_date,omitempty"`
+	Probability           float64                `protobuf:"fixed64,7,opt,name=probability,proto3" json:"probability,omitempty"`
+	LeadId                uint32                 `protobuf:"varint,8,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	AccountId             uint32                 `protobuf:"varint,9,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // company the opportunity is with
+	OwnerId               uint32                 `protobuf:"varint,10,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CreatedAt             string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PipelineId            uint32                 `protobuf:"varint,13,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`                                  // 0: the organization's default pipeline
+	ProbabilityOverridden bool                   `protobuf:"varint,14,opt,name=probability_overridden,json=probabilityOverridden,proto3" json:"probability_overridden,omitempty"` // probability was entered by hand, not taken from the stage
+	Currency              string                 `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`                                                         // ISO 4217; defaults to the organization's reporting currency
+	LossReason            string                 `protobuf:"bytes,16,opt,name=loss_reason,json=lossReason,proto3" json:"loss_reason,omitempty"`                                   // set by CloseOpportunityLost
+	Competitor            string                 `protobuf:"bytes,17,opt,name=competitor,proto3" json:"competitor,omitempty"`
+	ClosedAt              string                 `protobuf:"bytes,18,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`              // when the opportunity was closed as won or lost
+	AmountLocked          bool                   `protobuf:"varint,19,opt,name=amount_locked,json=amountLocked,proto3" json:"amount_locked,omitempty"` // closed; the amount and line items can no longer change
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Opportunity) Reset() {
+	*x = Opportunity{}
+	mi := &file_api_proto_crm_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Opportunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opportunity) ProtoMessage() {}
+
+func (x *Opportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opportunity.ProtoReflect.Descriptor instead.
+func (*Opportunity) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Opportunity) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Opportunity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Opportunity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Opportunity) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *Opportunity) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Opportunity) GetCloseDate() string {
+	if x != nil {
+		return x.CloseDate
+	}
+	return ""
+}
+
+func (x *Opportunity) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *Opportunity) GetLeadId() uint32 {
+	if x != nil {
+		return x.LeadId
+	}
+	return 0
+}
+
+func (x *Opportunity) GetAccountId() uint32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Opportunity) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Opportunity) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Opportunity) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Opportunity) GetPipelineId() uint32 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+func (x *Opportunity) GetProbabilityOverridden() bool {
+	if x != nil {
+		return x.ProbabilityOverridden
+	}
+	return false
+}
+
+func (x *Opportunity) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Opportunity) GetLossReason() string {
+	if x != nil {
+		return x.LossReason
+	}
+	return ""
+}
+
+func (x *Opportunity) GetCompetitor() string {
+	if x != nil {
+		return x.Competitor
+	}
+	return ""
+}
+
+func (x *Opportunity) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *Opportunity) GetAmountLocked() bool {
+	if x != nil {
+		return x.AmountLocked
+	}
+	return false
+}
+
+type CreateOpportunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOpportunityRequest) Reset() {
+	*x = CreateOpportunityRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOpportunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOpportunityRequest) ProtoMessage() {}
+
+func (x *CreateOpportunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOpportunityRequest.ProtoReflect.Descriptor instead.
+func (*CreateOpportunityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CreateOpportunityRequest) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+type CreateOpportunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOpportunityResponse) Reset() {
+	*x = CreateOpportunityResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOpportunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOpportunityResponse) ProtoMessage() {}
+
+func (x *CreateOpportunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOpportunityResponse.ProtoReflect.Descriptor instead.
+func (*CreateOpportunityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CreateOpportunityResponse) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+type GetOpportunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpportunityRequest) Reset() {
+	*x = GetOpportunityRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpportunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpportunityRequest) ProtoMessage() {}
+
+func (x *GetOpportunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpportunityRequest.ProtoReflect.Descriptor instead.
+func (*GetOpportunityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetOpportunityRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetOpportunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpportunityResponse) Reset() {
+	*x = GetOpportunityResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpportunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpportunityResponse) ProtoMessage() {}
+
+func (x *GetOpportunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpportunityResponse.ProtoReflect.Descriptor instead.
+func (*GetOpportunityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetOpportunityResponse) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+// The amount of an opportunity with line items is their sum; amount is
+// ignored for those.
+type UpdateOpportunityRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity      *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	ResetProbability bool                   `protobuf:"varint,2,opt,name=reset_probability,json=resetProbability,proto3" json:"reset_probability,omitempty"` // take the probability from the stage again
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateOpportunityRequest) Reset() {
+	*x = UpdateOpportunityRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOpportunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOpportunityRequest) ProtoMessage() {}
+
+func (x *UpdateOpportunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOpportunityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOpportunityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *UpdateOpportunityRequest) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+func (x *UpdateOpportunityRequest) GetResetProbability() bool {
+	if x != nil {
+		return x.ResetProbability
+	}
+	return false
+}
+
+type UpdateOpportunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOpportunityResponse) Reset() {
+	*x = UpdateOpportunityResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOpportunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOpportunityResponse) ProtoMessage() {}
+
+func (x *UpdateOpportunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOpportunityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOpportunityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *UpdateOpportunityResponse) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+type DeleteOpportunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOpportunityRequest) Reset() {
+	*x = DeleteOpportunityRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOpportunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOpportunityRequest) ProtoMessage() {}
+
+func (x *DeleteOpportunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOpportunityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOpportunityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DeleteOpportunityRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOpportunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOpportunityResponse) Reset() {
+	*x = DeleteOpportunityResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOpportunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOpportunityResponse) ProtoMessage() {}
+
+func (x *DeleteOpportunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOpportunityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOpportunityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *DeleteOpportunityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListOpportunitiesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId           uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                      // Optional filter
+	OrganizationId    uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // totals are in its reporting currency
+	Currency          string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                    // totals currency, overrides organization_id
+	Filter            *FilterGroup           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                        // totals are of all filtered opportunities, not only the page
+	Sort              []*SortField           `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListOpportunitiesRequest) Reset() {
+	*x = ListOpportunitiesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpportunitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpportunitiesRequest) ProtoMessage() {}
+
+func (x *ListOpportunitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpportunitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListOpportunitiesRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ListOpportunitiesRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ListOpportunitiesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListOpportunitiesRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListOpportunitiesRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListOpportunitiesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOpportunitiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOpportunitiesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// Totals are converted at the rate in effect on each close date.
+type ListOpportunitiesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Opportunities       []*Opportunity         `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	TotalAmount         float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalWeightedAmount float64                `protobuf:"fixed64,3,opt,name=total_weighted_amount,json=totalWeightedAmount,proto3" json:"total_weighted_amount,omitempty"`
+	Currency            string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	NextPageToken       string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken       string                 `protobuf:"bytes,6,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount          uint32                 `protobuf:"varint,7,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListOpportunitiesResponse) Reset() {
+	*x = ListOpportunitiesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpportunitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpportunitiesResponse) ProtoMessage() {}
+
+func (x *ListOpportunitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpportunitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListOpportunitiesResponse) GetOpportunities() []*Opportunity {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
+func (x *ListOpportunitiesResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *ListOpportunitiesResponse) GetTotalWeightedAmount() float64 {
+	if x != nil {
+		return x.TotalWeightedAmount
+	}
+	return 0
+}
+
+func (x *ListOpportunitiesResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListOpportunitiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListOpportunitiesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListOpportunitiesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetOpportunityHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpportunityHistoryRequest) Reset() {
+	*x = GetOpportunityHistoryRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpportunityHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpportunityHistoryRequest) ProtoMessage() {}
+
+func (x *GetOpportunityHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpportunityHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOpportunityHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetOpportunityHistoryRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type OpportunityChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                       // stage, amount, probability or close_date
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // empty for the value the opportunity was created with
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpportunityChange) Reset() {
+	*x = OpportunityChange{}
+	mi := &file_api_proto_crm_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpportunityChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpportunityChange) ProtoMessage() {}
+
+func (x *OpportunityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpportunityChange.ProtoReflect.Descriptor instead.
+func (*OpportunityChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *OpportunityChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *OpportunityChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *OpportunityChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *OpportunityChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type GetOpportunityHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*OpportunityChange   `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpportunityHistoryResponse) Reset() {
+	*x = GetOpportunityHistoryResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpportunityHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpportunityHistoryResponse) ProtoMessage() {}
+
+func (x *GetOpportunityHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpportunityHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOpportunityHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetOpportunityHistoryResponse) GetHistory() []*OpportunityChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type GetOpportunityMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId    uint32                 `protobuf:"varint,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`      // 0: opportunities on the built-in pipeline
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`               // Optional filter
+	CreatedSince  string                 `protobuf:"bytes,3,opt,name=created_since,json=createdSince,proto3" json:"created_since,omitempty"` // Optional, YYYY-MM-DD or RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpportunityMetricsRequest) Reset() {
+	*x = GetOpportunityMetricsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpportunityMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpportunityMetricsRequest) ProtoMessage() {}
+
+func (x *GetOpportunityMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpportunityMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetOpportunityMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetOpportunityMetricsRequest) GetPipelineId() uint32 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+func (x *GetOpportunityMetricsRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *GetOpportunityMetricsRequest) GetCreatedSince() string {
+	if x != nil {
+		return x.CreatedSince
+	}
+	return ""
+}
+
+type StageMetrics struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Stage          string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Entered        uint32                 `protobuf:"varint,2,opt,name=entered,proto3" json:"entered,omitempty"`                                      // opportunities that were in the stage
+	AverageDays    float64                `protobuf:"fixed64,3,opt,name=average_days,json=averageDays,proto3" json:"average_days,omitempty"`          // over stays that ended
+	ConversionRate float64                `protobuf:"fixed64,4,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"` // share that later reached a later, non-lost stage
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StageMetrics) Reset() {
+	*x = StageMetrics{}
+	mi := &file_api_proto_crm_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageMetrics) ProtoMessage() {}
+
+func (x *StageMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageMetrics.ProtoReflect.Descriptor instead.
+func (*StageMetrics) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *StageMetrics) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *StageMetrics) GetEntered() uint32 {
+	if x != nil {
+		return x.Entered
+	}
+	return 0
+}
+
+func (x *StageMetrics) GetAverageDays() float64 {
+	if x != nil {
+		return x.AverageDays
+	}
+	return 0
+}
+
+func (x *StageMetrics) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+type OwnerWinRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // 0: opportunities without an owner
+	Won           uint32                 `protobuf:"varint,2,opt,name=won,proto3" json:"won,omitempty"`
+	Lost          uint32                 `protobuf:"varint,3,opt,name=lost,proto3" json:"lost,omitempty"`
+	WinRate       float64                `protobuf:"fixed64,4,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerWinRate) Reset() {
+	*x = OwnerWinRate{}
+	mi := &file_api_proto_crm_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerWinRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerWinRate) ProtoMessage() {}
+
+func (x *OwnerWinRate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerWinRate.ProtoReflect.Descriptor instead.
+func (*OwnerWinRate) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *OwnerWinRate) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *OwnerWinRate) GetWon() uint32 {
+	if x != nil {
+		return x.Won
+	}
+	return 0
+}
+
+func (x *OwnerWinRate) GetLost() uint32 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *OwnerWinRate) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+type OpportunityMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pipeline      *Pipeline              `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Stages        []*StageMetrics        `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"` // in pipeline order
+	Owners        []*OwnerWinRate        `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpportunityMetrics) Reset() {
+	*x = OpportunityMetrics{}
+	mi := &file_api_proto_crm_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpportunityMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpportunityMetrics) ProtoMessage() {}
+
+func (x *OpportunityMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpportunityMetrics.ProtoReflect.Descriptor instead.
+func (*OpportunityMetrics) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *OpportunityMetrics) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *OpportunityMetrics) GetStages() []*StageMetrics {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *OpportunityMetrics) GetOwners() []*OwnerWinRate {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+// total is quantity * unit_price less discount_percent, plus tax_percent
+// on the discounted price.
+type OpportunityLineItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OpportunityId   uint32                 `protobuf:"varint,2,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	ProductId       uint32                 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PriceBookId     uint32                 `protobuf:"varint,4,opt,name=price_book_id,json=priceBookId,proto3" json:"price_book_id,omitempty"` // book the unit price was taken from, 0 if none
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity        float64                `protobuf:"fixed64,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice       *float64               `protobuf:"fixed64,7,opt,name=unit_price,json=unitPrice,proto3,oneof" json:"unit_price,omitempty"` // defaults to the price book or list price
+	DiscountPercent float64                `protobuf:"fixed64,8,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	TaxPercent      float64                `protobuf:"fixed64,9,opt,name=tax_percent,json=taxPercent,proto3" json:"tax_percent,omitempty"`
+	Total           float64                `protobuf:"fixed64,10,opt,name=total,proto3" json:"total,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OpportunityLineItem) Reset() {
+	*x = OpportunityLineItem{}
+	mi := &file_api_proto_crm_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpportunityLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpportunityLineItem) ProtoMessage() {}
+
+func (x *OpportunityLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpportunityLineItem.ProtoReflect.Descriptor instead.
+func (*OpportunityLineItem) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *OpportunityLineItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetPriceBookId() uint32 {
+	if x != nil {
+		return x.PriceBookId
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OpportunityLineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetUnitPrice() float64 {
+	if x != nil && x.UnitPrice != nil {
+		return *x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetTaxPercent() float64 {
+	if x != nil {
+		return x.TaxPercent
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OpportunityLineItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OpportunityLineItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListLineItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpportunityId uint32                 `protobuf:"varint,1,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLineItemsRequest) Reset() {
+	*x = ListLineItemsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLineItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLineItemsRequest) ProtoMessage() {}
+
+func (x *ListLineItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLineItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListLineItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListLineItemsRequest) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+type ListLineItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineItems     []*OpportunityLineItem `protobuf:"bytes,1,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLineItemsResponse) Reset() {
+	*x = ListLineItemsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLineItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLineItemsResponse) ProtoMessage() {}
+
+func (x *ListLineItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLineItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListLineItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListLineItemsResponse) GetLineItems() []*OpportunityLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+// Without unit_price the price comes from the price book of the
+// opportunity's pipeline, else the standard price book, in the
+// opportunity's currency; failing both, from the product's list price if it
+// is in that currency.
+type AddLineItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpportunityId uint32                 `protobuf:"varint,1,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	LineItem      *OpportunityLineItem   `protobuf:"bytes,2,opt,name=line_item,json=lineItem,proto3" json:"line_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLineItemRequest) Reset() {
+	*x = AddLineItemRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLineItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLineItemRequest) ProtoMessage() {}
+
+func (x *AddLineItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLineItemRequest.ProtoReflect.Descriptor instead.
+func (*AddLineItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AddLineItemRequest) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+func (x *AddLineItemRequest) GetLineItem() *OpportunityLineItem {
+	if x != nil {
+		return x.LineItem
+	}
+	return nil
+}
+
+// Changes quantity, unit_price, discount, tax and description; the product
+// cannot be changed. Without unit_price the current price is kept.
+type UpdateLineItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineItem      *OpportunityLineItem   `protobuf:"bytes,1,opt,name=line_item,json=lineItem,proto3" json:"line_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLineItemRequest) Reset() {
+	*x = UpdateLineItemRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLineItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLineItemRequest) ProtoMessage() {}
+
+func (x *UpdateLineItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLineItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLineItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UpdateLineItemRequest) GetLineItem() *OpportunityLineItem {
+	if x != nil {
+		return x.LineItem
+	}
+	return nil
+}
+
+type RemoveLineItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLineItemRequest) Reset() {
+	*x = RemoveLineItemRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLineItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLineItemRequest) ProtoMessage() {}
+
+func (x *RemoveLineItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLineItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLineItemRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *RemoveLineItemRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// opportunity carries the amount recomputed from the line items.
+type LineItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineItem      *OpportunityLineItem   `protobuf:"bytes,1,opt,name=line_item,json=lineItem,proto3" json:"line_item,omitempty"` // not set by RemoveLineItem
+	Opportunity   *Opportunity           `protobuf:"bytes,2,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineItemResponse) Reset() {
+	*x = LineItemResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItemResponse) ProtoMessage() {}
+
+func (x *LineItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItemResponse.ProtoReflect.Descriptor instead.
+func (*LineItemResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *LineItemResponse) GetLineItem() *OpportunityLineItem {
+	if x != nil {
+		return x.LineItem
+	}
+	return nil
+}
+
+func (x *LineItemResponse) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+// Moves the opportunity to a won stage of its pipeline (the first one unless
+// stage is given), sets the close date and final amount and locks the
+// amount.
+type CloseOpportunityWonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage         string                 `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`                          // Optional won stage
+	CloseDate     string                 `protobuf:"bytes,3,opt,name=close_date,json=closeDate,proto3" json:"close_date,omitempty"` // actual close date, YYYY-MM-DD or RFC3339; defaults to today
+	Amount        *float64               `protobuf:"fixed64,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`                // final amount; defaults to the current amount
+	Competitor    string                 `protobuf:"bytes,5,opt,name=competitor,proto3" json:"competitor,omitempty"`                // Optional, competitor the deal was won against
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseOpportunityWonRequest) Reset() {
+	*x = CloseOpportunityWonRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseOpportunityWonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseOpportunityWonRequest) ProtoMessage() {}
+
+func (x *CloseOpportunityWonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseOpportunityWonRequest.ProtoReflect.Descriptor instead.
+func (*CloseOpportunityWonRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CloseOpportunityWonRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloseOpportunityWonRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *CloseOpportunityWonRequest) GetCloseDate() string {
+	if x != nil {
+		return x.CloseDate
+	}
+	return ""
+}
+
+func (x *CloseOpportunityWonRequest) GetAmount() float64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *CloseOpportunityWonRequest) GetCompetitor() string {
+	if x != nil {
+		return x.Competitor
+	}
+	return ""
+}
+
+// Like CloseOpportunityWon, for a lost stage. loss_reason must be one of the
+// organization's active loss reasons.
+type CloseOpportunityLostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage         string                 `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"` // Optional lost stage
+	CloseDate     string                 `protobuf:"bytes,3,opt,name=close_date,json=closeDate,proto3" json:"close_date,omitempty"`
+	Amount        *float64               `protobuf:"fixed64,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	LossReason    string                 `protobuf:"bytes,5,opt,name=loss_reason,json=lossReason,proto3" json:"loss_reason,omitempty"`
+	Competitor    string                 `protobuf:"bytes,6,opt,name=competitor,proto3" json:"competitor,omitempty"` // Optional, competitor the deal was lost to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseOpportunityLostRequest) Reset() {
+	*x = CloseOpportunityLostRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseOpportunityLostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseOpportunityLostRequest) ProtoMessage() {}
+
+func (x *CloseOpportunityLostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseOpportunityLostRequest.ProtoReflect.Descriptor instead.
+func (*CloseOpportunityLostRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CloseOpportunityLostRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloseOpportunityLostRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *CloseOpportunityLostRequest) GetCloseDate() string {
+	if x != nil {
+		return x.CloseDate
+	}
+	return ""
+}
+
+func (x *CloseOpportunityLostRequest) GetAmount() float64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *CloseOpportunityLostRequest) GetLossReason() string {
+	if x != nil {
+		return x.LossReason
+	}
+	return ""
+}
+
+func (x *CloseOpportunityLostRequest) GetCompetitor() string {
+	if x != nil {
+		return x.Competitor
+	}
+	return ""
+}
+
+type CloseOpportunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   *Opportunity           `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseOpportunityResponse) Reset() {
+	*x = CloseOpportunityResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseOpportunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseOpportunityResponse) ProtoMessage() {}
+
+func (x *CloseOpportunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseOpportunityResponse.ProtoReflect.Descriptor instead.
+func (*CloseOpportunityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CloseOpportunityResponse) GetOpportunity() *Opportunity {
+	if x != nil {
+		return x.Opportunity
+	}
+	return nil
+}
+
+// Organizations without loss reasons of their own get a built-in list,
+// whose entries have no id.
+type LossReason struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Active         *bool                  `protobuf:"varint,4,opt,name=active,proto3,oneof" json:"active,omitempty"` // defaults to true; inactive reasons cannot be chosen
+	Position       uint32                 `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`   // display order
+	CreatedAt      string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LossReason) Reset() {
+	*x = LossReason{}
+	mi := &file_api_proto_crm_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LossReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LossReason) ProtoMessage() {}
+
+func (x *LossReason) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LossReason.ProtoReflect.Descriptor instead.
+func (*LossReason) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *LossReason) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LossReason) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *LossReason) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LossReason) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *LossReason) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LossReason) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *LossReason) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListLossReasonsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListLossReasonsRequest) Reset() {
+	*x = ListLossReasonsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLossReasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLossReasonsRequest) ProtoMessage() {}
+
+func (x *ListLossReasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLossReasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListLossReasonsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListLossReasonsRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type ListLossReasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LossReasons   []*LossReason          `protobuf:"bytes,1,rep,name=loss_reasons,json=lossReasons,proto3" json:"loss_reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLossReasonsResponse) Reset() {
+	*x = ListLossReasonsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLossReasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLossReasonsResponse) ProtoMessage() {}
+
+func (x *ListLossReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLossReasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListLossReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListLossReasonsResponse) GetLossReasons() []*LossReason {
+	if x != nil {
+		return x.LossReasons
+	}
+	return nil
+}
+
+type CreateLossReasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LossReason    *LossReason            `protobuf:"bytes,1,opt,name=loss_reason,json=lossReason,proto3" json:"loss_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLossReasonRequest) Reset() {
+	*x = CreateLossReasonRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLossReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLossReasonRequest) ProtoMessage() {}
+
+func (x *CreateLossReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLossReasonRequest.ProtoReflect.Descriptor instead.
+func (*CreateLossReasonRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CreateLossReasonRequest) GetLossReason() *LossReason {
+	if x != nil {
+		return x.LossReason
+	}
+	return nil
+}
+
+type UpdateLossReasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LossReason    *LossReason            `protobuf:"bytes,1,opt,name=loss_reason,json=lossReason,proto3" json:"loss_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLossReasonRequest) Reset() {
+	*x = UpdateLossReasonRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLossReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLossReasonRequest) ProtoMessage() {}
+
+func (x *UpdateLossReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLossReasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLossReasonRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *UpdateLossReasonRequest) GetLossReason() *LossReason {
+	if x != nil {
+		return x.LossReason
+	}
+	return nil
+}
+
+type DeleteLossReasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLossReasonRequest) Reset() {
+	*x = DeleteLossReasonRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLossReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLossReasonRequest) ProtoMessage() {}
+
+func (x *DeleteLossReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLossReasonRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLossReasonRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DeleteLossReasonRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteLossReasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLossReasonResponse) Reset() {
+	*x = DeleteLossReasonResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLossReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLossReasonResponse) ProtoMessage() {}
+
+func (x *DeleteLossReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLossReasonResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLossReasonResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *DeleteLossReasonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// role is decision_maker, champion, influencer or blocker. A contact can
+// have several roles on one opportunity.
+type OpportunityContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpportunityId uint32                 `protobuf:"varint,1,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	ContactId     uint32                 `protobuf:"varint,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpportunityContact) Reset() {
+	*x = OpportunityContact{}
+	mi := &file_api_proto_crm_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpportunityContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpportunityContact) ProtoMessage() {}
+
+func (x *OpportunityContact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpportunityContact.ProtoReflect.Descriptor instead.
+func (*OpportunityContact) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *OpportunityContact) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+func (x *OpportunityContact) GetContactId() uint32 {
+	if x != nil {
+		return x.ContactId
+	}
+	return 0
+}
+
+func (x *OpportunityContact) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OpportunityContact) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AddOpportunityContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpportunityId uint32                 `protobuf:"varint,1,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	ContactId     uint32                 `protobuf:"varint,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOpportunityContactRequest) Reset() {
+	*x = AddOpportunityContactRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOpportunityContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOpportunityContactRequest) ProtoMessage() {}
+
+func (x *AddOpportunityContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOpportunityContactRequest.ProtoReflect.Descriptor instead.
+func (*AddOpportunityContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *AddOpportunityContactRequest) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+func (x *AddOpportunityContactRequest) GetContactId() uint32 {
+	if x != nil {
+		return x.ContactId
+	}
+	return 0
+}
+
+func (x *AddOpportunityContactRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RemoveOpportunityContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpportunityId uint32                 `protobuf:"varint,1,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	ContactId     uint32                 `protobuf:"varint,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // empty removes all of the contact's roles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOpportunityContactRequest) Reset() {
+	*x = RemoveOpportunityContactRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOpportunityContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOpportunityContactRequest) ProtoMessage() {}
+
+func (x *RemoveOpportunityContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOpportunityContactRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOpportunityContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *RemoveOpportunityContactRequest) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+func (x *RemoveOpportunityContactRequest) GetContactId() uint32 {
+	if x != nil {
+		return x.ContactId
+	}
+	return 0
+}
+
+func (x *RemoveOpportunityContactRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RemoveOpportunityContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOpportunityContactResponse) Reset() {
+	*x = RemoveOpportunityContactResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOpportunityContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOpportunityContactResponse) ProtoMessage() {}
+
+func (x *RemoveOpportunityContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOpportunityContactResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOpportunityContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *RemoveOpportunityContactResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListOpportunityContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpportunityId uint32                 `protobuf:"varint,1,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpportunityContactsRequest) Reset() {
+	*x = ListOpportunityContactsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpportunityContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpportunityContactsRequest) ProtoMessage() {}
+
+func (x *ListOpportunityContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpportunityContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListOpportunityContactsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListOpportunityContactsRequest) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+type ListOpportunityContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*OpportunityContact  `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"` // one entry per role
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpportunityContactsResponse) Reset() {
+	*x = ListOpportunityContactsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpportunityContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpportunityContactsResponse) ProtoMessage() {}
+
+func (x *ListOpportunityContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpportunityContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListOpportunityContactsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ListOpportunityContactsResponse) GetContacts() []*OpportunityContact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type PipelineStage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Probability   float64                `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"` // default win probability, 0-100
+	Won           bool                   `protobuf:"varint,3,opt,name=won,proto3" json:"won,omitempty"`
+	Lost          bool                   `protobuf:"varint,4,opt,name=lost,proto3" json:"lost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineStage) Reset() {
+	*x = PipelineStage{}
+	mi := &file_api_proto_crm_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStage) ProtoMessage() {}
+
+func (x *PipelineStage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStage.ProtoReflect.Descriptor instead.
+func (*PipelineStage) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *PipelineStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStage) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *PipelineStage) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+func (x *PipelineStage) GetLost() bool {
+	if x != nil {
+		return x.Lost
+	}
+	return false
+}
+
+type Pipeline struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId uint32                 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault      bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Stages         []*PipelineStage       `protobuf:"bytes,5,rep,name=stages,proto3" json:"stages,omitempty"`                   // in pipeline order
+	BuiltIn        bool                   `protobuf:"varint,6,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"` // the built-in pipeline, which cannot be changed
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Pipeline) Reset() {
+	*x = Pipeline{}
+	mi := &file_api_proto_crm_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pipeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pipeline) ProtoMessage() {}
+
+func (x *Pipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
+func (*Pipeline) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *Pipeline) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pipeline) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *Pipeline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pipeline) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Pipeline) GetStages() []*PipelineStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *Pipeline) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+func (x *Pipeline) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Pipeline) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreatePipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pipeline      *Pipeline              `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePipelineRequest) Reset() {
+	*x = CreatePipelineRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePipelineRequest) ProtoMessage() {}
+
+func (x *CreatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CreatePipelineRequest) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type GetPipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineRequest) Reset() {
+	*x = GetPipelineRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRequest) ProtoMessage() {}
+
+func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetPipelineRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Replaces the name, default flag and stages of a pipeline. Stages that
+// opportunities are still in cannot be removed.
+type UpdatePipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pipeline      *Pipeline              `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePipelineRequest) Reset() {
+	*x = UpdatePipelineRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *UpdatePipelineRequest) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type DeletePipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePipelineRequest) Reset() {
+	*x = DeletePipelineRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePipelineRequest) ProtoMessage() {}
+
+func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePipelineRequest.ProtoReflect.Descriptor instead.
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *DeletePipelineRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePipelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePipelineResponse) Reset() {
+	*x = DeletePipelineResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePipelineResponse) ProtoMessage() {}
+
+func (x *DeletePipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePipelineResponse.ProtoReflect.Descriptor instead.
+func (*DeletePipelineResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *DeletePipelineResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPipelinesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPipelinesRequest) Reset() {
+	*x = ListPipelinesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelinesRequest) ProtoMessage() {}
+
+func (x *ListPipelinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelinesRequest.ProtoReflect.Descriptor instead.
+func (*ListPipelinesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ListPipelinesRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type ListPipelinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pipelines     []*Pipeline            `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPipelinesResponse) Reset() {
+	*x = ListPipelinesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPipelinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipelinesResponse) ProtoMessage() {}
+
+func (x *ListPipelinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipelinesResponse.ProtoReflect.Descriptor instead.
+func (*ListPipelinesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ListPipelinesResponse) GetPipelines() []*Pipeline {
+	if x != nil {
+		return x.Pipelines
+	}
+	return nil
+}
+
+// Opportunities closing between from and to (inclusive, YYYY-MM-DD or
+// RFC3339) are bucketed by period of close date. The range is widened to
+// whole periods.
+type ForecastRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                  // month (default) or quarter
+	GroupBy        string                 `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"` // owner (default), pipeline or stage
+	From           string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	OwnerId        uint32                 `protobuf:"varint,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                      // Optional filter
+	PipelineId     uint32                 `protobuf:"varint,6,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`             // Optional filter
+	OrganizationId uint32                 `protobuf:"varint,7,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // amounts are in its reporting currency
+	Currency       string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                    // overrides organization_id
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ForecastRequest) Reset() {
+	*x = ForecastRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastRequest) ProtoMessage() {}
+
+func (x *ForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastRequest.ProtoReflect.Descriptor instead.
+func (*ForecastRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ForecastRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ForecastRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *ForecastRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ForecastRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ForecastRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ForecastRequest) GetPipelineId() uint32 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+func (x *ForecastRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ForecastRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Totals of one group in one period; lost opportunities are left out.
+// Category totals are cumulative: commit includes closed, best_case
+// includes commit. pipeline totals all open opportunities.
+type ForecastRow struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // 2024-03 or 2024-Q1
+	PeriodStart    string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	GroupKey       string                 `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"` // owner id, pipeline id (0: built-in) or stage name
+	GroupLabel     string                 `protobuf:"bytes,4,opt,name=group_label,json=groupLabel,proto3" json:"group_label,omitempty"`
+	Count          uint32                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Amount         float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	WeightedAmount float64                `protobuf:"fixed64,7,opt,name=weighted_amount,json=weightedAmount,proto3" json:"weighted_amount,omitempty"` // amount times probability
+	Closed         float64                `protobuf:"fixed64,8,opt,name=closed,proto3" json:"closed,omitempty"`
+	Commit         float64                `protobuf:"fixed64,9,opt,name=commit,proto3" json:"commit,omitempty"`
+	BestCase       float64                `protobuf:"fixed64,10,opt,name=best_case,json=bestCase,proto3" json:"best_case,omitempty"`
+	Pipeline       float64                `protobuf:"fixed64,11,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ForecastRow) Reset() {
+	*x = ForecastRow{}
+	mi := &file_api_proto_crm_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastRow) ProtoMessage() {}
+
+func (x *ForecastRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastRow.ProtoReflect.Descriptor instead.
+func (*ForecastRow) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ForecastRow) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ForecastRow) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *ForecastRow) GetGroupKey() string {
+	if x != nil {
+		return x.GroupKey
+	}
+	return ""
+}
+
+func (x *ForecastRow) GetGroupLabel() string {
+	if x != nil {
+		return x.GroupLabel
+	}
+	return ""
+}
+
+func (x *ForecastRow) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ForecastRow) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ForecastRow) GetWeightedAmount() float64 {
+	if x != nil {
+		return x.WeightedAmount
+	}
+	return 0
+}
+
+func (x *ForecastRow) GetClosed() float64 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+func (x *ForecastRow) GetCommit() float64 {
+	if x != nil {
+		return x.Commit
+	}
+	return 0
+}
+
+func (x *ForecastRow) GetBestCase() float64 {
+	if x != nil {
+		return x.BestCase
+	}
+	return 0
+}
+
+func (x *ForecastRow) GetPipeline() float64 {
+	if x != nil {
+		return x.Pipeline
+	}
+	return 0
+}
+
+type Forecast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *ForecastRequest       `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"` // as normalized
+	Rows          []*ForecastRow         `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Forecast) Reset() {
+	*x = Forecast{}
+	mi := &file_api_proto_crm_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Forecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Forecast) ProtoMessage() {}
+
+func (x *Forecast) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Forecast.ProtoReflect.Descriptor instead.
+func (*Forecast) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *Forecast) GetRequest() *ForecastRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *Forecast) GetRows() []*ForecastRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type CreateForecastSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Forecast      *ForecastRequest       `protobuf:"bytes,2,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateForecastSnapshotRequest) Reset() {
+	*x = CreateForecastSnapshotRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateForecastSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateForecastSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateForecastSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateForecastSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateForecastSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *CreateForecastSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateForecastSnapshotRequest) GetForecast() *ForecastRequest {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+type ForecastSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Forecast      *Forecast              `protobuf:"bytes,4,opt,name=forecast,proto3" json:"forecast,omitempty"` // as projected when the snapshot was taken
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastSnapshot) Reset() {
+	*x = ForecastSnapshot{}
+	mi := &file_api_proto_crm_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastSnapshot) ProtoMessage() {}
+
+func (x *ForecastSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastSnapshot.ProtoReflect.Descriptor instead.
+func (*ForecastSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ForecastSnapshot) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ForecastSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ForecastSnapshot) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ForecastSnapshot) GetForecast() *Forecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+type GetForecastSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Compare       bool                   `protobuf:"varint,2,opt,name=compare,proto3" json:"compare,omitempty"` // also recompute the forecast from current data
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastSnapshotRequest) Reset() {
+	*x = GetForecastSnapshotRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastSnapshotRequest) ProtoMessage() {}
+
+func (x *GetForecastSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *GetForecastSnapshotRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetForecastSnapshotRequest) GetCompare() bool {
+	if x != nil {
+		return x.Compare
+	}
+	return false
+}
+
+type GetForecastSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *ForecastSnapshot      `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Current       *Forecast              `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"` // set when compare is requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastSnapshotResponse) Reset() {
+	*x = GetForecastSnapshotResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastSnapshotResponse) ProtoMessage() {}
+
+func (x *GetForecastSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetForecastSnapshotResponse) GetSnapshot() *ForecastSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GetForecastSnapshotResponse) GetCurrent() *Forecast {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type ListForecastSnapshotsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListForecastSnapshotsRequest) Reset() {
+	*x = ListForecastSnapshotsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListForecastSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForecastSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListForecastSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForecastSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListForecastSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ListForecastSnapshotsRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListForecastSnapshotsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListForecastSnapshotsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListForecastSnapshotsRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListForecastSnapshotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListForecastSnapshotsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListForecastSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*ForecastSnapshot    `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListForecastSnapshotsResponse) Reset() {
+	*x = ListForecastSnapshotsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListForecastSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForecastSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListForecastSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForecastSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListForecastSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ListForecastSnapshotsResponse) GetSnapshots() []*ForecastSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *ListForecastSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListForecastSnapshotsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListForecastSnapshotsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DeleteForecastSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteForecastSnapshotRequest) Reset() {
+	*x = DeleteForecastSnapshotRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteForecastSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteForecastSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteForecastSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteForecastSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteForecastSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DeleteForecastSnapshotRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteForecastSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteForecastSnapshotResponse) Reset() {
+	*x = DeleteForecastSnapshotResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteForecastSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteForecastSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteForecastSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteForecastSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteForecastSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DeleteForecastSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// One unit of from_currency is worth rate units of to_currency from
+// effective_date until the next rate for the pair. Rates are also used in
+// the opposite direction.
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromCurrency  string                 `protobuf:"bytes,2,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency    string                 `protobuf:"bytes,3,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	EffectiveDate string                 `protobuf:"bytes,5,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"` // YYYY-MM-DD
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                                    // api or import
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_api_proto_crm_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ExchangeRate) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Rates replace existing ones for the same pair and date; either all are
+// stored or none.
+type SetExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesRequest) Reset() {
+	*x = SetExchangeRatesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesRequest) ProtoMessage() {}
+
+func (x *SetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SetExchangeRatesRequest) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesResponse) Reset() {
+	*x = SetExchangeRatesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesResponse) ProtoMessage() {}
+
+func (x *SetExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SetExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// CSV with the columns from_currency, to_currency, rate and effective_date;
+// the header row is optional and lines starting with # are skipped.
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ImportExchangeRatesRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type ImportExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      uint32                 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesResponse) Reset() {
+	*x = ImportExchangeRatesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ImportExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ImportExchangeRatesResponse) GetImported() uint32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+type ListExchangeRatesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Currency          string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"` // Optional filter, either side of the pair
+	PageNumber        uint32                 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ListExchangeRatesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExchangeRatesRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *ListExchangeRatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DeleteExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExchangeRateRequest) Reset() {
+	*x = DeleteExchangeRateRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateRequest) ProtoMessage() {}
+
+func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *DeleteExchangeRateRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExchangeRateResponse) Reset() {
+	*x = DeleteExchangeRateResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateResponse) ProtoMessage() {}
+
+func (x *DeleteExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *DeleteExchangeRateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetReportingCurrencyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReportingCurrencyRequest) Reset() {
+	*x = GetReportingCurrencyRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportingCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportingCurrencyRequest) ProtoMessage() {}
+
+func (x *GetReportingCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportingCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*GetReportingCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *GetReportingCurrencyRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type ReportingCurrency struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportingCurrency) Reset() {
+	*x = ReportingCurrency{}
+	mi := &file_api_proto_crm_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportingCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportingCurrency) ProtoMessage() {}
+
+func (x *ReportingCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportingCurrency.ProtoReflect.Descriptor instead.
+func (*ReportingCurrency) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ReportingCurrency) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ReportingCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ConvertAmountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromCurrency  string                 `protobuf:"bytes,2,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency    string                 `protobuf:"bytes,3,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // rate date, YYYY-MM-DD or RFC3339; defaults to today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAmountRequest) Reset() {
+	*x = ConvertAmountRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAmountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAmountRequest) ProtoMessage() {}
+
+func (x *ConvertAmountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAmountRequest.ProtoReflect.Descriptor instead.
+func (*ConvertAmountRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *ConvertAmountRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConvertAmountRequest) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ConvertAmountRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *ConvertAmountRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ConvertAmountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAmountResponse) Reset() {
+	*x = ConvertAmountResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAmountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAmountResponse) ProtoMessage() {}
+
+func (x *ConvertAmountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAmountResponse.ProtoReflect.Descriptor instead.
+func (*ConvertAmountResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *ConvertAmountResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConvertAmountResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ListPrice     float64                `protobuf:"fixed64,5,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`    // ISO 4217, of list_price; defaults to USD
+	Active        *bool                  `protobuf:"varint,7,opt,name=active,proto3,oneof" json:"active,omitempty"` // defaults to true; only active products can be added to opportunities
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_api_proto_crm_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *Product) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetListPrice() float64 {
+	if x != nil {
+		return x.ListPrice
+	}
+	return 0
+}
+
+func (x *Product) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Product) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Product) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Product) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *CreateProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *GetProductRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *UpdateProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Products on line items cannot be deleted; deactivate them instead.
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *DeleteProductRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *DeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListProductsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly        bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Query             string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // Optional, part of the SKU or name
+	PageNumber        uint32                 `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *ListProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProductsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListProductsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// A price book with a pipeline prices the products of that pipeline's
+// opportunities; one without is the standard book for the others.
+type PriceBook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PipelineId    uint32                 `protobuf:"varint,3,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // 0: standard price book
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                        // ISO 4217; defaults to USD
+	Active        *bool                  `protobuf:"varint,5,opt,name=active,proto3,oneof" json:"active,omitempty"`                     // defaults to true; inactive books are not used for pricing
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBook) Reset() {
+	*x = PriceBook{}
+	mi := &file_api_proto_crm_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBook) ProtoMessage() {}
+
+func (x *PriceBook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBook.ProtoReflect.Descriptor instead.
+func (*PriceBook) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *PriceBook) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceBook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceBook) GetPipelineId() uint32 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+func (x *PriceBook) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceBook) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *PriceBook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PriceBook) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreatePriceBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceBook     *PriceBook             `protobuf:"bytes,1,opt,name=price_book,json=priceBook,proto3" json:"price_book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceBookRequest) Reset() {
+	*x = CreatePriceBookRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceBookRequest) ProtoMessage() {}
+
+func (x *CreatePriceBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceBookRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceBookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *CreatePriceBookRequest) GetPriceBook() *PriceBook {
+	if x != nil {
+		return x.PriceBook
+	}
+	return nil
+}
+
+type GetPriceBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceBookRequest) Reset() {
+	*x = GetPriceBookRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceBookRequest) ProtoMessage() {}
+
+func (x *GetPriceBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceBookRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceBookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *GetPriceBookRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdatePriceBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceBook     *PriceBook             `protobuf:"bytes,1,opt,name=price_book,json=priceBook,proto3" json:"price_book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePriceBookRequest) Reset() {
+	*x = UpdatePriceBookRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriceBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceBookRequest) ProtoMessage() {}
+
+func (x *UpdatePriceBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceBookRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceBookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *UpdatePriceBookRequest) GetPriceBook() *PriceBook {
+	if x != nil {
+		return x.PriceBook
+	}
+	return nil
+}
+
+type DeletePriceBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceBookRequest) Reset() {
+	*x = DeletePriceBookRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceBookRequest) ProtoMessage() {}
+
+func (x *DeletePriceBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceBookRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceBookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *DeletePriceBookRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePriceBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceBookResponse) Reset() {
+	*x = DeletePriceBookResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceBookResponse) ProtoMessage() {}
+
+func (x *DeletePriceBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceBookResponse.ProtoReflect.Descriptor instead.
+func (*DeletePriceBookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *DeletePriceBookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPriceBooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId    uint32                 `protobuf:"varint,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"` // Optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceBooksRequest) Reset() {
+	*x = ListPriceBooksRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceBooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceBooksRequest) ProtoMessage() {}
+
+func (x *ListPriceBooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceBooksRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceBooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *ListPriceBooksRequest) GetPipelineId() uint32 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+type ListPriceBooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceBooks    []*PriceBook           `protobuf:"bytes,1,rep,name=price_books,json=priceBooks,proto3" json:"price_books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceBooksResponse) Reset() {
+	*x = ListPriceBooksResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceBooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceBooksResponse) ProtoMessage() {}
+
+func (x *ListPriceBooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceBooksResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceBooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ListPriceBooksResponse) GetPriceBooks() []*PriceBook {
+	if x != nil {
+		return x.PriceBooks
+	}
+	return nil
+}
+
+// The price of a product in a price book, in the book's currency.
+type PriceBookEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceBookId   uint32                 `protobuf:"varint,1,opt,name=price_book_id,json=priceBookId,proto3" json:"price_book_id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBookEntry) Reset() {
+	*x = PriceBookEntry{}
+	mi := &file_api_proto_crm_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBookEntry) ProtoMessage() {}
+
+func (x *PriceBookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBookEntry.ProtoReflect.Descriptor instead.
+func (*PriceBookEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *PriceBookEntry) GetPriceBookId() uint32 {
+	if x != nil {
+		return x.PriceBookId
+	}
+	return 0
+}
+
+func (x *PriceBookEntry) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PriceBookEntry) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+type RemovePriceBookEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceBookId   uint32                 `protobuf:"varint,1,opt,name=price_book_id,json=priceBookId,proto3" json:"price_book_id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePriceBookEntryRequest) Reset() {
+	*x = RemovePriceBookEntryRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePriceBookEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePriceBookEntryRequest) ProtoMessage() {}
+
+func (x *RemovePriceBookEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePriceBookEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemovePriceBookEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *RemovePriceBookEntryRequest) GetPriceBookId() uint32 {
+	if x != nil {
+		return x.PriceBookId
+	}
+	return 0
+}
+
+func (x *RemovePriceBookEntryRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type RemovePriceBookEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePriceBookEntryResponse) Reset() {
+	*x = RemovePriceBookEntryResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePriceBookEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePriceBookEntryResponse) ProtoMessage() {}
+
+func (x *RemovePriceBookEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePriceBookEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemovePriceBookEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *RemovePriceBookEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPriceBookEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceBookId   uint32                 `protobuf:"varint,1,opt,name=price_book_id,json=priceBookId,proto3" json:"price_book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceBookEntriesRequest) Reset() {
+	*x = ListPriceBookEntriesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceBookEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceBookEntriesRequest) ProtoMessage() {}
+
+func (x *ListPriceBookEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceBookEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceBookEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *ListPriceBookEntriesRequest) GetPriceBookId() uint32 {
+	if x != nil {
+		return x.PriceBookId
+	}
+	return 0
+}
+
+type ListPriceBookEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PriceBookEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceBookEntriesResponse) Reset() {
+	*x = ListPriceBookEntriesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceBookEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceBookEntriesResponse) ProtoMessage() {}
+
+func (x *ListPriceBookEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceBookEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceBookEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ListPriceBookEntriesResponse) GetEntries() []*PriceBookEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Searches contacts, companies and leads by name, email, phone and
+// location. Misspelled terms still match similar words.
+type SearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                          // at least 2 characters
+	Types          []string               `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`                                          // contact, company, lead; empty searches all
+	OrganizationId uint32                 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional, contacts are matched through their company
+	Country        string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`                                      // Optional facet filters, case-insensitive
+	Industry       string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // lead status
+	PageNumber     uint32                 `protobuf:"varint,7,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetOrganizationId() uint32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // contact, company or lead
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle      string                 `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	Highlight     string                 `protobuf:"bytes,5,opt,name=highlight,proto3" json:"highlight,omitempty"` // HTML-escaped title and subtitle with matched words in <mark></mark>
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`       // higher is more relevant
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Industry      string                 `protobuf:"bytes,8,opt,name=industry,proto3" json:"industry,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_api_proto_crm_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *SearchHit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchHit) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *SearchHit) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *SearchHit) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *SearchHit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_api_proto_crm_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Facets count all matches, not only those of the returned page.
+type SearchFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          []*FacetCount          `protobuf:"bytes,1,rep,name=type,proto3" json:"type,omitempty"`
+	Country       []*FacetCount          `protobuf:"bytes,2,rep,name=country,proto3" json:"country,omitempty"`
+	Industry      []*FacetCount          `protobuf:"bytes,3,rep,name=industry,proto3" json:"industry,omitempty"`
+	Status        []*FacetCount          `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	mi := &file_api_proto_crm_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *SearchFacets) GetType() []*FacetCount {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetCountry() []*FacetCount {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetIndustry() []*FacetCount {
+	if x != nil {
+		return x.Industry
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetStatus() []*FacetCount {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchHit           `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Facets        *SearchFacets          `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *SearchResponse) GetResults() []*SearchHit {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type Meeting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MeetingLink   string                 `protobuf:"bytes,6,opt,name=meeting_link,json=meetingLink,proto3" json:"meeting_link,omitempty"`
+	OrganizerId   uint32                 `protobuf:"varint,7,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // Scheduled, Completed, Cancelled
+	Attendees     []*MeetingAttendee     `protobuf:"bytes,9,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Meeting) Reset() {
+	*x = Meeting{}
+	mi := &file_api_proto_crm_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meeting) ProtoMessage() {}
+
+func (x *Meeting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meeting.ProtoReflect.Descriptor instead.
+func (*Meeting) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *Meeting) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Meeting) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Meeting) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Meeting) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Meeting) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Meeting) GetMeetingLink() string {
+	if x != nil {
+		return x.MeetingLink
+	}
+	return ""
+}
+
+func (x *Meeting) GetOrganizerId() uint32 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *Meeting) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Meeting) GetAttendees() []*MeetingAttendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *Meeting) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Meeting) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Exactly one of contact_id or lead_id is set.
+type MeetingAttendee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContactId     uint32                 `protobuf:"varint,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	LeadId        uint32                 `protobuf:"varint,2,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeetingAttendee) Reset() {
+	*x = MeetingAttendee{}
+	mi := &file_api_proto_crm_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeetingAttendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingAttendee) ProtoMessage() {}
+
+func (x *MeetingAttendee) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingAttendee.ProtoReflect.Descriptor instead.
+func (*MeetingAttendee) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *MeetingAttendee) GetContactId() uint32 {
+	if x != nil {
+		return x.ContactId
+	}
+	return 0
+}
+
+func (x *MeetingAttendee) GetLeadId() uint32 {
+	if x != nil {
+		return x.LeadId
+	}
+	return 0
+}
+
+type ScheduleMeetingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MeetingLink   string                 `protobuf:"bytes,4,opt,name=meeting_link,json=meetingLink,proto3" json:"meeting_link,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	OrganizerId   uint32                 `protobuf:"varint,6,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Attendees     []*MeetingAttendee     `protobuf:"bytes,7,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMeetingRequest) Reset() {
+	*x = ScheduleMeetingRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMeetingRequest) ProtoMessage() {}
+
+func (x *ScheduleMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMeetingRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *ScheduleMeetingRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScheduleMeetingRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ScheduleMeetingRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ScheduleMeetingRequest) GetMeetingLink() string {
+	if x != nil {
+		return x.MeetingLink
+	}
+	return ""
+}
+
+func (x *ScheduleMeetingRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduleMeetingRequest) GetOrganizerId() uint32 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *ScheduleMeetingRequest) GetAttendees() []*MeetingAttendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+type MeetingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MeetingId     uint32                 `protobuf:"varint,1,opt,name=meeting_id,json=meetingId,proto3" json:"meeting_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Meeting       *Meeting               `protobuf:"bytes,3,opt,name=meeting,proto3" json:"meeting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeetingResponse) Reset() {
+	*x = MeetingResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeetingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingResponse) ProtoMessage() {}
+
+func (x *MeetingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingResponse.ProtoReflect.Descriptor instead.
+func (*MeetingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *MeetingResponse) GetMeetingId() uint32 {
+	if x != nil {
+		return x.MeetingId
+	}
+	return 0
+}
+
+func (x *MeetingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MeetingResponse) GetMeeting() *Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type GetMeetingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeetingRequest) Reset() {
+	*x = GetMeetingRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingRequest) ProtoMessage() {}
+
+func (x *GetMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingRequest.ProtoReflect.Descriptor instead.
+func (*GetMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *GetMeetingRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetMeetingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meeting       *Meeting               `protobuf:"bytes,1,opt,name=meeting,proto3" json:"meeting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeetingResponse) Reset() {
+	*x = GetMeetingResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeetingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeetingResponse) ProtoMessage() {}
+
+func (x *GetMeetingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeetingResponse.ProtoReflect.Descriptor instead.
+func (*GetMeetingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *GetMeetingResponse) GetMeeting() *Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type UpdateMeetingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meeting       *Meeting               `protobuf:"bytes,1,opt,name=meeting,proto3" json:"meeting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMeetingRequest) Reset() {
+	*x = UpdateMeetingRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingRequest) ProtoMessage() {}
+
+func (x *UpdateMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *UpdateMeetingRequest) GetMeeting() *Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type UpdateMeetingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meeting       *Meeting               `protobuf:"bytes,1,opt,name=meeting,proto3" json:"meeting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMeetingResponse) Reset() {
+	*x = UpdateMeetingResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMeetingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeetingResponse) ProtoMessage() {}
+
+func (x *UpdateMeetingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeetingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMeetingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *UpdateMeetingResponse) GetMeeting() *Meeting {
+	if x != nil {
+		return x.Meeting
+	}
+	return nil
+}
+
+type DeleteMeetingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMeetingRequest) Reset() {
+	*x = DeleteMeetingRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMeetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMeetingRequest) ProtoMessage() {}
+
+func (x *DeleteMeetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMeetingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMeetingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *DeleteMeetingRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteMeetingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMeetingResponse) Reset() {
+	*x = DeleteMeetingResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMeetingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMeetingResponse) ProtoMessage() {}
+
+func (x *DeleteMeetingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMeetingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMeetingResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *DeleteMeetingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListMeetingsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrganizerId       uint32                 `protobuf:"varint,3,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"` // Optional filter
+	Filter            *FilterGroup           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListMeetingsRequest) Reset() {
+	*x = ListMeetingsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMeetingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMeetingsRequest) ProtoMessage() {}
+
+func (x *ListMeetingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMeetingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMeetingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *ListMeetingsRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListMeetingsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMeetingsRequest) GetOrganizerId() uint32 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *ListMeetingsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListMeetingsRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListMeetingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMeetingsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListMeetingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meetings      []*Meeting             `protobuf:"bytes,1,rep,name=meetings,proto3" json:"meetings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMeetingsResponse) Reset() {
+	*x = ListMeetingsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMeetingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMeetingsResponse) ProtoMessage() {}
+
+func (x *ListMeetingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMeetingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMeetingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *ListMeetingsResponse) GetMeetings() []*Meeting {
+	if x != nil {
+		return x.Meetings
+	}
+	return nil
+}
+
+func (x *ListMeetingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMeetingsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListMeetingsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type Proposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ContactId     uint32                 `protobuf:"varint,6,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	CreatedBy     uint32                 `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OpportunityId uint32                 `protobuf:"varint,10,opt,name=opportunity_id,json=opportunityId,proto3" json:"opportunity_id,omitempty"`
+	ValidUntil    string                 `protobuf:"bytes,11,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	LineItems     []*ProposalLineItem    `protobuf:"bytes,12,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	SentAt        string                 `protobuf:"bytes,13,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	RespondedAt   string                 `protobuf:"bytes,14,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	Currency      string                 `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217; defaults to the opportunity's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	mi := &file_api_proto_crm_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *Proposal) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Proposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Proposal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Proposal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Proposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Proposal) GetContactId() uint32 {
+	if x != nil {
+		return x.ContactId
+	}
+	return 0
+}
+
+func (x *Proposal) GetCreatedBy() uint32 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Proposal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Proposal) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Proposal) GetOpportunityId() uint32 {
+	if x != nil {
+		return x.OpportunityId
+	}
+	return 0
+}
+
+func (x *Proposal) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Proposal) GetLineItems() []*ProposalLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Proposal) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+func (x *Proposal) GetRespondedAt() string {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return ""
+}
+
+func (x *Proposal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Amount-bearing row of a proposal. discount and tax_rate are percentages;
+// total is computed by the server.
+type ProposalLineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Discount      float64                `protobuf:"fixed64,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	TaxRate       float64                `protobuf:"fixed64,6,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	Total         float64                `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalLineItem) Reset() {
+	*x = ProposalLineItem{}
+	mi := &file_api_proto_crm_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalLineItem) ProtoMessage() {}
+
+func (x *ProposalLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalLineItem.ProtoReflect.Descriptor instead.
+func (*ProposalLineItem) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *ProposalLineItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProposalLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProposalLineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProposalLineItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *ProposalLineItem) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *ProposalLineItem) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *ProposalLineItem) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *Proposal              `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProposalRequest) Reset() {
+	*x = CreateProposalRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProposalRequest) ProtoMessage() {}
+
+func (x *CreateProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProposalRequest.ProtoReflect.Descriptor instead.
+func (*CreateProposalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *CreateProposalRequest) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type CreateProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *Proposal              `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProposalResponse) Reset() {
+	*x = CreateProposalResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProposalResponse) ProtoMessage() {}
+
+func (x *CreateProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProposalResponse.ProtoReflect.Descriptor instead.
+func (*CreateProposalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *CreateProposalResponse) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type GetProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProposalRequest) Reset() {
+	*x = GetProposalRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposalRequest) ProtoMessage() {}
+
+func (x *GetProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposalRequest.ProtoReflect.Descriptor instead.
+func (*GetProposalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *GetProposalRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *Proposal              `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProposalResponse) Reset() {
+	*x = GetProposalResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposalResponse) ProtoMessage() {}
+
+func (x *GetProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposalResponse.ProtoReflect.Descriptor instead.
+func (*GetProposalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *GetProposalResponse) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type UpdateProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *Proposal              `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProposalRequest) Reset() {
+	*x = UpdateProposalRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProposalRequest) ProtoMessage() {}
+
+func (x *UpdateProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProposalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProposalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *UpdateProposalRequest) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type UpdateProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *Proposal              `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProposalResponse) Reset() {
+	*x = UpdateProposalResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProposalResponse) ProtoMessage() {}
+
+func (x *UpdateProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProposalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProposalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *UpdateProposalResponse) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type DeleteProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProposalRequest) Reset() {
+	*x = DeleteProposalRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProposalRequest) ProtoMessage() {}
+
+func (x *DeleteProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProposalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProposalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *DeleteProposalRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProposalResponse) Reset() {
+	*x = DeleteProposalResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProposalResponse) ProtoMessage() {}
+
+func (x *DeleteProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProposalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProposalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *DeleteProposalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListProposalsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber        uint32                 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy            string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Ascending         bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                            // overrides sort_by/ascending
+	PageToken         string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page_number
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListProposalsRequest) Reset() {
+	*x = ListProposalsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsRequest) ProtoMessage() {}
+
+func (x *ListProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *ListProposalsRequest) GetPageNumber() uint32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListProposalsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProposalsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListProposalsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *ListProposalsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListProposalsRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListProposalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProposalsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListProposalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposals     []*Proposal            `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposalsResponse) Reset() {
+	*x = ListProposalsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsResponse) ProtoMessage() {}
+
+func (x *ListProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *ListProposalsResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *ListProposalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProposalsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListProposalsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Moves a proposal along draft -> sent -> accepted/rejected/expired.
+type UpdateProposalStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProposalStatusRequest) Reset() {
+	*x = UpdateProposalStatusRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProposalStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProposalStatusRequest) ProtoMessage() {}
+
+func (x *UpdateProposalStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProposalStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProposalStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *UpdateProposalStatusRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProposalStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateProposalStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *Proposal              `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProposalStatusResponse) Reset() {
+	*x = UpdateProposalStatusResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProposalStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProposalStatusResponse) ProtoMessage() {}
+
+func (x *UpdateProposalStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProposalStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProposalStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *UpdateProposalStatusResponse) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type SendNotificationWithSMTPRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Recipient        string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	TemplateName     string                 `protobuf:"bytes,3,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	Data             map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sync             bool                   `protobuf:"varint,5,opt,name=sync,proto3" json:"sync,omitempty"`
+	NotificationType string                 `protobuf:"bytes,6,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendNotificationWithSMTPRequest) Reset() {
+	*x = SendNotificationWithSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationWithSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationWithSMTPRequest) ProtoMessage() {}
+
+func (x *SendNotificationWithSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationWithSMTPRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationWithSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *SendNotificationWithSMTPRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendNotificationWithSMTPRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *SendNotificationWithSMTPRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *SendNotificationWithSMTPRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendNotificationWithSMTPRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *SendNotificationWithSMTPRequest) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+type SendNotificationWithSMSRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Recipient        string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	TemplateName     string                 `protobuf:"bytes,3,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	Data             map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sync             bool                   `protobuf:"varint,5,opt,name=sync,proto3" json:"sync,omitempty"`
+	NotificationType string                 `protobuf:"bytes,6,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendNotificationWithSMSRequest) Reset() {
+	*x = SendNotificationWithSMSRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationWithSMSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationWithSMSRequest) ProtoMessage() {}
+
+func (x *SendNotificationWithSMSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationWithSMSRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationWithSMSRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *SendNotificationWithSMSRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendNotificationWithSMSRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *SendNotificationWithSMSRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *SendNotificationWithSMSRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendNotificationWithSMSRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *SendNotificationWithSMSRequest) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+type SendNotificationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Recipient        string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	TemplateName     string                 `protobuf:"bytes,2,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	Data             map[string]string      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sync             bool                   `protobuf:"varint,4,opt,name=sync,proto3" json:"sync,omitempty"`
+	Channel          string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"` // email (default), sms or in_app
+	UserId           string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationType string                 `protobuf:"bytes,7,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendNotificationRequest) Reset() {
+	*x = SendNotificationRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest) ProtoMessage() {}
+
+func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *SendNotificationRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *SendNotificationRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+type SendNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *SendNotificationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendNotificationResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// Liveness only covers the process itself; readiness (the default) also
+// covers the dependencies it needs to serve requests.
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probe         string                 `protobuf:"bytes,1,opt,name=probe,proto3" json:"probe,omitempty"` // liveness or readiness (default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *HealthCheckRequest) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // SERVING or NOT_SERVING
+	Dependencies  []*DependencyStatus    `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	CheckedAt     string                 `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *HealthCheckResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetDependencies() []*DependencyStatus {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *HealthCheckResponse) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+type DependencyStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // SERVING or NOT_SERVING
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyStatus) Reset() {
+	*x = DependencyStatus{}
+	mi := &file_api_proto_crm_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyStatus) ProtoMessage() {}
+
+func (x *DependencyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyStatus.ProtoReflect.Descriptor instead.
+func (*DependencyStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *DependencyStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DependencyStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DependencyStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DependencyStatus) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type CreateSMTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SmtpHost      string                 `protobuf:"bytes,2,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort      int32                  `protobuf:"varint,3,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	SmtpUsername  string                 `protobuf:"bytes,4,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword  string                 `protobuf:"bytes,5,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	FromEmail     string                 `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSMTPRequest) Reset() {
+	*x = CreateSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSMTPRequest) ProtoMessage() {}
+
+func (x *CreateSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSMTPRequest.ProtoReflect.Descriptor instead.
+func (*CreateSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *CreateSMTPRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSMTPRequest) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *CreateSMTPRequest) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *CreateSMTPRequest) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *CreateSMTPRequest) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *CreateSMTPRequest) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+type GetSMTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSMTPRequest) Reset() {
+	*x = GetSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSMTPRequest) ProtoMessage() {}
+
+func (x *GetSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSMTPRequest.ProtoReflect.Descriptor instead.
+func (*GetSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *GetSMTPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// An empty smtp_password keeps the stored one.
+type UpdateSMTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SmtpHost      string                 `protobuf:"bytes,2,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort      int32                  `protobuf:"varint,3,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	SmtpUsername  string                 `protobuf:"bytes,4,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword  string                 `protobuf:"bytes,5,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	FromEmail     string                 `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSMTPRequest) Reset() {
+	*x = UpdateSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSMTPRequest) ProtoMessage() {}
+
+func (x *UpdateSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSMTPRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *UpdateSMTPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSMTPRequest) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *UpdateSMTPRequest) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *UpdateSMTPRequest) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *UpdateSMTPRequest) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *UpdateSMTPRequest) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+type DeleteSMTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSMTPRequest) Reset() {
+	*x = DeleteSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSMTPRequest) ProtoMessage() {}
+
+func (x *DeleteSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSMTPRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *DeleteSMTPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SMTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SmtpHost      string                 `protobuf:"bytes,3,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort      int32                  `protobuf:"varint,4,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	SmtpUsername  string                 `protobuf:"bytes,5,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	FromEmail     string                 `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	RepeatedAt    string                 `protobuf:"bytes,7,opt,name=repeated_at,json=repeatedAt,proto3" json:"repeated_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMTPResponse) Reset() {
+	*x = SMTPResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMTPResponse) ProtoMessage() {}
+
+func (x *SMTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMTPResponse.ProtoReflect.Descriptor instead.
+func (*SMTPResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *SMTPResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SMTPResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SMTPResponse) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *SMTPResponse) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *SMTPResponse) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *SMTPResponse) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *SMTPResponse) GetRepeatedAt() string {
+	if x != nil {
+		return x.RepeatedAt
+	}
+	return ""
+}
+
+func (x *SMTPResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListSMTPRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Page              int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListSMTPRequest) Reset() {
+	*x = ListSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSMTPRequest) ProtoMessage() {}
+
+func (x *ListSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSMTPRequest.ProtoReflect.Descriptor instead.
+func (*ListSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *ListSMTPRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSMTPRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSMTPRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSMTPRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListSMTPRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSMTPRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListSMTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credentials   []*SMTPResponse        `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSMTPResponse) Reset() {
+	*x = ListSMTPResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSMTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSMTPResponse) ProtoMessage() {}
+
+func (x *ListSMTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSMTPResponse.ProtoReflect.Descriptor instead.
+func (*ListSMTPResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *ListSMTPResponse) GetCredentials() []*SMTPResponse {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ListSMTPResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSMTPResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListSMTPResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DeleteSMTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSMTPResponse) Reset() {
+	*x = DeleteSMTPResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSMTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSMTPResponse) ProtoMessage() {}
+
+func (x *DeleteSMTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSMTPResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSMTPResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *DeleteSMTPResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSMTPResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Connects to the stored server, negotiates STARTTLS when offered and
+// authenticates, without sending mail.
+type TestSMTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSMTPRequest) Reset() {
+	*x = TestSMTPRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSMTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSMTPRequest) ProtoMessage() {}
+
+func (x *TestSMTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSMTPRequest.ProtoReflect.Descriptor instead.
+func (*TestSMTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *TestSMTPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TestSMTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSMTPResponse) Reset() {
+	*x = TestSMTPResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSMTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSMTPResponse) ProtoMessage() {}
+
+func (x *TestSMTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSMTPResponse.ProtoReflect.Descriptor instead.
+func (*TestSMTPResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *TestSMTPResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TestSMTPResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TestSMTPResponse) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+// Re-encrypts every stored password that is not yet under the configured
+// secrets.key_id.
+type RotateSMTPKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSMTPKeysRequest) Reset() {
+	*x = RotateSMTPKeysRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSMTPKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSMTPKeysRequest) ProtoMessage() {}
+
+func (x *RotateSMTPKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSMTPKeysRequest.ProtoReflect.Descriptor instead.
+func (*RotateSMTPKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{253}
+}
+
+type RotateSMTPKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rotated       int32                  `protobuf:"varint,1,opt,name=rotated,proto3" json:"rotated,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSMTPKeysResponse) Reset() {
+	*x = RotateSMTPKeysResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSMTPKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSMTPKeysResponse) ProtoMessage() {}
+
+func (x *RotateSMTPKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSMTPKeysResponse.ProtoReflect.Descriptor instead.
+func (*RotateSMTPKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *RotateSMTPKeysResponse) GetRotated() int32 {
+	if x != nil {
+		return x.Rotated
+	}
+	return 0
+}
+
+func (x *RotateSMTPKeysResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// data declares the variables the bodies may use, mapped to sample values
+// for previews. Bodies are Go templates, e.g. "Hello {{.first_name}}".
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Channels      []string               `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EmailSubject  string                 `protobuf:"bytes,5,opt,name=email_subject,json=emailSubject,proto3" json:"email_subject,omitempty"`
+	EmailHtml     string                 `protobuf:"bytes,6,opt,name=email_html,json=emailHtml,proto3" json:"email_html,omitempty"`
+	EmailText     string                 `protobuf:"bytes,7,opt,name=email_text,json=emailText,proto3" json:"email_text,omitempty"`
+	SmsText       string                 `protobuf:"bytes,8,opt,name=sms_text,json=smsText,proto3" json:"sms_text,omitempty"`
+	InAppText     string                 `protobuf:"bytes,9,opt,name=in_app_text,json=inAppText,proto3" json:"in_app_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetEmailSubject() string {
+	if x != nil {
+		return x.EmailSubject
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetEmailHtml() string {
+	if x != nil {
+		return x.EmailHtml
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetEmailText() string {
+	if x != nil {
+		return x.EmailText
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetSmsText() string {
+	if x != nil {
+		return x.SmsText
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetInAppText() string {
+	if x != nil {
+		return x.InAppText
+	}
+	return ""
+}
+
+// Changing any body or data saves a new version; name and url changes alone
+// do not.
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Channels      []string               `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EmailSubject  string                 `protobuf:"bytes,6,opt,name=email_subject,json=emailSubject,proto3" json:"email_subject,omitempty"`
+	EmailHtml     string                 `protobuf:"bytes,7,opt,name=email_html,json=emailHtml,proto3" json:"email_html,omitempty"`
+	EmailText     string                 `protobuf:"bytes,8,opt,name=email_text,json=emailText,proto3" json:"email_text,omitempty"`
+	SmsText       string                 `protobuf:"bytes,9,opt,name=sms_text,json=smsText,proto3" json:"sms_text,omitempty"`
+	InAppText     string                 `protobuf:"bytes,10,opt,name=in_app_text,json=inAppText,proto3" json:"in_app_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *UpdateTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *UpdateTemplateRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateTemplateRequest) GetEmailSubject() string {
+	if x != nil {
+		return x.EmailSubject
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetEmailHtml() string {
+	if x != nil {
+		return x.EmailHtml
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetEmailText() string {
+	if x != nil {
+		return x.EmailText
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetSmsText() string {
+	if x != nil {
+		return x.SmsText
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetInAppText() string {
+	if x != nil {
+		return x.InAppText
+	}
+	return ""
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0 for the current version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{257}
+}
+
+func (x *GetTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Channels      []string               `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	EmailSubject  string                 `protobuf:"bytes,9,opt,name=email_subject,json=emailSubject,proto3" json:"email_subject,omitempty"`
+	EmailHtml     string                 `protobuf:"bytes,10,opt,name=email_html,json=emailHtml,proto3" json:"email_html,omitempty"`
+	EmailText     string                 `protobuf:"bytes,11,opt,name=email_text,json=emailText,proto3" json:"email_text,omitempty"`
+	SmsText       string                 `protobuf:"bytes,12,opt,name=sms_text,json=smsText,proto3" json:"sms_text,omitempty"`
+	InAppText     string                 `protobuf:"bytes,13,opt,name=in_app_text,json=inAppText,proto3" json:"in_app_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateResponse) Reset() {
+	*x = TemplateResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateResponse) ProtoMessage() {}
+
+func (x *TemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateResponse.ProtoReflect.Descriptor instead.
+func (*TemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *TemplateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateResponse) GetEmailSubject() string {
+	if x != nil {
+		return x.EmailSubject
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetEmailHtml() string {
+	if x != nil {
+		return x.EmailHtml
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetEmailText() string {
+	if x != nil {
+		return x.EmailText
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetSmsText() string {
+	if x != nil {
+		return x.SmsText
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetInAppText() string {
+	if x != nil {
+		return x.InAppText
+	}
+	return ""
+}
+
+// Renders a stored template, looked up by id or name, with data laid over
+// its sample values.
+type PreviewTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 0 for the current version
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTemplateRequest) Reset() {
+	*x = PreviewTemplateRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTemplateRequest) ProtoMessage() {}
+
+func (x *PreviewTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *PreviewTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PreviewTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PreviewTemplateRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PreviewTemplateRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PreviewTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Html          string                 `protobuf:"bytes,4,opt,name=html,proto3" json:"html,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTemplateResponse) Reset() {
+	*x = PreviewTemplateResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTemplateResponse) ProtoMessage() {}
+
+func (x *PreviewTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTemplateResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *PreviewTemplateResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PreviewTemplateResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *PreviewTemplateResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ListTemplatesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Page              int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[261]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{261}
+}
+
+func (x *ListTemplatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTemplatesRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTemplatesRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListTemplatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TemplateResponse    `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                 `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[262]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*TemplateResponse {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTemplatesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListTemplatesResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type NotificationLogResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NotificationType string                 `protobuf:"bytes,2,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"` // e.g. new_lead,
+	TemplateName     string                 `protobuf:"bytes,3,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	Recipient        string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending, sending, sent, retrying, failed
+	ErrorMessage     string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SentAt           string                 `protobuf:"bytes,7,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	Channel          string                 `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
+	Attempts         int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	TemplateVersion  int32                  `protobuf:"varint,10,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	UserId           string                 `protobuf:"bytes,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NextAttemptAt    string                 `protobuf:"bytes,12,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NotificationLogResponse) Reset() {
+	*x = NotificationLogResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationLogResponse) ProtoMessage() {}
+
+func (x *NotificationLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[263]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationLogResponse.ProtoReflect.Descriptor instead.
+func (*NotificationLogResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *NotificationLogResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationLogResponse) GetTemplateVersion() int32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *NotificationLogResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *NotificationLogResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Filters are optional; created_from/created_to are RFC3339 and bound a
+// half-open range on created_at.
+type ListLogsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Page              int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Recipient         string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	TemplateName      string                 `protobuf:"bytes,5,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	CreatedFrom       string                 `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo         string                 `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Channel           string                 `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
+	Filter            *FilterGroup           `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort              []*SortField           `protobuf:"bytes,10,rep,name=sort,proto3" json:"sort,omitempty"`
+	PageToken         string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next or prev page token of an earlier response; overrides page
+	IncludeTotalCount bool                   `protobuf:"varint,12,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListLogsRequest) Reset() {
+	*x = ListLogsRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogsRequest) ProtoMessage() {}
+
+func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[264]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{264}
+}
+
+func (x *ListLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*DeleteOpportunityRequest) ProtoMessage() {}
-
-func (x *DeleteOpportunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[64]
+func (x *ListLogsRequest) GetRecipient() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Recipient
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteOpportunityRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOpportunityRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{64}
+func (x *ListLogsRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
 }
 
-func (x *DeleteOpportunityRequest) GetId() uint32 {
+func (x *ListLogsRequest) GetCreatedFrom() string {
 	if x != nil {
-		return x.Id
+		return x.CreatedFrom
 	}
-	return 0
+	return ""
 }
 
-type DeleteOpportunityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListLogsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
 }
 
-func (x *DeleteOpportunityResponse) Reset() {
-	*x = DeleteOpportunityResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[65]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListLogsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
 }
 
-func (x *DeleteOpportunityResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListLogsRequest) GetFilter() *FilterGroup {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (*DeleteOpportunityResponse) ProtoMessage() {}
-
-func (x *DeleteOpportunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[65]
+func (x *ListLogsRequest) GetSort() []*SortField {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sort
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteOpportunityResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOpportunityResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{65}
+func (x *ListLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func (x *DeleteOpportunityResponse) GetSuccess() bool {
+func (x *ListLogsRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		return x.Success
+		return x.IncludeTotalCount
 	}
 	return false
 }
 
-type ListOpportunitiesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Optional filter
+type ListLogsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Logs          []*NotificationLogResponse `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	NextPageToken string                     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	PrevPageToken string                     `protobuf:"bytes,3,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"` // empty on the first page
+	TotalCount    uint32                     `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOpportunitiesRequest) Reset() {
-	*x = ListOpportunitiesRequest{}
-	mi := &file_api_proto_crm_proto_msgTypes[66]
+func (x *ListLogsResponse) Reset() {
+	*x = ListLogsResponse{}
+	mi := &file_api_proto_crm_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOpportunitiesRequest) String() string {
+func (x *ListLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOpportunitiesRequest) ProtoMessage() {}
+func (*ListLogsResponse) ProtoMessage() {}
 
-func (x *ListOpportunitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[66]
+func (x *ListLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3617,40 +16459,61 @@ func (x *ListOpportunitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOpportunitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListOpportunitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use ListLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{265}
 }
 
-func (x *ListOpportunitiesRequest) GetOwnerId() uint32 {
+func (x *ListLogsResponse) GetLogs() []*NotificationLogResponse {
 	if x != nil {
-		return x.OwnerId
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListLogsResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListLogsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
 
-type ListOpportunitiesResponse struct {
+type GetLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Opportunities []*Opportunity         `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOpportunitiesResponse) Reset() {
-	*x = ListOpportunitiesResponse{}
-	mi := &file_api_proto_crm_proto_msgTypes[67]
+func (x *GetLogRequest) Reset() {
+	*x = GetLogRequest{}
+	mi := &file_api_proto_crm_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOpportunitiesResponse) String() string {
+func (x *GetLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOpportunitiesResponse) ProtoMessage() {}
+func (*GetLogRequest) ProtoMessage() {}
 
-func (x *ListOpportunitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_crm_proto_msgTypes[67]
+func (x *GetLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_crm_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3661,23 +16524,38 @@ func (x *ListOpportunitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOpportunitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListOpportunitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_crm_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use GetLogRequest.ProtoReflect.Descriptor instead.
+func (*GetLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_crm_proto_rawDescGZIP(), []int{266}
 }
 
-func (x *ListOpportunitiesResponse) GetOpportunities() []*Opportunity {
+func (x *GetLogRequest) GetId() string {
 	if x != nil {
-		return x.Opportunities
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
 var File_api_proto_crm_proto protoreflect.FileDescriptor
 
 const file_api_proto_crm_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/proto/crm.proto\x12\x03crm\"\xf6\x01\n" +
+	"\x13api/proto/crm.proto\x12\x03crm\"[\n" +
+	"\x0fFilterCondition\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\x83\x01\n" +
+	"\vFilterGroup\x12\x14\n" +
+	"\x05logic\x18\x01 \x01(\tR\x05logic\x124\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x14.crm.FilterConditionR\n" +
+	"conditions\x12(\n" +
+	"\x06groups\x18\x03 \x03(\v2\x10.crm.FilterGroupR\x06groups\"A\n" +
+	"\tSortField\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x02 \x01(\bR\n" +
+	"descending\"\xf6\x01\n" +
 	"\bActivity\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -3706,7 +16584,7 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x15DeleteActivityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"2\n" +
 	"\x16DeleteActivityResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xab\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc8\x02\n" +
 	"\x15ListActivitiesRequest\x12\x1f\n" +
 	"\vpage_number\x18\x01 \x01(\rR\n" +
 	"pageNumber\x12\x1b\n" +
@@ -3714,11 +16592,20 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1c\n" +
 	"\tascending\x18\x04 \x01(\bR\tascending\x12\x1d\n" +
 	"\n" +
-	"contact_id\x18\x05 \x01(\rR\tcontactId\"G\n" +
+	"contact_id\x18\x05 \x01(\rR\tcontactId\x12(\n" +
+	"\x06filter\x18\x06 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\a \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\t \x01(\bR\x11includeTotalCount\"\xb8\x01\n" +
 	"\x16ListActivitiesResponse\x12-\n" +
 	"\n" +
 	"activities\x18\x01 \x03(\v2\r.crm.ActivityR\n" +
-	"activities\"\xfc\x01\n" +
+	"activities\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xfc\x01\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -3747,7 +16634,7 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\".\n" +
 	"\x12DeleteTaskResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa8\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc5\x02\n" +
 	"\x10ListTasksRequest\x12\x1f\n" +
 	"\vpage_number\x18\x01 \x01(\rR\n" +
 	"pageNumber\x12\x1b\n" +
@@ -3755,9 +16642,18 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1c\n" +
 	"\tascending\x18\x04 \x01(\bR\tascending\x12\x1f\n" +
 	"\vactivity_id\x18\x05 \x01(\rR\n" +
-	"activityId\"4\n" +
+	"activityId\x12(\n" +
+	"\x06filter\x18\x06 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\a \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\t \x01(\bR\x11includeTotalCount\"\xa5\x01\n" +
 	"\x11ListTasksResponse\x12\x1f\n" +
-	"\x05tasks\x18\x01 \x03(\v2\t.crm.TaskR\x05tasks\"\xc5\x04\n" +
+	"\x05tasks\x18\x01 \x03(\v2\t.crm.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xc5\x04\n" +
 	"\aContact\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12!\n" +
 	"\fcontact_type\x18\x02 \x01(\tR\vcontactType\x12\x1d\n" +
@@ -3787,11 +16683,16 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x14CreateContactRequest\x12&\n" +
 	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\"?\n" +
 	"\x15CreateContactResponse\x12&\n" +
-	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\"#\n" +
+	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\"X\n" +
 	"\x11GetContactRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"<\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x123\n" +
+	"\x15include_opportunities\x18\x02 \x01(\bR\x14includeOpportunities\"^\n" +
+	"\x12ContactOpportunity\x122\n" +
+	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"{\n" +
 	"\x12GetContactResponse\x12&\n" +
-	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\">\n" +
+	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\x12=\n" +
+	"\ropportunities\x18\x02 \x03(\v2\x17.crm.ContactOpportunityR\ropportunities\">\n" +
 	"\x14UpdateContactRequest\x12&\n" +
 	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\"?\n" +
 	"\x15UpdateContactResponse\x12&\n" +
@@ -3799,15 +16700,24 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x14DeleteContactRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"1\n" +
 	"\x15DeleteContactResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8a\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa7\x02\n" +
 	"\x13ListContactsRequest\x12\x1f\n" +
 	"\vpage_number\x18\x01 \x01(\rR\n" +
 	"pageNumber\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x17\n" +
 	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1c\n" +
-	"\tascending\x18\x04 \x01(\bR\tascending\"@\n" +
+	"\tascending\x18\x04 \x01(\bR\tascending\x12(\n" +
+	"\x06filter\x18\x05 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x06 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xb1\x01\n" +
 	"\x14ListContactsResponse\x12(\n" +
-	"\bcontacts\x18\x01 \x03(\v2\f.crm.ContactR\bcontacts\"\x8e\x03\n" +
+	"\bcontacts\x18\x01 \x03(\v2\f.crm.ContactR\bcontacts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\x8e\x03\n" +
 	"\aCompany\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -3831,11 +16741,13 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x14CreateCompanyRequest\x12&\n" +
 	"\acompany\x18\x01 \x01(\v2\f.crm.CompanyR\acompany\"?\n" +
 	"\x15CreateCompanyResponse\x12&\n" +
-	"\acompany\x18\x01 \x01(\v2\f.crm.CompanyR\acompany\"#\n" +
+	"\acompany\x18\x01 \x01(\v2\f.crm.CompanyR\acompany\"X\n" +
 	"\x11GetCompanyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"<\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x123\n" +
+	"\x15include_opportunities\x18\x02 \x01(\bR\x14includeOpportunities\"t\n" +
 	"\x12GetCompanyResponse\x12&\n" +
-	"\acompany\x18\x01 \x01(\v2\f.crm.CompanyR\acompany\">\n" +
+	"\acompany\x18\x01 \x01(\v2\f.crm.CompanyR\acompany\x126\n" +
+	"\ropportunities\x18\x02 \x03(\v2\x10.crm.OpportunityR\ropportunities\">\n" +
 	"\x14UpdateCompanyRequest\x12&\n" +
 	"\acompany\x18\x01 \x01(\v2\f.crm.CompanyR\acompany\"?\n" +
 	"\x15UpdateCompanyResponse\x12&\n" +
@@ -3843,16 +16755,25 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x14DeleteCompanyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"1\n" +
 	"\x15DeleteCompanyResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb4\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd1\x02\n" +
 	"\x14ListCompaniesRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\x12\x1f\n" +
 	"\vpage_number\x18\x02 \x01(\rR\n" +
 	"pageNumber\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x17\n" +
 	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x1c\n" +
-	"\tascending\x18\x05 \x01(\bR\tascending\"C\n" +
+	"\tascending\x18\x05 \x01(\bR\tascending\x12(\n" +
+	"\x06filter\x18\x06 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\a \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\t \x01(\bR\x11includeTotalCount\"\xb4\x01\n" +
 	"\x15ListCompaniesResponse\x12*\n" +
-	"\tcompanies\x18\x01 \x03(\v2\f.crm.CompanyR\tcompanies\"\x9e\x02\n" +
+	"\tcompanies\x18\x01 \x03(\v2\f.crm.CompanyR\tcompanies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xe8\x05\n" +
 	"\x04Lead\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3868,7 +16789,21 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"2\n" +
+	" \x01(\tR\tupdatedAt\x12!\n" +
+	"\fconverted_at\x18\v \x01(\tR\vconvertedAt\x120\n" +
+	"\x14converted_contact_id\x18\f \x01(\rR\x12convertedContactId\x120\n" +
+	"\x14converted_company_id\x18\r \x01(\rR\x12convertedCompanyId\x128\n" +
+	"\x18converted_opportunity_id\x18\x0e \x01(\rR\x16convertedOpportunityId\x12*\n" +
+	"\x11status_entered_at\x18\x0f \x01(\tR\x0fstatusEnteredAt\x12\x14\n" +
+	"\x05score\x18\x10 \x01(\x05R\x05score\x12(\n" +
+	"\x10score_updated_at\x18\x11 \x01(\tR\x0escoreUpdatedAt\x12\x18\n" +
+	"\acountry\x18\x12 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x13 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x14 \x01(\rR\tcompanyId\x12\x1f\n" +
+	"\vassigned_at\x18\x15 \x01(\tR\n" +
+	"assignedAt\x12+\n" +
+	"\x11assignment_reason\x18\x16 \x01(\tR\x10assignmentReason\"2\n" +
 	"\x11CreateLeadRequest\x12\x1d\n" +
 	"\x04lead\x18\x01 \x01(\v2\t.crm.LeadR\x04lead\"3\n" +
 	"\x12CreateLeadResponse\x12\x1d\n" +
@@ -3884,14 +16819,234 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x11DeleteLeadRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\".\n" +
 	"\x12DeleteLeadResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x14\n" +
-	"\x12GetAllLeadsRequest\"6\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa6\x02\n" +
+	"\x12GetAllLeadsRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\x04 \x01(\bR\tascending\x12(\n" +
+	"\x06filter\x18\x05 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x06 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xa7\x01\n" +
 	"\x13GetAllLeadsResponse\x12\x1f\n" +
-	"\x05leads\x18\x01 \x03(\v2\t.crm.LeadR\x05leads\"-\n" +
+	"\x05leads\x18\x01 \x03(\v2\t.crm.LeadR\x05leads\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xc7\x01\n" +
+	"\x12ConvertLeadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12&\n" +
+	"\acontact\x18\x02 \x01(\v2\f.crm.ContactR\acontact\x12&\n" +
+	"\acompany\x18\x03 \x01(\v2\f.crm.CompanyR\acompany\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x04 \x01(\rR\tcompanyId\x122\n" +
+	"\vopportunity\x18\x05 \x01(\v2\x10.crm.OpportunityR\vopportunity\"\xb8\x01\n" +
+	"\x13ConvertLeadResponse\x12\x1d\n" +
+	"\x04lead\x18\x01 \x01(\v2\t.crm.LeadR\x04lead\x12&\n" +
+	"\acontact\x18\x02 \x01(\v2\f.crm.ContactR\acontact\x12&\n" +
+	"\acompany\x18\x03 \x01(\v2\f.crm.CompanyR\acompany\x122\n" +
+	"\vopportunity\x18\x04 \x01(\v2\x10.crm.OpportunityR\vopportunity\"\\\n" +
+	"\x14LeadStatusDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\ainitial\x18\x02 \x01(\bR\ainitial\x12\x16\n" +
+	"\x06closed\x18\x03 \x01(\bR\x06closed\":\n" +
+	"\x14LeadStatusTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xc5\x01\n" +
+	"\fLeadWorkflow\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\x125\n" +
+	"\bstatuses\x18\x02 \x03(\v2\x19.crm.LeadStatusDefinitionR\bstatuses\x12;\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x19.crm.LeadStatusTransitionR\vtransitions\x12\x18\n" +
+	"\adefault\x18\x04 \x01(\bR\adefault\"A\n" +
+	"\x16GetLeadWorkflowRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\"\xb5\x01\n" +
+	"\x16SetLeadWorkflowRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\x125\n" +
+	"\bstatuses\x18\x02 \x03(\v2\x19.crm.LeadStatusDefinitionR\bstatuses\x12;\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x19.crm.LeadStatusTransitionR\vtransitions\"-\n" +
+	"\x1bGetLeadStatusHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"o\n" +
+	"\x10LeadStatusChange\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x1d\n" +
+	"\n" +
+	"entered_at\x18\x03 \x01(\tR\tenteredAt\"O\n" +
+	"\x1cGetLeadStatusHistoryResponse\x12/\n" +
+	"\ahistory\x18\x01 \x03(\v2\x15.crm.LeadStatusChangeR\ahistory\"-\n" +
 	"\x15GetLeadByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"7\n" +
 	"\x16GetLeadByEmailResponse\x12\x1d\n" +
-	"\x04lead\x18\x01 \x01(\v2\t.crm.LeadR\x04lead\"\xd3\x02\n" +
+	"\x04lead\x18\x01 \x01(\v2\t.crm.LeadR\x04lead\"\xaf\x02\n" +
+	"\vScoringRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\trule_type\x18\x04 \x01(\tR\bruleType\x12\x14\n" +
+	"\x05field\x18\x05 \x01(\tR\x05field\x12\x1a\n" +
+	"\boperator\x18\x06 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\a \x01(\tR\x05value\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x05R\x06weight\x12\x18\n" +
+	"\aenabled\x18\t \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"@\n" +
+	"\x18CreateScoringRuleRequest\x12$\n" +
+	"\x04rule\x18\x01 \x01(\v2\x10.crm.ScoringRuleR\x04rule\"@\n" +
+	"\x18UpdateScoringRuleRequest\x12$\n" +
+	"\x04rule\x18\x01 \x01(\v2\x10.crm.ScoringRuleR\x04rule\"*\n" +
+	"\x18DeleteScoringRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"5\n" +
+	"\x19DeleteScoringRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9d\x02\n" +
+	"\x17ListScoringRulesRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\rR\x0eorganizationId\x12(\n" +
+	"\x06filter\x18\x04 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x05 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCount\"\xb3\x01\n" +
+	"\x18ListScoringRulesResponse\x12&\n" +
+	"\x05rules\x18\x01 \x03(\v2\x10.crm.ScoringRuleR\x05rules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"E\n" +
+	"\x1aRecomputeLeadScoresRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\"5\n" +
+	"\x1bRecomputeLeadScoresResponse\x12\x16\n" +
+	"\x06scored\x18\x01 \x01(\rR\x06scored\"l\n" +
+	"\x0fSalesTeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x12(\n" +
+	"\x10last_assigned_at\x18\x03 \x01(\tR\x0elastAssignedAt\"\xa7\x01\n" +
+	"\tSalesTeam\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\amembers\x18\x04 \x03(\v2\x14.crm.SalesTeamMemberR\amembers\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"U\n" +
+	"\x16CreateSalesTeamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"@\n" +
+	"\x15ListSalesTeamsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\">\n" +
+	"\x16ListSalesTeamsResponse\x12$\n" +
+	"\x05teams\x18\x01 \x03(\v2\x0e.crm.SalesTeamR\x05teams\"(\n" +
+	"\x16DeleteSalesTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"3\n" +
+	"\x17DeleteSalesTeamResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
+	"\x19SetSalesTeamMemberRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\"P\n" +
+	"\x1cRemoveSalesTeamMemberRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"9\n" +
+	"\x1dRemoveSalesTeamMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd2\x02\n" +
+	"\x0eAssignmentRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12\x1a\n" +
+	"\bstrategy\x18\x05 \x01(\tR\bstrategy\x12\x17\n" +
+	"\ateam_id\x18\x06 \x01(\rR\x06teamId\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\b \x01(\tR\x05state\x12\x1a\n" +
+	"\bindustry\x18\t \x01(\tR\bindustry\x12\x18\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"F\n" +
+	"\x1bCreateAssignmentRuleRequest\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.crm.AssignmentRuleR\x04rule\"F\n" +
+	"\x1bUpdateAssignmentRuleRequest\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.crm.AssignmentRuleR\x04rule\"-\n" +
+	"\x1bDeleteAssignmentRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"8\n" +
+	"\x1cDeleteAssignmentRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\x1aListAssignmentRulesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\"H\n" +
+	"\x1bListAssignmentRulesResponse\x12)\n" +
+	"\x05rules\x18\x01 \x03(\v2\x13.crm.AssignmentRuleR\x05rules\",\n" +
+	"\x11AssignLeadRequest\x12\x17\n" +
+	"\alead_id\x18\x01 \x01(\rR\x06leadId\"3\n" +
+	"\x12AssignLeadResponse\x12\x1d\n" +
+	"\x04lead\x18\x01 \x01(\v2\t.crm.LeadR\x04lead\"\x84\x01\n" +
+	"\x15FindDuplicatesRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12!\n" +
+	"\fmax_clusters\x18\x03 \x01(\rR\vmaxClusters\"\x8c\x01\n" +
+	"\x12DuplicateCandidate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\"f\n" +
+	"\x0eDuplicateMatch\x12\x11\n" +
+	"\x04id_a\x18\x01 \x01(\rR\x03idA\x12\x11\n" +
+	"\x04id_b\x18\x02 \x01(\rR\x03idB\x12\x18\n" +
+	"\areasons\x18\x03 \x03(\tR\areasons\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\"\x8a\x01\n" +
+	"\x10DuplicateCluster\x121\n" +
+	"\arecords\x18\x01 \x03(\v2\x17.crm.DuplicateCandidateR\arecords\x12-\n" +
+	"\amatches\x18\x02 \x03(\v2\x13.crm.DuplicateMatchR\amatches\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"K\n" +
+	"\x16FindDuplicatesResponse\x121\n" +
+	"\bclusters\x18\x01 \x03(\v2\x15.crm.DuplicateClusterR\bclusters\"N\n" +
+	"\fMergeRequest\x12\x1f\n" +
+	"\vsurvivor_id\x18\x01 \x01(\rR\n" +
+	"survivorId\x12\x1d\n" +
+	"\n" +
+	"merged_ids\x18\x02 \x03(\rR\tmergedIds\"\xc4\x03\n" +
+	"\n" +
+	"MergeAudit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\ventity_type\x18\x02 \x01(\tR\n" +
+	"entityType\x12\x1f\n" +
+	"\vsurvivor_id\x18\x03 \x01(\rR\n" +
+	"survivorId\x12\x1b\n" +
+	"\tmerged_id\x18\x04 \x01(\rR\bmergedId\x12#\n" +
+	"\rmerged_record\x18\x05 \x01(\tR\fmergedRecord\x12F\n" +
+	"\rfilled_fields\x18\x06 \x03(\v2!.crm.MergeAudit.FilledFieldsEntryR\ffilledFields\x12<\n" +
+	"\trepointed\x18\a \x03(\v2\x1e.crm.MergeAudit.RepointedEntryR\trepointed\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x1a?\n" +
+	"\x11FilledFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eRepointedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"h\n" +
+	"\x15MergeContactsResponse\x12&\n" +
+	"\acontact\x18\x01 \x01(\v2\f.crm.ContactR\acontact\x12'\n" +
+	"\x06audits\x18\x02 \x03(\v2\x0f.crm.MergeAuditR\x06audits\"\\\n" +
+	"\x12MergeLeadsResponse\x12\x1d\n" +
+	"\x04lead\x18\x01 \x01(\v2\t.crm.LeadR\x04lead\x12'\n" +
+	"\x06audits\x18\x02 \x03(\v2\x0f.crm.MergeAuditR\x06audits\"Z\n" +
+	"\x16ListMergeAuditsRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1f\n" +
+	"\vsurvivor_id\x18\x02 \x01(\rR\n" +
+	"survivorId\"B\n" +
+	"\x17ListMergeAuditsResponse\x12'\n" +
+	"\x06audits\x18\x01 \x03(\v2\x0f.crm.MergeAuditR\x06audits\"\xca\x04\n" +
 	"\vOpportunity\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -3909,7 +17064,18 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\"N\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vpipeline_id\x18\r \x01(\rR\n" +
+	"pipelineId\x125\n" +
+	"\x16probability_overridden\x18\x0e \x01(\bR\x15probabilityOverridden\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vloss_reason\x18\x10 \x01(\tR\n" +
+	"lossReason\x12\x1e\n" +
+	"\n" +
+	"competitor\x18\x11 \x01(\tR\n" +
+	"competitor\x12\x1b\n" +
+	"\tclosed_at\x18\x12 \x01(\tR\bclosedAt\x12#\n" +
+	"\ramount_locked\x18\x13 \x01(\bR\famountLocked\"N\n" +
 	"\x18CreateOpportunityRequest\x122\n" +
 	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\"O\n" +
 	"\x19CreateOpportunityResponse\x122\n" +
@@ -3917,19 +17083,805 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\x15GetOpportunityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"L\n" +
 	"\x16GetOpportunityResponse\x122\n" +
-	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\"N\n" +
+	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\"{\n" +
 	"\x18UpdateOpportunityRequest\x122\n" +
-	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\"O\n" +
+	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\x12+\n" +
+	"\x11reset_probability\x18\x02 \x01(\bR\x10resetProbability\"O\n" +
 	"\x19UpdateOpportunityResponse\x122\n" +
 	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\"*\n" +
 	"\x18DeleteOpportunityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"5\n" +
 	"\x19DeleteOpportunityResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb4\x02\n" +
 	"\x18ListOpportunitiesRequest\x12\x19\n" +
-	"\bowner_id\x18\x01 \x01(\rR\aownerId\"S\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12(\n" +
+	"\x06filter\x18\x04 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x05 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xb7\x02\n" +
 	"\x19ListOpportunitiesResponse\x126\n" +
-	"\ropportunities\x18\x01 \x03(\v2\x10.crm.OpportunityR\ropportunities2\xff\x02\n" +
+	"\ropportunities\x18\x01 \x03(\v2\x10.crm.OpportunityR\ropportunities\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\x122\n" +
+	"\x15total_weighted_amount\x18\x03 \x01(\x01R\x13totalWeightedAmount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x06 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\a \x01(\rR\n" +
+	"totalCount\".\n" +
+	"\x1cGetOpportunityHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x82\x01\n" +
+	"\x11OpportunityChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\tR\tchangedAt\"Q\n" +
+	"\x1dGetOpportunityHistoryResponse\x120\n" +
+	"\ahistory\x18\x01 \x03(\v2\x16.crm.OpportunityChangeR\ahistory\"\x7f\n" +
+	"\x1cGetOpportunityMetricsRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\rR\n" +
+	"pipelineId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\x12#\n" +
+	"\rcreated_since\x18\x03 \x01(\tR\fcreatedSince\"\x8a\x01\n" +
+	"\fStageMetrics\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x12\x18\n" +
+	"\aentered\x18\x02 \x01(\rR\aentered\x12!\n" +
+	"\faverage_days\x18\x03 \x01(\x01R\vaverageDays\x12'\n" +
+	"\x0fconversion_rate\x18\x04 \x01(\x01R\x0econversionRate\"j\n" +
+	"\fOwnerWinRate\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x10\n" +
+	"\x03won\x18\x02 \x01(\rR\x03won\x12\x12\n" +
+	"\x04lost\x18\x03 \x01(\rR\x04lost\x12\x19\n" +
+	"\bwin_rate\x18\x04 \x01(\x01R\awinRate\"\x95\x01\n" +
+	"\x12OpportunityMetrics\x12)\n" +
+	"\bpipeline\x18\x01 \x01(\v2\r.crm.PipelineR\bpipeline\x12)\n" +
+	"\x06stages\x18\x02 \x03(\v2\x11.crm.StageMetricsR\x06stages\x12)\n" +
+	"\x06owners\x18\x03 \x03(\v2\x11.crm.OwnerWinRateR\x06owners\"\xa0\x03\n" +
+	"\x13OpportunityLineItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12%\n" +
+	"\x0eopportunity_id\x18\x02 \x01(\rR\ropportunityId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\rR\tproductId\x12\"\n" +
+	"\rprice_book_id\x18\x04 \x01(\rR\vpriceBookId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x01R\bquantity\x12\"\n" +
+	"\n" +
+	"unit_price\x18\a \x01(\x01H\x00R\tunitPrice\x88\x01\x01\x12)\n" +
+	"\x10discount_percent\x18\b \x01(\x01R\x0fdiscountPercent\x12\x1f\n" +
+	"\vtax_percent\x18\t \x01(\x01R\n" +
+	"taxPercent\x12\x14\n" +
+	"\x05total\x18\n" +
+	" \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAtB\r\n" +
+	"\v_unit_price\"=\n" +
+	"\x14ListLineItemsRequest\x12%\n" +
+	"\x0eopportunity_id\x18\x01 \x01(\rR\ropportunityId\"P\n" +
+	"\x15ListLineItemsResponse\x127\n" +
+	"\n" +
+	"line_items\x18\x01 \x03(\v2\x18.crm.OpportunityLineItemR\tlineItems\"r\n" +
+	"\x12AddLineItemRequest\x12%\n" +
+	"\x0eopportunity_id\x18\x01 \x01(\rR\ropportunityId\x125\n" +
+	"\tline_item\x18\x02 \x01(\v2\x18.crm.OpportunityLineItemR\blineItem\"N\n" +
+	"\x15UpdateLineItemRequest\x125\n" +
+	"\tline_item\x18\x01 \x01(\v2\x18.crm.OpportunityLineItemR\blineItem\"'\n" +
+	"\x15RemoveLineItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"}\n" +
+	"\x10LineItemResponse\x125\n" +
+	"\tline_item\x18\x01 \x01(\v2\x18.crm.OpportunityLineItemR\blineItem\x122\n" +
+	"\vopportunity\x18\x02 \x01(\v2\x10.crm.OpportunityR\vopportunity\"\xa9\x01\n" +
+	"\x1aCloseOpportunityWonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\tR\x05stage\x12\x1d\n" +
+	"\n" +
+	"close_date\x18\x03 \x01(\tR\tcloseDate\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\x01H\x00R\x06amount\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"competitor\x18\x05 \x01(\tR\n" +
+	"competitorB\t\n" +
+	"\a_amount\"\xcb\x01\n" +
+	"\x1bCloseOpportunityLostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\tR\x05stage\x12\x1d\n" +
+	"\n" +
+	"close_date\x18\x03 \x01(\tR\tcloseDate\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\x01H\x00R\x06amount\x88\x01\x01\x12\x1f\n" +
+	"\vloss_reason\x18\x05 \x01(\tR\n" +
+	"lossReason\x12\x1e\n" +
+	"\n" +
+	"competitor\x18\x06 \x01(\tR\n" +
+	"competitorB\t\n" +
+	"\a_amount\"N\n" +
+	"\x18CloseOpportunityResponse\x122\n" +
+	"\vopportunity\x18\x01 \x01(\v2\x10.crm.OpportunityR\vopportunity\"\xdb\x01\n" +
+	"\n" +
+	"LossReason\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\x06active\x18\x04 \x01(\bH\x00R\x06active\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\rR\bposition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAtB\t\n" +
+	"\a_active\"A\n" +
+	"\x16ListLossReasonsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\"M\n" +
+	"\x17ListLossReasonsResponse\x122\n" +
+	"\floss_reasons\x18\x01 \x03(\v2\x0f.crm.LossReasonR\vlossReasons\"K\n" +
+	"\x17CreateLossReasonRequest\x120\n" +
+	"\vloss_reason\x18\x01 \x01(\v2\x0f.crm.LossReasonR\n" +
+	"lossReason\"K\n" +
+	"\x17UpdateLossReasonRequest\x120\n" +
+	"\vloss_reason\x18\x01 \x01(\v2\x0f.crm.LossReasonR\n" +
+	"lossReason\")\n" +
+	"\x17DeleteLossReasonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"4\n" +
+	"\x18DeleteLossReasonResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8d\x01\n" +
+	"\x12OpportunityContact\x12%\n" +
+	"\x0eopportunity_id\x18\x01 \x01(\rR\ropportunityId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\rR\tcontactId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"x\n" +
+	"\x1cAddOpportunityContactRequest\x12%\n" +
+	"\x0eopportunity_id\x18\x01 \x01(\rR\ropportunityId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\rR\tcontactId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"{\n" +
+	"\x1fRemoveOpportunityContactRequest\x12%\n" +
+	"\x0eopportunity_id\x18\x01 \x01(\rR\ropportunityId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\rR\tcontactId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"<\n" +
+	" RemoveOpportunityContactResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\x1eListOpportunityContactsRequest\x12%\n" +
+	"\x0eopportunity_id\x18\x01 \x01(\rR\ropportunityId\"V\n" +
+	"\x1fListOpportunityContactsResponse\x123\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x17.crm.OpportunityContactR\bcontacts\"k\n" +
+	"\rPipelineStage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vprobability\x18\x02 \x01(\x01R\vprobability\x12\x10\n" +
+	"\x03won\x18\x03 \x01(\bR\x03won\x12\x12\n" +
+	"\x04lost\x18\x04 \x01(\bR\x04lost\"\xfb\x01\n" +
+	"\bPipeline\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\rR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\x12*\n" +
+	"\x06stages\x18\x05 \x03(\v2\x12.crm.PipelineStageR\x06stages\x12\x19\n" +
+	"\bbuilt_in\x18\x06 \x01(\bR\abuiltIn\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"B\n" +
+	"\x15CreatePipelineRequest\x12)\n" +
+	"\bpipeline\x18\x01 \x01(\v2\r.crm.PipelineR\bpipeline\"$\n" +
+	"\x12GetPipelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"B\n" +
+	"\x15UpdatePipelineRequest\x12)\n" +
+	"\bpipeline\x18\x01 \x01(\v2\r.crm.PipelineR\bpipeline\"'\n" +
+	"\x15DeletePipelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"2\n" +
+	"\x16DeletePipelineResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\x14ListPipelinesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\"D\n" +
+	"\x15ListPipelinesResponse\x12+\n" +
+	"\tpipelines\x18\x01 \x03(\v2\r.crm.PipelineR\tpipelines\"\xe9\x01\n" +
+	"\x0fForecastRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x19\n" +
+	"\bgroup_by\x18\x02 \x01(\tR\agroupBy\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\rR\aownerId\x12\x1f\n" +
+	"\vpipeline_id\x18\x06 \x01(\rR\n" +
+	"pipelineId\x12'\n" +
+	"\x0forganization_id\x18\a \x01(\rR\x0eorganizationId\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"\xc6\x02\n" +
+	"\vForecastRow\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1b\n" +
+	"\tgroup_key\x18\x03 \x01(\tR\bgroupKey\x12\x1f\n" +
+	"\vgroup_label\x18\x04 \x01(\tR\n" +
+	"groupLabel\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\rR\x05count\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12'\n" +
+	"\x0fweighted_amount\x18\a \x01(\x01R\x0eweightedAmount\x12\x16\n" +
+	"\x06closed\x18\b \x01(\x01R\x06closed\x12\x16\n" +
+	"\x06commit\x18\t \x01(\x01R\x06commit\x12\x1b\n" +
+	"\tbest_case\x18\n" +
+	" \x01(\x01R\bbestCase\x12\x1a\n" +
+	"\bpipeline\x18\v \x01(\x01R\bpipeline\"`\n" +
+	"\bForecast\x12.\n" +
+	"\arequest\x18\x01 \x01(\v2\x14.crm.ForecastRequestR\arequest\x12$\n" +
+	"\x04rows\x18\x02 \x03(\v2\x10.crm.ForecastRowR\x04rows\"e\n" +
+	"\x1dCreateForecastSnapshotRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\bforecast\x18\x02 \x01(\v2\x14.crm.ForecastRequestR\bforecast\"\x80\x01\n" +
+	"\x10ForecastSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12)\n" +
+	"\bforecast\x18\x04 \x01(\v2\r.crm.ForecastR\bforecast\"F\n" +
+	"\x1aGetForecastSnapshotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\acompare\x18\x02 \x01(\bR\acompare\"y\n" +
+	"\x1bGetForecastSnapshotResponse\x121\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x15.crm.ForecastSnapshotR\bsnapshot\x12'\n" +
+	"\acurrent\x18\x02 \x01(\v2\r.crm.ForecastR\acurrent\"\xf9\x01\n" +
+	"\x1cListForecastSnapshotsRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12(\n" +
+	"\x06filter\x18\x03 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x04 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCount\"\xc5\x01\n" +
+	"\x1dListForecastSnapshotsResponse\x123\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x15.crm.ForecastSnapshotR\tsnapshots\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"/\n" +
+	"\x1dDeleteForecastSnapshotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\":\n" +
+	"\x1eDeleteForecastSnapshotResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd6\x01\n" +
+	"\fExchangeRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12#\n" +
+	"\rfrom_currency\x18\x02 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x03 \x01(\tR\n" +
+	"toCurrency\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12%\n" +
+	"\x0eeffective_date\x18\x05 \x01(\tR\reffectiveDate\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"B\n" +
+	"\x17SetExchangeRatesRequest\x12'\n" +
+	"\x05rates\x18\x01 \x03(\v2\x11.crm.ExchangeRateR\x05rates\"C\n" +
+	"\x18SetExchangeRatesResponse\x12'\n" +
+	"\x05rates\x18\x01 \x03(\v2\x11.crm.ExchangeRateR\x05rates\".\n" +
+	"\x1aImportExchangeRatesRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\"9\n" +
+	"\x1bImportExchangeRatesResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\rR\bimported\"\x91\x02\n" +
+	"\x18ListExchangeRatesRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vpage_number\x18\x02 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12(\n" +
+	"\x06filter\x18\x04 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x05 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCount\"\xb5\x01\n" +
+	"\x19ListExchangeRatesResponse\x12'\n" +
+	"\x05rates\x18\x01 \x03(\v2\x11.crm.ExchangeRateR\x05rates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"+\n" +
+	"\x19DeleteExchangeRateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"6\n" +
+	"\x1aDeleteExchangeRateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x1bGetReportingCurrencyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\"X\n" +
+	"\x11ReportingCurrency\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\rR\x0eorganizationId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x88\x01\n" +
+	"\x14ConvertAmountRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12#\n" +
+	"\rfrom_currency\x18\x02 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x03 \x01(\tR\n" +
+	"toCurrency\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\"K\n" +
+	"\x15ConvertAmountResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x82\x02\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"list_price\x18\x05 \x01(\x01R\tlistPrice\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\x06active\x18\a \x01(\bH\x00R\x06active\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAtB\t\n" +
+	"\a_active\">\n" +
+	"\x14CreateProductRequest\x12&\n" +
+	"\aproduct\x18\x01 \x01(\v2\f.crm.ProductR\aproduct\"#\n" +
+	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\">\n" +
+	"\x14UpdateProductRequest\x12&\n" +
+	"\aproduct\x18\x01 \x01(\v2\f.crm.ProductR\aproduct\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"1\n" +
+	"\x15DeleteProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa7\x02\n" +
+	"\x13ListProductsRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12(\n" +
+	"\x06filter\x18\x05 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x06 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xb1\x01\n" +
+	"\x14ListProductsResponse\x12(\n" +
+	"\bproducts\x18\x01 \x03(\v2\f.crm.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xd2\x01\n" +
+	"\tPriceBook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vpipeline_id\x18\x03 \x01(\rR\n" +
+	"pipelineId\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\x06active\x18\x05 \x01(\bH\x00R\x06active\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAtB\t\n" +
+	"\a_active\"G\n" +
+	"\x16CreatePriceBookRequest\x12-\n" +
+	"\n" +
+	"price_book\x18\x01 \x01(\v2\x0e.crm.PriceBookR\tpriceBook\"%\n" +
+	"\x13GetPriceBookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"G\n" +
+	"\x16UpdatePriceBookRequest\x12-\n" +
+	"\n" +
+	"price_book\x18\x01 \x01(\v2\x0e.crm.PriceBookR\tpriceBook\"(\n" +
+	"\x16DeletePriceBookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"3\n" +
+	"\x17DeletePriceBookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
+	"\x15ListPriceBooksRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\rR\n" +
+	"pipelineId\"I\n" +
+	"\x16ListPriceBooksResponse\x12/\n" +
+	"\vprice_books\x18\x01 \x03(\v2\x0e.crm.PriceBookR\n" +
+	"priceBooks\"r\n" +
+	"\x0ePriceBookEntry\x12\"\n" +
+	"\rprice_book_id\x18\x01 \x01(\rR\vpriceBookId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x01R\tunitPrice\"`\n" +
+	"\x1bRemovePriceBookEntryRequest\x12\"\n" +
+	"\rprice_book_id\x18\x01 \x01(\rR\vpriceBookId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\"8\n" +
+	"\x1cRemovePriceBookEntryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x1bListPriceBookEntriesRequest\x12\"\n" +
+	"\rprice_book_id\x18\x01 \x01(\rR\vpriceBookId\"M\n" +
+	"\x1cListPriceBookEntriesResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.crm.PriceBookEntryR\aentries\"\xf0\x01\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\rR\x0eorganizationId\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\x1a\n" +
+	"\bindustry\x18\x05 \x01(\tR\bindustry\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vpage_number\x18\a \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\rR\bpageSize\"\xe3\x01\n" +
+	"\tSearchHit\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsubtitle\x18\x04 \x01(\tR\bsubtitle\x12\x1c\n" +
+	"\thighlight\x18\x05 \x01(\tR\thighlight\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x1a\n" +
+	"\bindustry\x18\b \x01(\tR\bindustry\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"\xb4\x01\n" +
+	"\fSearchFacets\x12#\n" +
+	"\x04type\x18\x01 \x03(\v2\x0f.crm.FacetCountR\x04type\x12)\n" +
+	"\acountry\x18\x02 \x03(\v2\x0f.crm.FacetCountR\acountry\x12+\n" +
+	"\bindustry\x18\x03 \x03(\v2\x0f.crm.FacetCountR\bindustry\x12'\n" +
+	"\x06status\x18\x04 \x03(\v2\x0f.crm.FacetCountR\x06status\"\x86\x01\n" +
+	"\x0eSearchResponse\x12(\n" +
+	"\aresults\x18\x01 \x03(\v2\x0e.crm.SearchHitR\aresults\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12)\n" +
+	"\x06facets\x18\x03 \x01(\v2\x11.crm.SearchFacetsR\x06facets\"\xdb\x02\n" +
+	"\aMeeting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x12!\n" +
+	"\fmeeting_link\x18\x06 \x01(\tR\vmeetingLink\x12!\n" +
+	"\forganizer_id\x18\a \x01(\rR\vorganizerId\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x122\n" +
+	"\tattendees\x18\t \x03(\v2\x14.crm.MeetingAttendeeR\tattendees\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"I\n" +
+	"\x0fMeetingAttendee\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\rR\tcontactId\x12\x17\n" +
+	"\alead_id\x18\x02 \x01(\rR\x06leadId\"\x84\x02\n" +
+	"\x16ScheduleMeetingRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12!\n" +
+	"\fmeeting_link\x18\x04 \x01(\tR\vmeetingLink\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
+	"\forganizer_id\x18\x06 \x01(\rR\vorganizerId\x122\n" +
+	"\tattendees\x18\a \x03(\v2\x14.crm.MeetingAttendeeR\tattendees\"p\n" +
+	"\x0fMeetingResponse\x12\x1d\n" +
+	"\n" +
+	"meeting_id\x18\x01 \x01(\rR\tmeetingId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12&\n" +
+	"\ameeting\x18\x03 \x01(\v2\f.crm.MeetingR\ameeting\"#\n" +
+	"\x11GetMeetingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"<\n" +
+	"\x12GetMeetingResponse\x12&\n" +
+	"\ameeting\x18\x01 \x01(\v2\f.crm.MeetingR\ameeting\">\n" +
+	"\x14UpdateMeetingRequest\x12&\n" +
+	"\ameeting\x18\x01 \x01(\v2\f.crm.MeetingR\ameeting\"?\n" +
+	"\x15UpdateMeetingResponse\x12&\n" +
+	"\ameeting\x18\x01 \x01(\v2\f.crm.MeetingR\ameeting\"&\n" +
+	"\x14DeleteMeetingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"1\n" +
+	"\x15DeleteMeetingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x93\x02\n" +
+	"\x13ListMeetingsRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12!\n" +
+	"\forganizer_id\x18\x03 \x01(\rR\vorganizerId\x12(\n" +
+	"\x06filter\x18\x04 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x05 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCount\"\xb1\x01\n" +
+	"\x14ListMeetingsResponse\x12(\n" +
+	"\bmeetings\x18\x01 \x03(\v2\f.crm.MeetingR\bmeetings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xd4\x03\n" +
+	"\bProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x06 \x01(\rR\tcontactId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\rR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12%\n" +
+	"\x0eopportunity_id\x18\n" +
+	" \x01(\rR\ropportunityId\x12\x1f\n" +
+	"\vvalid_until\x18\v \x01(\tR\n" +
+	"validUntil\x124\n" +
+	"\n" +
+	"line_items\x18\f \x03(\v2\x15.crm.ProposalLineItemR\tlineItems\x12\x17\n" +
+	"\asent_at\x18\r \x01(\tR\x06sentAt\x12!\n" +
+	"\fresponded_at\x18\x0e \x01(\tR\vrespondedAt\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\"\xcc\x01\n" +
+	"\x10ProposalLineItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01R\tunitPrice\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x01R\bdiscount\x12\x19\n" +
+	"\btax_rate\x18\x06 \x01(\x01R\ataxRate\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total\"B\n" +
+	"\x15CreateProposalRequest\x12)\n" +
+	"\bproposal\x18\x01 \x01(\v2\r.crm.ProposalR\bproposal\"C\n" +
+	"\x16CreateProposalResponse\x12)\n" +
+	"\bproposal\x18\x01 \x01(\v2\r.crm.ProposalR\bproposal\"$\n" +
+	"\x12GetProposalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"@\n" +
+	"\x13GetProposalResponse\x12)\n" +
+	"\bproposal\x18\x01 \x01(\v2\r.crm.ProposalR\bproposal\"B\n" +
+	"\x15UpdateProposalRequest\x12)\n" +
+	"\bproposal\x18\x01 \x01(\v2\r.crm.ProposalR\bproposal\"C\n" +
+	"\x16UpdateProposalResponse\x12)\n" +
+	"\bproposal\x18\x01 \x01(\v2\r.crm.ProposalR\bproposal\"'\n" +
+	"\x15DeleteProposalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"2\n" +
+	"\x16DeleteProposalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa8\x02\n" +
+	"\x14ListProposalsRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\rR\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\x04 \x01(\bR\tascending\x12(\n" +
+	"\x06filter\x18\x05 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x06 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xb5\x01\n" +
+	"\x15ListProposalsResponse\x12+\n" +
+	"\tproposals\x18\x01 \x03(\v2\r.crm.ProposalR\tproposals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"E\n" +
+	"\x1bUpdateProposalStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"I\n" +
+	"\x1cUpdateProposalStatusResponse\x12)\n" +
+	"\bproposal\x18\x01 \x01(\v2\r.crm.ProposalR\bproposal\"\xbb\x02\n" +
+	"\x1fSendNotificationWithSMTPRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12#\n" +
+	"\rtemplate_name\x18\x03 \x01(\tR\ftemplateName\x12B\n" +
+	"\x04data\x18\x04 \x03(\v2..crm.SendNotificationWithSMTPRequest.DataEntryR\x04data\x12\x12\n" +
+	"\x04sync\x18\x05 \x01(\bR\x04sync\x12+\n" +
+	"\x11notification_type\x18\x06 \x01(\tR\x10notificationType\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb9\x02\n" +
+	"\x1eSendNotificationWithSMSRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12#\n" +
+	"\rtemplate_name\x18\x03 \x01(\tR\ftemplateName\x12A\n" +
+	"\x04data\x18\x04 \x03(\v2-.crm.SendNotificationWithSMSRequest.DataEntryR\x04data\x12\x12\n" +
+	"\x04sync\x18\x05 \x01(\bR\x04sync\x12+\n" +
+	"\x11notification_type\x18\x06 \x01(\tR\x10notificationType\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc5\x02\n" +
+	"\x17SendNotificationRequest\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12#\n" +
+	"\rtemplate_name\x18\x02 \x01(\tR\ftemplateName\x12:\n" +
+	"\x04data\x18\x03 \x03(\v2&.crm.SendNotificationRequest.DataEntryR\x04data\x12\x12\n" +
+	"\x04sync\x18\x04 \x01(\bR\x04sync\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12+\n" +
+	"\x11notification_type\x18\a \x01(\tR\x10notificationType\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"z\n" +
+	"\x18SendNotificationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\tR\ttimestamp\"*\n" +
+	"\x12HealthCheckRequest\x12\x14\n" +
+	"\x05probe\x18\x01 \x01(\tR\x05probe\"\x87\x01\n" +
+	"\x13HealthCheckResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x129\n" +
+	"\fdependencies\x18\x02 \x03(\v2\x15.crm.DependencyStatusR\fdependencies\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\tR\tcheckedAt\"w\n" +
+	"\x10DependencyStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x04 \x01(\x03R\tlatencyMs\"\xcf\x01\n" +
+	"\x11CreateSMTPRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tsmtp_host\x18\x02 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x03 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x04 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x05 \x01(\tR\fsmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\" \n" +
+	"\x0eGetSMTPRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc6\x01\n" +
+	"\x11UpdateSMTPRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsmtp_host\x18\x02 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x03 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x04 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x05 \x01(\tR\fsmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\"#\n" +
+	"\x11DeleteSMTPRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf5\x01\n" +
+	"\fSMTPResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tsmtp_host\x18\x03 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x04 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x05 \x01(\tR\fsmtpUsername\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1f\n" +
+	"\vrepeated_at\x18\a \x01(\tR\n" +
+	"repeatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\xdf\x01\n" +
+	"\x0fListSMTPRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12(\n" +
+	"\x06filter\x18\x03 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x04 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCount\"\xb8\x01\n" +
+	"\x10ListSMTPResponse\x123\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x11.crm.SMTPResponseR\vcredentials\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\">\n" +
+	"\x12DeleteSMTPResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"!\n" +
+	"\x0fTestSMTPRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
+	"\x10TestSMTPResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\"\x17\n" +
+	"\x15RotateSMTPKeysRequest\"I\n" +
+	"\x16RotateSMTPKeysResponse\x12\x18\n" +
+	"\arotated\x18\x01 \x01(\x05R\arotated\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\"\xea\x02\n" +
+	"\x15CreateTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bchannels\x18\x03 \x03(\tR\bchannels\x128\n" +
+	"\x04data\x18\x04 \x03(\v2$.crm.CreateTemplateRequest.DataEntryR\x04data\x12#\n" +
+	"\remail_subject\x18\x05 \x01(\tR\femailSubject\x12\x1d\n" +
+	"\n" +
+	"email_html\x18\x06 \x01(\tR\temailHtml\x12\x1d\n" +
+	"\n" +
+	"email_text\x18\a \x01(\tR\temailText\x12\x19\n" +
+	"\bsms_text\x18\b \x01(\tR\asmsText\x12\x1e\n" +
+	"\vin_app_text\x18\t \x01(\tR\tinAppText\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfa\x02\n" +
+	"\x15UpdateTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\bchannels\x18\x04 \x03(\tR\bchannels\x128\n" +
+	"\x04data\x18\x05 \x03(\v2$.crm.UpdateTemplateRequest.DataEntryR\x04data\x12#\n" +
+	"\remail_subject\x18\x06 \x01(\tR\femailSubject\x12\x1d\n" +
+	"\n" +
+	"email_html\x18\a \x01(\tR\temailHtml\x12\x1d\n" +
+	"\n" +
+	"email_text\x18\b \x01(\tR\temailText\x12\x19\n" +
+	"\bsms_text\x18\t \x01(\tR\asmsText\x12\x1e\n" +
+	"\vin_app_text\x18\n" +
+	" \x01(\tR\tinAppText\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x12GetTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xc8\x03\n" +
+	"\x10TemplateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\bchannels\x18\x04 \x03(\tR\bchannels\x123\n" +
+	"\x04data\x18\x05 \x03(\v2\x1f.crm.TemplateResponse.DataEntryR\x04data\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x12#\n" +
+	"\remail_subject\x18\t \x01(\tR\femailSubject\x12\x1d\n" +
+	"\n" +
+	"email_html\x18\n" +
+	" \x01(\tR\temailHtml\x12\x1d\n" +
+	"\n" +
+	"email_text\x18\v \x01(\tR\temailText\x12\x19\n" +
+	"\bsms_text\x18\f \x01(\tR\asmsText\x12\x1e\n" +
+	"\vin_app_text\x18\r \x01(\tR\tinAppText\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe4\x01\n" +
+	"\x16PreviewTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x129\n" +
+	"\x04data\x18\x05 \x03(\v2%.crm.PreviewTemplateRequest.DataEntryR\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x17PreviewTemplateResponse\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x12\n" +
+	"\x04html\x18\x04 \x01(\tR\x04html\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\"\xe4\x01\n" +
+	"\x14ListTemplatesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12(\n" +
+	"\x06filter\x18\x03 \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\x04 \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCount\"\xbd\x01\n" +
+	"\x15ListTemplatesResponse\x123\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x15.crm.TemplateResponseR\ttemplates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\xb0\x03\n" +
+	"\x17NotificationLogResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x11notification_type\x18\x02 \x01(\tR\x10notificationType\x12#\n" +
+	"\rtemplate_name\x18\x03 \x01(\tR\ftemplateName\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12\x17\n" +
+	"\asent_at\x18\a \x01(\tR\x06sentAt\x12\x18\n" +
+	"\achannel\x18\b \x01(\tR\achannel\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12)\n" +
+	"\x10template_version\x18\n" +
+	" \x01(\x05R\x0ftemplateVersion\x12\x17\n" +
+	"\auser_id\x18\v \x01(\tR\x06userId\x12&\n" +
+	"\x0fnext_attempt_at\x18\f \x01(\tR\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"\x96\x03\n" +
+	"\x0fListLogsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12#\n" +
+	"\rtemplate_name\x18\x05 \x01(\tR\ftemplateName\x12!\n" +
+	"\fcreated_from\x18\x06 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\a \x01(\tR\tcreatedTo\x12\x18\n" +
+	"\achannel\x18\b \x01(\tR\achannel\x12(\n" +
+	"\x06filter\x18\t \x01(\v2\x10.crm.FilterGroupR\x06filter\x12\"\n" +
+	"\x04sort\x18\n" +
+	" \x03(\v2\x0e.crm.SortFieldR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\f \x01(\bR\x11includeTotalCount\"\xb5\x01\n" +
+	"\x10ListLogsResponse\x120\n" +
+	"\x04logs\x18\x01 \x03(\v2\x1c.crm.NotificationLogResponseR\x04logs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x03 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\rR\n" +
+	"totalCount\"\x1f\n" +
+	"\rGetLogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xff\x02\n" +
 	"\x0fActivityService\x12I\n" +
 	"\x0eCreateActivity\x12\x1a.crm.CreateActivityRequest\x1a\x1b.crm.CreateActivityResponse\x12@\n" +
 	"\vGetActivity\x12\x17.crm.GetActivityRequest\x1a\x18.crm.GetActivityResponse\x12I\n" +
@@ -3958,7 +17910,7 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"GetCompany\x12\x16.crm.GetCompanyRequest\x1a\x17.crm.GetCompanyResponse\x12F\n" +
 	"\rUpdateCompany\x12\x19.crm.UpdateCompanyRequest\x1a\x1a.crm.UpdateCompanyResponse\x12F\n" +
 	"\rDeleteCompany\x12\x19.crm.DeleteCompanyRequest\x1a\x1a.crm.DeleteCompanyResponse\x12F\n" +
-	"\rListCompanies\x12\x19.crm.ListCompaniesRequest\x1a\x1a.crm.ListCompaniesResponse2\x8d\x03\n" +
+	"\rListCompanies\x12\x19.crm.ListCompaniesRequest\x1a\x1a.crm.ListCompaniesResponse2\xb2\x05\n" +
 	"\vLeadService\x12=\n" +
 	"\n" +
 	"CreateLead\x12\x16.crm.CreateLeadRequest\x1a\x17.crm.CreateLeadResponse\x124\n" +
@@ -3968,13 +17920,132 @@ const file_api_proto_crm_proto_rawDesc = "" +
 	"\n" +
 	"DeleteLead\x12\x16.crm.DeleteLeadRequest\x1a\x17.crm.DeleteLeadResponse\x12@\n" +
 	"\vGetAllLeads\x12\x17.crm.GetAllLeadsRequest\x1a\x18.crm.GetAllLeadsResponse\x12I\n" +
-	"\x0eGetLeadByEmail\x12\x1a.crm.GetLeadByEmailRequest\x1a\x1b.crm.GetLeadByEmailResponse2\xaf\x03\n" +
+	"\x0eGetLeadByEmail\x12\x1a.crm.GetLeadByEmailRequest\x1a\x1b.crm.GetLeadByEmailResponse\x12@\n" +
+	"\vConvertLead\x12\x17.crm.ConvertLeadRequest\x1a\x18.crm.ConvertLeadResponse\x12A\n" +
+	"\x0fGetLeadWorkflow\x12\x1b.crm.GetLeadWorkflowRequest\x1a\x11.crm.LeadWorkflow\x12A\n" +
+	"\x0fSetLeadWorkflow\x12\x1b.crm.SetLeadWorkflowRequest\x1a\x11.crm.LeadWorkflow\x12[\n" +
+	"\x14GetLeadStatusHistory\x12 .crm.GetLeadStatusHistoryRequest\x1a!.crm.GetLeadStatusHistoryResponse2\x9f\x03\n" +
+	"\x12LeadScoringService\x12D\n" +
+	"\x11CreateScoringRule\x12\x1d.crm.CreateScoringRuleRequest\x1a\x10.crm.ScoringRule\x12D\n" +
+	"\x11UpdateScoringRule\x12\x1d.crm.UpdateScoringRuleRequest\x1a\x10.crm.ScoringRule\x12R\n" +
+	"\x11DeleteScoringRule\x12\x1d.crm.DeleteScoringRuleRequest\x1a\x1e.crm.DeleteScoringRuleResponse\x12O\n" +
+	"\x10ListScoringRules\x12\x1c.crm.ListScoringRulesRequest\x1a\x1d.crm.ListScoringRulesResponse\x12X\n" +
+	"\x13RecomputeLeadScores\x12\x1f.crm.RecomputeLeadScoresRequest\x1a .crm.RecomputeLeadScoresResponse2\xb0\x06\n" +
+	"\x15LeadAssignmentService\x12>\n" +
+	"\x0fCreateSalesTeam\x12\x1b.crm.CreateSalesTeamRequest\x1a\x0e.crm.SalesTeam\x12I\n" +
+	"\x0eListSalesTeams\x12\x1a.crm.ListSalesTeamsRequest\x1a\x1b.crm.ListSalesTeamsResponse\x12L\n" +
+	"\x0fDeleteSalesTeam\x12\x1b.crm.DeleteSalesTeamRequest\x1a\x1c.crm.DeleteSalesTeamResponse\x12J\n" +
+	"\x12SetSalesTeamMember\x12\x1e.crm.SetSalesTeamMemberRequest\x1a\x14.crm.SalesTeamMember\x12^\n" +
+	"\x15RemoveSalesTeamMember\x12!.crm.RemoveSalesTeamMemberRequest\x1a\".crm.RemoveSalesTeamMemberResponse\x12M\n" +
+	"\x14CreateAssignmentRule\x12 .crm.CreateAssignmentRuleRequest\x1a\x13.crm.AssignmentRule\x12M\n" +
+	"\x14UpdateAssignmentRule\x12 .crm.UpdateAssignmentRuleRequest\x1a\x13.crm.AssignmentRule\x12[\n" +
+	"\x14DeleteAssignmentRule\x12 .crm.DeleteAssignmentRuleRequest\x1a!.crm.DeleteAssignmentRuleResponse\x12X\n" +
+	"\x13ListAssignmentRules\x12\x1f.crm.ListAssignmentRulesRequest\x1a .crm.ListAssignmentRulesResponse\x12=\n" +
+	"\n" +
+	"AssignLead\x12\x16.crm.AssignLeadRequest\x1a\x17.crm.AssignLeadResponse2\xa5\x02\n" +
+	"\x10DuplicateService\x12I\n" +
+	"\x0eFindDuplicates\x12\x1a.crm.FindDuplicatesRequest\x1a\x1b.crm.FindDuplicatesResponse\x12>\n" +
+	"\rMergeContacts\x12\x11.crm.MergeRequest\x1a\x1a.crm.MergeContactsResponse\x128\n" +
+	"\n" +
+	"MergeLeads\x12\x11.crm.MergeRequest\x1a\x17.crm.MergeLeadsResponse\x12L\n" +
+	"\x0fListMergeAudits\x12\x1b.crm.ListMergeAuditsRequest\x1a\x1c.crm.ListMergeAuditsResponse2\xb2\x0f\n" +
 	"\x12OpportunityService\x12R\n" +
 	"\x11CreateOpportunity\x12\x1d.crm.CreateOpportunityRequest\x1a\x1e.crm.CreateOpportunityResponse\x12I\n" +
 	"\x0eGetOpportunity\x12\x1a.crm.GetOpportunityRequest\x1a\x1b.crm.GetOpportunityResponse\x12R\n" +
 	"\x11UpdateOpportunity\x12\x1d.crm.UpdateOpportunityRequest\x1a\x1e.crm.UpdateOpportunityResponse\x12R\n" +
 	"\x11DeleteOpportunity\x12\x1d.crm.DeleteOpportunityRequest\x1a\x1e.crm.DeleteOpportunityResponse\x12R\n" +
-	"\x11ListOpportunities\x12\x1d.crm.ListOpportunitiesRequest\x1a\x1e.crm.ListOpportunitiesResponseB\x0fZ\rCRM/api/pb;pbb\x06proto3"
+	"\x11ListOpportunities\x12\x1d.crm.ListOpportunitiesRequest\x1a\x1e.crm.ListOpportunitiesResponse\x12^\n" +
+	"\x15GetOpportunityHistory\x12!.crm.GetOpportunityHistoryRequest\x1a\".crm.GetOpportunityHistoryResponse\x12S\n" +
+	"\x15GetOpportunityMetrics\x12!.crm.GetOpportunityMetricsRequest\x1a\x17.crm.OpportunityMetrics\x12F\n" +
+	"\rListLineItems\x12\x19.crm.ListLineItemsRequest\x1a\x1a.crm.ListLineItemsResponse\x12=\n" +
+	"\vAddLineItem\x12\x17.crm.AddLineItemRequest\x1a\x15.crm.LineItemResponse\x12C\n" +
+	"\x0eUpdateLineItem\x12\x1a.crm.UpdateLineItemRequest\x1a\x15.crm.LineItemResponse\x12C\n" +
+	"\x0eRemoveLineItem\x12\x1a.crm.RemoveLineItemRequest\x1a\x15.crm.LineItemResponse\x12U\n" +
+	"\x13CloseOpportunityWon\x12\x1f.crm.CloseOpportunityWonRequest\x1a\x1d.crm.CloseOpportunityResponse\x12W\n" +
+	"\x14CloseOpportunityLost\x12 .crm.CloseOpportunityLostRequest\x1a\x1d.crm.CloseOpportunityResponse\x12L\n" +
+	"\x0fListLossReasons\x12\x1b.crm.ListLossReasonsRequest\x1a\x1c.crm.ListLossReasonsResponse\x12A\n" +
+	"\x10CreateLossReason\x12\x1c.crm.CreateLossReasonRequest\x1a\x0f.crm.LossReason\x12A\n" +
+	"\x10UpdateLossReason\x12\x1c.crm.UpdateLossReasonRequest\x1a\x0f.crm.LossReason\x12O\n" +
+	"\x10DeleteLossReason\x12\x1c.crm.DeleteLossReasonRequest\x1a\x1d.crm.DeleteLossReasonResponse\x12S\n" +
+	"\x15AddOpportunityContact\x12!.crm.AddOpportunityContactRequest\x1a\x17.crm.OpportunityContact\x12g\n" +
+	"\x18RemoveOpportunityContact\x12$.crm.RemoveOpportunityContactRequest\x1a%.crm.RemoveOpportunityContactResponse\x12d\n" +
+	"\x17ListOpportunityContacts\x12#.crm.ListOpportunityContactsRequest\x1a$.crm.ListOpportunityContactsResponse\x12;\n" +
+	"\x0eCreatePipeline\x12\x1a.crm.CreatePipelineRequest\x1a\r.crm.Pipeline\x125\n" +
+	"\vGetPipeline\x12\x17.crm.GetPipelineRequest\x1a\r.crm.Pipeline\x12;\n" +
+	"\x0eUpdatePipeline\x12\x1a.crm.UpdatePipelineRequest\x1a\r.crm.Pipeline\x12I\n" +
+	"\x0eDeletePipeline\x12\x1a.crm.DeletePipelineRequest\x1a\x1b.crm.DeletePipelineResponse\x12F\n" +
+	"\rListPipelines\x12\x19.crm.ListPipelinesRequest\x1a\x1a.crm.ListPipelinesResponse2\xb7\x03\n" +
+	"\x0fForecastService\x122\n" +
+	"\vGetForecast\x12\x14.crm.ForecastRequest\x1a\r.crm.Forecast\x12S\n" +
+	"\x16CreateForecastSnapshot\x12\".crm.CreateForecastSnapshotRequest\x1a\x15.crm.ForecastSnapshot\x12X\n" +
+	"\x13GetForecastSnapshot\x12\x1f.crm.GetForecastSnapshotRequest\x1a .crm.GetForecastSnapshotResponse\x12^\n" +
+	"\x15ListForecastSnapshots\x12!.crm.ListForecastSnapshotsRequest\x1a\".crm.ListForecastSnapshotsResponse\x12a\n" +
+	"\x16DeleteForecastSnapshot\x12\".crm.DeleteForecastSnapshotRequest\x1a#.crm.DeleteForecastSnapshotResponse2\xc9\x04\n" +
+	"\x0fCurrencyService\x12O\n" +
+	"\x10SetExchangeRates\x12\x1c.crm.SetExchangeRatesRequest\x1a\x1d.crm.SetExchangeRatesResponse\x12X\n" +
+	"\x13ImportExchangeRates\x12\x1f.crm.ImportExchangeRatesRequest\x1a .crm.ImportExchangeRatesResponse\x12R\n" +
+	"\x11ListExchangeRates\x12\x1d.crm.ListExchangeRatesRequest\x1a\x1e.crm.ListExchangeRatesResponse\x12U\n" +
+	"\x12DeleteExchangeRate\x12\x1e.crm.DeleteExchangeRateRequest\x1a\x1f.crm.DeleteExchangeRateResponse\x12P\n" +
+	"\x14GetReportingCurrency\x12 .crm.GetReportingCurrencyRequest\x1a\x16.crm.ReportingCurrency\x12F\n" +
+	"\x14SetReportingCurrency\x12\x16.crm.ReportingCurrency\x1a\x16.crm.ReportingCurrency\x12F\n" +
+	"\rConvertAmount\x12\x19.crm.ConvertAmountRequest\x1a\x1a.crm.ConvertAmountResponse2\x91\a\n" +
+	"\x0eProductService\x128\n" +
+	"\rCreateProduct\x12\x19.crm.CreateProductRequest\x1a\f.crm.Product\x122\n" +
+	"\n" +
+	"GetProduct\x12\x16.crm.GetProductRequest\x1a\f.crm.Product\x128\n" +
+	"\rUpdateProduct\x12\x19.crm.UpdateProductRequest\x1a\f.crm.Product\x12F\n" +
+	"\rDeleteProduct\x12\x19.crm.DeleteProductRequest\x1a\x1a.crm.DeleteProductResponse\x12C\n" +
+	"\fListProducts\x12\x18.crm.ListProductsRequest\x1a\x19.crm.ListProductsResponse\x12>\n" +
+	"\x0fCreatePriceBook\x12\x1b.crm.CreatePriceBookRequest\x1a\x0e.crm.PriceBook\x128\n" +
+	"\fGetPriceBook\x12\x18.crm.GetPriceBookRequest\x1a\x0e.crm.PriceBook\x12>\n" +
+	"\x0fUpdatePriceBook\x12\x1b.crm.UpdatePriceBookRequest\x1a\x0e.crm.PriceBook\x12L\n" +
+	"\x0fDeletePriceBook\x12\x1b.crm.DeletePriceBookRequest\x1a\x1c.crm.DeletePriceBookResponse\x12I\n" +
+	"\x0eListPriceBooks\x12\x1a.crm.ListPriceBooksRequest\x1a\x1b.crm.ListPriceBooksResponse\x12=\n" +
+	"\x11SetPriceBookEntry\x12\x13.crm.PriceBookEntry\x1a\x13.crm.PriceBookEntry\x12[\n" +
+	"\x14RemovePriceBookEntry\x12 .crm.RemovePriceBookEntryRequest\x1a!.crm.RemovePriceBookEntryResponse\x12[\n" +
+	"\x14ListPriceBookEntries\x12 .crm.ListPriceBookEntriesRequest\x1a!.crm.ListPriceBookEntriesResponse2B\n" +
+	"\rSearchService\x121\n" +
+	"\x06Search\x12\x12.crm.SearchRequest\x1a\x13.crm.SearchResponse2\xea\x02\n" +
+	"\x0eMeetingService\x12D\n" +
+	"\x0fScheduleMeeting\x12\x1b.crm.ScheduleMeetingRequest\x1a\x14.crm.MeetingResponse\x12=\n" +
+	"\n" +
+	"GetMeeting\x12\x16.crm.GetMeetingRequest\x1a\x17.crm.GetMeetingResponse\x12F\n" +
+	"\rUpdateMeeting\x12\x19.crm.UpdateMeetingRequest\x1a\x1a.crm.UpdateMeetingResponse\x12F\n" +
+	"\rDeleteMeeting\x12\x19.crm.DeleteMeetingRequest\x1a\x1a.crm.DeleteMeetingResponse\x12C\n" +
+	"\fListMeetings\x12\x18.crm.ListMeetingsRequest\x1a\x19.crm.ListMeetingsResponse2\xd9\x03\n" +
+	"\x0fProposalService\x12I\n" +
+	"\x0eCreateProposal\x12\x1a.crm.CreateProposalRequest\x1a\x1b.crm.CreateProposalResponse\x12@\n" +
+	"\vGetProposal\x12\x17.crm.GetProposalRequest\x1a\x18.crm.GetProposalResponse\x12I\n" +
+	"\x0eUpdateProposal\x12\x1a.crm.UpdateProposalRequest\x1a\x1b.crm.UpdateProposalResponse\x12I\n" +
+	"\x0eDeleteProposal\x12\x1a.crm.DeleteProposalRequest\x1a\x1b.crm.DeleteProposalResponse\x12F\n" +
+	"\rListProposals\x12\x19.crm.ListProposalsRequest\x1a\x1a.crm.ListProposalsResponse\x12[\n" +
+	"\x14UpdateProposalStatus\x12 .crm.UpdateProposalStatusRequest\x1a!.crm.UpdateProposalStatusResponse2\xa6\x02\n" +
+	"\x13NotificationService\x12O\n" +
+	"\x10SendNotification\x12\x1c.crm.SendNotificationRequest\x1a\x1d.crm.SendNotificationResponse\x12_\n" +
+	"\x18SendNotificationWithSMTP\x12$.crm.SendNotificationWithSMTPRequest\x1a\x1d.crm.SendNotificationResponse\x12]\n" +
+	"\x17SendNotificationWithSMS\x12#.crm.SendNotificationWithSMSRequest\x1a\x1d.crm.SendNotificationResponse2K\n" +
+	"\rHealthService\x12:\n" +
+	"\x05Check\x12\x17.crm.HealthCheckRequest\x1a\x18.crm.HealthCheckResponse2\xae\x03\n" +
+	"\vSMTPService\x127\n" +
+	"\n" +
+	"CreateSMTP\x12\x16.crm.CreateSMTPRequest\x1a\x11.crm.SMTPResponse\x121\n" +
+	"\aGetSMTP\x12\x13.crm.GetSMTPRequest\x1a\x11.crm.SMTPResponse\x127\n" +
+	"\n" +
+	"UpdateSMTP\x12\x16.crm.UpdateSMTPRequest\x1a\x11.crm.SMTPResponse\x12=\n" +
+	"\n" +
+	"DeleteSMTP\x12\x16.crm.DeleteSMTPRequest\x1a\x17.crm.DeleteSMTPResponse\x127\n" +
+	"\bListSMTP\x12\x14.crm.ListSMTPRequest\x1a\x15.crm.ListSMTPResponse\x127\n" +
+	"\bTestSMTP\x12\x14.crm.TestSMTPRequest\x1a\x15.crm.TestSMTPResponse\x12I\n" +
+	"\x0eRotateSMTPKeys\x12\x1a.crm.RotateSMTPKeysRequest\x1a\x1b.crm.RotateSMTPKeysResponse2\xf0\x02\n" +
+	"\x0fTemplateService\x12C\n" +
+	"\x0eCreateTemplate\x12\x1a.crm.CreateTemplateRequest\x1a\x15.crm.TemplateResponse\x12=\n" +
+	"\vGetTemplate\x12\x17.crm.GetTemplateRequest\x1a\x15.crm.TemplateResponse\x12F\n" +
+	"\rListTemplates\x12\x19.crm.ListTemplatesRequest\x1a\x1a.crm.ListTemplatesResponse\x12C\n" +
+	"\x0eUpdateTemplate\x12\x1a.crm.UpdateTemplateRequest\x1a\x15.crm.TemplateResponse\x12L\n" +
+	"\x0fPreviewTemplate\x12\x1b.crm.PreviewTemplateRequest\x1a\x1c.crm.PreviewTemplateResponse2\x8d\x01\n" +
+	"\x16NotificationLogService\x12:\n" +
+	"\x06GetLog\x12\x12.crm.GetLogRequest\x1a\x1c.crm.NotificationLogResponse\x127\n" +
+	"\bListLogs\x12\x14.crm.ListLogsRequest\x1a\x15.crm.ListLogsResponseB\x0fZ\rCRM/api/pb;pbb\x06proto3"
 
 var (
 	file_api_proto_crm_proto_rawDescOnce sync.Once
@@ -3988,182 +18059,719 @@ func file_api_proto_crm_proto_rawDescGZIP() []byte {
 	return file_api_proto_crm_proto_rawDescData
 }
 
-var file_api_proto_crm_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_api_proto_crm_proto_msgTypes = make([]protoimpl.MessageInfo, 276)
 var file_api_proto_crm_proto_goTypes = []any{
-	(*Activity)(nil),                  // 0: crm.Activity
-	(*CreateActivityRequest)(nil),     // 1: crm.CreateActivityRequest
-	(*CreateActivityResponse)(nil),    // 2: crm.CreateActivityResponse
-	(*GetActivityRequest)(nil),        // 3: crm.GetActivityRequest
-	(*GetActivityResponse)(nil),       // 4: crm.GetActivityResponse
-	(*UpdateActivityRequest)(nil),     // 5: crm.UpdateActivityRequest
-	(*UpdateActivityResponse)(nil),    // 6: crm.UpdateActivityResponse
-	(*DeleteActivityRequest)(nil),     // 7: crm.DeleteActivityRequest
-	(*DeleteActivityResponse)(nil),    // 8: crm.DeleteActivityResponse
-	(*ListActivitiesRequest)(nil),     // 9: crm.ListActivitiesRequest
-	(*ListActivitiesResponse)(nil),    // 10: crm.ListActivitiesResponse
-	(*Task)(nil),                      // 11: crm.Task
-	(*CreateTaskRequest)(nil),         // 12: crm.CreateTaskRequest
-	(*CreateTaskResponse)(nil),        // 13: crm.CreateTaskResponse
-	(*GetTaskRequest)(nil),            // 14: crm.GetTaskRequest
-	(*GetTaskResponse)(nil),           // 15: crm.GetTaskResponse
-	(*UpdateTaskRequest)(nil),         // 16: crm.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),        // 17: crm.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),         // 18: crm.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),        // 19: crm.DeleteTaskResponse
-	(*ListTasksRequest)(nil),          // 20: crm.ListTasksRequest
-	(*ListTasksResponse)(nil),         // 21: crm.ListTasksResponse
-	(*Contact)(nil),                   // 22: crm.Contact
-	(*CreateContactRequest)(nil),      // 23: crm.CreateContactRequest
-	(*CreateContactResponse)(nil),     // 24: crm.CreateContactResponse
-	(*GetContactRequest)(nil),         // 25: crm.GetContactRequest
-	(*GetContactResponse)(nil),        // 26: crm.GetContactResponse
-	(*UpdateContactRequest)(nil),      // 27: crm.UpdateContactRequest
-	(*UpdateContactResponse)(nil),     // 28: crm.UpdateContactResponse
-	(*DeleteContactRequest)(nil),      // 29: crm.DeleteContactRequest
-	(*DeleteContactResponse)(nil),     // 30: crm.DeleteContactResponse
-	(*ListContactsRequest)(nil),       // 31: crm.ListContactsRequest
-	(*ListContactsResponse)(nil),      // 32: crm.ListContactsResponse
-	(*Company)(nil),                   // 33: crm.Company
-	(*CreateCompanyRequest)(nil),      // 34: crm.CreateCompanyRequest
-	(*CreateCompanyResponse)(nil),     // 35: crm.CreateCompanyResponse
-	(*GetCompanyRequest)(nil),         // 36: crm.GetCompanyRequest
-	(*GetCompanyResponse)(nil),        // 37: crm.GetCompanyResponse
-	(*UpdateCompanyRequest)(nil),      // 38: crm.UpdateCompanyRequest
-	(*UpdateCompanyResponse)(nil),     // 39: crm.UpdateCompanyResponse
-	(*DeleteCompanyRequest)(nil),      // 40: crm.DeleteCompanyRequest
-	(*DeleteCompanyResponse)(nil),     // 41: crm.DeleteCompanyResponse
-	(*ListCompaniesRequest)(nil),      // 42: crm.ListCompaniesRequest
-	(*ListCompaniesResponse)(nil),     // 43: crm.ListCompaniesResponse
-	(*Lead)(nil),                      // 44: crm.Lead
-	(*CreateLeadRequest)(nil),         // 45: crm.CreateLeadRequest
-	(*CreateLeadResponse)(nil),        // 46: crm.CreateLeadResponse
-	(*GetLeadRequest)(nil),            // 47: crm.GetLeadRequest
-	(*GetLeadResponse)(nil),           // 48: crm.GetLeadResponse
-	(*UpdateLeadRequest)(nil),         // 49: crm.UpdateLeadRequest
-	(*UpdateLeadResponse)(nil),        // 50: crm.UpdateLeadResponse
-	(*DeleteLeadRequest)(nil),         // 51: crm.DeleteLeadRequest
-	(*DeleteLeadResponse)(nil),        // 52: crm.DeleteLeadResponse
-	(*GetAllLeadsRequest)(nil),        // 53: crm.GetAllLeadsRequest
-	(*GetAllLeadsResponse)(nil),       // 54: crm.GetAllLeadsResponse
-	(*GetLeadByEmailRequest)(nil),     // 55: crm.GetLeadByEmailRequest
-	(*GetLeadByEmailResponse)(nil),    // 56: crm.GetLeadByEmailResponse
-	(*Opportunity)(nil),               // 57: crm.Opportunity
-	(*CreateOpportunityRequest)(nil),  // 58: crm.CreateOpportunityRequest
-	(*CreateOpportunityResponse)(nil), // 59: crm.CreateOpportunityResponse
-	(*GetOpportunityRequest)(nil),     // 60: crm.GetOpportunityRequest
-	(*GetOpportunityResponse)(nil),    // 61: crm.GetOpportunityResponse
-	(*UpdateOpportunityRequest)(nil),  // 62: crm.UpdateOpportunityRequest
-	(*UpdateOpportunityResponse)(nil), // 63: crm.UpdateOpportunityResponse
-	(*DeleteOpportunityRequest)(nil),  // 64: crm.DeleteOpportunityRequest
-	(*DeleteOpportunityResponse)(nil), // 65: crm.DeleteOpportunityResponse
-	(*ListOpportunitiesRequest)(nil),  // 66: crm.ListOpportunitiesRequest
-	(*ListOpportunitiesResponse)(nil), // 67: crm.ListOpportunitiesResponse
+	(*FilterCondition)(nil),                  // 0: crm.FilterCondition
+	(*FilterGroup)(nil),                      // 1: crm.FilterGroup
+	(*SortField)(nil),                        // 2: crm.SortField
+	(*Activity)(nil),                         // 3: crm.Activity
+	(*CreateActivityRequest)(nil),            // 4: crm.CreateActivityRequest
+	(*CreateActivityResponse)(nil),           // 5: crm.CreateActivityResponse
+	(*GetActivityRequest)(nil),               // 6: crm.GetActivityRequest
+	(*GetActivityResponse)(nil),              // 7: crm.GetActivityResponse
+	(*UpdateActivityRequest)(nil),            // 8: crm.UpdateActivityRequest
+	(*UpdateActivityResponse)(nil),           // 9: crm.UpdateActivityResponse
+	(*DeleteActivityRequest)(nil),            // 10: crm.DeleteActivityRequest
+	(*DeleteActivityResponse)(nil),           // 11: crm.DeleteActivityResponse
+	(*ListActivitiesRequest)(nil),            // 12: crm.ListActivitiesRequest
+	(*ListActivitiesResponse)(nil),           // 13: crm.ListActivitiesResponse
+	(*Task)(nil),                             // 14: crm.Task
+	(*CreateTaskRequest)(nil),                // 15: crm.CreateTaskRequest
+	(*CreateTaskResponse)(nil),               // 16: crm.CreateTaskResponse
+	(*GetTaskRequest)(nil),                   // 17: crm.GetTaskRequest
+	(*GetTaskResponse)(nil),                  // 18: crm.GetTaskResponse
+	(*UpdateTaskRequest)(nil),                // 19: crm.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),               // 20: crm.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),                // 21: crm.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),               // 22: crm.DeleteTaskResponse
+	(*ListTasksRequest)(nil),                 // 23: crm.ListTasksRequest
+	(*ListTasksResponse)(nil),                // 24: crm.ListTasksResponse
+	(*Contact)(nil),                          // 25: crm.Contact
+	(*CreateContactRequest)(nil),             // 26: crm.CreateContactRequest
+	(*CreateContactResponse)(nil),            // 27: crm.CreateContactResponse
+	(*GetContactRequest)(nil),                // 28: crm.GetContactRequest
+	(*ContactOpportunity)(nil),               // 29: crm.ContactOpportunity
+	(*GetContactResponse)(nil),               // 30: crm.GetContactResponse
+	(*UpdateContactRequest)(nil),             // 31: crm.UpdateContactRequest
+	(*UpdateContactResponse)(nil),            // 32: crm.UpdateContactResponse
+	(*DeleteContactRequest)(nil),             // 33: crm.DeleteContactRequest
+	(*DeleteContactResponse)(nil),            // 34: crm.DeleteContactResponse
+	(*ListContactsRequest)(nil),              // 35: crm.ListContactsRequest
+	(*ListContactsResponse)(nil),             // 36: crm.ListContactsResponse
+	(*Company)(nil),                          // 37: crm.Company
+	(*CreateCompanyRequest)(nil),             // 38: crm.CreateCompanyRequest
+	(*CreateCompanyResponse)(nil),            // 39: crm.CreateCompanyResponse
+	(*GetCompanyRequest)(nil),                // 40: crm.GetCompanyRequest
+	(*GetCompanyResponse)(nil),               // 41: crm.GetCompanyResponse
+	(*UpdateCompanyRequest)(nil),             // 42: crm.UpdateCompanyRequest
+	(*UpdateCompanyResponse)(nil),            // 43: crm.UpdateCompanyResponse
+	(*DeleteCompanyRequest)(nil),             // 44: crm.DeleteCompanyRequest
+	(*DeleteCompanyResponse)(nil),            // 45: crm.DeleteCompanyResponse
+	(*ListCompaniesRequest)(nil),             // 46: crm.ListCompaniesRequest
+	(*ListCompaniesResponse)(nil),            // 47: crm.ListCompaniesResponse
+	(*Lead)(nil),                             // 48: crm.Lead
+	(*CreateLeadRequest)(nil),                // 49: crm.CreateLeadRequest
+	(*CreateLeadResponse)(nil),               // 50: crm.CreateLeadResponse
+	(*GetLeadRequest)(nil),                   // 51: crm.GetLeadRequest
+	(*GetLeadResponse)(nil),                  // 52: crm.GetLeadResponse
+	(*UpdateLeadRequest)(nil),                // 53: crm.UpdateLeadRequest
+	(*UpdateLeadResponse)(nil),               // 54: crm.UpdateLeadResponse
+	(*DeleteLeadRequest)(nil),                // 55: crm.DeleteLeadRequest
+	(*DeleteLeadResponse)(nil),               // 56: crm.DeleteLeadResponse
+	(*GetAllLeadsRequest)(nil),               // 57: crm.GetAllLeadsRequest
+	(*GetAllLeadsResponse)(nil),              // 58: crm.GetAllLeadsResponse
+	(*ConvertLeadRequest)(nil),               // 59: crm.ConvertLeadRequest
+	(*ConvertLeadResponse)(nil),              // 60: crm.ConvertLeadResponse
+	(*LeadStatusDefinition)(nil),             // 61: crm.LeadStatusDefinition
+	(*LeadStatusTransition)(nil),             // 62: crm.LeadStatusTransition
+	(*LeadWorkflow)(nil),                     // 63: crm.LeadWorkflow
+	(*GetLeadWorkflowRequest)(nil),           // 64: crm.GetLeadWorkflowRequest
+	(*SetLeadWorkflowRequest)(nil),           // 65: crm.SetLeadWorkflowRequest
+	(*GetLeadStatusHistoryRequest)(nil),      // 66: crm.GetLeadStatusHistoryRequest
+	(*LeadStatusChange)(nil),                 // 67: crm.LeadStatusChange
+	(*GetLeadStatusHistoryResponse)(nil),     // 68: crm.GetLeadStatusHistoryResponse
+	(*GetLeadByEmailRequest)(nil),            // 69: crm.GetLeadByEmailRequest
+	(*GetLeadByEmailResponse)(nil),           // 70: crm.GetLeadByEmailResponse
+	(*ScoringRule)(nil),                      // 71: crm.ScoringRule
+	(*CreateScoringRuleRequest)(nil),         // 72: crm.CreateScoringRuleRequest
+	(*UpdateScoringRuleRequest)(nil),         // 73: crm.UpdateScoringRuleRequest
+	(*DeleteScoringRuleRequest)(nil),         // 74: crm.DeleteScoringRuleRequest
+	(*DeleteScoringRuleResponse)(nil),        // 75: crm.DeleteScoringRuleResponse
+	(*ListScoringRulesRequest)(nil),          // 76: crm.ListScoringRulesRequest
+	(*ListScoringRulesResponse)(nil),         // 77: crm.ListScoringRulesResponse
+	(*RecomputeLeadScoresRequest)(nil),       // 78: crm.RecomputeLeadScoresRequest
+	(*RecomputeLeadScoresResponse)(nil),      // 79: crm.RecomputeLeadScoresResponse
+	(*SalesTeamMember)(nil),                  // 80: crm.SalesTeamMember
+	(*SalesTeam)(nil),                        // 81: crm.SalesTeam
+	(*CreateSalesTeamRequest)(nil),           // 82: crm.CreateSalesTeamRequest
+	(*ListSalesTeamsRequest)(nil),            // 83: crm.ListSalesTeamsRequest
+	(*ListSalesTeamsResponse)(nil),           // 84: crm.ListSalesTeamsResponse
+	(*DeleteSalesTeamRequest)(nil),           // 85: crm.DeleteSalesTeamRequest
+	(*DeleteSalesTeamResponse)(nil),          // 86: crm.DeleteSalesTeamResponse
+	(*SetSalesTeamMemberRequest)(nil),        // 87: crm.SetSalesTeamMemberRequest
+	(*RemoveSalesTeamMemberRequest)(nil),     // 88: crm.RemoveSalesTeamMemberRequest
+	(*RemoveSalesTeamMemberResponse)(nil),    // 89: crm.RemoveSalesTeamMemberResponse
+	(*AssignmentRule)(nil),                   // 90: crm.AssignmentRule
+	(*CreateAssignmentRuleRequest)(nil),      // 91: crm.CreateAssignmentRuleRequest
+	(*UpdateAssignmentRuleRequest)(nil),      // 92: crm.UpdateAssignmentRuleRequest
+	(*DeleteAssignmentRuleRequest)(nil),      // 93: crm.DeleteAssignmentRuleRequest
+	(*DeleteAssignmentRuleResponse)(nil),     // 94: crm.DeleteAssignmentRuleResponse
+	(*ListAssignmentRulesRequest)(nil),       // 95: crm.ListAssignmentRulesRequest
+	(*ListAssignmentRulesResponse)(nil),      // 96: crm.ListAssignmentRulesResponse
+	(*AssignLeadRequest)(nil),                // 97: crm.AssignLeadRequest
+	(*AssignLeadResponse)(nil),               // 98: crm.AssignLeadResponse
+	(*FindDuplicatesRequest)(nil),            // 99: crm.FindDuplicatesRequest
+	(*DuplicateCandidate)(nil),               // 100: crm.DuplicateCandidate
+	(*DuplicateMatch)(nil),                   // 101: crm.DuplicateMatch
+	(*DuplicateCluster)(nil),                 // 102: crm.DuplicateCluster
+	(*FindDuplicatesResponse)(nil),           // 103: crm.FindDuplicatesResponse
+	(*MergeRequest)(nil),                     // 104: crm.MergeRequest
+	(*MergeAudit)(nil),                       // 105: crm.MergeAudit
+	(*MergeContactsResponse)(nil),            // 106: crm.MergeContactsResponse
+	(*MergeLeadsResponse)(nil),               // 107: crm.MergeLeadsResponse
+	(*ListMergeAuditsRequest)(nil),           // 108: crm.ListMergeAuditsRequest
+	(*ListMergeAuditsResponse)(nil),          // 109: crm.ListMergeAuditsResponse
+	(*Opportunity)(nil),                      // 110: crm.Opportunity
+	(*CreateOpportunityRequest)(nil),         // 111: crm.CreateOpportunityRequest
+	(*CreateOpportunityResponse)(nil),        // 112: crm.CreateOpportunityResponse
+	(*GetOpportunityRequest)(nil),            // 113: crm.GetOpportunityRequest
+	(*GetOpportunityResponse)(nil),           // 114: crm.GetOpportunityResponse
+	(*UpdateOpportunityRequest)(nil),         // 115: crm.UpdateOpportunityRequest
+	(*UpdateOpportunityResponse)(nil),        // 116: crm.UpdateOpportunityResponse
+	(*DeleteOpportunityRequest)(nil),         // 117: crm.DeleteOpportunityRequest
+	(*DeleteOpportunityResponse)(nil),        // 118: crm.DeleteOpportunityResponse
+	(*ListOpportunitiesRequest)(nil),         // 119: crm.ListOpportunitiesRequest
+	(*ListOpportunitiesResponse)(nil),        // 120: crm.ListOpportunitiesResponse
+	(*GetOpportunityHistoryRequest)(nil),     // 121: crm.GetOpportunityHistoryRequest
+	(*OpportunityChange)(nil),                // 122: crm.OpportunityChange
+	(*GetOpportunityHistoryResponse)(nil),    // 123: crm.GetOpportunityHistoryResponse
+	(*GetOpportunityMetricsRequest)(nil),     // 124: crm.GetOpportunityMetricsRequest
+	(*StageMetrics)(nil),                     // 125: crm.StageMetrics
+	(*OwnerWinRate)(nil),                     // 126: crm.OwnerWinRate
+	(*OpportunityMetrics)(nil),               // 127: crm.OpportunityMetrics
+	(*OpportunityLineItem)(nil),              // 128: crm.OpportunityLineItem
+	(*ListLineItemsRequest)(nil),             // 129: crm.ListLineItemsRequest
+	(*ListLineItemsResponse)(nil),            // 130: crm.ListLineItemsResponse
+	(*AddLineItemRequest)(nil),               // 131: crm.AddLineItemRequest
+	(*UpdateLineItemRequest)(nil),            // 132: crm.UpdateLineItemRequest
+	(*RemoveLineItemRequest)(nil),            // 133: crm.RemoveLineItemRequest
+	(*LineItemResponse)(nil),                 // 134: crm.LineItemResponse
+	(*CloseOpportunityWonRequest)(nil),       // 135: crm.CloseOpportunityWonRequest
+	(*CloseOpportunityLostRequest)(nil),      // 136: crm.CloseOpportunityLostRequest
+	(*CloseOpportunityResponse)(nil),         // 137: crm.CloseOpportunityResponse
+	(*LossReason)(nil),                       // 138: crm.LossReason
+	(*ListLossReasonsRequest)(nil),           // 139: crm.ListLossReasonsRequest
+	(*ListLossReasonsResponse)(nil),          // 140: crm.ListLossReasonsResponse
+	(*CreateLossReasonRequest)(nil),          // 141: crm.CreateLossReasonRequest
+	(*UpdateLossReasonRequest)(nil),          // 142: crm.UpdateLossReasonRequest
+	(*DeleteLossReasonRequest)(nil),          // 143: crm.DeleteLossReasonRequest
+	(*DeleteLossReasonResponse)(nil),         // 144: crm.DeleteLossReasonResponse
+	(*OpportunityContact)(nil),               // 145: crm.OpportunityContact
+	(*AddOpportunityContactRequest)(nil),     // 146: crm.AddOpportunityContactRequest
+	(*RemoveOpportunityContactRequest)(nil),  // 147: crm.RemoveOpportunityContactRequest
+	(*RemoveOpportunityContactResponse)(nil), // 148: crm.RemoveOpportunityContactResponse
+	(*ListOpportunityContactsRequest)(nil),   // 149: crm.ListOpportunityContactsRequest
+	(*ListOpportunityContactsResponse)(nil),  // 150: crm.ListOpportunityContactsResponse
+	(*PipelineStage)(nil),                    // 151: crm.PipelineStage
+	(*Pipeline)(nil),                         // 152: crm.Pipeline
+	(*CreatePipelineRequest)(nil),            // 153: crm.CreatePipelineRequest
+	(*GetPipelineRequest)(nil),               // 154: crm.GetPipelineRequest
+	(*UpdatePipelineRequest)(nil),            // 155: crm.UpdatePipelineRequest
+	(*DeletePipelineRequest)(nil),            // 156: crm.DeletePipelineRequest
+	(*DeletePipelineResponse)(nil),           // 157: crm.DeletePipelineResponse
+	(*ListPipelinesRequest)(nil),             // 158: crm.ListPipelinesRequest
+	(*ListPipelinesResponse)(nil),            // 159: crm.ListPipelinesResponse
+	(*ForecastRequest)(nil),                  // 160: crm.ForecastRequest
+	(*ForecastRow)(nil),                      // 161: crm.ForecastRow
+	(*Forecast)(nil),                         // 162: crm.Forecast
+	(*CreateForecastSnapshotRequest)(nil),    // 163: crm.CreateForecastSnapshotRequest
+	(*ForecastSnapshot)(nil),                 // 164: crm.ForecastSnapshot
+	(*GetForecastSnapshotRequest)(nil),       // 165: crm.GetForecastSnapshotRequest
+	(*GetForecastSnapshotResponse)(nil),      // 166: crm.GetForecastSnapshotResponse
+	(*ListForecastSnapshotsRequest)(nil),     // 167: crm.ListForecastSnapshotsRequest
+	(*ListForecastSnapshotsResponse)(nil),    // 168: crm.ListForecastSnapshotsResponse
+	(*DeleteForecastSnapshotRequest)(nil),    // 169: crm.DeleteForecastSnapshotRequest
+	(*DeleteForecastSnapshotResponse)(nil),   // 170: crm.DeleteForecastSnapshotResponse
+	(*ExchangeRate)(nil),                     // 171: crm.ExchangeRate
+	(*SetExchangeRatesRequest)(nil),          // 172: crm.SetExchangeRatesRequest
+	(*SetExchangeRatesResponse)(nil),         // 173: crm.SetExchangeRatesResponse
+	(*ImportExchangeRatesRequest)(nil),       // 174: crm.ImportExchangeRatesRequest
+	(*ImportExchangeRatesResponse)(nil),      // 175: crm.ImportExchangeRatesResponse
+	(*ListExchangeRatesRequest)(nil),         // 176: crm.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),        // 177: crm.ListExchangeRatesResponse
+	(*DeleteExchangeRateRequest)(nil),        // 178: crm.DeleteExchangeRateRequest
+	(*DeleteExchangeRateResponse)(nil),       // 179: crm.DeleteExchangeRateResponse
+	(*GetReportingCurrencyRequest)(nil),      // 180: crm.GetReportingCurrencyRequest
+	(*ReportingCurrency)(nil),                // 181: crm.ReportingCurrency
+	(*ConvertAmountRequest)(nil),             // 182: crm.ConvertAmountRequest
+	(*ConvertAmountResponse)(nil),            // 183: crm.ConvertAmountResponse
+	(*Product)(nil),                          // 184: crm.Product
+	(*CreateProductRequest)(nil),             // 185: crm.CreateProductRequest
+	(*GetProductRequest)(nil),                // 186: crm.GetProductRequest
+	(*UpdateProductRequest)(nil),             // 187: crm.UpdateProductRequest
+	(*DeleteProductRequest)(nil),             // 188: crm.DeleteProductRequest
+	(*DeleteProductResponse)(nil),            // 189: crm.DeleteProductResponse
+	(*ListProductsRequest)(nil),              // 190: crm.ListProductsRequest
+	(*ListProductsResponse)(nil),             // 191: crm.ListProductsResponse
+	(*PriceBook)(nil),                        // 192: crm.PriceBook
+	(*CreatePriceBookRequest)(nil),           // 193: crm.CreatePriceBookRequest
+	(*GetPriceBookRequest)(nil),              // 194: crm.GetPriceBookRequest
+	(*UpdatePriceBookRequest)(nil),           // 195: crm.UpdatePriceBookRequest
+	(*DeletePriceBookRequest)(nil),           // 196: crm.DeletePriceBookRequest
+	(*DeletePriceBookResponse)(nil),          // 197: crm.DeletePriceBookResponse
+	(*ListPriceBooksRequest)(nil),            // 198: crm.ListPriceBooksRequest
+	(*ListPriceBooksResponse)(nil),           // 199: crm.ListPriceBooksResponse
+	(*PriceBookEntry)(nil),                   // 200: crm.PriceBookEntry
+	(*RemovePriceBookEntryRequest)(nil),      // 201: crm.RemovePriceBookEntryRequest
+	(*RemovePriceBookEntryResponse)(nil),     // 202: crm.RemovePriceBookEntryResponse
+	(*ListPriceBookEntriesRequest)(nil),      // 203: crm.ListPriceBookEntriesRequest
+	(*ListPriceBookEntriesResponse)(nil),     // 204: crm.ListPriceBookEntriesResponse
+	(*SearchRequest)(nil),                    // 205: crm.SearchRequest
+	(*SearchHit)(nil),                        // 206: crm.SearchHit
+	(*FacetCount)(nil),                       // 207: crm.FacetCount
+	(*SearchFacets)(nil),                     // 208: crm.SearchFacets
+	(*SearchResponse)(nil),                   // 209: crm.SearchResponse
+	(*Meeting)(nil),                          // 210: crm.Meeting
+	(*MeetingAttendee)(nil),                  // 211: crm.MeetingAttendee
+	(*ScheduleMeetingRequest)(nil),           // 212: crm.ScheduleMeetingRequest
+	(*MeetingResponse)(nil),                  // 213: crm.MeetingResponse
+	(*GetMeetingRequest)(nil),                // 214: crm.GetMeetingRequest
+	(*GetMeetingResponse)(nil),               // 215: crm.GetMeetingResponse
+	(*UpdateMeetingRequest)(nil),             // 216: crm.UpdateMeetingRequest
+	(*UpdateMeetingResponse)(nil),            // 217: crm.UpdateMeetingResponse
+	(*DeleteMeetingRequest)(nil),             // 218: crm.DeleteMeetingRequest
+	(*DeleteMeetingResponse)(nil),            // 219: crm.DeleteMeetingResponse
+	(*ListMeetingsRequest)(nil),              // 220: crm.ListMeetingsRequest
+	(*ListMeetingsResponse)(nil),             // 221: crm.ListMeetingsResponse
+	(*Proposal)(nil),                         // 222: crm.Proposal
+	(*ProposalLineItem)(nil),                 // 223: crm.ProposalLineItem
+	(*CreateProposalRequest)(nil),            // 224: crm.CreateProposalRequest
+	(*CreateProposalResponse)(nil),           // 225: crm.CreateProposalResponse
+	(*GetProposalRequest)(nil),               // 226: crm.GetProposalRequest
+	(*GetProposalResponse)(nil),              // 227: crm.GetProposalResponse
+	(*UpdateProposalRequest)(nil),            // 228: crm.UpdateProposalRequest
+	(*UpdateProposalResponse)(nil),           // 229: crm.UpdateProposalResponse
+	(*DeleteProposalRequest)(nil),            // 230: crm.DeleteProposalRequest
+	(*DeleteProposalResponse)(nil),           // 231: crm.DeleteProposalResponse
+	(*ListProposalsRequest)(nil),             // 232: crm.ListProposalsRequest
+	(*ListProposalsResponse)(nil),            // 233: crm.ListProposalsResponse
+	(*UpdateProposalStatusRequest)(nil),      // 234: crm.UpdateProposalStatusRequest
+	(*UpdateProposalStatusResponse)(nil),     // 235: crm.UpdateProposalStatusResponse
+	(*SendNotificationWithSMTPRequest)(nil),  // 236: crm.SendNotificationWithSMTPRequest
+	(*SendNotificationWithSMSRequest)(nil),   // 237: crm.SendNotificationWithSMSRequest
+	(*SendNotificationRequest)(nil),          // 238: crm.SendNotificationRequest
+	(*SendNotificationResponse)(nil),         // 239: crm.SendNotificationResponse
+	(*HealthCheckRequest)(nil),               // 240: crm.HealthCheckRequest
+	(*HealthCheckResponse)(nil),              // 241: crm.HealthCheckResponse
+	(*DependencyStatus)(nil),                 // 242: crm.DependencyStatus
+	(*CreateSMTPRequest)(nil),                // 243: crm.CreateSMTPRequest
+	(*GetSMTPRequest)(nil),                   // 244: crm.GetSMTPRequest
+	(*UpdateSMTPRequest)(nil),                // 245: crm.UpdateSMTPRequest
+	(*DeleteSMTPRequest)(nil),                // 246: crm.DeleteSMTPRequest
+	(*SMTPResponse)(nil),                     // 247: crm.SMTPResponse
+	(*ListSMTPRequest)(nil),                  // 248: crm.ListSMTPRequest
+	(*ListSMTPResponse)(nil),                 // 249: crm.ListSMTPResponse
+	(*DeleteSMTPResponse)(nil),               // 250: crm.DeleteSMTPResponse
+	(*TestSMTPRequest)(nil),                  // 251: crm.TestSMTPRequest
+	(*TestSMTPResponse)(nil),                 // 252: crm.TestSMTPResponse
+	(*RotateSMTPKeysRequest)(nil),            // 253: crm.RotateSMTPKeysRequest
+	(*RotateSMTPKeysResponse)(nil),           // 254: crm.RotateSMTPKeysResponse
+	(*CreateTemplateRequest)(nil),            // 255: crm.CreateTemplateRequest
+	(*UpdateTemplateRequest)(nil),            // 256: crm.UpdateTemplateRequest
+	(*GetTemplateRequest)(nil),               // 257: crm.GetTemplateRequest
+	(*TemplateResponse)(nil),                 // 258: crm.TemplateResponse
+	(*PreviewTemplateRequest)(nil),           // 259: crm.PreviewTemplateRequest
+	(*PreviewTemplateResponse)(nil),          // 260: crm.PreviewTemplateResponse
+	(*ListTemplatesRequest)(nil),             // 261: crm.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),            // 262: crm.ListTemplatesResponse
+	(*NotificationLogResponse)(nil),          // 263: crm.NotificationLogResponse
+	(*ListLogsRequest)(nil),                  // 264: crm.ListLogsRequest
+	(*ListLogsResponse)(nil),                 // 265: crm.ListLogsResponse
+	(*GetLogRequest)(nil),                    // 266: crm.GetLogRequest
+	nil,                                      // 267: crm.MergeAudit.FilledFieldsEntry
+	nil,                                      // 268: crm.MergeAudit.RepointedEntry
+	nil,                                      // 269: crm.SendNotificationWithSMTPRequest.DataEntry
+	nil,                                      // 270: crm.SendNotificationWithSMSRequest.DataEntry
+	nil,                                      // 271: crm.SendNotificationRequest.DataEntry
+	nil,                                      // 272: crm.CreateTemplateRequest.DataEntry
+	nil,                                      // 273: crm.UpdateTemplateRequest.DataEntry
+	nil,                                      // 274: crm.TemplateResponse.DataEntry
+	nil,                                      // 275: crm.PreviewTemplateRequest.DataEntry
 }
 var file_api_proto_crm_proto_depIdxs = []int32{
-	0,  // 0: crm.CreateActivityRequest.activity:type_name -> crm.Activity
-	0,  // 1: crm.CreateActivityResponse.activity:type_name -> crm.Activity
-	0,  // 2: crm.GetActivityResponse.activity:type_name -> crm.Activity
-	0,  // 3: crm.UpdateActivityRequest.activity:type_name -> crm.Activity
-	0,  // 4: crm.UpdateActivityResponse.activity:type_name -> crm.Activity
-	0,  // 5: crm.ListActivitiesResponse.activities:type_name -> crm.Activity
-	11, // 6: crm.CreateTaskRequest.task:type_name -> crm.Task
-	11, // 7: crm.CreateTaskResponse.task:type_name -> crm.Task
-	11, // 8: crm.GetTaskResponse.task:type_name -> crm.Task
-	11, // 9: crm.UpdateTaskRequest.task:type_name -> crm.Task
-	11, // 10: crm.UpdateTaskResponse.task:type_name -> crm.Task
-	11, // 11: crm.ListTasksResponse.tasks:type_name -> crm.Task
-	22, // 12: crm.CreateContactRequest.contact:type_name -> crm.Contact
-	22, // 13: crm.CreateContactResponse.contact:type_name -> crm.Contact
-	22, // 14: crm.GetContactResponse.contact:type_name -> crm.Contact
-	22, // 15: crm.UpdateContactRequest.contact:type_name -> crm.Contact
-	22, // 16: crm.UpdateContactResponse.contact:type_name -> crm.Contact
-	22, // 17: crm.ListContactsResponse.contacts:type_name -> crm.Contact
-	33, // 18: crm.CreateCompanyRequest.company:type_name -> crm.Company
-	33, // 19: crm.CreateCompanyResponse.company:type_name -> crm.Company
-	33, // 20: crm.GetCompanyResponse.company:type_name -> crm.Company
-	33, // 21: crm.UpdateCompanyRequest.company:type_name -> crm.Company
-	33, // 22: crm.UpdateCompanyResponse.company:type_name -> crm.Company
-	33, // 23: crm.ListCompaniesResponse.companies:type_name -> crm.Company
-	44, // 24: crm.CreateLeadRequest.lead:type_name -> crm.Lead
-	44, // 25: crm.CreateLeadResponse.lead:type_name -> crm.Lead
-	44, // 26: crm.GetLeadResponse.lead:type_name -> crm.Lead
-	44, // 27: crm.UpdateLeadRequest.lead:type_name -> crm.Lead
-	44, // 28: crm.UpdateLeadResponse.lead:type_name -> crm.Lead
-	44, // 29: crm.GetAllLeadsResponse.leads:type_name -> crm.Lead
-	44, // 30: crm.GetLeadByEmailResponse.lead:type_name -> crm.Lead
-	57, // 31: crm.CreateOpportunityRequest.opportunity:type_name -> crm.Opportunity
-	57, // 32: crm.CreateOpportunityResponse.opportunity:type_name -> crm.Opportunity
-	57, // 33: crm.GetOpportunityResponse.opportunity:type_name -> crm.Opportunity
-	57, // 34: crm.UpdateOpportunityRequest.opportunity:type_name -> crm.Opportunity
-	57, // 35: crm.UpdateOpportunityResponse.opportunity:type_name -> crm.Opportunity
-	57, // 36: crm.ListOpportunitiesResponse.opportunities:type_name -> crm.Opportunity
-	1,  // 37: crm.ActivityService.CreateActivity:input_type -> crm.CreateActivityRequest
-	3,  // 38: crm.ActivityService.GetActivity:input_type -> crm.GetActivityRequest
-	5,  // 39: crm.ActivityService.UpdateActivity:input_type -> crm.UpdateActivityRequest
-	7,  // 40: crm.ActivityService.DeleteActivity:input_type -> crm.DeleteActivityRequest
-	9,  // 41: crm.ActivityService.ListActivities:input_type -> crm.ListActivitiesRequest
-	12, // 42: crm.TaskService.CreateTask:input_type -> crm.CreateTaskRequest
-	14, // 43: crm.TaskService.GetTask:input_type -> crm.GetTaskRequest
-	16, // 44: crm.TaskService.UpdateTask:input_type -> crm.UpdateTaskRequest
-	18, // 45: crm.TaskService.DeleteTask:input_type -> crm.DeleteTaskRequest
-	20, // 46: crm.TaskService.ListTasks:input_type -> crm.ListTasksRequest
-	23, // 47: crm.ContactService.CreateContact:input_type -> crm.CreateContactRequest
-	25, // 48: crm.ContactService.GetContact:input_type -> crm.GetContactRequest
-	27, // 49: crm.ContactService.UpdateContact:input_type -> crm.UpdateContactRequest
-	29, // 50: crm.ContactService.DeleteContact:input_type -> crm.DeleteContactRequest
-	31, // 51: crm.ContactService.ListContacts:input_type -> crm.ListContactsRequest
-	34, // 52: crm.CompanyService.CreateCompany:input_type -> crm.CreateCompanyRequest
-	36, // 53: crm.CompanyService.GetCompany:input_type -> crm.GetCompanyRequest
-	38, // 54: crm.CompanyService.UpdateCompany:input_type -> crm.UpdateCompanyRequest
-	40, // 55: crm.CompanyService.DeleteCompany:input_type -> crm.DeleteCompanyRequest
-	42, // 56: crm.CompanyService.ListCompanies:input_type -> crm.ListCompaniesRequest
-	45, // 57: crm.LeadService.CreateLead:input_type -> crm.CreateLeadRequest
-	47, // 58: crm.LeadService.GetLead:input_type -> crm.GetLeadRequest
-	49, // 59: crm.LeadService.UpdateLead:input_type -> crm.UpdateLeadRequest
-	51, // 60: crm.LeadService.DeleteLead:input_type -> crm.DeleteLeadRequest
-	53, // 61: crm.LeadService.GetAllLeads:input_type -> crm.GetAllLeadsRequest
-	55, // 62: crm.LeadService.GetLeadByEmail:input_type -> crm.GetLeadByEmailRequest
-	58, // 63: crm.OpportunityService.CreateOpportunity:input_type -> crm.CreateOpportunityRequest
-	60, // 64: crm.OpportunityService.GetOpportunity:input_type -> crm.GetOpportunityRequest
-	62, // 65: crm.OpportunityService.UpdateOpportunity:input_type -> crm.UpdateOpportunityRequest
-	64, // 66: crm.OpportunityService.DeleteOpportunity:input_type -> crm.DeleteOpportunityRequest
-	66, // 67: crm.OpportunityService.ListOpportunities:input_type -> crm.ListOpportunitiesRequest
-	2,  // 68: crm.ActivityService.CreateActivity:output_type -> crm.CreateActivityResponse
-	4,  // 69: crm.ActivityService.GetActivity:output_type -> crm.GetActivityResponse
-	6,  // 70: crm.ActivityService.UpdateActivity:output_type -> crm.UpdateActivityResponse
-	8,  // 71: crm.ActivityService.DeleteActivity:output_type -> crm.DeleteActivityResponse
-	10, // 72: crm.ActivityService.ListActivities:output_type -> crm.ListActivitiesResponse
-	13, // 73: crm.TaskService.CreateTask:output_type -> crm.CreateTaskResponse
-	15, // 74: crm.TaskService.GetTask:output_type -> crm.GetTaskResponse
-	17, // 75: crm.TaskService.UpdateTask:output_type -> crm.UpdateTaskResponse
-	19, // 76: crm.TaskService.DeleteTask:output_type -> crm.DeleteTaskResponse
-	21, // 77: crm.TaskService.ListTasks:output_type -> crm.ListTasksResponse
-	24, // 78: crm.ContactService.CreateContact:output_type -> crm.CreateContactResponse
-	26, // 79: crm.ContactService.GetContact:output_type -> crm.GetContactResponse
-	28, // 80: crm.ContactService.UpdateContact:output_type -> crm.UpdateContactResponse
-	30, // 81: crm.ContactService.DeleteContact:output_type -> crm.DeleteContactResponse
-	32, // 82: crm.ContactService.ListContacts:output_type -> crm.ListContactsResponse
-	35, // 83: crm.CompanyService.CreateCompany:output_type -> crm.CreateCompanyResponse
-	37, // 84: crm.CompanyService.GetCompany:output_type -> crm.GetCompanyResponse
-	39, // 85: crm.CompanyService.UpdateCompany:output_type -> crm.UpdateCompanyResponse
-	41, // 86: crm.CompanyService.DeleteCompany:output_type -> crm.DeleteCompanyResponse
-	43, // 87: crm.CompanyService.ListCompanies:output_type -> crm.ListCompaniesResponse
-	46, // 88: crm.LeadService.CreateLead:output_type -> crm.CreateLeadResponse
-	48, // 89: crm.LeadService.GetLead:output_type -> crm.GetLeadResponse
-	50, // 90: crm.LeadService.UpdateLead:output_type -> crm.UpdateLeadResponse
-	52, // 91: crm.LeadService.DeleteLead:output_type -> crm.DeleteLeadResponse
-	54, // 92: crm.LeadService.GetAllLeads:output_type -> crm.GetAllLeadsResponse
-	56, // 93: crm.LeadService.GetLeadByEmail:output_type -> crm.GetLeadByEmailResponse
-	59, // 94: crm.OpportunityService.CreateOpportunity:output_type -> crm.CreateOpportunityResponse
-	61, // 95: crm.OpportunityService.GetOpportunity:output_type -> crm.GetOpportunityResponse
-	63, // 96: crm.OpportunityService.UpdateOpportunity:output_type -> crm.UpdateOpportunityResponse
-	65, // 97: crm.OpportunityService.DeleteOpportunity:output_type -> crm.DeleteOpportunityResponse
-	67, // 98: crm.OpportunityService.ListOpportunities:output_type -> crm.ListOpportunitiesResponse
-	68, // [68:99] is the sub-list for method output_type
-	37, // [37:68] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	0,   // 0: crm.FilterGroup.conditions:type_name -> crm.FilterCondition
+	1,   // 1: crm.FilterGroup.groups:type_name -> crm.FilterGroup
+	3,   // 2: crm.CreateActivityRequest.activity:type_name -> crm.Activity
+	3,   // 3: crm.CreateActivityResponse.activity:type_name -> crm.Activity
+	3,   // 4: crm.GetActivityResponse.activity:type_name -> crm.Activity
+	3,   // 5: crm.UpdateActivityRequest.activity:type_name -> crm.Activity
+	3,   // 6: crm.UpdateActivityResponse.activity:type_name -> crm.Activity
+	1,   // 7: crm.ListActivitiesRequest.filter:type_name -> crm.FilterGroup
+	2,   // 8: crm.ListActivitiesRequest.sort:type_name -> crm.SortField
+	3,   // 9: crm.ListActivitiesResponse.activities:type_name -> crm.Activity
+	14,  // 10: crm.CreateTaskRequest.task:type_name -> crm.Task
+	14,  // 11: crm.CreateTaskResponse.task:type_name -> crm.Task
+	14,  // 12: crm.GetTaskResponse.task:type_name -> crm.Task
+	14,  // 13: crm.UpdateTaskRequest.task:type_name -> crm.Task
+	14,  // 14: crm.UpdateTaskResponse.task:type_name -> crm.Task
+	1,   // 15: crm.ListTasksRequest.filter:type_name -> crm.FilterGroup
+	2,   // 16: crm.ListTasksRequest.sort:type_name -> crm.SortField
+	14,  // 17: crm.ListTasksResponse.tasks:type_name -> crm.Task
+	25,  // 18: crm.CreateContactRequest.contact:type_name -> crm.Contact
+	25,  // 19: crm.CreateContactResponse.contact:type_name -> crm.Contact
+	110, // 20: crm.ContactOpportunity.opportunity:type_name -> crm.Opportunity
+	25,  // 21: crm.GetContactResponse.contact:type_name -> crm.Contact
+	29,  // 22: crm.GetContactResponse.opportunities:type_name -> crm.ContactOpportunity
+	25,  // 23: crm.UpdateContactRequest.contact:type_name -> crm.Contact
+	25,  // 24: crm.UpdateContactResponse.contact:type_name -> crm.Contact
+	1,   // 25: crm.ListContactsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 26: crm.ListContactsRequest.sort:type_name -> crm.SortField
+	25,  // 27: crm.ListContactsResponse.contacts:type_name -> crm.Contact
+	37,  // 28: crm.CreateCompanyRequest.company:type_name -> crm.Company
+	37,  // 29: crm.CreateCompanyResponse.company:type_name -> crm.Company
+	37,  // 30: crm.GetCompanyResponse.company:type_name -> crm.Company
+	110, // 31: crm.GetCompanyResponse.opportunities:type_name -> crm.Opportunity
+	37,  // 32: crm.UpdateCompanyRequest.company:type_name -> crm.Company
+	37,  // 33: crm.UpdateCompanyResponse.company:type_name -> crm.Company
+	1,   // 34: crm.ListCompaniesRequest.filter:type_name -> crm.FilterGroup
+	2,   // 35: crm.ListCompaniesRequest.sort:type_name -> crm.SortField
+	37,  // 36: crm.ListCompaniesResponse.companies:type_name -> crm.Company
+	48,  // 37: crm.CreateLeadRequest.lead:type_name -> crm.Lead
+	48,  // 38: crm.CreateLeadResponse.lead:type_name -> crm.Lead
+	48,  // 39: crm.GetLeadResponse.lead:type_name -> crm.Lead
+	48,  // 40: crm.UpdateLeadRequest.lead:type_name -> crm.Lead
+	48,  // 41: crm.UpdateLeadResponse.lead:type_name -> crm.Lead
+	1,   // 42: crm.GetAllLeadsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 43: crm.GetAllLeadsRequest.sort:type_name -> crm.SortField
+	48,  // 44: crm.GetAllLeadsResponse.leads:type_name -> crm.Lead
+	25,  // 45: crm.ConvertLeadRequest.contact:type_name -> crm.Contact
+	37,  // 46: crm.ConvertLeadRequest.company:type_name -> crm.Company
+	110, // 47: crm.ConvertLeadRequest.opportunity:type_name -> crm.Opportunity
+	48,  // 48: crm.ConvertLeadResponse.lead:type_name -> crm.Lead
+	25,  // 49: crm.ConvertLeadResponse.contact:type_name -> crm.Contact
+	37,  // 50: crm.ConvertLeadResponse.company:type_name -> crm.Company
+	110, // 51: crm.ConvertLeadResponse.opportunity:type_name -> crm.Opportunity
+	61,  // 52: crm.LeadWorkflow.statuses:type_name -> crm.LeadStatusDefinition
+	62,  // 53: crm.LeadWorkflow.transitions:type_name -> crm.LeadStatusTransition
+	61,  // 54: crm.SetLeadWorkflowRequest.statuses:type_name -> crm.LeadStatusDefinition
+	62,  // 55: crm.SetLeadWorkflowRequest.transitions:type_name -> crm.LeadStatusTransition
+	67,  // 56: crm.GetLeadStatusHistoryResponse.history:type_name -> crm.LeadStatusChange
+	48,  // 57: crm.GetLeadByEmailResponse.lead:type_name -> crm.Lead
+	71,  // 58: crm.CreateScoringRuleRequest.rule:type_name -> crm.ScoringRule
+	71,  // 59: crm.UpdateScoringRuleRequest.rule:type_name -> crm.ScoringRule
+	1,   // 60: crm.ListScoringRulesRequest.filter:type_name -> crm.FilterGroup
+	2,   // 61: crm.ListScoringRulesRequest.sort:type_name -> crm.SortField
+	71,  // 62: crm.ListScoringRulesResponse.rules:type_name -> crm.ScoringRule
+	80,  // 63: crm.SalesTeam.members:type_name -> crm.SalesTeamMember
+	81,  // 64: crm.ListSalesTeamsResponse.teams:type_name -> crm.SalesTeam
+	90,  // 65: crm.CreateAssignmentRuleRequest.rule:type_name -> crm.AssignmentRule
+	90,  // 66: crm.UpdateAssignmentRuleRequest.rule:type_name -> crm.AssignmentRule
+	90,  // 67: crm.ListAssignmentRulesResponse.rules:type_name -> crm.AssignmentRule
+	48,  // 68: crm.AssignLeadResponse.lead:type_name -> crm.Lead
+	100, // 69: crm.DuplicateCluster.records:type_name -> crm.DuplicateCandidate
+	101, // 70: crm.DuplicateCluster.matches:type_name -> crm.DuplicateMatch
+	102, // 71: crm.FindDuplicatesResponse.clusters:type_name -> crm.DuplicateCluster
+	267, // 72: crm.MergeAudit.filled_fields:type_name -> crm.MergeAudit.FilledFieldsEntry
+	268, // 73: crm.MergeAudit.repointed:type_name -> crm.MergeAudit.RepointedEntry
+	25,  // 74: crm.MergeContactsResponse.contact:type_name -> crm.Contact
+	105, // 75: crm.MergeContactsResponse.audits:type_name -> crm.MergeAudit
+	48,  // 76: crm.MergeLeadsResponse.lead:type_name -> crm.Lead
+	105, // 77: crm.MergeLeadsResponse.audits:type_name -> crm.MergeAudit
+	105, // 78: crm.ListMergeAuditsResponse.audits:type_name -> crm.MergeAudit
+	110, // 79: crm.CreateOpportunityRequest.opportunity:type_name -> crm.Opportunity
+	110, // 80: crm.CreateOpportunityResponse.opportunity:type_name -> crm.Opportunity
+	110, // 81: crm.GetOpportunityResponse.opportunity:type_name -> crm.Opportunity
+	110, // 82: crm.UpdateOpportunityRequest.opportunity:type_name -> crm.Opportunity
+	110, // 83: crm.UpdateOpportunityResponse.opportunity:type_name -> crm.Opportunity
+	1,   // 84: crm.ListOpportunitiesRequest.filter:type_name -> crm.FilterGroup
+	2,   // 85: crm.ListOpportunitiesRequest.sort:type_name -> crm.SortField
+	110, // 86: crm.ListOpportunitiesResponse.opportunities:type_name -> crm.Opportunity
+	122, // 87: crm.GetOpportunityHistoryResponse.history:type_name -> crm.OpportunityChange
+	152, // 88: crm.OpportunityMetrics.pipeline:type_name -> crm.Pipeline
+	125, // 89: crm.OpportunityMetrics.stages:type_name -> crm.StageMetrics
+	126, // 90: crm.OpportunityMetrics.owners:type_name -> crm.OwnerWinRate
+	128, // 91: crm.ListLineItemsResponse.line_items:type_name -> crm.OpportunityLineItem
+	128, // 92: crm.AddLineItemRequest.line_item:type_name -> crm.OpportunityLineItem
+	128, // 93: crm.UpdateLineItemRequest.line_item:type_name -> crm.OpportunityLineItem
+	128, // 94: crm.LineItemResponse.line_item:type_name -> crm.OpportunityLineItem
+	110, // 95: crm.LineItemResponse.opportunity:type_name -> crm.Opportunity
+	110, // 96: crm.CloseOpportunityResponse.opportunity:type_name -> crm.Opportunity
+	138, // 97: crm.ListLossReasonsResponse.loss_reasons:type_name -> crm.LossReason
+	138, // 98: crm.CreateLossReasonRequest.loss_reason:type_name -> crm.LossReason
+	138, // 99: crm.UpdateLossReasonRequest.loss_reason:type_name -> crm.LossReason
+	145, // 100: crm.ListOpportunityContactsResponse.contacts:type_name -> crm.OpportunityContact
+	151, // 101: crm.Pipeline.stages:type_name -> crm.PipelineStage
+	152, // 102: crm.CreatePipelineRequest.pipeline:type_name -> crm.Pipeline
+	152, // 103: crm.UpdatePipelineRequest.pipeline:type_name -> crm.Pipeline
+	152, // 104: crm.ListPipelinesResponse.pipelines:type_name -> crm.Pipeline
+	160, // 105: crm.Forecast.request:type_name -> crm.ForecastRequest
+	161, // 106: crm.Forecast.rows:type_name -> crm.ForecastRow
+	160, // 107: crm.CreateForecastSnapshotRequest.forecast:type_name -> crm.ForecastRequest
+	162, // 108: crm.ForecastSnapshot.forecast:type_name -> crm.Forecast
+	164, // 109: crm.GetForecastSnapshotResponse.snapshot:type_name -> crm.ForecastSnapshot
+	162, // 110: crm.GetForecastSnapshotResponse.current:type_name -> crm.Forecast
+	1,   // 111: crm.ListForecastSnapshotsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 112: crm.ListForecastSnapshotsRequest.sort:type_name -> crm.SortField
+	164, // 113: crm.ListForecastSnapshotsResponse.snapshots:type_name -> crm.ForecastSnapshot
+	171, // 114: crm.SetExchangeRatesRequest.rates:type_name -> crm.ExchangeRate
+	171, // 115: crm.SetExchangeRatesResponse.rates:type_name -> crm.ExchangeRate
+	1,   // 116: crm.ListExchangeRatesRequest.filter:type_name -> crm.FilterGroup
+	2,   // 117: crm.ListExchangeRatesRequest.sort:type_name -> crm.SortField
+	171, // 118: crm.ListExchangeRatesResponse.rates:type_name -> crm.ExchangeRate
+	184, // 119: crm.CreateProductRequest.product:type_name -> crm.Product
+	184, // 120: crm.UpdateProductRequest.product:type_name -> crm.Product
+	1,   // 121: crm.ListProductsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 122: crm.ListProductsRequest.sort:type_name -> crm.SortField
+	184, // 123: crm.ListProductsResponse.products:type_name -> crm.Product
+	192, // 124: crm.CreatePriceBookRequest.price_book:type_name -> crm.PriceBook
+	192, // 125: crm.UpdatePriceBookRequest.price_book:type_name -> crm.PriceBook
+	192, // 126: crm.ListPriceBooksResponse.price_books:type_name -> crm.PriceBook
+	200, // 127: crm.ListPriceBookEntriesResponse.entries:type_name -> crm.PriceBookEntry
+	207, // 128: crm.SearchFacets.type:type_name -> crm.FacetCount
+	207, // 129: crm.SearchFacets.country:type_name -> crm.FacetCount
+	207, // 130: crm.SearchFacets.industry:type_name -> crm.FacetCount
+	207, // 131: crm.SearchFacets.status:type_name -> crm.FacetCount
+	206, // 132: crm.SearchResponse.results:type_name -> crm.SearchHit
+	208, // 133: crm.SearchResponse.facets:type_name -> crm.SearchFacets
+	211, // 134: crm.Meeting.attendees:type_name -> crm.MeetingAttendee
+	211, // 135: crm.ScheduleMeetingRequest.attendees:type_name -> crm.MeetingAttendee
+	210, // 136: crm.MeetingResponse.meeting:type_name -> crm.Meeting
+	210, // 137: crm.GetMeetingResponse.meeting:type_name -> crm.Meeting
+	210, // 138: crm.UpdateMeetingRequest.meeting:type_name -> crm.Meeting
+	210, // 139: crm.UpdateMeetingResponse.meeting:type_name -> crm.Meeting
+	1,   // 140: crm.ListMeetingsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 141: crm.ListMeetingsRequest.sort:type_name -> crm.SortField
+	210, // 142: crm.ListMeetingsResponse.meetings:type_name -> crm.Meeting
+	223, // 143: crm.Proposal.line_items:type_name -> crm.ProposalLineItem
+	222, // 144: crm.CreateProposalRequest.proposal:type_name -> crm.Proposal
+	222, // 145: crm.CreateProposalResponse.proposal:type_name -> crm.Proposal
+	222, // 146: crm.GetProposalResponse.proposal:type_name -> crm.Proposal
+	222, // 147: crm.UpdateProposalRequest.proposal:type_name -> crm.Proposal
+	222, // 148: crm.UpdateProposalResponse.proposal:type_name -> crm.Proposal
+	1,   // 149: crm.ListProposalsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 150: crm.ListProposalsRequest.sort:type_name -> crm.SortField
+	222, // 151: crm.ListProposalsResponse.proposals:type_name -> crm.Proposal
+	222, // 152: crm.UpdateProposalStatusResponse.proposal:type_name -> crm.Proposal
+	269, // 153: crm.SendNotificationWithSMTPRequest.data:type_name -> crm.SendNotificationWithSMTPRequest.DataEntry
+	270, // 154: crm.SendNotificationWithSMSRequest.data:type_name -> crm.SendNotificationWithSMSRequest.DataEntry
+	271, // 155: crm.SendNotificationRequest.data:type_name -> crm.SendNotificationRequest.DataEntry
+	242, // 156: crm.HealthCheckResponse.dependencies:type_name -> crm.DependencyStatus
+	1,   // 157: crm.ListSMTPRequest.filter:type_name -> crm.FilterGroup
+	2,   // 158: crm.ListSMTPRequest.sort:type_name -> crm.SortField
+	247, // 159: crm.ListSMTPResponse.credentials:type_name -> crm.SMTPResponse
+	272, // 160: crm.CreateTemplateRequest.data:type_name -> crm.CreateTemplateRequest.DataEntry
+	273, // 161: crm.UpdateTemplateRequest.data:type_name -> crm.UpdateTemplateRequest.DataEntry
+	274, // 162: crm.TemplateResponse.data:type_name -> crm.TemplateResponse.DataEntry
+	275, // 163: crm.PreviewTemplateRequest.data:type_name -> crm.PreviewTemplateRequest.DataEntry
+	1,   // 164: crm.ListTemplatesRequest.filter:type_name -> crm.FilterGroup
+	2,   // 165: crm.ListTemplatesRequest.sort:type_name -> crm.SortField
+	258, // 166: crm.ListTemplatesResponse.templates:type_name -> crm.TemplateResponse
+	1,   // 167: crm.ListLogsRequest.filter:type_name -> crm.FilterGroup
+	2,   // 168: crm.ListLogsRequest.sort:type_name -> crm.SortField
+	263, // 169: crm.ListLogsResponse.logs:type_name -> crm.NotificationLogResponse
+	4,   // 170: crm.ActivityService.CreateActivity:input_type -> crm.CreateActivityRequest
+	6,   // 171: crm.ActivityService.GetActivity:input_type -> crm.GetActivityRequest
+	8,   // 172: crm.ActivityService.UpdateActivity:input_type -> crm.UpdateActivityRequest
+	10,  // 173: crm.ActivityService.DeleteActivity:input_type -> crm.DeleteActivityRequest
+	12,  // 174: crm.ActivityService.ListActivities:input_type -> crm.ListActivitiesRequest
+	15,  // 175: crm.TaskService.CreateTask:input_type -> crm.CreateTaskRequest
+	17,  // 176: crm.TaskService.GetTask:input_type -> crm.GetTaskRequest
+	19,  // 177: crm.TaskService.UpdateTask:input_type -> crm.UpdateTaskRequest
+	21,  // 178: crm.TaskService.DeleteTask:input_type -> crm.DeleteTaskRequest
+	23,  // 179: crm.TaskService.ListTasks:input_type -> crm.ListTasksRequest
+	26,  // 180: crm.ContactService.CreateContact:input_type -> crm.CreateContactRequest
+	28,  // 181: crm.ContactService.GetContact:input_type -> crm.GetContactRequest
+	31,  // 182: crm.ContactService.UpdateContact:input_type -> crm.UpdateContactRequest
+	33,  // 183: crm.ContactService.DeleteContact:input_type -> crm.DeleteContactRequest
+	35,  // 184: crm.ContactService.ListContacts:input_type -> crm.ListContactsRequest
+	38,  // 185: crm.CompanyService.CreateCompany:input_type -> crm.CreateCompanyRequest
+	40,  // 186: crm.CompanyService.GetCompany:input_type -> crm.GetCompanyRequest
+	42,  // 187: crm.CompanyService.UpdateCompany:input_type -> crm.UpdateCompanyRequest
+	44,  // 188: crm.CompanyService.DeleteCompany:input_type -> crm.DeleteCompanyRequest
+	46,  // 189: crm.CompanyService.ListCompanies:input_type -> crm.ListCompaniesRequest
+	49,  // 190: crm.LeadService.CreateLead:input_type -> crm.CreateLeadRequest
+	51,  // 191: crm.LeadService.GetLead:input_type -> crm.GetLeadRequest
+	53,  // 192: crm.LeadService.UpdateLead:input_type -> crm.UpdateLeadRequest
+	55,  // 193: crm.LeadService.DeleteLead:input_type -> crm.DeleteLeadRequest
+	57,  // 194: crm.LeadService.GetAllLeads:input_type -> crm.GetAllLeadsRequest
+	69,  // 195: crm.LeadService.GetLeadByEmail:input_type -> crm.GetLeadByEmailRequest
+	59,  // 196: crm.LeadService.ConvertLead:input_type -> crm.ConvertLeadRequest
+	64,  // 197: crm.LeadService.GetLeadWorkflow:input_type -> crm.GetLeadWorkflowRequest
+	65,  // 198: crm.LeadService.SetLeadWorkflow:input_type -> crm.SetLeadWorkflowRequest
+	66,  // 199: crm.LeadService.GetLeadStatusHistory:input_type -> crm.GetLeadStatusHistoryRequest
+	72,  // 200: crm.LeadScoringService.CreateScoringRule:input_type -> crm.CreateScoringRuleRequest
+	73,  // 201: crm.LeadScoringService.UpdateScoringRule:input_type -> crm.UpdateScoringRuleRequest
+	74,  // 202: crm.LeadScoringService.DeleteScoringRule:input_type -> crm.DeleteScoringRuleRequest
+	76,  // 203: crm.LeadScoringService.ListScoringRules:input_type -> crm.ListScoringRulesRequest
+	78,  // 204: crm.LeadScoringService.RecomputeLeadScores:input_type -> crm.RecomputeLeadScoresRequest
+	82,  // 205: crm.LeadAssignmentService.CreateSalesTeam:input_type -> crm.CreateSalesTeamRequest
+	83,  // 206: crm.LeadAssignmentService.ListSalesTeams:input_type -> crm.ListSalesTeamsRequest
+	85,  // 207: crm.LeadAssignmentService.DeleteSalesTeam:input_type -> crm.DeleteSalesTeamRequest
+	87,  // 208: crm.LeadAssignmentService.SetSalesTeamMember:input_type -> crm.SetSalesTeamMemberRequest
+	88,  // 209: crm.LeadAssignmentService.RemoveSalesTeamMember:input_type -> crm.RemoveSalesTeamMemberRequest
+	91,  // 210: crm.LeadAssignmentService.CreateAssignmentRule:input_type -> crm.CreateAssignmentRuleRequest
+	92,  // 211: crm.LeadAssignmentService.UpdateAssignmentRule:input_type -> crm.UpdateAssignmentRuleRequest
+	93,  // 212: crm.LeadAssignmentService.DeleteAssignmentRule:input_type -> crm.DeleteAssignmentRuleRequest
+	95,  // 213: crm.LeadAssignmentService.ListAssignmentRules:input_type -> crm.ListAssignmentRulesRequest
+	97,  // 214: crm.LeadAssignmentService.AssignLead:input_type -> crm.AssignLeadRequest
+	99,  // 215: crm.DuplicateService.FindDuplicates:input_type -> crm.FindDuplicatesRequest
+	104, // 216: crm.DuplicateService.MergeContacts:input_type -> crm.MergeRequest
+	104, // 217: crm.DuplicateService.MergeLeads:input_type -> crm.MergeRequest
+	108, // 218: crm.DuplicateService.ListMergeAudits:input_type -> crm.ListMergeAuditsRequest
+	111, // 219: crm.OpportunityService.CreateOpportunity:input_type -> crm.CreateOpportunityRequest
+	113, // 220: crm.OpportunityService.GetOpportunity:input_type -> crm.GetOpportunityRequest
+	115, // 221: crm.OpportunityService.UpdateOpportunity:input_type -> crm.UpdateOpportunityRequest
+	117, // 222: crm.OpportunityService.DeleteOpportunity:input_type -> crm.DeleteOpportunityRequest
+	119, // 223: crm.OpportunityService.ListOpportunities:input_type -> crm.ListOpportunitiesRequest
+	121, // 224: crm.OpportunityService.GetOpportunityHistory:input_type -> crm.GetOpportunityHistoryRequest
+	124, // 225: crm.OpportunityService.GetOpportunityMetrics:input_type -> crm.GetOpportunityMetricsRequest
+	129, // 226: crm.OpportunityService.ListLineItems:input_type -> crm.ListLineItemsRequest
+	131, // 227: crm.OpportunityService.AddLineItem:input_type -> crm.AddLineItemRequest
+	132, // 228: crm.OpportunityService.UpdateLineItem:input_type -> crm.UpdateLineItemRequest
+	133, // 229: crm.OpportunityService.RemoveLineItem:input_type -> crm.RemoveLineItemRequest
+	135, // 230: crm.OpportunityService.CloseOpportunityWon:input_type -> crm.CloseOpportunityWonRequest
+	136, // 231: crm.OpportunityService.CloseOpportunityLost:input_type -> crm.CloseOpportunityLostRequest
+	139, // 232: crm.OpportunityService.ListLossReasons:input_type -> crm.ListLossReasonsRequest
+	141, // 233: crm.OpportunityService.CreateLossReason:input_type -> crm.CreateLossReasonRequest
+	142, // 234: crm.OpportunityService.UpdateLossReason:input_type -> crm.UpdateLossReasonRequest
+	143, // 235: crm.OpportunityService.DeleteLossReason:input_type -> crm.DeleteLossReasonRequest
+	146, // 236: crm.OpportunityService.AddOpportunityContact:input_type -> crm.AddOpportunityContactRequest
+	147, // 237: crm.OpportunityService.RemoveOpportunityContact:input_type -> crm.RemoveOpportunityContactRequest
+	149, // 238: crm.OpportunityService.ListOpportunityContacts:input_type -> crm.ListOpportunityContactsRequest
+	153, // 239: crm.OpportunityService.CreatePipeline:input_type -> crm.CreatePipelineRequest
+	154, // 240: crm.OpportunityService.GetPipeline:input_type -> crm.GetPipelineRequest
+	155, // 241: crm.OpportunityService.UpdatePipeline:input_type -> crm.UpdatePipelineRequest
+	156, // 242: crm.OpportunityService.DeletePipeline:input_type -> crm.DeletePipelineRequest
+	158, // 243: crm.OpportunityService.ListPipelines:input_type -> crm.ListPipelinesRequest
+	160, // 244: crm.ForecastService.GetForecast:input_type -> crm.ForecastRequest
+	163, // 245: crm.ForecastService.CreateForecastSnapshot:input_type -> crm.CreateForecastSnapshotRequest
+	165, // 246: crm.ForecastService.GetForecastSnapshot:input_type -> crm.GetForecastSnapshotRequest
+	167, // 247: crm.ForecastService.ListForecastSnapshots:input_type -> crm.ListForecastSnapshotsRequest
+	169, // 248: crm.ForecastService.DeleteForecastSnapshot:input_type -> crm.DeleteForecastSnapshotRequest
+	172, // 249: crm.CurrencyService.SetExchangeRates:input_type -> crm.SetExchangeRatesRequest
+	174, // 250: crm.CurrencyService.ImportExchangeRates:input_type -> crm.ImportExchangeRatesRequest
+	176, // 251: crm.CurrencyService.ListExchangeRates:input_type -> crm.ListExchangeRatesRequest
+	178, // 252: crm.CurrencyService.DeleteExchangeRate:input_type -> crm.DeleteExchangeRateRequest
+	180, // 253: crm.CurrencyService.GetReportingCurrency:input_type -> crm.GetReportingCurrencyRequest
+	181, // 254: crm.CurrencyService.SetReportingCurrency:input_type -> crm.ReportingCurrency
+	182, // 255: crm.CurrencyService.ConvertAmount:input_type -> crm.ConvertAmountRequest
+	185, // 256: crm.ProductService.CreateProduct:input_type -> crm.CreateProductRequest
+	186, // 257: crm.ProductService.GetProduct:input_type -> crm.GetProductRequest
+	187, // 258: crm.ProductService.UpdateProduct:input_type -> crm.UpdateProductRequest
+	188, // 259: crm.ProductService.DeleteProduct:input_type -> crm.DeleteProductRequest
+	190, // 260: crm.ProductService.ListProducts:input_type -> crm.ListProductsRequest
+	193, // 261: crm.ProductService.CreatePriceBook:input_type -> crm.CreatePriceBookRequest
+	194, // 262: crm.ProductService.GetPriceBook:input_type -> crm.GetPriceBookRequest
+	195, // 263: crm.ProductService.UpdatePriceBook:input_type -> crm.UpdatePriceBookRequest
+	196, // 264: crm.ProductService.DeletePriceBook:input_type -> crm.DeletePriceBookRequest
+	198, // 265: crm.ProductService.ListPriceBooks:input_type -> crm.ListPriceBooksRequest
+	200, // 266: crm.ProductService.SetPriceBookEntry:input_type -> crm.PriceBookEntry
+	201, // 267: crm.ProductService.RemovePriceBookEntry:input_type -> crm.RemovePriceBookEntryRequest
+	203, // 268: crm.ProductService.ListPriceBookEntries:input_type -> crm.ListPriceBookEntriesRequest
+	205, // 269: crm.SearchService.Search:input_type -> crm.SearchRequest
+	212, // 270: crm.MeetingService.ScheduleMeeting:input_type -> crm.ScheduleMeetingRequest
+	214, // 271: crm.MeetingService.GetMeeting:input_type -> crm.GetMeetingRequest
+	216, // 272: crm.MeetingService.UpdateMeeting:input_type -> crm.UpdateMeetingRequest
+	218, // 273: crm.MeetingService.DeleteMeeting:input_type -> crm.DeleteMeetingRequest
+	220, // 274: crm.MeetingService.ListMeetings:input_type -> crm.ListMeetingsRequest
+	224, // 275: crm.ProposalService.CreateProposal:input_type -> crm.CreateProposalRequest
+	226, // 276: crm.ProposalService.GetProposal:input_type -> crm.GetProposalRequest
+	228, // 277: crm.ProposalService.UpdateProposal:input_type -> crm.UpdateProposalRequest
+	230, // 278: crm.ProposalService.DeleteProposal:input_type -> crm.DeleteProposalRequest
+	232, // 279: crm.ProposalService.ListProposals:input_type -> crm.ListProposalsRequest
+	234, // 280: crm.ProposalService.UpdateProposalStatus:input_type -> crm.UpdateProposalStatusRequest
+	238, // 281: crm.NotificationService.SendNotification:input_type -> crm.SendNotificationRequest
+	236, // 282: crm.NotificationService.SendNotificationWithSMTP:input_type -> crm.SendNotificationWithSMTPRequest
+	237, // 283: crm.NotificationService.SendNotificationWithSMS:input_type -> crm.SendNotificationWithSMSRequest
+	240, // 284: crm.HealthService.Check:input_type -> crm.HealthCheckRequest
+	243, // 285: crm.SMTPService.CreateSMTP:input_type -> crm.CreateSMTPRequest
+	244, // 286: crm.SMTPService.GetSMTP:input_type -> crm.GetSMTPRequest
+	245, // 287: crm.SMTPService.UpdateSMTP:input_type -> crm.UpdateSMTPRequest
+	246, // 288: crm.SMTPService.DeleteSMTP:input_type -> crm.DeleteSMTPRequest
+	248, // 289: crm.SMTPService.ListSMTP:input_type -> crm.ListSMTPRequest
+	251, // 290: crm.SMTPService.TestSMTP:input_type -> crm.TestSMTPRequest
+	253, // 291: crm.SMTPService.RotateSMTPKeys:input_type -> crm.RotateSMTPKeysRequest
+	255, // 292: crm.TemplateService.CreateTemplate:input_type -> crm.CreateTemplateRequest
+	257, // 293: crm.TemplateService.GetTemplate:input_type -> crm.GetTemplateRequest
+	261, // 294: crm.TemplateService.ListTemplates:input_type -> crm.ListTemplatesRequest
+	256, // 295: crm.TemplateService.UpdateTemplate:input_type -> crm.UpdateTemplateRequest
+	259, // 296: crm.TemplateService.PreviewTemplate:input_type -> crm.PreviewTemplateRequest
+	266, // 297: crm.NotificationLogService.GetLog:input_type -> crm.GetLogRequest
+	264, // 298: crm.NotificationLogService.ListLogs:input_type -> crm.ListLogsRequest
+	5,   // 299: crm.ActivityService.CreateActivity:output_type -> crm.CreateActivityResponse
+	7,   // 300: crm.ActivityService.GetActivity:output_type -> crm.GetActivityResponse
+	9,   // 301: crm.ActivityService.UpdateActivity:output_type -> crm.UpdateActivityResponse
+	11,  // 302: crm.ActivityService.DeleteActivity:output_type -> crm.DeleteActivityResponse
+	13,  // 303: crm.ActivityService.ListActivities:output_type -> crm.ListActivitiesResponse
+	16,  // 304: crm.TaskService.CreateTask:output_type -> crm.CreateTaskResponse
+	18,  // 305: crm.TaskService.GetTask:output_type -> crm.GetTaskResponse
+	20,  // 306: crm.TaskService.UpdateTask:output_type -> crm.UpdateTaskResponse
+	22,  // 307: crm.TaskService.DeleteTask:output_type -> crm.DeleteTaskResponse
+	24,  // 308: crm.TaskService.ListTasks:output_type -> crm.ListTasksResponse
+	27,  // 309: crm.ContactService.CreateContact:output_type -> crm.CreateContactResponse
+	30,  // 310: crm.ContactService.GetContact:output_type -> crm.GetContactResponse
+	32,  // 311: crm.ContactService.UpdateContact:output_type -> crm.UpdateContactResponse
+	34,  // 312: crm.ContactService.DeleteContact:output_type -> crm.DeleteContactResponse
+	36,  // 313: crm.ContactService.ListContacts:output_type -> crm.ListContactsResponse
+	39,  // 314: crm.CompanyService.CreateCompany:output_type -> crm.CreateCompanyResponse
+	41,  // 315: crm.CompanyService.GetCompany:output_type -> crm.GetCompanyResponse
+	43,  // 316: crm.CompanyService.UpdateCompany:output_type -> crm.UpdateCompanyResponse
+	45,  // 317: crm.CompanyService.DeleteCompany:output_type -> crm.DeleteCompanyResponse
+	47,  // 318: crm.CompanyService.ListCompanies:output_type -> crm.ListCompaniesResponse
+	50,  // 319: crm.LeadService.CreateLead:output_type -> crm.CreateLeadResponse
+	52,  // 320: crm.LeadService.GetLead:output_type -> crm.GetLeadResponse
+	54,  // 321: crm.LeadService.UpdateLead:output_type -> crm.UpdateLeadResponse
+	56,  // 322: crm.LeadService.DeleteLead:output_type -> crm.DeleteLeadResponse
+	58,  // 323: crm.LeadService.GetAllLeads:output_type -> crm.GetAllLeadsResponse
+	70,  // 324: crm.LeadService.GetLeadByEmail:output_type -> crm.GetLeadByEmailResponse
+	60,  // 325: crm.LeadService.ConvertLead:output_type -> crm.ConvertLeadResponse
+	63,  // 326: crm.LeadService.GetLeadWorkflow:output_type -> crm.LeadWorkflow
+	63,  // 327: crm.LeadService.SetLeadWorkflow:output_type -> crm.LeadWorkflow
+	68,  // 328: crm.LeadService.GetLeadStatusHistory:output_type -> crm.GetLeadStatusHistoryResponse
+	71,  // 329: crm.LeadScoringService.CreateScoringRule:output_type -> crm.ScoringRule
+	71,  // 330: crm.LeadScoringService.UpdateScoringRule:output_type -> crm.ScoringRule
+	75,  // 331: crm.LeadScoringService.DeleteScoringRule:output_type -> crm.DeleteScoringRuleResponse
+	77,  // 332: crm.LeadScoringService.ListScoringRules:output_type -> crm.ListScoringRulesResponse
+	79,  // 333: crm.LeadScoringService.RecomputeLeadScores:output_type -> crm.RecomputeLeadScoresResponse
+	81,  // 334: crm.LeadAssignmentService.CreateSalesTeam:output_type -> crm.SalesTeam
+	84,  // 335: crm.LeadAssignmentService.ListSalesTeams:output_type -> crm.ListSalesTeamsResponse
+	86,  // 336: crm.LeadAssignmentService.DeleteSalesTeam:output_type -> crm.DeleteSalesTeamResponse
+	80,  // 337: crm.LeadAssignmentService.SetSalesTeamMember:output_type -> crm.SalesTeamMember
+	89,  // 338: crm.LeadAssignmentService.RemoveSalesTeamMember:output_type -> crm.RemoveSalesTeamMemberResponse
+	90,  // 339: crm.LeadAssignmentService.CreateAssignmentRule:output_type -> crm.AssignmentRule
+	90,  // 340: crm.LeadAssignmentService.UpdateAssignmentRule:output_type -> crm.AssignmentRule
+	94,  // 341: crm.LeadAssignmentService.DeleteAssignmentRule:output_type -> crm.DeleteAssignmentRuleResponse
+	96,  // 342: crm.LeadAssignmentService.ListAssignmentRules:output_type -> crm.ListAssignmentRulesResponse
+	98,  // 343: crm.LeadAssignmentService.AssignLead:output_type -> crm.AssignLeadResponse
+	103, // 344: crm.DuplicateService.FindDuplicates:output_type -> crm.FindDuplicatesResponse
+	106, // 345: crm.DuplicateService.MergeContacts:output_type -> crm.MergeContactsResponse
+	107, // 346: crm.DuplicateService.MergeLeads:output_type -> crm.MergeLeadsResponse
+	109, // 347: crm.DuplicateService.ListMergeAudits:output_type -> crm.ListMergeAuditsResponse
+	112, // 348: crm.OpportunityService.CreateOpportunity:output_type -> crm.CreateOpportunityResponse
+	114, // 349: crm.OpportunityService.GetOpportunity:output_type -> crm.GetOpportunityResponse
+	116, // 350: crm.OpportunityService.UpdateOpportunity:output_type -> crm.UpdateOpportunityResponse
+	118, // 351: crm.OpportunityService.DeleteOpportunity:output_type -> crm.DeleteOpportunityResponse
+	120, // 352: crm.OpportunityService.ListOpportunities:output_type -> crm.ListOpportunitiesResponse
+	123, // 353: crm.OpportunityService.GetOpportunityHistory:output_type -> crm.GetOpportunityHistoryResponse
+	127, // 354: crm.OpportunityService.GetOpportunityMetrics:output_type -> crm.OpportunityMetrics
+	130, // 355: crm.OpportunityService.ListLineItems:output_type -> crm.ListLineItemsResponse
+	134, // 356: crm.OpportunityService.AddLineItem:output_type -> crm.LineItemResponse
+	134, // 357: crm.OpportunityService.UpdateLineItem:output_type -> crm.LineItemResponse
+	134, // 358: crm.OpportunityService.RemoveLineItem:output_type -> crm.LineItemResponse
+	137, // 359: crm.OpportunityService.CloseOpportunityWon:output_type -> crm.CloseOpportunityResponse
+	137, // 360: crm.OpportunityService.CloseOpportunityLost:output_type -> crm.CloseOpportunityResponse
+	140, // 361: crm.OpportunityService.ListLossReasons:output_type -> crm.ListLossReasonsResponse
+	138, // 362: crm.OpportunityService.CreateLossReason:output_type -> crm.LossReason
+	138, // 363: crm.OpportunityService.UpdateLossReason:output_type -> crm.LossReason
+	144, // 364: crm.OpportunityService.DeleteLossReason:output_type -> crm.DeleteLossReasonResponse
+	145, // 365: crm.OpportunityService.AddOpportunityContact:output_type -> crm.OpportunityContact
+	148, // 366: crm.OpportunityService.RemoveOpportunityContact:output_type -> crm.RemoveOpportunityContactResponse
+	150, // 367: crm.OpportunityService.ListOpportunityContacts:output_type -> crm.ListOpportunityContactsResponse
+	152, // 368: crm.OpportunityService.CreatePipeline:output_type -> crm.Pipeline
+	152, // 369: crm.OpportunityService.GetPipeline:output_type -> crm.Pipeline
+	152, // 370: crm.OpportunityService.UpdatePipeline:output_type -> crm.Pipeline
+	157, // 371: crm.OpportunityService.DeletePipeline:output_type -> crm.DeletePipelineResponse
+	159, // 372: crm.OpportunityService.ListPipelines:output_type -> crm.ListPipelinesResponse
+	162, // 373: crm.ForecastService.GetForecast:output_type -> crm.Forecast
+	164, // 374: crm.ForecastService.CreateForecastSnapshot:output_type -> crm.ForecastSnapshot
+	166, // 375: crm.ForecastService.GetForecastSnapshot:output_type -> crm.GetForecastSnapshotResponse
+	168, // 376: crm.ForecastService.ListForecastSnapshots:output_type -> crm.ListForecastSnapshotsResponse
+	170, // 377: crm.ForecastService.DeleteForecastSnapshot:output_type -> crm.DeleteForecastSnapshotResponse
+	173, // 378: crm.CurrencyService.SetExchangeRates:output_type -> crm.SetExchangeRatesResponse
+	175, // 379: crm.CurrencyService.ImportExchangeRates:output_type -> crm.ImportExchangeRatesResponse
+	177, // 380: crm.CurrencyService.ListExchangeRates:output_type -> crm.ListExchangeRatesResponse
+	179, // 381: crm.CurrencyService.DeleteExchangeRate:output_type -> crm.DeleteExchangeRateResponse
+	181, // 382: crm.CurrencyService.GetReportingCurrency:output_type -> crm.ReportingCurrency
+	181, // 383: crm.CurrencyService.SetReportingCurrency:output_type -> crm.ReportingCurrency
+	183, // 384: crm.CurrencyService.ConvertAmount:output_type -> crm.ConvertAmountResponse
+	184, // 385: crm.ProductService.CreateProduct:output_type -> crm.Product
+	184, // 386: crm.ProductService.GetProduct:output_type -> crm.Product
+	184, // 387: crm.ProductService.UpdateProduct:output_type -> crm.Product
+	189, // 388: crm.ProductService.DeleteProduct:output_type -> crm.DeleteProductResponse
+	191, // 389: crm.ProductService.ListProducts:output_type -> crm.ListProductsResponse
+	192, // 390: crm.ProductService.CreatePriceBook:output_type -> crm.PriceBook
+	192, // 391: crm.ProductService.GetPriceBook:output_type -> crm.PriceBook
+	192, // 392: crm.ProductService.UpdatePriceBook:output_type -> crm.PriceBook
+	197, // 393: crm.ProductService.DeletePriceBook:output_type -> crm.DeletePriceBookResponse
+	199, // 394: crm.ProductService.ListPriceBooks:output_type -> crm.ListPriceBooksResponse
+	200, // 395: crm.ProductService.SetPriceBookEntry:output_type -> crm.PriceBookEntry
+	202, // 396: crm.ProductService.RemovePriceBookEntry:output_type -> crm.RemovePriceBookEntryResponse
+	204, // 397: crm.ProductService.ListPriceBookEntries:output_type -> crm.ListPriceBookEntriesResponse
+	209, // 398: crm.SearchService.Search:output_type -> crm.SearchResponse
+	213, // 399: crm.MeetingService.ScheduleMeeting:output_type -> crm.MeetingResponse
+	215, // 400: crm.MeetingService.GetMeeting:output_type -> crm.GetMeetingResponse
+	217, // 401: crm.MeetingService.UpdateMeeting:output_type -> crm.UpdateMeetingResponse
+	219, // 402: crm.MeetingService.DeleteMeeting:output_type -> crm.DeleteMeetingResponse
+	221, // 403: crm.MeetingService.ListMeetings:output_type -> crm.ListMeetingsResponse
+	225, // 404: crm.ProposalService.CreateProposal:output_type -> crm.CreateProposalResponse
+	227, // 405: crm.ProposalService.GetProposal:output_type -> crm.GetProposalResponse
+	229, // 406: crm.ProposalService.UpdateProposal:output_type -> crm.UpdateProposalResponse
+	231, // 407: crm.ProposalService.DeleteProposal:output_type -> crm.DeleteProposalResponse
+	233, // 408: crm.ProposalService.ListProposals:output_type -> crm.ListProposalsResponse
+	235, // 409: crm.ProposalService.UpdateProposalStatus:output_type -> crm.UpdateProposalStatusResponse
+	239, // 410: crm.NotificationService.SendNotification:output_type -> crm.SendNotificationResponse
+	239, // 411: crm.NotificationService.SendNotificationWithSMTP:output_type -> crm.SendNotificationResponse
+	239, // 412: crm.NotificationService.SendNotificationWithSMS:output_type -> crm.SendNotificationResponse
+	241, // 413: crm.HealthService.Check:output_type -> crm.HealthCheckResponse
+	247, // 414: crm.SMTPService.CreateSMTP:output_type -> crm.SMTPResponse
+	247, // 415: crm.SMTPService.GetSMTP:output_type -> crm.SMTPResponse
+	247, // 416: crm.SMTPService.UpdateSMTP:output_type -> crm.SMTPResponse
+	250, // 417: crm.SMTPService.DeleteSMTP:output_type -> crm.DeleteSMTPResponse
+	249, // 418: crm.SMTPService.ListSMTP:output_type -> crm.ListSMTPResponse
+	252, // 419: crm.SMTPService.TestSMTP:output_type -> crm.TestSMTPResponse
+	254, // 420: crm.SMTPService.RotateSMTPKeys:output_type -> crm.RotateSMTPKeysResponse
+	258, // 421: crm.TemplateService.CreateTemplate:output_type -> crm.TemplateResponse
+	258, // 422: crm.TemplateService.GetTemplate:output_type -> crm.TemplateResponse
+	262, // 423: crm.TemplateService.ListTemplates:output_type -> crm.ListTemplatesResponse
+	258, // 424: crm.TemplateService.UpdateTemplate:output_type -> crm.TemplateResponse
+	260, // 425: crm.TemplateService.PreviewTemplate:output_type -> crm.PreviewTemplateResponse
+	263, // 426: crm.NotificationLogService.GetLog:output_type -> crm.NotificationLogResponse
+	265, // 427: crm.NotificationLogService.ListLogs:output_type -> crm.ListLogsResponse
+	299, // [299:428] is the sub-list for method output_type
+	170, // [170:299] is the sub-list for method input_type
+	170, // [170:170] is the sub-list for extension type_name
+	170, // [170:170] is the sub-list for extension extendee
+	0,   // [0:170] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_crm_proto_init() }
@@ -4171,16 +18779,22 @@ func file_api_proto_crm_proto_init() {
 	if File_api_proto_crm_proto != nil {
 		return
 	}
-	file_api_proto_crm_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[128].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[135].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[136].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[138].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[184].OneofWrappers = []any{}
+	file_api_proto_crm_proto_msgTypes[192].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_crm_proto_rawDesc), len(file_api_proto_crm_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   68,
+			NumMessages:   276,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   20,
 		},
 		GoTypes:           file_api_proto_crm_proto_goTypes,
 		DependencyIndexes: file_api_proto_crm_proto_depIdxs,
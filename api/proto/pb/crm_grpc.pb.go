@@ -1047,12 +1047,16 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LeadService_CreateLead_FullMethodName     = "/crm.LeadService/CreateLead"
-	LeadService_GetLead_FullMethodName        = "/crm.LeadService/GetLead"
-	LeadService_UpdateLead_FullMethodName     = "/crm.LeadService/UpdateLead"
-	LeadService_DeleteLead_FullMethodName     = "/crm.LeadService/DeleteLead"
-	LeadService_GetAllLeads_FullMethodName    = "/crm.LeadService/GetAllLeads"
-	LeadService_GetLeadByEmail_FullMethodName = "/crm.LeadService/GetLeadByEmail"
+	LeadService_CreateLead_FullMethodName           = "/crm.LeadService/CreateLead"
+	LeadService_GetLead_FullMethodName              = "/crm.LeadService/GetLead"
+	LeadService_UpdateLead_FullMethodName           = "/crm.LeadService/UpdateLead"
+	LeadService_DeleteLead_FullMethodName           = "/crm.LeadService/DeleteLead"
+	LeadService_GetAllLeads_FullMethodName          = "/crm.LeadService/GetAllLeads"
+	LeadService_GetLeadByEmail_FullMethodName       = "/crm.LeadService/GetLeadByEmail"
+	LeadService_ConvertLead_FullMethodName          = "/crm.LeadService/ConvertLead"
+	LeadService_GetLeadWorkflow_FullMethodName      = "/crm.LeadService/GetLeadWorkflow"
+	LeadService_SetLeadWorkflow_FullMethodName      = "/crm.LeadService/SetLeadWorkflow"
+	LeadService_GetLeadStatusHistory_FullMethodName = "/crm.LeadService/GetLeadStatusHistory"
 )
 
 // LeadServiceClient is the client API for LeadService service.
@@ -1065,6 +1069,10 @@ type LeadServiceClient interface {
 	DeleteLead(ctx context.Context, in *DeleteLeadRequest, opts ...grpc.CallOption) (*DeleteLeadResponse, error)
 	GetAllLeads(ctx context.Context, in *GetAllLeadsRequest, opts ...grpc.CallOption) (*GetAllLeadsResponse, error)
 	GetLeadByEmail(ctx context.Context, in *GetLeadByEmailRequest, opts ...grpc.CallOption) (*GetLeadByEmailResponse, error)
+	ConvertLead(ctx context.Context, in *ConvertLeadRequest, opts ...grpc.CallOption) (*ConvertLeadResponse, error)
+	GetLeadWorkflow(ctx context.Context, in *GetLeadWorkflowRequest, opts ...grpc.CallOption) (*LeadWorkflow, error)
+	SetLeadWorkflow(ctx context.Context, in *SetLeadWorkflowRequest, opts ...grpc.CallOption) (*LeadWorkflow, error)
+	GetLeadStatusHistory(ctx context.Context, in *GetLeadStatusHistoryRequest, opts ...grpc.CallOption) (*GetLeadStatusHistoryResponse, error)
 }
 
 type leadServiceClient struct {
@@ -1135,6 +1143,46 @@ func (c *leadServiceClient) GetLeadByEmail(ctx context.Context, in *GetLeadByEma
 	return out, nil
 }
 
+func (c *leadServiceClient) ConvertLead(ctx context.Context, in *ConvertLeadRequest, opts ...grpc.CallOption) (*ConvertLeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertLeadResponse)
+	err := c.cc.Invoke(ctx, LeadService_ConvertLead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) GetLeadWorkflow(ctx context.Context, in *GetLeadWorkflowRequest, opts ...grpc.CallOption) (*LeadWorkflow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeadWorkflow)
+	err := c.cc.Invoke(ctx, LeadService_GetLeadWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) SetLeadWorkflow(ctx context.Context, in *SetLeadWorkflowRequest, opts ...grpc.CallOption) (*LeadWorkflow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeadWorkflow)
+	err := c.cc.Invoke(ctx, LeadService_SetLeadWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) GetLeadStatusHistory(ctx context.Context, in *GetLeadStatusHistoryRequest, opts ...grpc.CallOption) (*GetLeadStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeadStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, LeadService_GetLeadStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeadServiceServer is the server API for LeadService service.
 // All implementations must embed UnimplementedLeadServiceServer
 // for forward compatibility.
@@ -1145,6 +1193,10 @@ type LeadServiceServer interface {
 	DeleteLead(context.Context, *DeleteLeadRequest) (*DeleteLeadResponse, error)
 	GetAllLeads(context.Context, *GetAllLeadsRequest) (*GetAllLeadsResponse, error)
 	GetLeadByEmail(context.Context, *GetLeadByEmailRequest) (*GetLeadByEmailResponse, error)
+	ConvertLead(context.Context, *ConvertLeadRequest) (*ConvertLeadResponse, error)
+	GetLeadWorkflow(context.Context, *GetLeadWorkflowRequest) (*LeadWorkflow, error)
+	SetLeadWorkflow(context.Context, *SetLeadWorkflowRequest) (*LeadWorkflow, error)
+	GetLeadStatusHistory(context.Context, *GetLeadStatusHistoryRequest) (*GetLeadStatusHistoryResponse, error)
 	mustEmbedUnimplementedLeadServiceServer()
 }
 
@@ -1173,6 +1225,18 @@ func (UnimplementedLeadServiceServer) GetAllLeads(context.Context, *GetAllLeadsR
 func (UnimplementedLeadServiceServer) GetLeadByEmail(context.Context, *GetLeadByEmailRequest) (*GetLeadByEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeadByEmail not implemented")
 }
+func (UnimplementedLeadServiceServer) ConvertLead(context.Context, *ConvertLeadRequest) (*ConvertLeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertLead not implemented")
+}
+func (UnimplementedLeadServiceServer) GetLeadWorkflow(context.Context, *GetLeadWorkflowRequest) (*LeadWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeadWorkflow not implemented")
+}
+func (UnimplementedLeadServiceServer) SetLeadWorkflow(context.Context, *SetLeadWorkflowRequest) (*LeadWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeadWorkflow not implemented")
+}
+func (UnimplementedLeadServiceServer) GetLeadStatusHistory(context.Context, *GetLeadStatusHistoryRequest) (*GetLeadStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeadStatusHistory not implemented")
+}
 func (UnimplementedLeadServiceServer) mustEmbedUnimplementedLeadServiceServer() {}
 func (UnimplementedLeadServiceServer) testEmbeddedByValue()                     {}
 
@@ -1302,6 +1366,78 @@ func _LeadService_GetLeadByEmail_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeadService_ConvertLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertLeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).ConvertLead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_ConvertLead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).ConvertLead(ctx, req.(*ConvertLeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_GetLeadWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeadWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetLeadWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetLeadWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetLeadWorkflow(ctx, req.(*GetLeadWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_SetLeadWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeadWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).SetLeadWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_SetLeadWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).SetLeadWorkflow(ctx, req.(*SetLeadWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_GetLeadStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeadStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetLeadStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetLeadStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetLeadStatusHistory(ctx, req.(*GetLeadStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeadService_ServiceDesc is the grpc.ServiceDesc for LeadService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1333,259 +1469,4750 @@ var LeadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLeadByEmail",
 			Handler:    _LeadService_GetLeadByEmail_Handler,
 		},
+		{
+			MethodName: "ConvertLead",
+			Handler:    _LeadService_ConvertLead_Handler,
+		},
+		{
+			MethodName: "GetLeadWorkflow",
+			Handler:    _LeadService_GetLeadWorkflow_Handler,
+		},
+		{
+			MethodName: "SetLeadWorkflow",
+			Handler:    _LeadService_SetLeadWorkflow_Handler,
+		},
+		{
+			MethodName: "GetLeadStatusHistory",
+			Handler:    _LeadService_GetLeadStatusHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/crm.proto",
 }
 
 const (
-	OpportunityService_CreateOpportunity_FullMethodName = "/crm.OpportunityService/CreateOpportunity"
-	OpportunityService_GetOpportunity_FullMethodName    = "/crm.OpportunityService/GetOpportunity"
-	OpportunityService_UpdateOpportunity_FullMethodName = "/crm.OpportunityService/UpdateOpportunity"
-	OpportunityService_DeleteOpportunity_FullMethodName = "/crm.OpportunityService/DeleteOpportunity"
-	OpportunityService_ListOpportunities_FullMethodName = "/crm.OpportunityService/ListOpportunities"
+	LeadScoringService_CreateScoringRule_FullMethodName   = "/crm.LeadScoringService/CreateScoringRule"
+	LeadScoringService_UpdateScoringRule_FullMethodName   = "/crm.LeadScoringService/UpdateScoringRule"
+	LeadScoringService_DeleteScoringRule_FullMethodName   = "/crm.LeadScoringService/DeleteScoringRule"
+	LeadScoringService_ListScoringRules_FullMethodName    = "/crm.LeadScoringService/ListScoringRules"
+	LeadScoringService_RecomputeLeadScores_FullMethodName = "/crm.LeadScoringService/RecomputeLeadScores"
 )
 
-// OpportunityServiceClient is the client API for OpportunityService service.
+// LeadScoringServiceClient is the client API for LeadScoringService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type OpportunityServiceClient interface {
-	CreateOpportunity(ctx context.Context, in *CreateOpportunityRequest, opts ...grpc.CallOption) (*CreateOpportunityResponse, error)
-	GetOpportunity(ctx context.Context, in *GetOpportunityRequest, opts ...grpc.CallOption) (*GetOpportunityResponse, error)
-	UpdateOpportunity(ctx context.Context, in *UpdateOpportunityRequest, opts ...grpc.CallOption) (*UpdateOpportunityResponse, error)
-	DeleteOpportunity(ctx context.Context, in *DeleteOpportunityRequest, opts ...grpc.CallOption) (*DeleteOpportunityResponse, error)
-	ListOpportunities(ctx context.Context, in *ListOpportunitiesRequest, opts ...grpc.CallOption) (*ListOpportunitiesResponse, error)
+type LeadScoringServiceClient interface {
+	CreateScoringRule(ctx context.Context, in *CreateScoringRuleRequest, opts ...grpc.CallOption) (*ScoringRule, error)
+	UpdateScoringRule(ctx context.Context, in *UpdateScoringRuleRequest, opts ...grpc.CallOption) (*ScoringRule, error)
+	DeleteScoringRule(ctx context.Context, in *DeleteScoringRuleRequest, opts ...grpc.CallOption) (*DeleteScoringRuleResponse, error)
+	ListScoringRules(ctx context.Context, in *ListScoringRulesRequest, opts ...grpc.CallOption) (*ListScoringRulesResponse, error)
+	RecomputeLeadScores(ctx context.Context, in *RecomputeLeadScoresRequest, opts ...grpc.CallOption) (*RecomputeLeadScoresResponse, error)
 }
 
-type opportunityServiceClient struct {
+type leadScoringServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewOpportunityServiceClient(cc grpc.ClientConnInterface) OpportunityServiceClient {
-	return &opportunityServiceClient{cc}
+func NewLeadScoringServiceClient(cc grpc.ClientConnInterface) LeadScoringServiceClient {
+	return &leadScoringServiceClient{cc}
 }
 
-func (c *opportunityServiceClient) CreateOpportunity(ctx context.Context, in *CreateOpportunityRequest, opts ...grpc.CallOption) (*CreateOpportunityResponse, error) {
+func (c *leadScoringServiceClient) CreateScoringRule(ctx context.Context, in *CreateScoringRuleRequest, opts ...grpc.CallOption) (*ScoringRule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateOpportunityResponse)
-	err := c.cc.Invoke(ctx, OpportunityService_CreateOpportunity_FullMethodName, in, out, cOpts...)
+	out := new(ScoringRule)
+	err := c.cc.Invoke(ctx, LeadScoringService_CreateScoringRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *opportunityServiceClient) GetOpportunity(ctx context.Context, in *GetOpportunityRequest, opts ...grpc.CallOption) (*GetOpportunityResponse, error) {
+func (c *leadScoringServiceClient) UpdateScoringRule(ctx context.Context, in *UpdateScoringRuleRequest, opts ...grpc.CallOption) (*ScoringRule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetOpportunityResponse)
-	err := c.cc.Invoke(ctx, OpportunityService_GetOpportunity_FullMethodName, in, out, cOpts...)
+	out := new(ScoringRule)
+	err := c.cc.Invoke(ctx, LeadScoringService_UpdateScoringRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *opportunityServiceClient) UpdateOpportunity(ctx context.Context, in *UpdateOpportunityRequest, opts ...grpc.CallOption) (*UpdateOpportunityResponse, error) {
+func (c *leadScoringServiceClient) DeleteScoringRule(ctx context.Context, in *DeleteScoringRuleRequest, opts ...grpc.CallOption) (*DeleteScoringRuleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateOpportunityResponse)
-	err := c.cc.Invoke(ctx, OpportunityService_UpdateOpportunity_FullMethodName, in, out, cOpts...)
+	out := new(DeleteScoringRuleResponse)
+	err := c.cc.Invoke(ctx, LeadScoringService_DeleteScoringRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *opportunityServiceClient) DeleteOpportunity(ctx context.Context, in *DeleteOpportunityRequest, opts ...grpc.CallOption) (*DeleteOpportunityResponse, error) {
+func (c *leadScoringServiceClient) ListScoringRules(ctx context.Context, in *ListScoringRulesRequest, opts ...grpc.CallOption) (*ListScoringRulesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteOpportunityResponse)
-	err := c.cc.Invoke(ctx, OpportunityService_DeleteOpportunity_FullMethodName, in, out, cOpts...)
+	out := new(ListScoringRulesResponse)
+	err := c.cc.Invoke(ctx, LeadScoringService_ListScoringRules_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *opportunityServiceClient) ListOpportunities(ctx context.Context, in *ListOpportunitiesRequest, opts ...grpc.CallOption) (*ListOpportunitiesResponse, error) {
+func (c *leadScoringServiceClient) RecomputeLeadScores(ctx context.Context, in *RecomputeLeadScoresRequest, opts ...grpc.CallOption) (*RecomputeLeadScoresResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListOpportunitiesResponse)
-	err := c.cc.Invoke(ctx, OpportunityService_ListOpportunities_FullMethodName, in, out, cOpts...)
+	out := new(RecomputeLeadScoresResponse)
+	err := c.cc.Invoke(ctx, LeadScoringService_RecomputeLeadScores_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// OpportunityServiceServer is the server API for OpportunityService service.
-// All implementations must embed UnimplementedOpportunityServiceServer
+// LeadScoringServiceServer is the server API for LeadScoringService service.
+// All implementations must embed UnimplementedLeadScoringServiceServer
 // for forward compatibility.
-type OpportunityServiceServer interface {
-	CreateOpportunity(context.Context, *CreateOpportunityRequest) (*CreateOpportunityResponse, error)
-	GetOpportunity(context.Context, *GetOpportunityRequest) (*GetOpportunityResponse, error)
-	UpdateOpportunity(context.Context, *UpdateOpportunityRequest) (*UpdateOpportunityResponse, error)
-	DeleteOpportunity(context.Context, *DeleteOpportunityRequest) (*DeleteOpportunityResponse, error)
-	ListOpportunities(context.Context, *ListOpportunitiesRequest) (*ListOpportunitiesResponse, error)
-	mustEmbedUnimplementedOpportunityServiceServer()
+type LeadScoringServiceServer interface {
+	CreateScoringRule(context.Context, *CreateScoringRuleRequest) (*ScoringRule, error)
+	UpdateScoringRule(context.Context, *UpdateScoringRuleRequest) (*ScoringRule, error)
+	DeleteScoringRule(context.Context, *DeleteScoringRuleRequest) (*DeleteScoringRuleResponse, error)
+	ListScoringRules(context.Context, *ListScoringRulesRequest) (*ListScoringRulesResponse, error)
+	RecomputeLeadScores(context.Context, *RecomputeLeadScoresRequest) (*RecomputeLeadScoresResponse, error)
+	mustEmbedUnimplementedLeadScoringServiceServer()
 }
 
-// UnimplementedOpportunityServiceServer must be embedded to have
+// UnimplementedLeadScoringServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedOpportunityServiceServer struct{}
+type UnimplementedLeadScoringServiceServer struct{}
 
-func (UnimplementedOpportunityServiceServer) CreateOpportunity(context.Context, *CreateOpportunityRequest) (*CreateOpportunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOpportunity not implemented")
+func (UnimplementedLeadScoringServiceServer) CreateScoringRule(context.Context, *CreateScoringRuleRequest) (*ScoringRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScoringRule not implemented")
 }
-func (UnimplementedOpportunityServiceServer) GetOpportunity(context.Context, *GetOpportunityRequest) (*GetOpportunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOpportunity not implemented")
+func (UnimplementedLeadScoringServiceServer) UpdateScoringRule(context.Context, *UpdateScoringRuleRequest) (*ScoringRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScoringRule not implemented")
 }
-func (UnimplementedOpportunityServiceServer) UpdateOpportunity(context.Context, *UpdateOpportunityRequest) (*UpdateOpportunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOpportunity not implemented")
+func (UnimplementedLeadScoringServiceServer) DeleteScoringRule(context.Context, *DeleteScoringRuleRequest) (*DeleteScoringRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScoringRule not implemented")
 }
-func (UnimplementedOpportunityServiceServer) DeleteOpportunity(context.Context, *DeleteOpportunityRequest) (*DeleteOpportunityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOpportunity not implemented")
+func (UnimplementedLeadScoringServiceServer) ListScoringRules(context.Context, *ListScoringRulesRequest) (*ListScoringRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScoringRules not implemented")
 }
-func (UnimplementedOpportunityServiceServer) ListOpportunities(context.Context, *ListOpportunitiesRequest) (*ListOpportunitiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOpportunities not implemented")
+func (UnimplementedLeadScoringServiceServer) RecomputeLeadScores(context.Context, *RecomputeLeadScoresRequest) (*RecomputeLeadScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeLeadScores not implemented")
 }
-func (UnimplementedOpportunityServiceServer) mustEmbedUnimplementedOpportunityServiceServer() {}
-func (UnimplementedOpportunityServiceServer) testEmbeddedByValue()                            {}
+func (UnimplementedLeadScoringServiceServer) mustEmbedUnimplementedLeadScoringServiceServer() {}
+func (UnimplementedLeadScoringServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafeOpportunityServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OpportunityServiceServer will
+// UnsafeLeadScoringServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeadScoringServiceServer will
 // result in compilation errors.
-type UnsafeOpportunityServiceServer interface {
-	mustEmbedUnimplementedOpportunityServiceServer()
+type UnsafeLeadScoringServiceServer interface {
+	mustEmbedUnimplementedLeadScoringServiceServer()
 }
 
-func RegisterOpportunityServiceServer(s grpc.ServiceRegistrar, srv OpportunityServiceServer) {
-	// If the following call pancis, it indicates UnimplementedOpportunityServiceServer was
+func RegisterLeadScoringServiceServer(s grpc.ServiceRegistrar, srv LeadScoringServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLeadScoringServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&OpportunityService_ServiceDesc, srv)
+	s.RegisterService(&LeadScoringService_ServiceDesc, srv)
 }
 
-func _OpportunityService_CreateOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOpportunityRequest)
+func _LeadScoringService_CreateScoringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScoringRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpportunityServiceServer).CreateOpportunity(ctx, in)
+		return srv.(LeadScoringServiceServer).CreateScoringRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpportunityService_CreateOpportunity_FullMethodName,
+		FullMethod: LeadScoringService_CreateScoringRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpportunityServiceServer).CreateOpportunity(ctx, req.(*CreateOpportunityRequest))
+		return srv.(LeadScoringServiceServer).CreateScoringRule(ctx, req.(*CreateScoringRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpportunityService_GetOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOpportunityRequest)
+func _LeadScoringService_UpdateScoringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScoringRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpportunityServiceServer).GetOpportunity(ctx, in)
+		return srv.(LeadScoringServiceServer).UpdateScoringRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpportunityService_GetOpportunity_FullMethodName,
+		FullMethod: LeadScoringService_UpdateScoringRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpportunityServiceServer).GetOpportunity(ctx, req.(*GetOpportunityRequest))
+		return srv.(LeadScoringServiceServer).UpdateScoringRule(ctx, req.(*UpdateScoringRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpportunityService_UpdateOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOpportunityRequest)
+func _LeadScoringService_DeleteScoringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScoringRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpportunityServiceServer).UpdateOpportunity(ctx, in)
+		return srv.(LeadScoringServiceServer).DeleteScoringRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpportunityService_UpdateOpportunity_FullMethodName,
+		FullMethod: LeadScoringService_DeleteScoringRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpportunityServiceServer).UpdateOpportunity(ctx, req.(*UpdateOpportunityRequest))
+		return srv.(LeadScoringServiceServer).DeleteScoringRule(ctx, req.(*DeleteScoringRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpportunityService_DeleteOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOpportunityRequest)
+func _LeadScoringService_ListScoringRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScoringRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpportunityServiceServer).DeleteOpportunity(ctx, in)
+		return srv.(LeadScoringServiceServer).ListScoringRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpportunityService_DeleteOpportunity_FullMethodName,
+		FullMethod: LeadScoringService_ListScoringRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpportunityServiceServer).DeleteOpportunity(ctx, req.(*DeleteOpportunityRequest))
+		return srv.(LeadScoringServiceServer).ListScoringRules(ctx, req.(*ListScoringRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpportunityService_ListOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOpportunitiesRequest)
+func _LeadScoringService_RecomputeLeadScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeLeadScoresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpportunityServiceServer).ListOpportunities(ctx, in)
+		return srv.(LeadScoringServiceServer).RecomputeLeadScores(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpportunityService_ListOpportunities_FullMethodName,
+		FullMethod: LeadScoringService_RecomputeLeadScores_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpportunityServiceServer).ListOpportunities(ctx, req.(*ListOpportunitiesRequest))
+		return srv.(LeadScoringServiceServer).RecomputeLeadScores(ctx, req.(*RecomputeLeadScoresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// OpportunityService_ServiceDesc is the grpc.ServiceDesc for OpportunityService service.
+// LeadScoringService_ServiceDesc is the grpc.ServiceDesc for LeadScoringService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var OpportunityService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "crm.OpportunityService",
-	HandlerType: (*OpportunityServiceServer)(nil),
+var LeadScoringService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.LeadScoringService",
+	HandlerType: (*LeadScoringServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateOpportunity",
-			Handler:    _OpportunityService_CreateOpportunity_Handler,
+			MethodName: "CreateScoringRule",
+			Handler:    _LeadScoringService_CreateScoringRule_Handler,
 		},
 		{
-			MethodName: "GetOpportunity",
-			Handler:    _OpportunityService_GetOpportunity_Handler,
+			MethodName: "UpdateScoringRule",
+			Handler:    _LeadScoringService_UpdateScoringRule_Handler,
 		},
 		{
-			MethodName: "UpdateOpportunity",
-			Handler:    _OpportunityService_UpdateOpportunity_Handler,
+			MethodName: "DeleteScoringRule",
+			Handler:    _LeadScoringService_DeleteScoringRule_Handler,
 		},
 		{
-			MethodName: "DeleteOpportunity",
-			Handler:    _OpportunityService_DeleteOpportunity_Handler,
+			MethodName: "ListScoringRules",
+			Handler:    _LeadScoringService_ListScoringRules_Handler,
 		},
 		{
-			MethodName: "ListOpportunities",
-			Handler:    _OpportunityService_ListOpportunities_Handler,
+			MethodName: "RecomputeLeadScores",
+			Handler:    _LeadScoringService_RecomputeLeadScores_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	LeadAssignmentService_CreateSalesTeam_FullMethodName       = "/crm.LeadAssignmentService/CreateSalesTeam"
+	LeadAssignmentService_ListSalesTeams_FullMethodName        = "/crm.LeadAssignmentService/ListSalesTeams"
+	LeadAssignmentService_DeleteSalesTeam_FullMethodName       = "/crm.LeadAssignmentService/DeleteSalesTeam"
+	LeadAssignmentService_SetSalesTeamMember_FullMethodName    = "/crm.LeadAssignmentService/SetSalesTeamMember"
+	LeadAssignmentService_RemoveSalesTeamMember_FullMethodName = "/crm.LeadAssignmentService/RemoveSalesTeamMember"
+	LeadAssignmentService_CreateAssignmentRule_FullMethodName  = "/crm.LeadAssignmentService/CreateAssignmentRule"
+	LeadAssignmentService_UpdateAssignmentRule_FullMethodName  = "/crm.LeadAssignmentService/UpdateAssignmentRule"
+	LeadAssignmentService_DeleteAssignmentRule_FullMethodName  = "/crm.LeadAssignmentService/DeleteAssignmentRule"
+	LeadAssignmentService_ListAssignmentRules_FullMethodName   = "/crm.LeadAssignmentService/ListAssignmentRules"
+	LeadAssignmentService_AssignLead_FullMethodName            = "/crm.LeadAssignmentService/AssignLead"
+)
+
+// LeadAssignmentServiceClient is the client API for LeadAssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Leads created without assigned_to are assigned by the first enabled rule,
+// in priority order, that matches them and whose team has an active member.
+type LeadAssignmentServiceClient interface {
+	CreateSalesTeam(ctx context.Context, in *CreateSalesTeamRequest, opts ...grpc.CallOption) (*SalesTeam, error)
+	ListSalesTeams(ctx context.Context, in *ListSalesTeamsRequest, opts ...grpc.CallOption) (*ListSalesTeamsResponse, error)
+	DeleteSalesTeam(ctx context.Context, in *DeleteSalesTeamRequest, opts ...grpc.CallOption) (*DeleteSalesTeamResponse, error)
+	SetSalesTeamMember(ctx context.Context, in *SetSalesTeamMemberRequest, opts ...grpc.CallOption) (*SalesTeamMember, error)
+	RemoveSalesTeamMember(ctx context.Context, in *RemoveSalesTeamMemberRequest, opts ...grpc.CallOption) (*RemoveSalesTeamMemberResponse, error)
+	CreateAssignmentRule(ctx context.Context, in *CreateAssignmentRuleRequest, opts ...grpc.CallOption) (*AssignmentRule, error)
+	UpdateAssignmentRule(ctx context.Context, in *UpdateAssignmentRuleRequest, opts ...grpc.CallOption) (*AssignmentRule, error)
+	DeleteAssignmentRule(ctx context.Context, in *DeleteAssignmentRuleRequest, opts ...grpc.CallOption) (*DeleteAssignmentRuleResponse, error)
+	ListAssignmentRules(ctx context.Context, in *ListAssignmentRulesRequest, opts ...grpc.CallOption) (*ListAssignmentRulesResponse, error)
+	AssignLead(ctx context.Context, in *AssignLeadRequest, opts ...grpc.CallOption) (*AssignLeadResponse, error)
+}
+
+type leadAssignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeadAssignmentServiceClient(cc grpc.ClientConnInterface) LeadAssignmentServiceClient {
+	return &leadAssignmentServiceClient{cc}
+}
+
+func (c *leadAssignmentServiceClient) CreateSalesTeam(ctx context.Context, in *CreateSalesTeamRequest, opts ...grpc.CallOption) (*SalesTeam, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalesTeam)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_CreateSalesTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) ListSalesTeams(ctx context.Context, in *ListSalesTeamsRequest, opts ...grpc.CallOption) (*ListSalesTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSalesTeamsResponse)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_ListSalesTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) DeleteSalesTeam(ctx context.Context, in *DeleteSalesTeamRequest, opts ...grpc.CallOption) (*DeleteSalesTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSalesTeamResponse)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_DeleteSalesTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) SetSalesTeamMember(ctx context.Context, in *SetSalesTeamMemberRequest, opts ...grpc.CallOption) (*SalesTeamMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalesTeamMember)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_SetSalesTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) RemoveSalesTeamMember(ctx context.Context, in *RemoveSalesTeamMemberRequest, opts ...grpc.CallOption) (*RemoveSalesTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSalesTeamMemberResponse)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_RemoveSalesTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) CreateAssignmentRule(ctx context.Context, in *CreateAssignmentRuleRequest, opts ...grpc.CallOption) (*AssignmentRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentRule)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_CreateAssignmentRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) UpdateAssignmentRule(ctx context.Context, in *UpdateAssignmentRuleRequest, opts ...grpc.CallOption) (*AssignmentRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentRule)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_UpdateAssignmentRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) DeleteAssignmentRule(ctx context.Context, in *DeleteAssignmentRuleRequest, opts ...grpc.CallOption) (*DeleteAssignmentRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssignmentRuleResponse)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_DeleteAssignmentRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) ListAssignmentRules(ctx context.Context, in *ListAssignmentRulesRequest, opts ...grpc.CallOption) (*ListAssignmentRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentRulesResponse)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_ListAssignmentRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadAssignmentServiceClient) AssignLead(ctx context.Context, in *AssignLeadRequest, opts ...grpc.CallOption) (*AssignLeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignLeadResponse)
+	err := c.cc.Invoke(ctx, LeadAssignmentService_AssignLead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeadAssignmentServiceServer is the server API for LeadAssignmentService service.
+// All implementations must embed UnimplementedLeadAssignmentServiceServer
+// for forward compatibility.
+//
+// Leads created without assigned_to are assigned by the first enabled rule,
+// in priority order, that matches them and whose team has an active member.
+type LeadAssignmentServiceServer interface {
+	CreateSalesTeam(context.Context, *CreateSalesTeamRequest) (*SalesTeam, error)
+	ListSalesTeams(context.Context, *ListSalesTeamsRequest) (*ListSalesTeamsResponse, error)
+	DeleteSalesTeam(context.Context, *DeleteSalesTeamRequest) (*DeleteSalesTeamResponse, error)
+	SetSalesTeamMember(context.Context, *SetSalesTeamMemberRequest) (*SalesTeamMember, error)
+	RemoveSalesTeamMember(context.Context, *RemoveSalesTeamMemberRequest) (*RemoveSalesTeamMemberResponse, error)
+	CreateAssignmentRule(context.Context, *CreateAssignmentRuleRequest) (*AssignmentRule, error)
+	UpdateAssignmentRule(context.Context, *UpdateAssignmentRuleRequest) (*AssignmentRule, error)
+	DeleteAssignmentRule(context.Context, *DeleteAssignmentRuleRequest) (*DeleteAssignmentRuleResponse, error)
+	ListAssignmentRules(context.Context, *ListAssignmentRulesRequest) (*ListAssignmentRulesResponse, error)
+	AssignLead(context.Context, *AssignLeadRequest) (*AssignLeadResponse, error)
+	mustEmbedUnimplementedLeadAssignmentServiceServer()
+}
+
+// UnimplementedLeadAssignmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLeadAssignmentServiceServer struct{}
+
+func (UnimplementedLeadAssignmentServiceServer) CreateSalesTeam(context.Context, *CreateSalesTeamRequest) (*SalesTeam, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSalesTeam not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) ListSalesTeams(context.Context, *ListSalesTeamsRequest) (*ListSalesTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSalesTeams not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) DeleteSalesTeam(context.Context, *DeleteSalesTeamRequest) (*DeleteSalesTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSalesTeam not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) SetSalesTeamMember(context.Context, *SetSalesTeamMemberRequest) (*SalesTeamMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSalesTeamMember not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) RemoveSalesTeamMember(context.Context, *RemoveSalesTeamMemberRequest) (*RemoveSalesTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSalesTeamMember not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) CreateAssignmentRule(context.Context, *CreateAssignmentRuleRequest) (*AssignmentRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignmentRule not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) UpdateAssignmentRule(context.Context, *UpdateAssignmentRuleRequest) (*AssignmentRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignmentRule not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) DeleteAssignmentRule(context.Context, *DeleteAssignmentRuleRequest) (*DeleteAssignmentRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignmentRule not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) ListAssignmentRules(context.Context, *ListAssignmentRulesRequest) (*ListAssignmentRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentRules not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) AssignLead(context.Context, *AssignLeadRequest) (*AssignLeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignLead not implemented")
+}
+func (UnimplementedLeadAssignmentServiceServer) mustEmbedUnimplementedLeadAssignmentServiceServer() {}
+func (UnimplementedLeadAssignmentServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeLeadAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeadAssignmentServiceServer will
+// result in compilation errors.
+type UnsafeLeadAssignmentServiceServer interface {
+	mustEmbedUnimplementedLeadAssignmentServiceServer()
+}
+
+func RegisterLeadAssignmentServiceServer(s grpc.ServiceRegistrar, srv LeadAssignmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLeadAssignmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LeadAssignmentService_ServiceDesc, srv)
+}
+
+func _LeadAssignmentService_CreateSalesTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSalesTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).CreateSalesTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_CreateSalesTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).CreateSalesTeam(ctx, req.(*CreateSalesTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_ListSalesTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSalesTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).ListSalesTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_ListSalesTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).ListSalesTeams(ctx, req.(*ListSalesTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_DeleteSalesTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSalesTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).DeleteSalesTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_DeleteSalesTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).DeleteSalesTeam(ctx, req.(*DeleteSalesTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_SetSalesTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSalesTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).SetSalesTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_SetSalesTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).SetSalesTeamMember(ctx, req.(*SetSalesTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_RemoveSalesTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSalesTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).RemoveSalesTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_RemoveSalesTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).RemoveSalesTeamMember(ctx, req.(*RemoveSalesTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_CreateAssignmentRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).CreateAssignmentRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_CreateAssignmentRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).CreateAssignmentRule(ctx, req.(*CreateAssignmentRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_UpdateAssignmentRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).UpdateAssignmentRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_UpdateAssignmentRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).UpdateAssignmentRule(ctx, req.(*UpdateAssignmentRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_DeleteAssignmentRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssignmentRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).DeleteAssignmentRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_DeleteAssignmentRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).DeleteAssignmentRule(ctx, req.(*DeleteAssignmentRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_ListAssignmentRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).ListAssignmentRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_ListAssignmentRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).ListAssignmentRules(ctx, req.(*ListAssignmentRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadAssignmentService_AssignLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignLeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadAssignmentServiceServer).AssignLead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadAssignmentService_AssignLead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadAssignmentServiceServer).AssignLead(ctx, req.(*AssignLeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeadAssignmentService_ServiceDesc is the grpc.ServiceDesc for LeadAssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeadAssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.LeadAssignmentService",
+	HandlerType: (*LeadAssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSalesTeam",
+			Handler:    _LeadAssignmentService_CreateSalesTeam_Handler,
+		},
+		{
+			MethodName: "ListSalesTeams",
+			Handler:    _LeadAssignmentService_ListSalesTeams_Handler,
+		},
+		{
+			MethodName: "DeleteSalesTeam",
+			Handler:    _LeadAssignmentService_DeleteSalesTeam_Handler,
+		},
+		{
+			MethodName: "SetSalesTeamMember",
+			Handler:    _LeadAssignmentService_SetSalesTeamMember_Handler,
+		},
+		{
+			MethodName: "RemoveSalesTeamMember",
+			Handler:    _LeadAssignmentService_RemoveSalesTeamMember_Handler,
+		},
+		{
+			MethodName: "CreateAssignmentRule",
+			Handler:    _LeadAssignmentService_CreateAssignmentRule_Handler,
+		},
+		{
+			MethodName: "UpdateAssignmentRule",
+			Handler:    _LeadAssignmentService_UpdateAssignmentRule_Handler,
+		},
+		{
+			MethodName: "DeleteAssignmentRule",
+			Handler:    _LeadAssignmentService_DeleteAssignmentRule_Handler,
+		},
+		{
+			MethodName: "ListAssignmentRules",
+			Handler:    _LeadAssignmentService_ListAssignmentRules_Handler,
+		},
+		{
+			MethodName: "AssignLead",
+			Handler:    _LeadAssignmentService_AssignLead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	DuplicateService_FindDuplicates_FullMethodName  = "/crm.DuplicateService/FindDuplicates"
+	DuplicateService_MergeContacts_FullMethodName   = "/crm.DuplicateService/MergeContacts"
+	DuplicateService_MergeLeads_FullMethodName      = "/crm.DuplicateService/MergeLeads"
+	DuplicateService_ListMergeAudits_FullMethodName = "/crm.DuplicateService/ListMergeAudits"
+)
+
+// DuplicateServiceClient is the client API for DuplicateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DuplicateServiceClient interface {
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	MergeContacts(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeContactsResponse, error)
+	MergeLeads(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeLeadsResponse, error)
+	ListMergeAudits(ctx context.Context, in *ListMergeAuditsRequest, opts ...grpc.CallOption) (*ListMergeAuditsResponse, error)
+}
+
+type duplicateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDuplicateServiceClient(cc grpc.ClientConnInterface) DuplicateServiceClient {
+	return &duplicateServiceClient{cc}
+}
+
+func (c *duplicateServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicatesResponse)
+	err := c.cc.Invoke(ctx, DuplicateService_FindDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duplicateServiceClient) MergeContacts(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeContactsResponse)
+	err := c.cc.Invoke(ctx, DuplicateService_MergeContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duplicateServiceClient) MergeLeads(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeLeadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeLeadsResponse)
+	err := c.cc.Invoke(ctx, DuplicateService_MergeLeads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duplicateServiceClient) ListMergeAudits(ctx context.Context, in *ListMergeAuditsRequest, opts ...grpc.CallOption) (*ListMergeAuditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMergeAuditsResponse)
+	err := c.cc.Invoke(ctx, DuplicateService_ListMergeAudits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DuplicateServiceServer is the server API for DuplicateService service.
+// All implementations must embed UnimplementedDuplicateServiceServer
+// for forward compatibility.
+type DuplicateServiceServer interface {
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	MergeContacts(context.Context, *MergeRequest) (*MergeContactsResponse, error)
+	MergeLeads(context.Context, *MergeRequest) (*MergeLeadsResponse, error)
+	ListMergeAudits(context.Context, *ListMergeAuditsRequest) (*ListMergeAuditsResponse, error)
+	mustEmbedUnimplementedDuplicateServiceServer()
+}
+
+// UnimplementedDuplicateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDuplicateServiceServer struct{}
+
+func (UnimplementedDuplicateServiceServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedDuplicateServiceServer) MergeContacts(context.Context, *MergeRequest) (*MergeContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeContacts not implemented")
+}
+func (UnimplementedDuplicateServiceServer) MergeLeads(context.Context, *MergeRequest) (*MergeLeadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLeads not implemented")
+}
+func (UnimplementedDuplicateServiceServer) ListMergeAudits(context.Context, *ListMergeAuditsRequest) (*ListMergeAuditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMergeAudits not implemented")
+}
+func (UnimplementedDuplicateServiceServer) mustEmbedUnimplementedDuplicateServiceServer() {}
+func (UnimplementedDuplicateServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeDuplicateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DuplicateServiceServer will
+// result in compilation errors.
+type UnsafeDuplicateServiceServer interface {
+	mustEmbedUnimplementedDuplicateServiceServer()
+}
+
+func RegisterDuplicateServiceServer(s grpc.ServiceRegistrar, srv DuplicateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDuplicateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DuplicateService_ServiceDesc, srv)
+}
+
+func _DuplicateService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuplicateServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuplicateService_FindDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuplicateServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuplicateService_MergeContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuplicateServiceServer).MergeContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuplicateService_MergeContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuplicateServiceServer).MergeContacts(ctx, req.(*MergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuplicateService_MergeLeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuplicateServiceServer).MergeLeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuplicateService_MergeLeads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuplicateServiceServer).MergeLeads(ctx, req.(*MergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuplicateService_ListMergeAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMergeAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuplicateServiceServer).ListMergeAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuplicateService_ListMergeAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuplicateServiceServer).ListMergeAudits(ctx, req.(*ListMergeAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DuplicateService_ServiceDesc is the grpc.ServiceDesc for DuplicateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DuplicateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.DuplicateService",
+	HandlerType: (*DuplicateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _DuplicateService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeContacts",
+			Handler:    _DuplicateService_MergeContacts_Handler,
+		},
+		{
+			MethodName: "MergeLeads",
+			Handler:    _DuplicateService_MergeLeads_Handler,
+		},
+		{
+			MethodName: "ListMergeAudits",
+			Handler:    _DuplicateService_ListMergeAudits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	OpportunityService_CreateOpportunity_FullMethodName        = "/crm.OpportunityService/CreateOpportunity"
+	OpportunityService_GetOpportunity_FullMethodName           = "/crm.OpportunityService/GetOpportunity"
+	OpportunityService_UpdateOpportunity_FullMethodName        = "/crm.OpportunityService/UpdateOpportunity"
+	OpportunityService_DeleteOpportunity_FullMethodName        = "/crm.OpportunityService/DeleteOpportunity"
+	OpportunityService_ListOpportunities_FullMethodName        = "/crm.OpportunityService/ListOpportunities"
+	OpportunityService_GetOpportunityHistory_FullMethodName    = "/crm.OpportunityService/GetOpportunityHistory"
+	OpportunityService_GetOpportunityMetrics_FullMethodName    = "/crm.OpportunityService/GetOpportunityMetrics"
+	OpportunityService_ListLineItems_FullMethodName            = "/crm.OpportunityService/ListLineItems"
+	OpportunityService_AddLineItem_FullMethodName              = "/crm.OpportunityService/AddLineItem"
+	OpportunityService_UpdateLineItem_FullMethodName           = "/crm.OpportunityService/UpdateLineItem"
+	OpportunityService_RemoveLineItem_FullMethodName           = "/crm.OpportunityService/RemoveLineItem"
+	OpportunityService_CloseOpportunityWon_FullMethodName      = "/crm.OpportunityService/CloseOpportunityWon"
+	OpportunityService_CloseOpportunityLost_FullMethodName     = "/crm.OpportunityService/CloseOpportunityLost"
+	OpportunityService_ListLossReasons_FullMethodName          = "/crm.OpportunityService/ListLossReasons"
+	OpportunityService_CreateLossReason_FullMethodName         = "/crm.OpportunityService/CreateLossReason"
+	OpportunityService_UpdateLossReason_FullMethodName         = "/crm.OpportunityService/UpdateLossReason"
+	OpportunityService_DeleteLossReason_FullMethodName         = "/crm.OpportunityService/DeleteLossReason"
+	OpportunityService_AddOpportunityContact_FullMethodName    = "/crm.OpportunityService/AddOpportunityContact"
+	OpportunityService_RemoveOpportunityContact_FullMethodName = "/crm.OpportunityService/RemoveOpportunityContact"
+	OpportunityService_ListOpportunityContacts_FullMethodName  = "/crm.OpportunityService/ListOpportunityContacts"
+	OpportunityService_CreatePipeline_FullMethodName           = "/crm.OpportunityService/CreatePipeline"
+	OpportunityService_GetPipeline_FullMethodName              = "/crm.OpportunityService/GetPipeline"
+	OpportunityService_UpdatePipeline_FullMethodName           = "/crm.OpportunityService/UpdatePipeline"
+	OpportunityService_DeletePipeline_FullMethodName           = "/crm.OpportunityService/DeletePipeline"
+	OpportunityService_ListPipelines_FullMethodName            = "/crm.OpportunityService/ListPipelines"
+)
+
+// OpportunityServiceClient is the client API for OpportunityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OpportunityServiceClient interface {
+	CreateOpportunity(ctx context.Context, in *CreateOpportunityRequest, opts ...grpc.CallOption) (*CreateOpportunityResponse, error)
+	GetOpportunity(ctx context.Context, in *GetOpportunityRequest, opts ...grpc.CallOption) (*GetOpportunityResponse, error)
+	UpdateOpportunity(ctx context.Context, in *UpdateOpportunityRequest, opts ...grpc.CallOption) (*UpdateOpportunityResponse, error)
+	DeleteOpportunity(ctx context.Context, in *DeleteOpportunityRequest, opts ...grpc.CallOption) (*DeleteOpportunityResponse, error)
+	ListOpportunities(ctx context.Context, in *ListOpportunitiesRequest, opts ...grpc.CallOption) (*ListOpportunitiesResponse, error)
+	GetOpportunityHistory(ctx context.Context, in *GetOpportunityHistoryRequest, opts ...grpc.CallOption) (*GetOpportunityHistoryResponse, error)
+	GetOpportunityMetrics(ctx context.Context, in *GetOpportunityMetricsRequest, opts ...grpc.CallOption) (*OpportunityMetrics, error)
+	ListLineItems(ctx context.Context, in *ListLineItemsRequest, opts ...grpc.CallOption) (*ListLineItemsResponse, error)
+	AddLineItem(ctx context.Context, in *AddLineItemRequest, opts ...grpc.CallOption) (*LineItemResponse, error)
+	UpdateLineItem(ctx context.Context, in *UpdateLineItemRequest, opts ...grpc.CallOption) (*LineItemResponse, error)
+	RemoveLineItem(ctx context.Context, in *RemoveLineItemRequest, opts ...grpc.CallOption) (*LineItemResponse, error)
+	CloseOpportunityWon(ctx context.Context, in *CloseOpportunityWonRequest, opts ...grpc.CallOption) (*CloseOpportunityResponse, error)
+	CloseOpportunityLost(ctx context.Context, in *CloseOpportunityLostRequest, opts ...grpc.CallOption) (*CloseOpportunityResponse, error)
+	ListLossReasons(ctx context.Context, in *ListLossReasonsRequest, opts ...grpc.CallOption) (*ListLossReasonsResponse, error)
+	CreateLossReason(ctx context.Context, in *CreateLossReasonRequest, opts ...grpc.CallOption) (*LossReason, error)
+	UpdateLossReason(ctx context.Context, in *UpdateLossReasonRequest, opts ...grpc.CallOption) (*LossReason, error)
+	DeleteLossReason(ctx context.Context, in *DeleteLossReasonRequest, opts ...grpc.CallOption) (*DeleteLossReasonResponse, error)
+	AddOpportunityContact(ctx context.Context, in *AddOpportunityContactRequest, opts ...grpc.CallOption) (*OpportunityContact, error)
+	RemoveOpportunityContact(ctx context.Context, in *RemoveOpportunityContactRequest, opts ...grpc.CallOption) (*RemoveOpportunityContactResponse, error)
+	ListOpportunityContacts(ctx context.Context, in *ListOpportunityContactsRequest, opts ...grpc.CallOption) (*ListOpportunityContactsResponse, error)
+	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*DeletePipelineResponse, error)
+	ListPipelines(ctx context.Context, in *ListPipelinesRequest, opts ...grpc.CallOption) (*ListPipelinesResponse, error)
+}
+
+type opportunityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpportunityServiceClient(cc grpc.ClientConnInterface) OpportunityServiceClient {
+	return &opportunityServiceClient{cc}
+}
+
+func (c *opportunityServiceClient) CreateOpportunity(ctx context.Context, in *CreateOpportunityRequest, opts ...grpc.CallOption) (*CreateOpportunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOpportunityResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_CreateOpportunity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) GetOpportunity(ctx context.Context, in *GetOpportunityRequest, opts ...grpc.CallOption) (*GetOpportunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOpportunityResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_GetOpportunity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) UpdateOpportunity(ctx context.Context, in *UpdateOpportunityRequest, opts ...grpc.CallOption) (*UpdateOpportunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOpportunityResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_UpdateOpportunity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) DeleteOpportunity(ctx context.Context, in *DeleteOpportunityRequest, opts ...grpc.CallOption) (*DeleteOpportunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOpportunityResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_DeleteOpportunity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) ListOpportunities(ctx context.Context, in *ListOpportunitiesRequest, opts ...grpc.CallOption) (*ListOpportunitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_ListOpportunities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) GetOpportunityHistory(ctx context.Context, in *GetOpportunityHistoryRequest, opts ...grpc.CallOption) (*GetOpportunityHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOpportunityHistoryResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_GetOpportunityHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) GetOpportunityMetrics(ctx context.Context, in *GetOpportunityMetricsRequest, opts ...grpc.CallOption) (*OpportunityMetrics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpportunityMetrics)
+	err := c.cc.Invoke(ctx, OpportunityService_GetOpportunityMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) ListLineItems(ctx context.Context, in *ListLineItemsRequest, opts ...grpc.CallOption) (*ListLineItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLineItemsResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_ListLineItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) AddLineItem(ctx context.Context, in *AddLineItemRequest, opts ...grpc.CallOption) (*LineItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineItemResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_AddLineItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) UpdateLineItem(ctx context.Context, in *UpdateLineItemRequest, opts ...grpc.CallOption) (*LineItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineItemResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_UpdateLineItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) RemoveLineItem(ctx context.Context, in *RemoveLineItemRequest, opts ...grpc.CallOption) (*LineItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineItemResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_RemoveLineItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) CloseOpportunityWon(ctx context.Context, in *CloseOpportunityWonRequest, opts ...grpc.CallOption) (*CloseOpportunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseOpportunityResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_CloseOpportunityWon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) CloseOpportunityLost(ctx context.Context, in *CloseOpportunityLostRequest, opts ...grpc.CallOption) (*CloseOpportunityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseOpportunityResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_CloseOpportunityLost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) ListLossReasons(ctx context.Context, in *ListLossReasonsRequest, opts ...grpc.CallOption) (*ListLossReasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLossReasonsResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_ListLossReasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) CreateLossReason(ctx context.Context, in *CreateLossReasonRequest, opts ...grpc.CallOption) (*LossReason, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LossReason)
+	err := c.cc.Invoke(ctx, OpportunityService_CreateLossReason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) UpdateLossReason(ctx context.Context, in *UpdateLossReasonRequest, opts ...grpc.CallOption) (*LossReason, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LossReason)
+	err := c.cc.Invoke(ctx, OpportunityService_UpdateLossReason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) DeleteLossReason(ctx context.Context, in *DeleteLossReasonRequest, opts ...grpc.CallOption) (*DeleteLossReasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLossReasonResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_DeleteLossReason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) AddOpportunityContact(ctx context.Context, in *AddOpportunityContactRequest, opts ...grpc.CallOption) (*OpportunityContact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpportunityContact)
+	err := c.cc.Invoke(ctx, OpportunityService_AddOpportunityContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) RemoveOpportunityContact(ctx context.Context, in *RemoveOpportunityContactRequest, opts ...grpc.CallOption) (*RemoveOpportunityContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOpportunityContactResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_RemoveOpportunityContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) ListOpportunityContacts(ctx context.Context, in *ListOpportunityContactsRequest, opts ...grpc.CallOption) (*ListOpportunityContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOpportunityContactsResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_ListOpportunityContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, OpportunityService_CreatePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, OpportunityService_GetPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, OpportunityService_UpdatePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*DeletePipelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePipelineResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_DeletePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opportunityServiceClient) ListPipelines(ctx context.Context, in *ListPipelinesRequest, opts ...grpc.CallOption) (*ListPipelinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPipelinesResponse)
+	err := c.cc.Invoke(ctx, OpportunityService_ListPipelines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpportunityServiceServer is the server API for OpportunityService service.
+// All implementations must embed UnimplementedOpportunityServiceServer
+// for forward compatibility.
+type OpportunityServiceServer interface {
+	CreateOpportunity(context.Context, *CreateOpportunityRequest) (*CreateOpportunityResponse, error)
+	GetOpportunity(context.Context, *GetOpportunityRequest) (*GetOpportunityResponse, error)
+	UpdateOpportunity(context.Context, *UpdateOpportunityRequest) (*UpdateOpportunityResponse, error)
+	DeleteOpportunity(context.Context, *DeleteOpportunityRequest) (*DeleteOpportunityResponse, error)
+	ListOpportunities(context.Context, *ListOpportunitiesRequest) (*ListOpportunitiesResponse, error)
+	GetOpportunityHistory(context.Context, *GetOpportunityHistoryRequest) (*GetOpportunityHistoryResponse, error)
+	GetOpportunityMetrics(context.Context, *GetOpportunityMetricsRequest) (*OpportunityMetrics, error)
+	ListLineItems(context.Context, *ListLineItemsRequest) (*ListLineItemsResponse, error)
+	AddLineItem(context.Context, *AddLineItemRequest) (*LineItemResponse, error)
+	UpdateLineItem(context.Context, *UpdateLineItemRequest) (*LineItemResponse, error)
+	RemoveLineItem(context.Context, *RemoveLineItemRequest) (*LineItemResponse, error)
+	CloseOpportunityWon(context.Context, *CloseOpportunityWonRequest) (*CloseOpportunityResponse, error)
+	CloseOpportunityLost(context.Context, *CloseOpportunityLostRequest) (*CloseOpportunityResponse, error)
+	ListLossReasons(context.Context, *ListLossReasonsRequest) (*ListLossReasonsResponse, error)
+	CreateLossReason(context.Context, *CreateLossReasonRequest) (*LossReason, error)
+	UpdateLossReason(context.Context, *UpdateLossReasonRequest) (*LossReason, error)
+	DeleteLossReason(context.Context, *DeleteLossReasonRequest) (*DeleteLossReasonResponse, error)
+	AddOpportunityContact(context.Context, *AddOpportunityContactRequest) (*OpportunityContact, error)
+	RemoveOpportunityContact(context.Context, *RemoveOpportunityContactRequest) (*RemoveOpportunityContactResponse, error)
+	ListOpportunityContacts(context.Context, *ListOpportunityContactsRequest) (*ListOpportunityContactsResponse, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*Pipeline, error)
+	GetPipeline(context.Context, *GetPipelineRequest) (*Pipeline, error)
+	UpdatePipeline(context.Context, *UpdatePipelineRequest) (*Pipeline, error)
+	DeletePipeline(context.Context, *DeletePipelineRequest) (*DeletePipelineResponse, error)
+	ListPipelines(context.Context, *ListPipelinesRequest) (*ListPipelinesResponse, error)
+	mustEmbedUnimplementedOpportunityServiceServer()
+}
+
+// UnimplementedOpportunityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOpportunityServiceServer struct{}
+
+func (UnimplementedOpportunityServiceServer) CreateOpportunity(context.Context, *CreateOpportunityRequest) (*CreateOpportunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOpportunity not implemented")
+}
+func (UnimplementedOpportunityServiceServer) GetOpportunity(context.Context, *GetOpportunityRequest) (*GetOpportunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpportunity not implemented")
+}
+func (UnimplementedOpportunityServiceServer) UpdateOpportunity(context.Context, *UpdateOpportunityRequest) (*UpdateOpportunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOpportunity not implemented")
+}
+func (UnimplementedOpportunityServiceServer) DeleteOpportunity(context.Context, *DeleteOpportunityRequest) (*DeleteOpportunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOpportunity not implemented")
+}
+func (UnimplementedOpportunityServiceServer) ListOpportunities(context.Context, *ListOpportunitiesRequest) (*ListOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpportunities not implemented")
+}
+func (UnimplementedOpportunityServiceServer) GetOpportunityHistory(context.Context, *GetOpportunityHistoryRequest) (*GetOpportunityHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpportunityHistory not implemented")
+}
+func (UnimplementedOpportunityServiceServer) GetOpportunityMetrics(context.Context, *GetOpportunityMetricsRequest) (*OpportunityMetrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpportunityMetrics not implemented")
+}
+func (UnimplementedOpportunityServiceServer) ListLineItems(context.Context, *ListLineItemsRequest) (*ListLineItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLineItems not implemented")
+}
+func (UnimplementedOpportunityServiceServer) AddLineItem(context.Context, *AddLineItemRequest) (*LineItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLineItem not implemented")
+}
+func (UnimplementedOpportunityServiceServer) UpdateLineItem(context.Context, *UpdateLineItemRequest) (*LineItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLineItem not implemented")
+}
+func (UnimplementedOpportunityServiceServer) RemoveLineItem(context.Context, *RemoveLineItemRequest) (*LineItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLineItem not implemented")
+}
+func (UnimplementedOpportunityServiceServer) CloseOpportunityWon(context.Context, *CloseOpportunityWonRequest) (*CloseOpportunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseOpportunityWon not implemented")
+}
+func (UnimplementedOpportunityServiceServer) CloseOpportunityLost(context.Context, *CloseOpportunityLostRequest) (*CloseOpportunityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseOpportunityLost not implemented")
+}
+func (UnimplementedOpportunityServiceServer) ListLossReasons(context.Context, *ListLossReasonsRequest) (*ListLossReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLossReasons not implemented")
+}
+func (UnimplementedOpportunityServiceServer) CreateLossReason(context.Context, *CreateLossReasonRequest) (*LossReason, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLossReason not implemented")
+}
+func (UnimplementedOpportunityServiceServer) UpdateLossReason(context.Context, *UpdateLossReasonRequest) (*LossReason, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLossReason not implemented")
+}
+func (UnimplementedOpportunityServiceServer) DeleteLossReason(context.Context, *DeleteLossReasonRequest) (*DeleteLossReasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLossReason not implemented")
+}
+func (UnimplementedOpportunityServiceServer) AddOpportunityContact(context.Context, *AddOpportunityContactRequest) (*OpportunityContact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOpportunityContact not implemented")
+}
+func (UnimplementedOpportunityServiceServer) RemoveOpportunityContact(context.Context, *RemoveOpportunityContactRequest) (*RemoveOpportunityContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOpportunityContact not implemented")
+}
+func (UnimplementedOpportunityServiceServer) ListOpportunityContacts(context.Context, *ListOpportunityContactsRequest) (*ListOpportunityContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpportunityContacts not implemented")
+}
+func (UnimplementedOpportunityServiceServer) CreatePipeline(context.Context, *CreatePipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
+}
+func (UnimplementedOpportunityServiceServer) GetPipeline(context.Context, *GetPipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipeline not implemented")
+}
+func (UnimplementedOpportunityServiceServer) UpdatePipeline(context.Context, *UpdatePipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePipeline not implemented")
+}
+func (UnimplementedOpportunityServiceServer) DeletePipeline(context.Context, *DeletePipelineRequest) (*DeletePipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePipeline not implemented")
+}
+func (UnimplementedOpportunityServiceServer) ListPipelines(context.Context, *ListPipelinesRequest) (*ListPipelinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPipelines not implemented")
+}
+func (UnimplementedOpportunityServiceServer) mustEmbedUnimplementedOpportunityServiceServer() {}
+func (UnimplementedOpportunityServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeOpportunityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpportunityServiceServer will
+// result in compilation errors.
+type UnsafeOpportunityServiceServer interface {
+	mustEmbedUnimplementedOpportunityServiceServer()
+}
+
+func RegisterOpportunityServiceServer(s grpc.ServiceRegistrar, srv OpportunityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOpportunityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OpportunityService_ServiceDesc, srv)
+}
+
+func _OpportunityService_CreateOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOpportunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).CreateOpportunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_CreateOpportunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).CreateOpportunity(ctx, req.(*CreateOpportunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_GetOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpportunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).GetOpportunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_GetOpportunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).GetOpportunity(ctx, req.(*GetOpportunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_UpdateOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOpportunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).UpdateOpportunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_UpdateOpportunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).UpdateOpportunity(ctx, req.(*UpdateOpportunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_DeleteOpportunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOpportunityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).DeleteOpportunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_DeleteOpportunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).DeleteOpportunity(ctx, req.(*DeleteOpportunityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_ListOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).ListOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_ListOpportunities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).ListOpportunities(ctx, req.(*ListOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_GetOpportunityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpportunityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).GetOpportunityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_GetOpportunityHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).GetOpportunityHistory(ctx, req.(*GetOpportunityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_GetOpportunityMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpportunityMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).GetOpportunityMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_GetOpportunityMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).GetOpportunityMetrics(ctx, req.(*GetOpportunityMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_ListLineItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLineItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).ListLineItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_ListLineItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).ListLineItems(ctx, req.(*ListLineItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_AddLineItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLineItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).AddLineItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_AddLineItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).AddLineItem(ctx, req.(*AddLineItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_UpdateLineItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLineItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).UpdateLineItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_UpdateLineItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).UpdateLineItem(ctx, req.(*UpdateLineItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_RemoveLineItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLineItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).RemoveLineItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_RemoveLineItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).RemoveLineItem(ctx, req.(*RemoveLineItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_CloseOpportunityWon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseOpportunityWonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).CloseOpportunityWon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_CloseOpportunityWon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).CloseOpportunityWon(ctx, req.(*CloseOpportunityWonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_CloseOpportunityLost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseOpportunityLostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).CloseOpportunityLost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_CloseOpportunityLost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).CloseOpportunityLost(ctx, req.(*CloseOpportunityLostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_ListLossReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLossReasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).ListLossReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_ListLossReasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).ListLossReasons(ctx, req.(*ListLossReasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_CreateLossReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLossReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).CreateLossReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_CreateLossReason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).CreateLossReason(ctx, req.(*CreateLossReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_UpdateLossReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLossReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).UpdateLossReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_UpdateLossReason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).UpdateLossReason(ctx, req.(*UpdateLossReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_DeleteLossReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLossReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).DeleteLossReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_DeleteLossReason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).DeleteLossReason(ctx, req.(*DeleteLossReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_AddOpportunityContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOpportunityContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).AddOpportunityContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_AddOpportunityContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).AddOpportunityContact(ctx, req.(*AddOpportunityContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_RemoveOpportunityContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOpportunityContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).RemoveOpportunityContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_RemoveOpportunityContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).RemoveOpportunityContact(ctx, req.(*RemoveOpportunityContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_ListOpportunityContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpportunityContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).ListOpportunityContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_ListOpportunityContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).ListOpportunityContacts(ctx, req.(*ListOpportunityContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).CreatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_CreatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_GetPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).GetPipeline(ctx, req.(*GetPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_UpdatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).UpdatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_UpdatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).UpdatePipeline(ctx, req.(*UpdatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).DeletePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_DeletePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpportunityService_ListPipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpportunityServiceServer).ListPipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpportunityService_ListPipelines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpportunityServiceServer).ListPipelines(ctx, req.(*ListPipelinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OpportunityService_ServiceDesc is the grpc.ServiceDesc for OpportunityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OpportunityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.OpportunityService",
+	HandlerType: (*OpportunityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOpportunity",
+			Handler:    _OpportunityService_CreateOpportunity_Handler,
+		},
+		{
+			MethodName: "GetOpportunity",
+			Handler:    _OpportunityService_GetOpportunity_Handler,
+		},
+		{
+			MethodName: "UpdateOpportunity",
+			Handler:    _OpportunityService_UpdateOpportunity_Handler,
+		},
+		{
+			MethodName: "DeleteOpportunity",
+			Handler:    _OpportunityService_DeleteOpportunity_Handler,
+		},
+		{
+			MethodName: "ListOpportunities",
+			Handler:    _OpportunityService_ListOpportunities_Handler,
+		},
+		{
+			MethodName: "GetOpportunityHistory",
+			Handler:    _OpportunityService_GetOpportunityHistory_Handler,
+		},
+		{
+			MethodName: "GetOpportunityMetrics",
+			Handler:    _OpportunityService_GetOpportunityMetrics_Handler,
+		},
+		{
+			MethodName: "ListLineItems",
+			Handler:    _OpportunityService_ListLineItems_Handler,
+		},
+		{
+			MethodName: "AddLineItem",
+			Handler:    _OpportunityService_AddLineItem_Handler,
+		},
+		{
+			MethodName: "UpdateLineItem",
+			Handler:    _OpportunityService_UpdateLineItem_Handler,
+		},
+		{
+			MethodName: "RemoveLineItem",
+			Handler:    _OpportunityService_RemoveLineItem_Handler,
+		},
+		{
+			MethodName: "CloseOpportunityWon",
+			Handler:    _OpportunityService_CloseOpportunityWon_Handler,
+		},
+		{
+			MethodName: "CloseOpportunityLost",
+			Handler:    _OpportunityService_CloseOpportunityLost_Handler,
+		},
+		{
+			MethodName: "ListLossReasons",
+			Handler:    _OpportunityService_ListLossReasons_Handler,
+		},
+		{
+			MethodName: "CreateLossReason",
+			Handler:    _OpportunityService_CreateLossReason_Handler,
+		},
+		{
+			MethodName: "UpdateLossReason",
+			Handler:    _OpportunityService_UpdateLossReason_Handler,
+		},
+		{
+			MethodName: "DeleteLossReason",
+			Handler:    _OpportunityService_DeleteLossReason_Handler,
+		},
+		{
+			MethodName: "AddOpportunityContact",
+			Handler:    _OpportunityService_AddOpportunityContact_Handler,
+		},
+		{
+			MethodName: "RemoveOpportunityContact",
+			Handler:    _OpportunityService_RemoveOpportunityContact_Handler,
+		},
+		{
+			MethodName: "ListOpportunityContacts",
+			Handler:    _OpportunityService_ListOpportunityContacts_Handler,
+		},
+		{
+			MethodName: "CreatePipeline",
+			Handler:    _OpportunityService_CreatePipeline_Handler,
+		},
+		{
+			MethodName: "GetPipeline",
+			Handler:    _OpportunityService_GetPipeline_Handler,
+		},
+		{
+			MethodName: "UpdatePipeline",
+			Handler:    _OpportunityService_UpdatePipeline_Handler,
+		},
+		{
+			MethodName: "DeletePipeline",
+			Handler:    _OpportunityService_DeletePipeline_Handler,
+		},
+		{
+			MethodName: "ListPipelines",
+			Handler:    _OpportunityService_ListPipelines_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	ForecastService_GetForecast_FullMethodName            = "/crm.ForecastService/GetForecast"
+	ForecastService_CreateForecastSnapshot_FullMethodName = "/crm.ForecastService/CreateForecastSnapshot"
+	ForecastService_GetForecastSnapshot_FullMethodName    = "/crm.ForecastService/GetForecastSnapshot"
+	ForecastService_ListForecastSnapshots_FullMethodName  = "/crm.ForecastService/ListForecastSnapshots"
+	ForecastService_DeleteForecastSnapshot_FullMethodName = "/crm.ForecastService/DeleteForecastSnapshot"
+)
+
+// ForecastServiceClient is the client API for ForecastService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ForecastServiceClient interface {
+	GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*Forecast, error)
+	CreateForecastSnapshot(ctx context.Context, in *CreateForecastSnapshotRequest, opts ...grpc.CallOption) (*ForecastSnapshot, error)
+	GetForecastSnapshot(ctx context.Context, in *GetForecastSnapshotRequest, opts ...grpc.CallOption) (*GetForecastSnapshotResponse, error)
+	ListForecastSnapshots(ctx context.Context, in *ListForecastSnapshotsRequest, opts ...grpc.CallOption) (*ListForecastSnapshotsResponse, error)
+	DeleteForecastSnapshot(ctx context.Context, in *DeleteForecastSnapshotRequest, opts ...grpc.CallOption) (*DeleteForecastSnapshotResponse, error)
+}
+
+type forecastServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewForecastServiceClient(cc grpc.ClientConnInterface) ForecastServiceClient {
+	return &forecastServiceClient{cc}
+}
+
+func (c *forecastServiceClient) GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*Forecast, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Forecast)
+	err := c.cc.Invoke(ctx, ForecastService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forecastServiceClient) CreateForecastSnapshot(ctx context.Context, in *CreateForecastSnapshotRequest, opts ...grpc.CallOption) (*ForecastSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastSnapshot)
+	err := c.cc.Invoke(ctx, ForecastService_CreateForecastSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forecastServiceClient) GetForecastSnapshot(ctx context.Context, in *GetForecastSnapshotRequest, opts ...grpc.CallOption) (*GetForecastSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForecastSnapshotResponse)
+	err := c.cc.Invoke(ctx, ForecastService_GetForecastSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forecastServiceClient) ListForecastSnapshots(ctx context.Context, in *ListForecastSnapshotsRequest, opts ...grpc.CallOption) (*ListForecastSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListForecastSnapshotsResponse)
+	err := c.cc.Invoke(ctx, ForecastService_ListForecastSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forecastServiceClient) DeleteForecastSnapshot(ctx context.Context, in *DeleteForecastSnapshotRequest, opts ...grpc.CallOption) (*DeleteForecastSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteForecastSnapshotResponse)
+	err := c.cc.Invoke(ctx, ForecastService_DeleteForecastSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ForecastServiceServer is the server API for ForecastService service.
+// All implementations must embed UnimplementedForecastServiceServer
+// for forward compatibility.
+type ForecastServiceServer interface {
+	GetForecast(context.Context, *ForecastRequest) (*Forecast, error)
+	CreateForecastSnapshot(context.Context, *CreateForecastSnapshotRequest) (*ForecastSnapshot, error)
+	GetForecastSnapshot(context.Context, *GetForecastSnapshotRequest) (*GetForecastSnapshotResponse, error)
+	ListForecastSnapshots(context.Context, *ListForecastSnapshotsRequest) (*ListForecastSnapshotsResponse, error)
+	DeleteForecastSnapshot(context.Context, *DeleteForecastSnapshotRequest) (*DeleteForecastSnapshotResponse, error)
+	mustEmbedUnimplementedForecastServiceServer()
+}
+
+// UnimplementedForecastServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedForecastServiceServer struct{}
+
+func (UnimplementedForecastServiceServer) GetForecast(context.Context, *ForecastRequest) (*Forecast, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedForecastServiceServer) CreateForecastSnapshot(context.Context, *CreateForecastSnapshotRequest) (*ForecastSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForecastSnapshot not implemented")
+}
+func (UnimplementedForecastServiceServer) GetForecastSnapshot(context.Context, *GetForecastSnapshotRequest) (*GetForecastSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecastSnapshot not implemented")
+}
+func (UnimplementedForecastServiceServer) ListForecastSnapshots(context.Context, *ListForecastSnapshotsRequest) (*ListForecastSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForecastSnapshots not implemented")
+}
+func (UnimplementedForecastServiceServer) DeleteForecastSnapshot(context.Context, *DeleteForecastSnapshotRequest) (*DeleteForecastSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteForecastSnapshot not implemented")
+}
+func (UnimplementedForecastServiceServer) mustEmbedUnimplementedForecastServiceServer() {}
+func (UnimplementedForecastServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeForecastServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ForecastServiceServer will
+// result in compilation errors.
+type UnsafeForecastServiceServer interface {
+	mustEmbedUnimplementedForecastServiceServer()
+}
+
+func RegisterForecastServiceServer(s grpc.ServiceRegistrar, srv ForecastServiceServer) {
+	// If the following call pancis, it indicates UnimplementedForecastServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ForecastService_ServiceDesc, srv)
+}
+
+func _ForecastService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForecastServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForecastService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForecastServiceServer).GetForecast(ctx, req.(*ForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForecastService_CreateForecastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateForecastSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForecastServiceServer).CreateForecastSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForecastService_CreateForecastSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForecastServiceServer).CreateForecastSnapshot(ctx, req.(*CreateForecastSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForecastService_GetForecastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForecastSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForecastServiceServer).GetForecastSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForecastService_GetForecastSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForecastServiceServer).GetForecastSnapshot(ctx, req.(*GetForecastSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForecastService_ListForecastSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForecastSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForecastServiceServer).ListForecastSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForecastService_ListForecastSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForecastServiceServer).ListForecastSnapshots(ctx, req.(*ListForecastSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForecastService_DeleteForecastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteForecastSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForecastServiceServer).DeleteForecastSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForecastService_DeleteForecastSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForecastServiceServer).DeleteForecastSnapshot(ctx, req.(*DeleteForecastSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ForecastService_ServiceDesc is the grpc.ServiceDesc for ForecastService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ForecastService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.ForecastService",
+	HandlerType: (*ForecastServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetForecast",
+			Handler:    _ForecastService_GetForecast_Handler,
+		},
+		{
+			MethodName: "CreateForecastSnapshot",
+			Handler:    _ForecastService_CreateForecastSnapshot_Handler,
+		},
+		{
+			MethodName: "GetForecastSnapshot",
+			Handler:    _ForecastService_GetForecastSnapshot_Handler,
+		},
+		{
+			MethodName: "ListForecastSnapshots",
+			Handler:    _ForecastService_ListForecastSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteForecastSnapshot",
+			Handler:    _ForecastService_DeleteForecastSnapshot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	CurrencyService_SetExchangeRates_FullMethodName     = "/crm.CurrencyService/SetExchangeRates"
+	CurrencyService_ImportExchangeRates_FullMethodName  = "/crm.CurrencyService/ImportExchangeRates"
+	CurrencyService_ListExchangeRates_FullMethodName    = "/crm.CurrencyService/ListExchangeRates"
+	CurrencyService_DeleteExchangeRate_FullMethodName   = "/crm.CurrencyService/DeleteExchangeRate"
+	CurrencyService_GetReportingCurrency_FullMethodName = "/crm.CurrencyService/GetReportingCurrency"
+	CurrencyService_SetReportingCurrency_FullMethodName = "/crm.CurrencyService/SetReportingCurrency"
+	CurrencyService_ConvertAmount_FullMethodName        = "/crm.CurrencyService/ConvertAmount"
+)
+
+// CurrencyServiceClient is the client API for CurrencyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CurrencyServiceClient interface {
+	SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+	GetReportingCurrency(ctx context.Context, in *GetReportingCurrencyRequest, opts ...grpc.CallOption) (*ReportingCurrency, error)
+	SetReportingCurrency(ctx context.Context, in *ReportingCurrency, opts ...grpc.CallOption) (*ReportingCurrency, error)
+	ConvertAmount(ctx context.Context, in *ConvertAmountRequest, opts ...grpc.CallOption) (*ConvertAmountResponse, error)
+}
+
+type currencyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCurrencyServiceClient(cc grpc.ClientConnInterface) CurrencyServiceClient {
+	return &currencyServiceClient{cc}
+}
+
+func (c *currencyServiceClient) SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_SetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_DeleteExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) GetReportingCurrency(ctx context.Context, in *GetReportingCurrencyRequest, opts ...grpc.CallOption) (*ReportingCurrency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportingCurrency)
+	err := c.cc.Invoke(ctx, CurrencyService_GetReportingCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) SetReportingCurrency(ctx context.Context, in *ReportingCurrency, opts ...grpc.CallOption) (*ReportingCurrency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportingCurrency)
+	err := c.cc.Invoke(ctx, CurrencyService_SetReportingCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) ConvertAmount(ctx context.Context, in *ConvertAmountRequest, opts ...grpc.CallOption) (*ConvertAmountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertAmountResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_ConvertAmount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CurrencyServiceServer is the server API for CurrencyService service.
+// All implementations must embed UnimplementedCurrencyServiceServer
+// for forward compatibility.
+type CurrencyServiceServer interface {
+	SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	GetReportingCurrency(context.Context, *GetReportingCurrencyRequest) (*ReportingCurrency, error)
+	SetReportingCurrency(context.Context, *ReportingCurrency) (*ReportingCurrency, error)
+	ConvertAmount(context.Context, *ConvertAmountRequest) (*ConvertAmountResponse, error)
+	mustEmbedUnimplementedCurrencyServiceServer()
+}
+
+// UnimplementedCurrencyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCurrencyServiceServer struct{}
+
+func (UnimplementedCurrencyServiceServer) SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedCurrencyServiceServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedCurrencyServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedCurrencyServiceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedCurrencyServiceServer) GetReportingCurrency(context.Context, *GetReportingCurrencyRequest) (*ReportingCurrency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportingCurrency not implemented")
+}
+func (UnimplementedCurrencyServiceServer) SetReportingCurrency(context.Context, *ReportingCurrency) (*ReportingCurrency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReportingCurrency not implemented")
+}
+func (UnimplementedCurrencyServiceServer) ConvertAmount(context.Context, *ConvertAmountRequest) (*ConvertAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertAmount not implemented")
+}
+func (UnimplementedCurrencyServiceServer) mustEmbedUnimplementedCurrencyServiceServer() {}
+func (UnimplementedCurrencyServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCurrencyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CurrencyServiceServer will
+// result in compilation errors.
+type UnsafeCurrencyServiceServer interface {
+	mustEmbedUnimplementedCurrencyServiceServer()
+}
+
+func RegisterCurrencyServiceServer(s grpc.ServiceRegistrar, srv CurrencyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCurrencyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CurrencyService_ServiceDesc, srv)
+}
+
+func _CurrencyService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_SetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).SetExchangeRates(ctx, req.(*SetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_DeleteExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_GetReportingCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportingCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).GetReportingCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_GetReportingCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).GetReportingCurrency(ctx, req.(*GetReportingCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_SetReportingCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportingCurrency)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).SetReportingCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_SetReportingCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).SetReportingCurrency(ctx, req.(*ReportingCurrency))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_ConvertAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).ConvertAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_ConvertAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).ConvertAmount(ctx, req.(*ConvertAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CurrencyService_ServiceDesc is the grpc.ServiceDesc for CurrencyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CurrencyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.CurrencyService",
+	HandlerType: (*CurrencyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _CurrencyService_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _CurrencyService_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _CurrencyService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _CurrencyService_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetReportingCurrency",
+			Handler:    _CurrencyService_GetReportingCurrency_Handler,
+		},
+		{
+			MethodName: "SetReportingCurrency",
+			Handler:    _CurrencyService_SetReportingCurrency_Handler,
+		},
+		{
+			MethodName: "ConvertAmount",
+			Handler:    _CurrencyService_ConvertAmount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	ProductService_CreateProduct_FullMethodName        = "/crm.ProductService/CreateProduct"
+	ProductService_GetProduct_FullMethodName           = "/crm.ProductService/GetProduct"
+	ProductService_UpdateProduct_FullMethodName        = "/crm.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName        = "/crm.ProductService/DeleteProduct"
+	ProductService_ListProducts_FullMethodName         = "/crm.ProductService/ListProducts"
+	ProductService_CreatePriceBook_FullMethodName      = "/crm.ProductService/CreatePriceBook"
+	ProductService_GetPriceBook_FullMethodName         = "/crm.ProductService/GetPriceBook"
+	ProductService_UpdatePriceBook_FullMethodName      = "/crm.ProductService/UpdatePriceBook"
+	ProductService_DeletePriceBook_FullMethodName      = "/crm.ProductService/DeletePriceBook"
+	ProductService_ListPriceBooks_FullMethodName       = "/crm.ProductService/ListPriceBooks"
+	ProductService_SetPriceBookEntry_FullMethodName    = "/crm.ProductService/SetPriceBookEntry"
+	ProductService_RemovePriceBookEntry_FullMethodName = "/crm.ProductService/RemovePriceBookEntry"
+	ProductService_ListPriceBookEntries_FullMethodName = "/crm.ProductService/ListPriceBookEntries"
+)
+
+// ProductServiceClient is the client API for ProductService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProductServiceClient interface {
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*Product, error)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	CreatePriceBook(ctx context.Context, in *CreatePriceBookRequest, opts ...grpc.CallOption) (*PriceBook, error)
+	GetPriceBook(ctx context.Context, in *GetPriceBookRequest, opts ...grpc.CallOption) (*PriceBook, error)
+	UpdatePriceBook(ctx context.Context, in *UpdatePriceBookRequest, opts ...grpc.CallOption) (*PriceBook, error)
+	DeletePriceBook(ctx context.Context, in *DeletePriceBookRequest, opts ...grpc.CallOption) (*DeletePriceBookResponse, error)
+	ListPriceBooks(ctx context.Context, in *ListPriceBooksRequest, opts ...grpc.CallOption) (*ListPriceBooksResponse, error)
+	SetPriceBookEntry(ctx context.Context, in *PriceBookEntry, opts ...grpc.CallOption) (*PriceBookEntry, error)
+	RemovePriceBookEntry(ctx context.Context, in *RemovePriceBookEntryRequest, opts ...grpc.CallOption) (*RemovePriceBookEntryResponse, error)
+	ListPriceBookEntries(ctx context.Context, in *ListPriceBookEntriesRequest, opts ...grpc.CallOption) (*ListPriceBookEntriesResponse, error)
+}
+
+type productServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductServiceClient(cc grpc.ClientConnInterface) ProductServiceClient {
+	return &productServiceClient{cc}
+}
+
+func (c *productServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductService_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductService_GetProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreatePriceBook(ctx context.Context, in *CreatePriceBookRequest, opts ...grpc.CallOption) (*PriceBook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceBook)
+	err := c.cc.Invoke(ctx, ProductService_CreatePriceBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceBook(ctx context.Context, in *GetPriceBookRequest, opts ...grpc.CallOption) (*PriceBook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceBook)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdatePriceBook(ctx context.Context, in *UpdatePriceBookRequest, opts ...grpc.CallOption) (*PriceBook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceBook)
+	err := c.cc.Invoke(ctx, ProductService_UpdatePriceBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeletePriceBook(ctx context.Context, in *DeletePriceBookRequest, opts ...grpc.CallOption) (*DeletePriceBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePriceBookResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeletePriceBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListPriceBooks(ctx context.Context, in *ListPriceBooksRequest, opts ...grpc.CallOption) (*ListPriceBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceBooksResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListPriceBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceBookEntry(ctx context.Context, in *PriceBookEntry, opts ...grpc.CallOption) (*PriceBookEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceBookEntry)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceBookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemovePriceBookEntry(ctx context.Context, in *RemovePriceBookEntryRequest, opts ...grpc.CallOption) (*RemovePriceBookEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePriceBookEntryResponse)
+	err := c.cc.Invoke(ctx, ProductService_RemovePriceBookEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListPriceBookEntries(ctx context.Context, in *ListPriceBookEntriesRequest, opts ...grpc.CallOption) (*ListPriceBookEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceBookEntriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListPriceBookEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+type ProductServiceServer interface {
+	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
+	GetProduct(context.Context, *GetProductRequest) (*Product, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	CreatePriceBook(context.Context, *CreatePriceBookRequest) (*PriceBook, error)
+	GetPriceBook(context.Context, *GetPriceBookRequest) (*PriceBook, error)
+	UpdatePriceBook(context.Context, *UpdatePriceBookRequest) (*PriceBook, error)
+	DeletePriceBook(context.Context, *DeletePriceBookRequest) (*DeletePriceBookResponse, error)
+	ListPriceBooks(context.Context, *ListPriceBooksRequest) (*ListPriceBooksResponse, error)
+	SetPriceBookEntry(context.Context, *PriceBookEntry) (*PriceBookEntry, error)
+	RemovePriceBookEntry(context.Context, *RemovePriceBookEntryRequest) (*RemovePriceBookEntryResponse, error)
+	ListPriceBookEntries(context.Context, *ListPriceBookEntriesRequest) (*ListPriceBookEntriesResponse, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CreatePriceBook(context.Context, *CreatePriceBookRequest) (*PriceBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceBook not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceBook(context.Context, *GetPriceBookRequest) (*PriceBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceBook not implemented")
+}
+func (UnimplementedProductServiceServer) UpdatePriceBook(context.Context, *UpdatePriceBookRequest) (*PriceBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceBook not implemented")
+}
+func (UnimplementedProductServiceServer) DeletePriceBook(context.Context, *DeletePriceBookRequest) (*DeletePriceBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceBook not implemented")
+}
+func (UnimplementedProductServiceServer) ListPriceBooks(context.Context, *ListPriceBooksRequest) (*ListPriceBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceBooks not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceBookEntry(context.Context, *PriceBookEntry) (*PriceBookEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceBookEntry not implemented")
+}
+func (UnimplementedProductServiceServer) RemovePriceBookEntry(context.Context, *RemovePriceBookEntryRequest) (*RemovePriceBookEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceBookEntry not implemented")
+}
+func (UnimplementedProductServiceServer) ListPriceBookEntries(context.Context, *ListPriceBookEntriesRequest) (*ListPriceBookEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceBookEntries not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreatePriceBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreatePriceBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreatePriceBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreatePriceBook(ctx, req.(*CreatePriceBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceBook(ctx, req.(*GetPriceBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdatePriceBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdatePriceBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdatePriceBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdatePriceBook(ctx, req.(*UpdatePriceBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeletePriceBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeletePriceBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeletePriceBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeletePriceBook(ctx, req.(*DeletePriceBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListPriceBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListPriceBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListPriceBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListPriceBooks(ctx, req.(*ListPriceBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceBookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceBookEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceBookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceBookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceBookEntry(ctx, req.(*PriceBookEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemovePriceBookEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePriceBookEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemovePriceBookEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemovePriceBookEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemovePriceBookEntry(ctx, req.(*RemovePriceBookEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListPriceBookEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceBookEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListPriceBookEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListPriceBookEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListPriceBookEntries(ctx, req.(*ListPriceBookEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _ProductService_GetProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _ProductService_ListProducts_Handler,
+		},
+		{
+			MethodName: "CreatePriceBook",
+			Handler:    _ProductService_CreatePriceBook_Handler,
+		},
+		{
+			MethodName: "GetPriceBook",
+			Handler:    _ProductService_GetPriceBook_Handler,
+		},
+		{
+			MethodName: "UpdatePriceBook",
+			Handler:    _ProductService_UpdatePriceBook_Handler,
+		},
+		{
+			MethodName: "DeletePriceBook",
+			Handler:    _ProductService_DeletePriceBook_Handler,
+		},
+		{
+			MethodName: "ListPriceBooks",
+			Handler:    _ProductService_ListPriceBooks_Handler,
+		},
+		{
+			MethodName: "SetPriceBookEntry",
+			Handler:    _ProductService_SetPriceBookEntry_Handler,
+		},
+		{
+			MethodName: "RemovePriceBookEntry",
+			Handler:    _ProductService_RemovePriceBookEntry_Handler,
+		},
+		{
+			MethodName: "ListPriceBookEntries",
+			Handler:    _ProductService_ListPriceBookEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	SearchService_Search_FullMethodName = "/crm.SearchService/Search"
+)
+
+// SearchServiceClient is the client API for SearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchServiceClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type searchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
+	return &searchServiceClient{cc}
+}
+
+func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, SearchService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServiceServer is the server API for SearchService service.
+// All implementations must embed UnimplementedSearchServiceServer
+// for forward compatibility.
+type SearchServiceServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+// UnimplementedSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSearchServiceServer struct{}
+
+func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
+func (UnimplementedSearchServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServiceServer will
+// result in compilation errors.
+type UnsafeSearchServiceServer interface {
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+func RegisterSearchServiceServer(s grpc.ServiceRegistrar, srv SearchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SearchService_ServiceDesc, srv)
+}
+
+func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.SearchService",
+	HandlerType: (*SearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchService_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	MeetingService_ScheduleMeeting_FullMethodName = "/crm.MeetingService/ScheduleMeeting"
+	MeetingService_GetMeeting_FullMethodName      = "/crm.MeetingService/GetMeeting"
+	MeetingService_UpdateMeeting_FullMethodName   = "/crm.MeetingService/UpdateMeeting"
+	MeetingService_DeleteMeeting_FullMethodName   = "/crm.MeetingService/DeleteMeeting"
+	MeetingService_ListMeetings_FullMethodName    = "/crm.MeetingService/ListMeetings"
+)
+
+// MeetingServiceClient is the client API for MeetingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MeetingServiceClient interface {
+	ScheduleMeeting(ctx context.Context, in *ScheduleMeetingRequest, opts ...grpc.CallOption) (*MeetingResponse, error)
+	GetMeeting(ctx context.Context, in *GetMeetingRequest, opts ...grpc.CallOption) (*GetMeetingResponse, error)
+	UpdateMeeting(ctx context.Context, in *UpdateMeetingRequest, opts ...grpc.CallOption) (*UpdateMeetingResponse, error)
+	DeleteMeeting(ctx context.Context, in *DeleteMeetingRequest, opts ...grpc.CallOption) (*DeleteMeetingResponse, error)
+	ListMeetings(ctx context.Context, in *ListMeetingsRequest, opts ...grpc.CallOption) (*ListMeetingsResponse, error)
+}
+
+type meetingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMeetingServiceClient(cc grpc.ClientConnInterface) MeetingServiceClient {
+	return &meetingServiceClient{cc}
+}
+
+func (c *meetingServiceClient) ScheduleMeeting(ctx context.Context, in *ScheduleMeetingRequest, opts ...grpc.CallOption) (*MeetingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeetingResponse)
+	err := c.cc.Invoke(ctx, MeetingService_ScheduleMeeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingServiceClient) GetMeeting(ctx context.Context, in *GetMeetingRequest, opts ...grpc.CallOption) (*GetMeetingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMeetingResponse)
+	err := c.cc.Invoke(ctx, MeetingService_GetMeeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingServiceClient) UpdateMeeting(ctx context.Context, in *UpdateMeetingRequest, opts ...grpc.CallOption) (*UpdateMeetingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMeetingResponse)
+	err := c.cc.Invoke(ctx, MeetingService_UpdateMeeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingServiceClient) DeleteMeeting(ctx context.Context, in *DeleteMeetingRequest, opts ...grpc.CallOption) (*DeleteMeetingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMeetingResponse)
+	err := c.cc.Invoke(ctx, MeetingService_DeleteMeeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meetingServiceClient) ListMeetings(ctx context.Context, in *ListMeetingsRequest, opts ...grpc.CallOption) (*ListMeetingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMeetingsResponse)
+	err := c.cc.Invoke(ctx, MeetingService_ListMeetings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MeetingServiceServer is the server API for MeetingService service.
+// All implementations must embed UnimplementedMeetingServiceServer
+// for forward compatibility.
+type MeetingServiceServer interface {
+	ScheduleMeeting(context.Context, *ScheduleMeetingRequest) (*MeetingResponse, error)
+	GetMeeting(context.Context, *GetMeetingRequest) (*GetMeetingResponse, error)
+	UpdateMeeting(context.Context, *UpdateMeetingRequest) (*UpdateMeetingResponse, error)
+	DeleteMeeting(context.Context, *DeleteMeetingRequest) (*DeleteMeetingResponse, error)
+	ListMeetings(context.Context, *ListMeetingsRequest) (*ListMeetingsResponse, error)
+	mustEmbedUnimplementedMeetingServiceServer()
+}
+
+// UnimplementedMeetingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMeetingServiceServer struct{}
+
+func (UnimplementedMeetingServiceServer) ScheduleMeeting(context.Context, *ScheduleMeetingRequest) (*MeetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMeeting not implemented")
+}
+func (UnimplementedMeetingServiceServer) GetMeeting(context.Context, *GetMeetingRequest) (*GetMeetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeeting not implemented")
+}
+func (UnimplementedMeetingServiceServer) UpdateMeeting(context.Context, *UpdateMeetingRequest) (*UpdateMeetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeeting not implemented")
+}
+func (UnimplementedMeetingServiceServer) DeleteMeeting(context.Context, *DeleteMeetingRequest) (*DeleteMeetingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeeting not implemented")
+}
+func (UnimplementedMeetingServiceServer) ListMeetings(context.Context, *ListMeetingsRequest) (*ListMeetingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMeetings not implemented")
+}
+func (UnimplementedMeetingServiceServer) mustEmbedUnimplementedMeetingServiceServer() {}
+func (UnimplementedMeetingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeMeetingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MeetingServiceServer will
+// result in compilation errors.
+type UnsafeMeetingServiceServer interface {
+	mustEmbedUnimplementedMeetingServiceServer()
+}
+
+func RegisterMeetingServiceServer(s grpc.ServiceRegistrar, srv MeetingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMeetingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MeetingService_ServiceDesc, srv)
+}
+
+func _MeetingService_ScheduleMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingServiceServer).ScheduleMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingService_ScheduleMeeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingServiceServer).ScheduleMeeting(ctx, req.(*ScheduleMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingService_GetMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingServiceServer).GetMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingService_GetMeeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingServiceServer).GetMeeting(ctx, req.(*GetMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingService_UpdateMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingServiceServer).UpdateMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingService_UpdateMeeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingServiceServer).UpdateMeeting(ctx, req.(*UpdateMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingService_DeleteMeeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMeetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingServiceServer).DeleteMeeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingService_DeleteMeeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingServiceServer).DeleteMeeting(ctx, req.(*DeleteMeetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeetingService_ListMeetings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMeetingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeetingServiceServer).ListMeetings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeetingService_ListMeetings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeetingServiceServer).ListMeetings(ctx, req.(*ListMeetingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MeetingService_ServiceDesc is the grpc.ServiceDesc for MeetingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MeetingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.MeetingService",
+	HandlerType: (*MeetingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleMeeting",
+			Handler:    _MeetingService_ScheduleMeeting_Handler,
+		},
+		{
+			MethodName: "GetMeeting",
+			Handler:    _MeetingService_GetMeeting_Handler,
+		},
+		{
+			MethodName: "UpdateMeeting",
+			Handler:    _MeetingService_UpdateMeeting_Handler,
+		},
+		{
+			MethodName: "DeleteMeeting",
+			Handler:    _MeetingService_DeleteMeeting_Handler,
+		},
+		{
+			MethodName: "ListMeetings",
+			Handler:    _MeetingService_ListMeetings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	ProposalService_CreateProposal_FullMethodName       = "/crm.ProposalService/CreateProposal"
+	ProposalService_GetProposal_FullMethodName          = "/crm.ProposalService/GetProposal"
+	ProposalService_UpdateProposal_FullMethodName       = "/crm.ProposalService/UpdateProposal"
+	ProposalService_DeleteProposal_FullMethodName       = "/crm.ProposalService/DeleteProposal"
+	ProposalService_ListProposals_FullMethodName        = "/crm.ProposalService/ListProposals"
+	ProposalService_UpdateProposalStatus_FullMethodName = "/crm.ProposalService/UpdateProposalStatus"
+)
+
+// ProposalServiceClient is the client API for ProposalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProposalServiceClient interface {
+	CreateProposal(ctx context.Context, in *CreateProposalRequest, opts ...grpc.CallOption) (*CreateProposalResponse, error)
+	GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error)
+	UpdateProposal(ctx context.Context, in *UpdateProposalRequest, opts ...grpc.CallOption) (*UpdateProposalResponse, error)
+	DeleteProposal(ctx context.Context, in *DeleteProposalRequest, opts ...grpc.CallOption) (*DeleteProposalResponse, error)
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+	UpdateProposalStatus(ctx context.Context, in *UpdateProposalStatusRequest, opts ...grpc.CallOption) (*UpdateProposalStatusResponse, error)
+}
+
+type proposalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProposalServiceClient(cc grpc.ClientConnInterface) ProposalServiceClient {
+	return &proposalServiceClient{cc}
+}
+
+func (c *proposalServiceClient) CreateProposal(ctx context.Context, in *CreateProposalRequest, opts ...grpc.CallOption) (*CreateProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProposalResponse)
+	err := c.cc.Invoke(ctx, ProposalService_CreateProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalServiceClient) GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProposalResponse)
+	err := c.cc.Invoke(ctx, ProposalService_GetProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalServiceClient) UpdateProposal(ctx context.Context, in *UpdateProposalRequest, opts ...grpc.CallOption) (*UpdateProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProposalResponse)
+	err := c.cc.Invoke(ctx, ProposalService_UpdateProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalServiceClient) DeleteProposal(ctx context.Context, in *DeleteProposalRequest, opts ...grpc.CallOption) (*DeleteProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProposalResponse)
+	err := c.cc.Invoke(ctx, ProposalService_DeleteProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalServiceClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, ProposalService_ListProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalServiceClient) UpdateProposalStatus(ctx context.Context, in *UpdateProposalStatusRequest, opts ...grpc.CallOption) (*UpdateProposalStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProposalStatusResponse)
+	err := c.cc.Invoke(ctx, ProposalService_UpdateProposalStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProposalServiceServer is the server API for ProposalService service.
+// All implementations must embed UnimplementedProposalServiceServer
+// for forward compatibility.
+type ProposalServiceServer interface {
+	CreateProposal(context.Context, *CreateProposalRequest) (*CreateProposalResponse, error)
+	GetProposal(context.Context, *GetProposalRequest) (*GetProposalResponse, error)
+	UpdateProposal(context.Context, *UpdateProposalRequest) (*UpdateProposalResponse, error)
+	DeleteProposal(context.Context, *DeleteProposalRequest) (*DeleteProposalResponse, error)
+	ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error)
+	UpdateProposalStatus(context.Context, *UpdateProposalStatusRequest) (*UpdateProposalStatusResponse, error)
+	mustEmbedUnimplementedProposalServiceServer()
+}
+
+// UnimplementedProposalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProposalServiceServer struct{}
+
+func (UnimplementedProposalServiceServer) CreateProposal(context.Context, *CreateProposalRequest) (*CreateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProposal not implemented")
+}
+func (UnimplementedProposalServiceServer) GetProposal(context.Context, *GetProposalRequest) (*GetProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
+}
+func (UnimplementedProposalServiceServer) UpdateProposal(context.Context, *UpdateProposalRequest) (*UpdateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposal not implemented")
+}
+func (UnimplementedProposalServiceServer) DeleteProposal(context.Context, *DeleteProposalRequest) (*DeleteProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProposal not implemented")
+}
+func (UnimplementedProposalServiceServer) ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedProposalServiceServer) UpdateProposalStatus(context.Context, *UpdateProposalStatusRequest) (*UpdateProposalStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposalStatus not implemented")
+}
+func (UnimplementedProposalServiceServer) mustEmbedUnimplementedProposalServiceServer() {}
+func (UnimplementedProposalServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeProposalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProposalServiceServer will
+// result in compilation errors.
+type UnsafeProposalServiceServer interface {
+	mustEmbedUnimplementedProposalServiceServer()
+}
+
+func RegisterProposalServiceServer(s grpc.ServiceRegistrar, srv ProposalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProposalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProposalService_ServiceDesc, srv)
+}
+
+func _ProposalService_CreateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).CreateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProposalService_CreateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).CreateProposal(ctx, req.(*CreateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProposalService_GetProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).GetProposal(ctx, req.(*GetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalService_UpdateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).UpdateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProposalService_UpdateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).UpdateProposal(ctx, req.(*UpdateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalService_DeleteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).DeleteProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProposalService_DeleteProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).DeleteProposal(ctx, req.(*DeleteProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProposalService_ListProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalService_UpdateProposalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProposalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).UpdateProposalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProposalService_UpdateProposalStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).UpdateProposalStatus(ctx, req.(*UpdateProposalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProposalService_ServiceDesc is the grpc.ServiceDesc for ProposalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProposalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.ProposalService",
+	HandlerType: (*ProposalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProposal",
+			Handler:    _ProposalService_CreateProposal_Handler,
+		},
+		{
+			MethodName: "GetProposal",
+			Handler:    _ProposalService_GetProposal_Handler,
+		},
+		{
+			MethodName: "UpdateProposal",
+			Handler:    _ProposalService_UpdateProposal_Handler,
+		},
+		{
+			MethodName: "DeleteProposal",
+			Handler:    _ProposalService_DeleteProposal_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _ProposalService_ListProposals_Handler,
+		},
+		{
+			MethodName: "UpdateProposalStatus",
+			Handler:    _ProposalService_UpdateProposalStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	NotificationService_SendNotification_FullMethodName         = "/crm.NotificationService/SendNotification"
+	NotificationService_SendNotificationWithSMTP_FullMethodName = "/crm.NotificationService/SendNotificationWithSMTP"
+	NotificationService_SendNotificationWithSMS_FullMethodName  = "/crm.NotificationService/SendNotificationWithSMS"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	SendNotificationWithSMTP(ctx context.Context, in *SendNotificationWithSMTPRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	SendNotificationWithSMS(ctx context.Context, in *SendNotificationWithSMSRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendNotificationWithSMTP(ctx context.Context, in *SendNotificationWithSMTPRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendNotificationWithSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendNotificationWithSMS(ctx context.Context, in *SendNotificationWithSMSRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendNotificationWithSMS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+type NotificationServiceServer interface {
+	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	SendNotificationWithSMTP(context.Context, *SendNotificationWithSMTPRequest) (*SendNotificationResponse, error)
+	SendNotificationWithSMS(context.Context, *SendNotificationWithSMSRequest) (*SendNotificationResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendNotificationWithSMTP(context.Context, *SendNotificationWithSMTPRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotificationWithSMTP not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendNotificationWithSMS(context.Context, *SendNotificationWithSMSRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotificationWithSMS not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendNotification(ctx, req.(*SendNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendNotificationWithSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationWithSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendNotificationWithSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendNotificationWithSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendNotificationWithSMTP(ctx, req.(*SendNotificationWithSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendNotificationWithSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationWithSMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendNotificationWithSMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendNotificationWithSMS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendNotificationWithSMS(ctx, req.(*SendNotificationWithSMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendNotification",
+			Handler:    _NotificationService_SendNotification_Handler,
+		},
+		{
+			MethodName: "SendNotificationWithSMTP",
+			Handler:    _NotificationService_SendNotificationWithSMTP_Handler,
+		},
+		{
+			MethodName: "SendNotificationWithSMS",
+			Handler:    _NotificationService_SendNotificationWithSMS_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	HealthService_Check_FullMethodName = "/crm.HealthService/Check"
+)
+
+// HealthServiceClient is the client API for HealthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HealthServiceClient interface {
+	Check(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type healthServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHealthServiceClient(cc grpc.ClientConnInterface) HealthServiceClient {
+	return &healthServiceClient{cc}
+}
+
+func (c *healthServiceClient) Check(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, HealthService_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthServiceServer is the server API for HealthService service.
+// All implementations must embed UnimplementedHealthServiceServer
+// for forward compatibility.
+type HealthServiceServer interface {
+	Check(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	mustEmbedUnimplementedHealthServiceServer()
+}
+
+// UnimplementedHealthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHealthServiceServer struct{}
+
+func (UnimplementedHealthServiceServer) Check(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
+func (UnimplementedHealthServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeHealthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthServiceServer will
+// result in compilation errors.
+type UnsafeHealthServiceServer interface {
+	mustEmbedUnimplementedHealthServiceServer()
+}
+
+func RegisterHealthServiceServer(s grpc.ServiceRegistrar, srv HealthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHealthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HealthService_ServiceDesc, srv)
+}
+
+func _HealthService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).Check(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HealthService_ServiceDesc is the grpc.ServiceDesc for HealthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HealthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.HealthService",
+	HandlerType: (*HealthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Check",
+			Handler:    _HealthService_Check_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	SMTPService_CreateSMTP_FullMethodName     = "/crm.SMTPService/CreateSMTP"
+	SMTPService_GetSMTP_FullMethodName        = "/crm.SMTPService/GetSMTP"
+	SMTPService_UpdateSMTP_FullMethodName     = "/crm.SMTPService/UpdateSMTP"
+	SMTPService_DeleteSMTP_FullMethodName     = "/crm.SMTPService/DeleteSMTP"
+	SMTPService_ListSMTP_FullMethodName       = "/crm.SMTPService/ListSMTP"
+	SMTPService_TestSMTP_FullMethodName       = "/crm.SMTPService/TestSMTP"
+	SMTPService_RotateSMTPKeys_FullMethodName = "/crm.SMTPService/RotateSMTPKeys"
+)
+
+// SMTPServiceClient is the client API for SMTPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SMTPServiceClient interface {
+	CreateSMTP(ctx context.Context, in *CreateSMTPRequest, opts ...grpc.CallOption) (*SMTPResponse, error)
+	GetSMTP(ctx context.Context, in *GetSMTPRequest, opts ...grpc.CallOption) (*SMTPResponse, error)
+	UpdateSMTP(ctx context.Context, in *UpdateSMTPRequest, opts ...grpc.CallOption) (*SMTPResponse, error)
+	DeleteSMTP(ctx context.Context, in *DeleteSMTPRequest, opts ...grpc.CallOption) (*DeleteSMTPResponse, error)
+	ListSMTP(ctx context.Context, in *ListSMTPRequest, opts ...grpc.CallOption) (*ListSMTPResponse, error)
+	TestSMTP(ctx context.Context, in *TestSMTPRequest, opts ...grpc.CallOption) (*TestSMTPResponse, error)
+	RotateSMTPKeys(ctx context.Context, in *RotateSMTPKeysRequest, opts ...grpc.CallOption) (*RotateSMTPKeysResponse, error)
+}
+
+type sMTPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSMTPServiceClient(cc grpc.ClientConnInterface) SMTPServiceClient {
+	return &sMTPServiceClient{cc}
+}
+
+func (c *sMTPServiceClient) CreateSMTP(ctx context.Context, in *CreateSMTPRequest, opts ...grpc.CallOption) (*SMTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SMTPResponse)
+	err := c.cc.Invoke(ctx, SMTPService_CreateSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMTPServiceClient) GetSMTP(ctx context.Context, in *GetSMTPRequest, opts ...grpc.CallOption) (*SMTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SMTPResponse)
+	err := c.cc.Invoke(ctx, SMTPService_GetSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMTPServiceClient) UpdateSMTP(ctx context.Context, in *UpdateSMTPRequest, opts ...grpc.CallOption) (*SMTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SMTPResponse)
+	err := c.cc.Invoke(ctx, SMTPService_UpdateSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMTPServiceClient) DeleteSMTP(ctx context.Context, in *DeleteSMTPRequest, opts ...grpc.CallOption) (*DeleteSMTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSMTPResponse)
+	err := c.cc.Invoke(ctx, SMTPService_DeleteSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMTPServiceClient) ListSMTP(ctx context.Context, in *ListSMTPRequest, opts ...grpc.CallOption) (*ListSMTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSMTPResponse)
+	err := c.cc.Invoke(ctx, SMTPService_ListSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMTPServiceClient) TestSMTP(ctx context.Context, in *TestSMTPRequest, opts ...grpc.CallOption) (*TestSMTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestSMTPResponse)
+	err := c.cc.Invoke(ctx, SMTPService_TestSMTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sMTPServiceClient) RotateSMTPKeys(ctx context.Context, in *RotateSMTPKeysRequest, opts ...grpc.CallOption) (*RotateSMTPKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSMTPKeysResponse)
+	err := c.cc.Invoke(ctx, SMTPService_RotateSMTPKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SMTPServiceServer is the server API for SMTPService service.
+// All implementations must embed UnimplementedSMTPServiceServer
+// for forward compatibility.
+type SMTPServiceServer interface {
+	CreateSMTP(context.Context, *CreateSMTPRequest) (*SMTPResponse, error)
+	GetSMTP(context.Context, *GetSMTPRequest) (*SMTPResponse, error)
+	UpdateSMTP(context.Context, *UpdateSMTPRequest) (*SMTPResponse, error)
+	DeleteSMTP(context.Context, *DeleteSMTPRequest) (*DeleteSMTPResponse, error)
+	ListSMTP(context.Context, *ListSMTPRequest) (*ListSMTPResponse, error)
+	TestSMTP(context.Context, *TestSMTPRequest) (*TestSMTPResponse, error)
+	RotateSMTPKeys(context.Context, *RotateSMTPKeysRequest) (*RotateSMTPKeysResponse, error)
+	mustEmbedUnimplementedSMTPServiceServer()
+}
+
+// UnimplementedSMTPServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSMTPServiceServer struct{}
+
+func (UnimplementedSMTPServiceServer) CreateSMTP(context.Context, *CreateSMTPRequest) (*SMTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSMTP not implemented")
+}
+func (UnimplementedSMTPServiceServer) GetSMTP(context.Context, *GetSMTPRequest) (*SMTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSMTP not implemented")
+}
+func (UnimplementedSMTPServiceServer) UpdateSMTP(context.Context, *UpdateSMTPRequest) (*SMTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSMTP not implemented")
+}
+func (UnimplementedSMTPServiceServer) DeleteSMTP(context.Context, *DeleteSMTPRequest) (*DeleteSMTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSMTP not implemented")
+}
+func (UnimplementedSMTPServiceServer) ListSMTP(context.Context, *ListSMTPRequest) (*ListSMTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSMTP not implemented")
+}
+func (UnimplementedSMTPServiceServer) TestSMTP(context.Context, *TestSMTPRequest) (*TestSMTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestSMTP not implemented")
+}
+func (UnimplementedSMTPServiceServer) RotateSMTPKeys(context.Context, *RotateSMTPKeysRequest) (*RotateSMTPKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSMTPKeys not implemented")
+}
+func (UnimplementedSMTPServiceServer) mustEmbedUnimplementedSMTPServiceServer() {}
+func (UnimplementedSMTPServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSMTPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SMTPServiceServer will
+// result in compilation errors.
+type UnsafeSMTPServiceServer interface {
+	mustEmbedUnimplementedSMTPServiceServer()
+}
+
+func RegisterSMTPServiceServer(s grpc.ServiceRegistrar, srv SMTPServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSMTPServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SMTPService_ServiceDesc, srv)
+}
+
+func _SMTPService_CreateSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).CreateSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_CreateSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).CreateSMTP(ctx, req.(*CreateSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMTPService_GetSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).GetSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_GetSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).GetSMTP(ctx, req.(*GetSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMTPService_UpdateSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).UpdateSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_UpdateSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).UpdateSMTP(ctx, req.(*UpdateSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMTPService_DeleteSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).DeleteSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_DeleteSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).DeleteSMTP(ctx, req.(*DeleteSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMTPService_ListSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).ListSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_ListSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).ListSMTP(ctx, req.(*ListSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMTPService_TestSMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSMTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).TestSMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_TestSMTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).TestSMTP(ctx, req.(*TestSMTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SMTPService_RotateSMTPKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSMTPKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SMTPServiceServer).RotateSMTPKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SMTPService_RotateSMTPKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SMTPServiceServer).RotateSMTPKeys(ctx, req.(*RotateSMTPKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SMTPService_ServiceDesc is the grpc.ServiceDesc for SMTPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SMTPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.SMTPService",
+	HandlerType: (*SMTPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSMTP",
+			Handler:    _SMTPService_CreateSMTP_Handler,
+		},
+		{
+			MethodName: "GetSMTP",
+			Handler:    _SMTPService_GetSMTP_Handler,
+		},
+		{
+			MethodName: "UpdateSMTP",
+			Handler:    _SMTPService_UpdateSMTP_Handler,
+		},
+		{
+			MethodName: "DeleteSMTP",
+			Handler:    _SMTPService_DeleteSMTP_Handler,
+		},
+		{
+			MethodName: "ListSMTP",
+			Handler:    _SMTPService_ListSMTP_Handler,
+		},
+		{
+			MethodName: "TestSMTP",
+			Handler:    _SMTPService_TestSMTP_Handler,
+		},
+		{
+			MethodName: "RotateSMTPKeys",
+			Handler:    _SMTPService_RotateSMTPKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	TemplateService_CreateTemplate_FullMethodName  = "/crm.TemplateService/CreateTemplate"
+	TemplateService_GetTemplate_FullMethodName     = "/crm.TemplateService/GetTemplate"
+	TemplateService_ListTemplates_FullMethodName   = "/crm.TemplateService/ListTemplates"
+	TemplateService_UpdateTemplate_FullMethodName  = "/crm.TemplateService/UpdateTemplate"
+	TemplateService_PreviewTemplate_FullMethodName = "/crm.TemplateService/PreviewTemplate"
+)
+
+// TemplateServiceClient is the client API for TemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TemplateServiceClient interface {
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error)
+}
+
+type templateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTemplateServiceClient(cc grpc.ClientConnInterface) TemplateServiceClient {
+	return &templateServiceClient{cc}
+}
+
+func (c *templateServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateResponse)
+	err := c.cc.Invoke(ctx, TemplateService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateResponse)
+	err := c.cc.Invoke(ctx, TemplateService_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, TemplateService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateResponse)
+	err := c.cc.Invoke(ctx, TemplateService_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewTemplateResponse)
+	err := c.cc.Invoke(ctx, TemplateService_PreviewTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TemplateServiceServer is the server API for TemplateService service.
+// All implementations must embed UnimplementedTemplateServiceServer
+// for forward compatibility.
+type TemplateServiceServer interface {
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*TemplateResponse, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*TemplateResponse, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*TemplateResponse, error)
+	PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error)
+	mustEmbedUnimplementedTemplateServiceServer()
+}
+
+// UnimplementedTemplateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTemplateServiceServer struct{}
+
+func (UnimplementedTemplateServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*TemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*TemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedTemplateServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*TemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) mustEmbedUnimplementedTemplateServiceServer() {}
+func (UnimplementedTemplateServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TemplateServiceServer will
+// result in compilation errors.
+type UnsafeTemplateServiceServer interface {
+	mustEmbedUnimplementedTemplateServiceServer()
+}
+
+func RegisterTemplateServiceServer(s grpc.ServiceRegistrar, srv TemplateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTemplateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TemplateService_ServiceDesc, srv)
+}
+
+func _TemplateService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_PreviewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).PreviewTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_PreviewTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).PreviewTemplate(ctx, req.(*PreviewTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TemplateService_ServiceDesc is the grpc.ServiceDesc for TemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.TemplateService",
+	HandlerType: (*TemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _TemplateService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _TemplateService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _TemplateService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _TemplateService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "PreviewTemplate",
+			Handler:    _TemplateService_PreviewTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/crm.proto",
+}
+
+const (
+	NotificationLogService_GetLog_FullMethodName   = "/crm.NotificationLogService/GetLog"
+	NotificationLogService_ListLogs_FullMethodName = "/crm.NotificationLogService/ListLogs"
+)
+
+// NotificationLogServiceClient is the client API for NotificationLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationLogServiceClient interface {
+	GetLog(ctx context.Context, in *GetLogRequest, opts ...grpc.CallOption) (*NotificationLogResponse, error)
+	ListLogs(ctx context.Context, in *ListLogsRequest, opts ...grpc.CallOption) (*ListLogsResponse, error)
+}
+
+type notificationLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationLogServiceClient(cc grpc.ClientConnInterface) NotificationLogServiceClient {
+	return &notificationLogServiceClient{cc}
+}
+
+func (c *notificationLogServiceClient) GetLog(ctx context.Context, in *GetLogRequest, opts ...grpc.CallOption) (*NotificationLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationLogResponse)
+	err := c.cc.Invoke(ctx, NotificationLogService_GetLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationLogServiceClient) ListLogs(ctx context.Context, in *ListLogsRequest, opts ...grpc.CallOption) (*ListLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLogsResponse)
+	err := c.cc.Invoke(ctx, NotificationLogService_ListLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationLogServiceServer is the server API for NotificationLogService service.
+// All implementations must embed UnimplementedNotificationLogServiceServer
+// for forward compatibility.
+type NotificationLogServiceServer interface {
+	GetLog(context.Context, *GetLogRequest) (*NotificationLogResponse, error)
+	ListLogs(context.Context, *ListLogsRequest) (*ListLogsResponse, error)
+	mustEmbedUnimplementedNotificationLogServiceServer()
+}
+
+// UnimplementedNotificationLogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationLogServiceServer struct{}
+
+func (UnimplementedNotificationLogServiceServer) GetLog(context.Context, *GetLogRequest) (*NotificationLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLog not implemented")
+}
+func (UnimplementedNotificationLogServiceServer) ListLogs(context.Context, *ListLogsRequest) (*ListLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogs not implemented")
+}
+func (UnimplementedNotificationLogServiceServer) mustEmbedUnimplementedNotificationLogServiceServer() {
+}
+func (UnimplementedNotificationLogServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationLogServiceServer will
+// result in compilation errors.
+type UnsafeNotificationLogServiceServer interface {
+	mustEmbedUnimplementedNotificationLogServiceServer()
+}
+
+func RegisterNotificationLogServiceServer(s grpc.ServiceRegistrar, srv NotificationLogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationLogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationLogService_ServiceDesc, srv)
+}
+
+func _NotificationLogService_GetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationLogServiceServer).GetLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationLogService_GetLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationLogServiceServer).GetLog(ctx, req.(*GetLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationLogService_ListLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationLogServiceServer).ListLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationLogService_ListLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationLogServiceServer).ListLogs(ctx, req.(*ListLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationLogService_ServiceDesc is the grpc.ServiceDesc for NotificationLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crm.NotificationLogService",
+	HandlerType: (*NotificationLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLog",
+			Handler:    _NotificationLogService_GetLog_Handler,
+		},
+		{
+			MethodName: "ListLogs",
+			Handler:    _NotificationLogService_ListLogs_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/adapters/notify"
+	"crm/internal/adapters/secrets"
+	"crm/internal/config"
+	"crm/internal/core/services"
+	handler "crm/internal/ports/grpc_server"
+	"crm/internal/transport/websockets"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	_ "github.com/jackc/pgx/v4/stdlib"
+	kafkago "github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	shutdownTimeout      = 15 * time.Second
+	configReloadInterval = 10 * time.Second
+	proposalExpiryPeriod = time.Hour
+	healthCheckPeriod    = 5 * time.Second
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	logLevel := zap.NewAtomicLevelAt(mustParseLevel(cfg.LogLevel))
+	zapCfg := zap.NewProductionConfig()
+	zapCfg.Level = logLevel
+	logger, err := zapCfg.Build()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	services.SetPageLimits(cfg.Pagination.DefaultPageSize, cfg.Pagination.MaxPageSize)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Log level and page limits follow the config file without a restart.
+	go config.Watch(ctx, cfg, configReloadInterval, logger, func(r config.Reloadable) {
+		logLevel.SetLevel(mustParseLevel(r.LogLevel))
+		services.SetPageLimits(r.Pagination.DefaultPageSize, r.Pagination.MaxPageSize)
+	})
+
+	// ---------- Postgres ----------
+	pool, err := sql.Open("pgx", cfg.Database.DSN)
+	if err != nil {
+		logger.Fatal("failed to open database", zap.Error(err))
+	}
+	pingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err = pool.PingContext(pingCtx)
+	cancel()
+	if err != nil {
+		logger.Fatal("failed to connect to database", zap.Error(err))
+	}
+	queries := db.New(pool)
+
+	// ---------- Kafka ----------
+	topicConfigs := make([]kafkago.TopicConfig, 0, len(kafka.AllTopics))
+	for _, topic := range kafka.AllTopics {
+		topicConfigs = append(topicConfigs, kafkago.TopicConfig{Topic: topic, NumPartitions: 1, ReplicationFactor: 1})
+	}
+	if err := kafka.EnsureTopics(cfg.Kafka.Brokers, topicConfigs); err != nil {
+		logger.Warn("failed to ensure kafka topics", zap.Error(err))
+	}
+	producer := kafka.NewProducer(cfg.Kafka.Brokers, logger)
+
+	// ---------- WebSockets ----------
+	wsServer := websockets.NewServer(cfg.WebSocket.AllowedOrigins...)
+	go wsServer.Start()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws", wsServer.HandleConnections)
+	httpServer := &http.Server{Addr: cfg.HTTP.Addr, Handler: mux}
+
+	// ---------- Services & Handlers ----------
+	activityService := services.NewActivityService(queries, producer)
+	taskService := services.NewTaskService(queries, producer)
+	contactService := services.NewContactService(queries, producer)
+	companyService := services.NewCompanyService(queries, producer)
+	leadService := services.NewLeadService(pool, queries, producer)
+	leadScoringService := services.NewLeadScoringService(queries, producer)
+	leadAssignmentService := services.NewLeadAssignmentService(pool, queries, producer)
+	duplicateService := services.NewDuplicateService(pool, queries, producer)
+	opportunityService := services.NewOpportunityService(pool, queries, producer)
+	forecastService := services.NewForecastService(queries)
+	currencyService := services.NewCurrencyService(pool, queries)
+	productService := services.NewProductService(pool, queries)
+	searchService := services.NewSearchService(queries)
+	meetingService := services.NewMeetingService(pool, queries, producer)
+	proposalService := services.NewProposalService(pool, queries, producer)
+	templateService := services.NewTemplateService(pool, queries, producer)
+	smtpService := services.NewSMTPService(pool, queries, producer, keyring(cfg.Secrets, logger))
+	retry := cfg.Notification.Retry
+	notificationService := services.NewNotificationService(queries, producer, templateService, map[string]notify.Sender{
+		notify.ChannelEmail: notify.NewSMTPSender(notify.ChainCredentials{
+			smtpService, notify.StaticCredentials(cfg.Notification.SMTP),
+		}),
+		notify.ChannelSMS:   notify.NewSMSSender(smsProvider(cfg.Notification.SMS, logger)),
+		notify.ChannelInApp: notify.NewInAppSender(wsServer),
+	}, services.RetryPolicy{
+		MaxAttempts: retry.MaxAttempts,
+		BaseDelay:   time.Duration(retry.BaseDelaySeconds) * time.Second,
+		MaxDelay:    time.Duration(retry.MaxDelaySeconds) * time.Second,
+	})
+	notificationLogService := services.NewNotificationLogService(queries)
+
+	// Background workers run until ctx is cancelled; shutdown waits for
+	// them before closing what they use.
+	var workers sync.WaitGroup
+	background := func(run func()) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run()
+		}()
+	}
+	background(func() {
+		runPeriodically(ctx, logger, "expire proposals", proposalExpiryPeriod, func(ctx context.Context) (int, error) {
+			return proposalService.ExpireProposals(ctx, time.Now())
+		})
+	})
+	background(func() {
+		runPeriodically(ctx, logger, "retry notifications", time.Duration(retry.IntervalSeconds)*time.Second, notificationService.RetryDue)
+	})
+	background(func() {
+		kafka.RunWorker(ctx, cfg.Kafka.Brokers, kafka.TopicNotificationRequested,
+			cfg.ConsumerGroup("notifications"), logger, notificationService.HandleQueued)
+	})
+	// Leads are rescored whenever they change. Each topic gets its own
+	// group so the readers do not rebalance one another.
+	for _, topic := range []string{kafka.TopicLeadCreated, kafka.TopicLeadUpdated, kafka.TopicLeadConverted, kafka.TopicLeadAssigned, kafka.TopicLeadMerged} {
+		background(func() {
+			kafka.RunWorker(ctx, cfg.Kafka.Brokers, topic,
+				cfg.ConsumerGroup("lead-scoring-"+topic), logger, leadScoringService.HandleLeadEvent)
+		})
+	}
+	// Creating or deleting an activity changes the activity count of the
+	// leads converted to its contact.
+	for _, topic := range []string{kafka.TopicActivityCreated, kafka.TopicActivityDeleted} {
+		background(func() {
+			kafka.RunWorker(ctx, cfg.Kafka.Brokers, topic,
+				cfg.ConsumerGroup("lead-scoring-"+topic), logger, leadScoringService.HandleActivityEvent)
+		})
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterActivityServiceServer(grpcServer, handler.NewActivityHandler(activityService))
+	pb.RegisterTaskServiceServer(grpcServer, handler.NewTaskHandler(taskService))
+	pb.RegisterContactServiceServer(grpcServer, handler.NewContactHandler(contactService))
+	pb.RegisterCompanyServiceServer(grpcServer, handler.NewCompanyHandler(companyService))
+	pb.RegisterLeadServiceServer(grpcServer, handler.NewLeadHandler(leadService, wsServer))
+	pb.RegisterLeadScoringServiceServer(grpcServer, handler.NewLeadScoringHandler(leadScoringService))
+	pb.RegisterLeadAssignmentServiceServer(grpcServer, handler.NewLeadAssignmentHandler(leadAssignmentService))
+	pb.RegisterDuplicateServiceServer(grpcServer, handler.NewDuplicateHandler(duplicateService))
+	pb.RegisterOpportunityServiceServer(grpcServer, handler.NewOpportunityHandler(opportunityService))
+	pb.RegisterForecastServiceServer(grpcServer, handler.NewForecastHandler(forecastService))
+	pb.RegisterCurrencyServiceServer(grpcServer, handler.NewCurrencyHandler(currencyService))
+	pb.RegisterProductServiceServer(grpcServer, handler.NewProductHandler(productService))
+	pb.RegisterSearchServiceServer(grpcServer, handler.NewSearchHandler(searchService))
+	pb.RegisterMeetingServiceServer(grpcServer, handler.NewMeetingHandler(meetingService))
+	pb.RegisterProposalServiceServer(grpcServer, handler.NewProposalHandler(proposalService))
+	pb.RegisterNotificationServiceServer(grpcServer, handler.NewNotificationHandler(notificationService))
+	pb.RegisterTemplateServiceServer(grpcServer, handler.NewTemplateHandler(templateService))
+	pb.RegisterNotificationLogServiceServer(grpcServer, handler.NewNotificationLogHandler(notificationLogService))
+	pb.RegisterSMTPServiceServer(grpcServer, handler.NewSMTPHandler(smtpService, cfg.Secrets.KeyID))
+
+	// ---------- Health ----------
+	healthHandler := handler.NewHealthHandler(services.NewHealthService(
+		services.DatabaseHealthCheck(pool),
+		services.KafkaHealthCheck(cfg.Kafka.Brokers),
+		services.WebSocketHealthCheck(wsServer),
+	))
+	pb.RegisterHealthServiceServer(grpcServer, healthHandler)
+	serviceNames := make([]string, 0, len(grpcServer.GetServiceInfo()))
+	for name := range grpcServer.GetServiceInfo() {
+		serviceNames = append(serviceNames, name)
+	}
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	background(func() {
+		runPeriodically(ctx, logger, "health check", healthCheckPeriod, healthHandler.SyncGRPCHealth(healthServer, serviceNames))
+	})
+	mux.HandleFunc("/healthz", healthHandler.ServeLiveness)
+	mux.HandleFunc("/readyz", healthHandler.ServeReadiness)
+
+	// ---------- Serve ----------
+	lis, err := net.Listen("tcp", cfg.GRPC.Addr)
+	if err != nil {
+		logger.Fatal("failed to listen", zap.String("addr", cfg.GRPC.Addr), zap.Error(err))
+	}
+
+	errCh := make(chan error, 2)
+	go func() {
+		logger.Info("gRPC server listening", zap.String("addr", cfg.GRPC.Addr))
+		errCh <- grpcServer.Serve(lis)
+	}()
+	go func() {
+		logger.Info("HTTP server listening", zap.String("addr", cfg.HTTP.Addr))
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Info("shutdown signal received")
+	case err := <-errCh:
+		logger.Error("server stopped unexpectedly", zap.Error(err))
+	}
+
+	// ---------- Shutdown ----------
+	// Stop the background workers whichever way we got here.
+	stop()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// Report NOT_SERVING so health-checking clients stop routing here, then
+	// drain in-flight RPCs, forcing a stop if they outlive the timeout.
+	healthServer.Shutdown()
+	drained := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-shutdownCtx.Done():
+		logger.Warn("gRPC drain timed out, forcing stop")
+		grpcServer.Stop()
+	}
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("failed to shut down HTTP server", zap.Error(err))
+	}
+	stopped := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		logger.Warn("background workers did not stop in time")
+	}
+	wsServer.Shutdown()
+	if err := producer.Close(); err != nil {
+		logger.Error("failed to close kafka producer", zap.Error(err))
+	}
+	if err := pool.Close(); err != nil {
+		logger.Error("failed to close database", zap.Error(err))
+	}
+	logger.Info("service stopped")
+}
+
+// mustParseLevel converts an already validated level name into a zap level.
+func mustParseLevel(level string) zapcore.Level {
+	l, err := zapcore.ParseLevel(level)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// runPeriodically calls job every period until ctx is cancelled, logging
+// failures and how many items each run processed.
+func runPeriodically(ctx context.Context, logger *zap.Logger, name string, period time.Duration, job func(context.Context) (int, error)) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		n, err := job(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Warn("periodic job failed", zap.String("job", name), zap.Error(err))
+		} else if n > 0 {
+			logger.Info("periodic job finished", zap.String("job", name), zap.Int("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// smsProvider writes SMS messages to the configured outbox file, or to the
+// log when none is set.
+func smsProvider(cfg config.SMSConfig, logger *zap.Logger) notify.SMSProvider {
+	if cfg.OutboxPath != "" {
+		return notify.NewFileSMSProvider(cfg.OutboxPath)
+	}
+	return notify.NewLogSMSProvider(logger)
+}
+
+// keyring builds the keyring for stored secrets, or returns nil when no keys
+// are configured, in which case per-user SMTP credentials are unavailable.
+func keyring(cfg config.SecretsConfig, logger *zap.Logger) *secrets.Keyring {
+	if len(cfg.Keys) == 0 {
+		logger.Warn("no secrets keys configured, per-user smtp credentials are disabled")
+		return nil
+	}
+	k, err := secrets.NewKeyring(cfg.KeyID, cfg.DecodedKeys())
+	if err != nil {
+		logger.Fatal("invalid secrets keys", zap.Error(err))
+	}
+	return k
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"crm/internal/adapters/database/migration"
+	"crm/internal/config"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+)
+
+const migrateUsage = `usage: service migrate <command> [flags]
+
+commands:
+  up [N]      apply all pending migrations, or the next N
+  down [N]    roll back the last migration, or the last N ("all" for every one)
+  status      list migrations; exits 1 when applied ones were edited or are unknown
+  seed        load the development sample data
+  check       check the embedded migration file names and up/down pairs without
+              a database. It does not detect drift between the migrations and
+              the sqlc-generated code; run scripts/check-schema.sh for that
+
+flags are the same as for the service, e.g. -config or -db-dsn.
+`
+
+// runMigrate implements the migrate subcommand and returns the exit code.
+func runMigrate(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, migrateUsage)
+		return 2
+	}
+	command, args := args[0], args[1:]
+
+	if command == "check" {
+		migrations, err := migration.Load()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "%d migrations ok\n", len(migrations))
+		return 0
+	}
+
+	steps := 0
+	if command == "down" {
+		steps = 1
+	}
+	if (command == "up" || command == "down") && len(args) > 0 {
+		if args[0] == "all" && command == "down" {
+			steps = 0
+			args = args[1:]
+		} else if n, err := strconv.Atoi(args[0]); err == nil && n > 0 {
+			steps = n
+			args = args[1:]
+		}
+	}
+
+	cfg, err := config.LoadDatabase(args)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	conn, err := sql.Open("pgx", cfg.Database.DSN)
+	if err != nil {
+		fmt.Fprintln(stderr, "failed to open database:", err)
+		return 1
+	}
+	defer conn.Close()
+
+	runner, err := migration.NewRunner(conn)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch command {
+	case "up":
+		applied, err := runner.Up(ctx, steps)
+		for _, m := range applied {
+			fmt.Fprintf(stdout, "applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(stdout, "no pending migrations")
+		}
+	case "down":
+		rolledBack, err := runner.Down(ctx, steps)
+		for _, m := range rolledBack {
+			fmt.Fprintf(stdout, "rolled back %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		if len(rolledBack) == 0 {
+			fmt.Fprintln(stdout, "no applied migrations")
+		}
+	case "status":
+		statuses, unknown, err := runner.Status(ctx)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		drift := len(unknown) > 0
+		for _, st := range statuses {
+			state := "pending"
+			if st.Applied {
+				state = "applied " + st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if st.Modified {
+				state += " (modified since applied)"
+				drift = true
+			}
+			fmt.Fprintf(stdout, "%04d_%-30s %s\n", st.Version, st.Name, state)
+		}
+		for _, v := range unknown {
+			fmt.Fprintf(stdout, "%04d %-31s applied but not in this binary\n", v, "")
+		}
+		if drift {
+			return 1
+		}
+	case "seed":
+		if err := runner.Seed(ctx); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		fmt.Fprintln(stdout, "seed data loaded")
+	default:
+		fmt.Fprintf(stderr, "unknown migrate command %q\n\n%s", command, migrateUsage)
+		return 2
+	}
+	return 0
+}
@@ -14,7 +14,7 @@ const createCompany = `-- name: CreateCompany :one
 INSERT INTO companies (
     name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id
 ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-RETURNING id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at
+RETURNING id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at, website_domain
 `
 
 type CreateCompanyParams struct {
@@ -64,6 +64,7 @@ func (q *Queries) CreateCompany(ctx context.Context, arg CreateCompanyParams) (C
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebsiteDomain,
 	)
 	return i, err
 }
@@ -78,7 +79,7 @@ func (q *Queries) DeleteCompany(ctx context.Context, id int32) error {
 }
 
 const getCompany = `-- name: GetCompany :one
-SELECT id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at FROM companies WHERE id = $1
+SELECT id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at, website_domain FROM companies WHERE id = $1
 `
 
 func (q *Queries) GetCompany(ctx context.Context, id int32) (Company, error) {
@@ -100,12 +101,13 @@ func (q *Queries) GetCompany(ctx context.Context, id int32) (Company, error) {
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebsiteDomain,
 	)
 	return i, err
 }
 
 const listCompanies = `-- name: ListCompanies :many
-SELECT id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at
+SELECT id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at, website_domain
 FROM companies
 WHERE organization_id = $1
 ORDER BY created_at DESC
@@ -143,6 +145,7 @@ func (q *Queries) ListCompanies(ctx context.Context, arg ListCompaniesParams) ([
 			&i.OrganizationID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WebsiteDomain,
 		); err != nil {
 			return nil, err
 		}
@@ -162,7 +165,7 @@ UPDATE companies
 SET name = $2, industry = $3, website = $4, phone = $5, email = $6, address = $7, city = $8, state = $9, country = $10,
     zipcode = $11, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at
+RETURNING id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at, website_domain
 `
 
 type UpdateCompanyParams struct {
@@ -210,6 +213,7 @@ func (q *Queries) UpdateCompany(ctx context.Context, arg UpdateCompanyParams) (C
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebsiteDomain,
 	)
 	return i, err
 }
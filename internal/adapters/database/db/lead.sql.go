@@ -11,19 +11,25 @@ import (
 )
 
 const createLead = `-- name: CreateLead :one
-INSERT INTO leads (first_name, last_name, email, phone, status, assigned_to, organization_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at
+INSERT INTO leads (first_name, last_name, email, phone, status, assigned_to, organization_id, country, state, company_id,
+                   assignment_reason, assigned_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+        CASE WHEN $6::int IS NULL THEN NULL ELSE CURRENT_TIMESTAMP END)
+RETURNING id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason
 `
 
 type CreateLeadParams struct {
-	FirstName      string
-	LastName       string
-	Email          string
-	Phone          sql.NullString
-	Status         string
-	AssignedTo     sql.NullInt32
-	OrganizationID sql.NullInt32
+	FirstName        string
+	LastName         string
+	Email            string
+	Phone            sql.NullString
+	Status           string
+	AssignedTo       sql.NullInt32
+	OrganizationID   sql.NullInt32
+	Country          sql.NullString
+	State            sql.NullString
+	CompanyID        sql.NullInt32
+	AssignmentReason sql.NullString
 }
 
 func (q *Queries) CreateLead(ctx context.Context, arg CreateLeadParams) (Lead, error) {
@@ -35,6 +41,10 @@ func (q *Queries) CreateLead(ctx context.Context, arg CreateLeadParams) (Lead, e
 		arg.Status,
 		arg.AssignedTo,
 		arg.OrganizationID,
+		arg.Country,
+		arg.State,
+		arg.CompanyID,
+		arg.AssignmentReason,
 	)
 	var i Lead
 	err := row.Scan(
@@ -48,6 +58,18 @@ func (q *Queries) CreateLead(ctx context.Context, arg CreateLeadParams) (Lead, e
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ConvertedAt,
+		&i.ConvertedContactID,
+		&i.ConvertedCompanyID,
+		&i.ConvertedOpportunityID,
+		&i.StatusEnteredAt,
+		&i.Score,
+		&i.ScoreUpdatedAt,
+		&i.Country,
+		&i.State,
+		&i.CompanyID,
+		&i.AssignedAt,
+		&i.AssignmentReason,
 	)
 	return i, err
 }
@@ -62,7 +84,7 @@ func (q *Queries) DeleteLead(ctx context.Context, id int32) error {
 }
 
 const getAll = `-- name: GetAll :many
-SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at FROM leads
+SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason FROM leads
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -92,6 +114,18 @@ func (q *Queries) GetAll(ctx context.Context, arg GetAllParams) ([]Lead, error)
 			&i.OrganizationID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ConvertedAt,
+			&i.ConvertedContactID,
+			&i.ConvertedCompanyID,
+			&i.ConvertedOpportunityID,
+			&i.StatusEnteredAt,
+			&i.Score,
+			&i.ScoreUpdatedAt,
+			&i.Country,
+			&i.State,
+			&i.CompanyID,
+			&i.AssignedAt,
+			&i.AssignmentReason,
 		); err != nil {
 			return nil, err
 		}
@@ -107,7 +141,7 @@ func (q *Queries) GetAll(ctx context.Context, arg GetAllParams) ([]Lead, error)
 }
 
 const getLeadByEmail = `-- name: GetLeadByEmail :one
-SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at FROM leads WHERE email = $1 LIMIT 1
+SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason FROM leads WHERE email = $1 LIMIT 1
 `
 
 func (q *Queries) GetLeadByEmail(ctx context.Context, email string) (Lead, error) {
@@ -124,12 +158,24 @@ func (q *Queries) GetLeadByEmail(ctx context.Context, email string) (Lead, error
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ConvertedAt,
+		&i.ConvertedContactID,
+		&i.ConvertedCompanyID,
+		&i.ConvertedOpportunityID,
+		&i.StatusEnteredAt,
+		&i.Score,
+		&i.ScoreUpdatedAt,
+		&i.Country,
+		&i.State,
+		&i.CompanyID,
+		&i.AssignedAt,
+		&i.AssignmentReason,
 	)
 	return i, err
 }
 
 const getLeadById = `-- name: GetLeadById :one
-SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at FROM leads WHERE id = $1
+SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason FROM leads WHERE id = $1
 `
 
 func (q *Queries) GetLeadById(ctx context.Context, id int32) (Lead, error) {
@@ -146,15 +192,119 @@ func (q *Queries) GetLeadById(ctx context.Context, id int32) (Lead, error) {
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ConvertedAt,
+		&i.ConvertedContactID,
+		&i.ConvertedCompanyID,
+		&i.ConvertedOpportunityID,
+		&i.StatusEnteredAt,
+		&i.Score,
+		&i.ScoreUpdatedAt,
+		&i.Country,
+		&i.State,
+		&i.CompanyID,
+		&i.AssignedAt,
+		&i.AssignmentReason,
+	)
+	return i, err
+}
+
+const getLeadForUpdate = `-- name: GetLeadForUpdate :one
+SELECT id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason FROM leads WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetLeadForUpdate(ctx context.Context, id int32) (Lead, error) {
+	row := q.db.QueryRowContext(ctx, getLeadForUpdate, id)
+	var i Lead
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.Phone,
+		&i.Status,
+		&i.AssignedTo,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ConvertedAt,
+		&i.ConvertedContactID,
+		&i.ConvertedCompanyID,
+		&i.ConvertedOpportunityID,
+		&i.StatusEnteredAt,
+		&i.Score,
+		&i.ScoreUpdatedAt,
+		&i.Country,
+		&i.State,
+		&i.CompanyID,
+		&i.AssignedAt,
+		&i.AssignmentReason,
+	)
+	return i, err
+}
+
+const markLeadConverted = `-- name: MarkLeadConverted :one
+UPDATE leads
+SET status = $1,
+    converted_at = CURRENT_TIMESTAMP,
+    status_entered_at = CURRENT_TIMESTAMP,
+    converted_contact_id = $2,
+    converted_company_id = $3,
+    converted_opportunity_id = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $5 AND converted_at IS NULL
+RETURNING id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason
+`
+
+type MarkLeadConvertedParams struct {
+	Status        string
+	ContactID     sql.NullInt32
+	CompanyID     sql.NullInt32
+	OpportunityID sql.NullInt32
+	ID            int32
+}
+
+func (q *Queries) MarkLeadConverted(ctx context.Context, arg MarkLeadConvertedParams) (Lead, error) {
+	row := q.db.QueryRowContext(ctx, markLeadConverted,
+		arg.Status,
+		arg.ContactID,
+		arg.CompanyID,
+		arg.OpportunityID,
+		arg.ID,
+	)
+	var i Lead
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.Phone,
+		&i.Status,
+		&i.AssignedTo,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ConvertedAt,
+		&i.ConvertedContactID,
+		&i.ConvertedCompanyID,
+		&i.ConvertedOpportunityID,
+		&i.StatusEnteredAt,
+		&i.Score,
+		&i.ScoreUpdatedAt,
+		&i.Country,
+		&i.State,
+		&i.CompanyID,
+		&i.AssignedAt,
+		&i.AssignmentReason,
 	)
 	return i, err
 }
 
 const updateLead = `-- name: UpdateLead :one
 UPDATE leads
-SET status=$2, assigned_to=$3, updated_at=CURRENT_TIMESTAMP
+SET status=$2, assigned_to=$3, updated_at=CURRENT_TIMESTAMP,
+    status_entered_at = CASE WHEN status = $2 THEN status_entered_at ELSE CURRENT_TIMESTAMP END
 WHERE id=$1
-RETURNING id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at
+RETURNING id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason
 `
 
 type UpdateLeadParams struct {
@@ -177,6 +327,18 @@ func (q *Queries) UpdateLead(ctx context.Context, arg UpdateLeadParams) (Lead, e
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ConvertedAt,
+		&i.ConvertedContactID,
+		&i.ConvertedCompanyID,
+		&i.ConvertedOpportunityID,
+		&i.StatusEnteredAt,
+		&i.Score,
+		&i.ScoreUpdatedAt,
+		&i.Country,
+		&i.State,
+		&i.CompanyID,
+		&i.AssignedAt,
+		&i.AssignmentReason,
 	)
 	return i, err
 }
@@ -0,0 +1,515 @@
+package db
+
+// This file is not generated: sqlc cannot express filters and sort orders
+// chosen at run time, so the List queries that take them are written here.
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// ListQuery is a WHERE condition and ORDER BY list built at run time with
+// the arguments of its $N placeholders. Where and OrderBy must only contain
+// SQL generated from whitelisted columns; values always go in Args.
+type ListQuery struct {
+	Where   string
+	OrderBy string
+	// Key is an expression of the sort key of a row, returned as text with
+	// each row when set.
+	Key  string
+	Args []interface{}
+	// Limit of 0 returns all rows.
+	Limit  int32
+	Offset int32
+}
+
+const (
+	contactColumns          = "id, contact_type, first_name, last_name, company_name, company_id, email, phone, address, city, state, country, zipcode, position, social_media_profiles, notes, taxation_detail_id, created_at, updated_at"
+	companyColumns          = "id, name, industry, website, phone, email, address, city, state, country, zipcode, created_by, organization_id, created_at, updated_at, website_domain"
+	leadColumns             = "id, first_name, last_name, email, phone, status, assigned_to, organization_id, created_at, updated_at, converted_at, converted_contact_id, converted_company_id, converted_opportunity_id, status_entered_at, score, score_updated_at, country, state, company_id, assigned_at, assignment_reason"
+	opportunityColumns      = "id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked"
+	activityColumns         = "id, title, description, type, status, due_date, contact_id, created_at, updated_at"
+	taskColumns             = "id, title, description, status, priority, due_date, activity_id, created_at, updated_at"
+	meetingColumns          = "id, title, description, start_time, end_time, meeting_link, organizer_id, status, created_at, updated_at"
+	proposalColumns         = "id, title, description, amount, status, contact_id, opportunity_id, created_by, valid_until, sent_at, responded_at, created_at, updated_at, currency"
+	templateColumns         = "id, name, url, current_version, created_at, updated_at"
+	notificationLogColumns  = "id, notification_type, channel, user_id, recipient, template_name, template_version, data, status, error_message, attempts, next_attempt_at, sent_at, created_at, updated_at"
+	smtpCredentialColumns   = "id, user_id, smtp_host, smtp_port, smtp_username, from_email, key_id, wrapped_key, password_ciphertext, created_at, updated_at"
+	productColumns          = "id, sku, name, description, list_price, currency, active, created_at, updated_at"
+	exchangeRateColumns     = "id, from_currency, to_currency, rate, effective_date, source, created_at"
+	forecastSnapshotColumns = "id, name, period, group_by, close_from, close_to, owner_id, pipeline_id, rows, created_at, currency"
+	leadScoringRuleColumns  = "id, organization_id, name, rule_type, field, operator, value, weight, enabled, created_at, updated_at"
+)
+
+func (q *Queries) FilterContacts(ctx context.Context, lq ListQuery) ([]Contact, []string, error) {
+	return listRows(ctx, q, "contacts", contactColumns, lq, func(rows rowScanner, i *Contact) error {
+		return rows.Scan(
+			&i.ID,
+			&i.ContactType,
+			&i.FirstName,
+			&i.LastName,
+			&i.CompanyName,
+			&i.CompanyID,
+			&i.Email,
+			&i.Phone,
+			&i.Address,
+			&i.City,
+			&i.State,
+			&i.Country,
+			&i.Zipcode,
+			&i.Position,
+			&i.SocialMediaProfiles,
+			&i.Notes,
+			&i.TaxationDetailID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterCompanies(ctx context.Context, lq ListQuery) ([]Company, []string, error) {
+	return listRows(ctx, q, "companies", companyColumns, lq, func(rows rowScanner, i *Company) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Industry,
+			&i.Website,
+			&i.Phone,
+			&i.Email,
+			&i.Address,
+			&i.City,
+			&i.State,
+			&i.Country,
+			&i.Zipcode,
+			&i.CreatedBy,
+			&i.OrganizationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WebsiteDomain,
+		)
+	})
+}
+
+func (q *Queries) FilterLeads(ctx context.Context, lq ListQuery) ([]Lead, []string, error) {
+	return listRows(ctx, q, "leads", leadColumns, lq, func(rows rowScanner, i *Lead) error {
+		return rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.Phone,
+			&i.Status,
+			&i.AssignedTo,
+			&i.OrganizationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ConvertedAt,
+			&i.ConvertedContactID,
+			&i.ConvertedCompanyID,
+			&i.ConvertedOpportunityID,
+			&i.StatusEnteredAt,
+			&i.Score,
+			&i.ScoreUpdatedAt,
+			&i.Country,
+			&i.State,
+			&i.CompanyID,
+			&i.AssignedAt,
+			&i.AssignmentReason,
+		)
+	})
+}
+
+func (q *Queries) FilterOpportunities(ctx context.Context, lq ListQuery) ([]Opportunity, []string, error) {
+	return listRows(ctx, q, "opportunities", opportunityColumns, lq, func(rows rowScanner, i *Opportunity) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Stage,
+			&i.Amount,
+			&i.CloseDate,
+			&i.Probability,
+			&i.LeadID,
+			&i.AccountID,
+			&i.OwnerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PipelineID,
+			&i.ProbabilityOverridden,
+			&i.Currency,
+			&i.LossReason,
+			&i.Competitor,
+			&i.ClosedAt,
+			&i.AmountLocked,
+		)
+	})
+}
+
+func (q *Queries) FilterActivities(ctx context.Context, lq ListQuery) ([]Activity, []string, error) {
+	return listRows(ctx, q, "activities", activityColumns, lq, func(rows rowScanner, i *Activity) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Type,
+			&i.Status,
+			&i.DueDate,
+			&i.ContactID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterTasks(ctx context.Context, lq ListQuery) ([]Task, []string, error) {
+	return listRows(ctx, q, "tasks", taskColumns, lq, func(rows rowScanner, i *Task) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.Priority,
+			&i.DueDate,
+			&i.ActivityID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterMeetings(ctx context.Context, lq ListQuery) ([]Meeting, []string, error) {
+	return listRows(ctx, q, "meetings", meetingColumns, lq, func(rows rowScanner, i *Meeting) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.StartTime,
+			&i.EndTime,
+			&i.MeetingLink,
+			&i.OrganizerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterProposals(ctx context.Context, lq ListQuery) ([]Proposal, []string, error) {
+	return listRows(ctx, q, "proposals", proposalColumns, lq, func(rows rowScanner, i *Proposal) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Amount,
+			&i.Status,
+			&i.ContactID,
+			&i.OpportunityID,
+			&i.CreatedBy,
+			&i.ValidUntil,
+			&i.SentAt,
+			&i.RespondedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Currency,
+		)
+	})
+}
+
+func (q *Queries) FilterTemplates(ctx context.Context, lq ListQuery) ([]NotificationTemplate, []string, error) {
+	return listRows(ctx, q, "notification_templates", templateColumns, lq, func(rows rowScanner, i *NotificationTemplate) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Url,
+			&i.CurrentVersion,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterNotificationLogs(ctx context.Context, lq ListQuery) ([]NotificationLog, []string, error) {
+	return listRows(ctx, q, "notification_logs", notificationLogColumns, lq, func(rows rowScanner, i *NotificationLog) error {
+		return rows.Scan(
+			&i.ID,
+			&i.NotificationType,
+			&i.Channel,
+			&i.UserID,
+			&i.Recipient,
+			&i.TemplateName,
+			&i.TemplateVersion,
+			&i.Data,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterSMTPCredentials(ctx context.Context, lq ListQuery) ([]SmtpCredential, []string, error) {
+	return listRows(ctx, q, "smtp_credentials", smtpCredentialColumns, lq, func(rows rowScanner, i *SmtpCredential) error {
+		return rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.SmtpHost,
+			&i.SmtpPort,
+			&i.SmtpUsername,
+			&i.FromEmail,
+			&i.KeyID,
+			&i.WrappedKey,
+			&i.PasswordCiphertext,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterProducts(ctx context.Context, lq ListQuery) ([]Product, []string, error) {
+	return listRows(ctx, q, "products", productColumns, lq, func(rows rowScanner, i *Product) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Sku,
+			&i.Name,
+			&i.Description,
+			&i.ListPrice,
+			&i.Currency,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterExchangeRates(ctx context.Context, lq ListQuery) ([]ExchangeRate, []string, error) {
+	return listRows(ctx, q, "exchange_rates", exchangeRateColumns, lq, func(rows rowScanner, i *ExchangeRate) error {
+		return rows.Scan(
+			&i.ID,
+			&i.FromCurrency,
+			&i.ToCurrency,
+			&i.Rate,
+			&i.EffectiveDate,
+			&i.Source,
+			&i.CreatedAt,
+		)
+	})
+}
+
+func (q *Queries) FilterForecastSnapshots(ctx context.Context, lq ListQuery) ([]ForecastSnapshot, []string, error) {
+	return listRows(ctx, q, "forecast_snapshots", forecastSnapshotColumns, lq, func(rows rowScanner, i *ForecastSnapshot) error {
+		return rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Period,
+			&i.GroupBy,
+			&i.CloseFrom,
+			&i.CloseTo,
+			&i.OwnerID,
+			&i.PipelineID,
+			&i.Rows,
+			&i.CreatedAt,
+			&i.Currency,
+		)
+	})
+}
+
+func (q *Queries) FilterLeadScoringRules(ctx context.Context, lq ListQuery) ([]LeadScoringRule, []string, error) {
+	return listRows(ctx, q, "lead_scoring_rules", leadScoringRuleColumns, lq, func(rows rowScanner, i *LeadScoringRule) error {
+		return rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.RuleType,
+			&i.Field,
+			&i.Operator,
+			&i.Value,
+			&i.Weight,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+	})
+}
+
+func (q *Queries) CountContacts(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "contacts", lq)
+}
+
+func (q *Queries) CountCompanies(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "companies", lq)
+}
+
+func (q *Queries) CountLeads(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "leads", lq)
+}
+
+func (q *Queries) CountOpportunities(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "opportunities", lq)
+}
+
+func (q *Queries) CountActivities(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "activities", lq)
+}
+
+func (q *Queries) CountTasks(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "tasks", lq)
+}
+
+func (q *Queries) CountMeetings(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "meetings", lq)
+}
+
+func (q *Queries) CountProposals(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "proposals", lq)
+}
+
+func (q *Queries) CountTemplates(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "notification_templates", lq)
+}
+
+func (q *Queries) CountNotificationLogs(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "notification_logs", lq)
+}
+
+func (q *Queries) CountSMTPCredentials(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "smtp_credentials", lq)
+}
+
+func (q *Queries) CountProducts(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "products", lq)
+}
+
+func (q *Queries) CountExchangeRates(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "exchange_rates", lq)
+}
+
+func (q *Queries) CountForecastSnapshots(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "forecast_snapshots", lq)
+}
+
+func (q *Queries) CountLeadScoringRules(ctx context.Context, lq ListQuery) (int64, error) {
+	return countRows(ctx, q, "lead_scoring_rules", lq)
+}
+
+// OpportunityAmounts is the sum of the amounts of opportunities with the
+// same currency and close date.
+type OpportunityAmounts struct {
+	Currency  string
+	CloseDate sql.NullTime
+	Amount    float64
+	// WeightedAmount weighs each amount by its probability.
+	WeightedAmount float64
+}
+
+// SumOpportunities sums the amounts of the opportunities matching the Where
+// of lq by currency and close date.
+func (q *Queries) SumOpportunities(ctx context.Context, lq ListQuery) ([]OpportunityAmounts, error) {
+	query := "SELECT currency, close_date, sum(amount)::float8, sum(amount * probability / 100)::float8 FROM opportunities"
+	if lq.Where != "" {
+		query += " WHERE " + lq.Where
+	}
+	query += " GROUP BY currency, close_date"
+
+	rows, err := q.db.QueryContext(ctx, query, lq.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OpportunityAmounts
+	for rows.Next() {
+		var i OpportunityAmounts
+		if err := rows.Scan(&i.Currency, &i.CloseDate, &i.Amount, &i.WeightedAmount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// listRows returns the rows of lq and, when lq has a Key, the key of each.
+func listRows[T any](ctx context.Context, q *Queries, table, columns string, lq ListQuery, scan func(rowScanner, *T) error) ([]T, []string, error) {
+	if lq.Key != "" {
+		columns += ", " + lq.Key
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", columns, table)
+	args := lq.Args
+	if lq.Where != "" {
+		query += " WHERE " + lq.Where
+	}
+	if lq.OrderBy != "" {
+		query += " ORDER BY " + lq.OrderBy
+	}
+	if lq.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+		args = append(args[:len(args):len(args)], lq.Limit, lq.Offset)
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var (
+		items []T
+		keys  []string
+	)
+	scanner := &keyedRows{Rows: rows, key: lq.Key != "", dest: &keys}
+	for rows.Next() {
+		var i T
+		if err := scan(scanner, &i); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return items, keys, nil
+}
+
+// countRows counts the rows matching the Where of lq.
+func countRows(ctx context.Context, q *Queries, table string, lq ListQuery) (int64, error) {
+	query := "SELECT count(*) FROM " + table
+	if lq.Where != "" {
+		query += " WHERE " + lq.Where
+	}
+	var count int64
+	err := q.db.QueryRowContext(ctx, query, lq.Args...).Scan(&count)
+	return count, err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// keyedRows scans the key column after the columns of a row.
+type keyedRows struct {
+	*sql.Rows
+	key  bool
+	dest *[]string
+}
+
+func (r *keyedRows) Scan(dest ...interface{}) error {
+	if !r.key {
+		return r.Rows.Scan(dest...)
+	}
+	var key string
+	if err := r.Rows.Scan(append(dest, &key)...); err != nil {
+		return err
+	}
+	*r.dest = append(*r.dest, key)
+	return nil
+}
@@ -6,6 +6,8 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
+	"time"
 )
 
 type Activity struct {
@@ -36,6 +38,7 @@ type Company struct {
 	OrganizationID int32
 	CreatedAt      sql.NullTime
 	UpdatedAt      sql.NullTime
+	WebsiteDomain  sql.NullString
 }
 
 type Contact struct {
@@ -60,34 +63,342 @@ type Contact struct {
 	UpdatedAt           sql.NullTime
 }
 
+type ExchangeRate struct {
+	ID            int32
+	FromCurrency  string
+	ToCurrency    string
+	Rate          float64
+	EffectiveDate time.Time
+	Source        string
+	CreatedAt     time.Time
+}
+
+type ForecastSnapshot struct {
+	ID         int32
+	Name       string
+	Period     string
+	GroupBy    string
+	CloseFrom  time.Time
+	CloseTo    time.Time
+	OwnerID    int32
+	PipelineID int32
+	Rows       json.RawMessage
+	CreatedAt  time.Time
+	Currency   string
+}
+
 type Lead struct {
+	ID                     int32
+	FirstName              string
+	LastName               string
+	Email                  string
+	Phone                  sql.NullString
+	Status                 string
+	AssignedTo             sql.NullInt32
+	OrganizationID         sql.NullInt32
+	CreatedAt              sql.NullTime
+	UpdatedAt              sql.NullTime
+	ConvertedAt            sql.NullTime
+	ConvertedContactID     sql.NullInt32
+	ConvertedCompanyID     sql.NullInt32
+	ConvertedOpportunityID sql.NullInt32
+	StatusEnteredAt        sql.NullTime
+	Score                  int32
+	ScoreUpdatedAt         sql.NullTime
+	Country                sql.NullString
+	State                  sql.NullString
+	CompanyID              sql.NullInt32
+	AssignedAt             sql.NullTime
+	AssignmentReason       sql.NullString
+}
+
+type LeadAssignmentRule struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	Priority       int32
+	Strategy       string
+	TeamID         int32
+	Country        string
+	State          string
+	Industry       string
+	Enabled        bool
+	CreatedAt      sql.NullTime
+	UpdatedAt      sql.NullTime
+}
+
+type LeadScoringRule struct {
 	ID             int32
-	FirstName      string
-	LastName       string
-	Email          string
-	Phone          sql.NullString
-	Status         string
-	AssignedTo     sql.NullInt32
 	OrganizationID sql.NullInt32
+	Name           string
+	RuleType       string
+	Field          sql.NullString
+	Operator       string
+	Value          string
+	Weight         int32
+	Enabled        bool
 	CreatedAt      sql.NullTime
 	UpdatedAt      sql.NullTime
 }
 
-type Opportunity struct {
+type LeadStatus struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	Position       int32
+	IsInitial      bool
+	CreatedAt      sql.NullTime
+	IsClosed       bool
+}
+
+type LeadStatusHistory struct {
+	ID         int32
+	LeadID     int32
+	FromStatus sql.NullString
+	ToStatus   string
+	EnteredAt  time.Time
+}
+
+type LeadStatusTransition struct {
+	OrganizationID int32
+	FromStatus     string
+	ToStatus       string
+}
+
+type LossReason struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	Active         bool
+	Position       int32
+	CreatedAt      sql.NullTime
+	UpdatedAt      sql.NullTime
+}
+
+type Meeting struct {
 	ID          int32
-	Name        sql.NullString
+	Title       string
 	Description sql.NullString
-	Stage       sql.NullString
-	Amount      float64
-	CloseDate   sql.NullTime
+	StartTime   time.Time
+	EndTime     time.Time
+	MeetingLink sql.NullString
+	OrganizerID int32
+	Status      string
+	CreatedAt   sql.NullTime
+	UpdatedAt   sql.NullTime
+}
+
+type MeetingAttendee struct {
+	ID        int32
+	MeetingID int32
+	ContactID sql.NullInt32
+	LeadID    sql.NullInt32
+}
+
+type MergeAudit struct {
+	ID           int32
+	EntityType   string
+	SurvivorID   int32
+	MergedID     int32
+	MergedRecord json.RawMessage
+	FilledFields json.RawMessage
+	Repointed    json.RawMessage
+	CreatedAt    sql.NullTime
+}
+
+type NotificationLog struct {
+	ID               string
+	NotificationType string
+	Channel          string
+	UserID           string
+	Recipient        string
+	TemplateName     string
+	TemplateVersion  int32
+	Data             json.RawMessage
+	Status           string
+	ErrorMessage     string
+	Attempts         int32
+	NextAttemptAt    sql.NullTime
+	SentAt           sql.NullTime
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type NotificationTemplate struct {
+	ID             int32
+	Name           string
+	Url            sql.NullString
+	CurrentVersion int32
+	CreatedAt      sql.NullTime
+	UpdatedAt      sql.NullTime
+}
+
+type Opportunity struct {
+	ID                    int32
+	Name                  sql.NullString
+	Description           sql.NullString
+	Stage                 sql.NullString
+	Amount                float64
+	CloseDate             sql.NullTime
+	Probability           float64
+	LeadID                sql.NullInt32
+	AccountID             sql.NullInt32
+	OwnerID               sql.NullInt32
+	CreatedAt             sql.NullTime
+	UpdatedAt             sql.NullTime
+	PipelineID            sql.NullInt32
+	ProbabilityOverridden bool
+	Currency              string
+	LossReason            sql.NullString
+	Competitor            sql.NullString
+	ClosedAt              sql.NullTime
+	AmountLocked          bool
+}
+
+type OpportunityContact struct {
+	OpportunityID int32
+	ContactID     int32
+	Role          string
+	CreatedAt     sql.NullTime
+}
+
+type OpportunityHistory struct {
+	ID            int32
+	OpportunityID int32
+	Field         string
+	OldValue      sql.NullString
+	NewValue      sql.NullString
+	ChangedAt     time.Time
+}
+
+type OpportunityLineItem struct {
+	ID              int32
+	OpportunityID   int32
+	ProductID       int32
+	PriceBookID     sql.NullInt32
+	Description     sql.NullString
+	Quantity        float64
+	UnitPrice       float64
+	DiscountPercent float64
+	TaxPercent      float64
+	Total           float64
+	Position        int32
+	CreatedAt       sql.NullTime
+	UpdatedAt       sql.NullTime
+}
+
+type OrganizationSetting struct {
+	OrganizationID    int32
+	ReportingCurrency string
+	UpdatedAt         sql.NullTime
+}
+
+type Pipeline struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	IsDefault      bool
+	CreatedAt      sql.NullTime
+	UpdatedAt      sql.NullTime
+}
+
+type PipelineStage struct {
+	ID          int32
+	PipelineID  int32
+	Name        string
+	Position    int32
 	Probability float64
-	LeadID      sql.NullInt32
-	AccountID   sql.NullInt32
-	OwnerID     sql.NullInt32
+	IsWon       bool
+	IsLost      bool
+}
+
+type PriceBook struct {
+	ID         int32
+	Name       string
+	PipelineID sql.NullInt32
+	Currency   string
+	Active     bool
+	CreatedAt  sql.NullTime
+	UpdatedAt  sql.NullTime
+}
+
+type PriceBookEntry struct {
+	PriceBookID int32
+	ProductID   int32
+	UnitPrice   float64
+}
+
+type Product struct {
+	ID          int32
+	Sku         string
+	Name        string
+	Description sql.NullString
+	ListPrice   float64
+	Currency    string
+	Active      bool
 	CreatedAt   sql.NullTime
 	UpdatedAt   sql.NullTime
 }
 
+type Proposal struct {
+	ID            int32
+	Title         string
+	Description   sql.NullString
+	Amount        float64
+	Status        string
+	ContactID     int32
+	OpportunityID sql.NullInt32
+	CreatedBy     int32
+	ValidUntil    sql.NullTime
+	SentAt        sql.NullTime
+	RespondedAt   sql.NullTime
+	CreatedAt     sql.NullTime
+	UpdatedAt     sql.NullTime
+	Currency      string
+}
+
+type ProposalLineItem struct {
+	ID          int32
+	ProposalID  int32
+	Description string
+	Quantity    float64
+	UnitPrice   float64
+	Discount    float64
+	TaxRate     float64
+	Total       float64
+	Position    int32
+}
+
+type SalesTeam struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	CreatedAt      sql.NullTime
+	UpdatedAt      sql.NullTime
+}
+
+type SalesTeamMember struct {
+	TeamID         int32
+	UserID         int32
+	Active         bool
+	LastAssignedAt sql.NullTime
+	CreatedAt      sql.NullTime
+}
+
+type SmtpCredential struct {
+	ID                 int32
+	UserID             string
+	SmtpHost           string
+	SmtpPort           int32
+	SmtpUsername       string
+	FromEmail          string
+	KeyID              string
+	WrappedKey         []byte
+	PasswordCiphertext []byte
+	CreatedAt          sql.NullTime
+	UpdatedAt          sql.NullTime
+}
+
 type Task struct {
 	ID          int32
 	Title       string
@@ -99,3 +410,26 @@ type Task struct {
 	CreatedAt   sql.NullTime
 	UpdatedAt   sql.NullTime
 }
+
+type TaxationDetail struct {
+	ID            int32
+	TaxID         string
+	TaxType       sql.NullString
+	Country       sql.NullString
+	VatRegistered bool
+	CreatedAt     sql.NullTime
+	UpdatedAt     sql.NullTime
+}
+
+type TemplateVersion struct {
+	ID           int32
+	TemplateID   int32
+	Version      int32
+	EmailSubject string
+	EmailHtml    string
+	EmailText    string
+	SmsText      string
+	InAppText    string
+	Variables    json.RawMessage
+	CreatedAt    sql.NullTime
+}
@@ -10,22 +10,79 @@ import (
 	"database/sql"
 )
 
-const createOpportunity = `-- name: CreateOpportunity :one
-INSERT INTO opportunities (name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id)
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
-RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at
+const closeOpportunity = `-- name: CloseOpportunity :one
+UPDATE opportunities
+SET stage=$2, amount=$3, probability=$4, probability_overridden=FALSE, close_date=$5, loss_reason=$6, competitor=$7,
+    closed_at=CURRENT_TIMESTAMP, amount_locked=TRUE, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked
 `
 
-type CreateOpportunityParams struct {
-	Name        sql.NullString
-	Description sql.NullString
+type CloseOpportunityParams struct {
+	ID          int32
 	Stage       sql.NullString
 	Amount      float64
-	CloseDate   sql.NullTime
 	Probability float64
-	LeadID      sql.NullInt32
-	AccountID   sql.NullInt32
-	OwnerID     sql.NullInt32
+	CloseDate   sql.NullTime
+	LossReason  sql.NullString
+	Competitor  sql.NullString
+}
+
+func (q *Queries) CloseOpportunity(ctx context.Context, arg CloseOpportunityParams) (Opportunity, error) {
+	row := q.db.QueryRowContext(ctx, closeOpportunity,
+		arg.ID,
+		arg.Stage,
+		arg.Amount,
+		arg.Probability,
+		arg.CloseDate,
+		arg.LossReason,
+		arg.Competitor,
+	)
+	var i Opportunity
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Stage,
+		&i.Amount,
+		&i.CloseDate,
+		&i.Probability,
+		&i.LeadID,
+		&i.AccountID,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PipelineID,
+		&i.ProbabilityOverridden,
+		&i.Currency,
+		&i.LossReason,
+		&i.Competitor,
+		&i.ClosedAt,
+		&i.AmountLocked,
+	)
+	return i, err
+}
+
+const createOpportunity = `-- name: CreateOpportunity :one
+INSERT INTO opportunities (name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id,
+                           pipeline_id, probability_overridden, currency)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
+RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked
+`
+
+type CreateOpportunityParams struct {
+	Name                  sql.NullString
+	Description           sql.NullString
+	Stage                 sql.NullString
+	Amount                float64
+	CloseDate             sql.NullTime
+	Probability           float64
+	LeadID                sql.NullInt32
+	AccountID             sql.NullInt32
+	OwnerID               sql.NullInt32
+	PipelineID            sql.NullInt32
+	ProbabilityOverridden bool
+	Currency              string
 }
 
 func (q *Queries) CreateOpportunity(ctx context.Context, arg CreateOpportunityParams) (Opportunity, error) {
@@ -39,6 +96,9 @@ func (q *Queries) CreateOpportunity(ctx context.Context, arg CreateOpportunityPa
 		arg.LeadID,
 		arg.AccountID,
 		arg.OwnerID,
+		arg.PipelineID,
+		arg.ProbabilityOverridden,
+		arg.Currency,
 	)
 	var i Opportunity
 	err := row.Scan(
@@ -54,6 +114,13 @@ func (q *Queries) CreateOpportunity(ctx context.Context, arg CreateOpportunityPa
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PipelineID,
+		&i.ProbabilityOverridden,
+		&i.Currency,
+		&i.LossReason,
+		&i.Competitor,
+		&i.ClosedAt,
+		&i.AmountLocked,
 	)
 	return i, err
 }
@@ -68,7 +135,7 @@ func (q *Queries) DeleteOpportunity(ctx context.Context, id int32) error {
 }
 
 const getOpportunity = `-- name: GetOpportunity :one
-SELECT id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at FROM opportunities WHERE id = $1 LIMIT 1
+SELECT id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked FROM opportunities WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetOpportunity(ctx context.Context, id int32) (Opportunity, error) {
@@ -87,12 +154,50 @@ func (q *Queries) GetOpportunity(ctx context.Context, id int32) (Opportunity, er
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PipelineID,
+		&i.ProbabilityOverridden,
+		&i.Currency,
+		&i.LossReason,
+		&i.Competitor,
+		&i.ClosedAt,
+		&i.AmountLocked,
+	)
+	return i, err
+}
+
+const getOpportunityForUpdate = `-- name: GetOpportunityForUpdate :one
+SELECT id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked FROM opportunities WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetOpportunityForUpdate(ctx context.Context, id int32) (Opportunity, error) {
+	row := q.db.QueryRowContext(ctx, getOpportunityForUpdate, id)
+	var i Opportunity
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Stage,
+		&i.Amount,
+		&i.CloseDate,
+		&i.Probability,
+		&i.LeadID,
+		&i.AccountID,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PipelineID,
+		&i.ProbabilityOverridden,
+		&i.Currency,
+		&i.LossReason,
+		&i.Competitor,
+		&i.ClosedAt,
+		&i.AmountLocked,
 	)
 	return i, err
 }
 
 const listOpportunities = `-- name: ListOpportunities :many
-SELECT id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at
+SELECT id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked
 FROM opportunities
 WHERE ($1::int = 0 OR owner_id = $1)
 ORDER BY created_at DESC
@@ -120,6 +225,13 @@ func (q *Queries) ListOpportunities(ctx context.Context, dollar_1 int32) ([]Oppo
 			&i.OwnerID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PipelineID,
+			&i.ProbabilityOverridden,
+			&i.Currency,
+			&i.LossReason,
+			&i.Competitor,
+			&i.ClosedAt,
+			&i.AmountLocked,
 		); err != nil {
 			return nil, err
 		}
@@ -136,16 +248,18 @@ func (q *Queries) ListOpportunities(ctx context.Context, dollar_1 int32) ([]Oppo
 
 const updateOpportunity = `-- name: UpdateOpportunity :one
 UPDATE opportunities
-SET stage=$2, amount=$3, probability=$4, updated_at=CURRENT_TIMESTAMP
+SET stage=$2, amount=$3, probability=$4, probability_overridden=$5, close_date=$6, updated_at=CURRENT_TIMESTAMP
 WHERE id=$1
-RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at
+RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked
 `
 
 type UpdateOpportunityParams struct {
-	ID          int32
-	Stage       sql.NullString
-	Amount      float64
-	Probability float64
+	ID                    int32
+	Stage                 sql.NullString
+	Amount                float64
+	Probability           float64
+	ProbabilityOverridden bool
+	CloseDate             sql.NullTime
 }
 
 func (q *Queries) UpdateOpportunity(ctx context.Context, arg UpdateOpportunityParams) (Opportunity, error) {
@@ -154,6 +268,8 @@ func (q *Queries) UpdateOpportunity(ctx context.Context, arg UpdateOpportunityPa
 		arg.Stage,
 		arg.Amount,
 		arg.Probability,
+		arg.ProbabilityOverridden,
+		arg.CloseDate,
 	)
 	var i Opportunity
 	err := row.Scan(
@@ -169,6 +285,13 @@ func (q *Queries) UpdateOpportunity(ctx context.Context, arg UpdateOpportunityPa
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PipelineID,
+		&i.ProbabilityOverridden,
+		&i.Currency,
+		&i.LossReason,
+		&i.Competitor,
+		&i.ClosedAt,
+		&i.AmountLocked,
 	)
 	return i, err
 }
@@ -187,7 +310,7 @@ SET
   owner_id    = COALESCE($9, owner_id),
   updated_at  = CURRENT_TIMESTAMP
 WHERE id = $10
-RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at
+RETURNING id, name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id, created_at, updated_at, pipeline_id, probability_overridden, currency, loss_reason, competitor, closed_at, amount_locked
 `
 
 type UpdateOpportunitySelectiveParams struct {
@@ -230,6 +353,13 @@ func (q *Queries) UpdateOpportunitySelective(ctx context.Context, arg UpdateOppo
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PipelineID,
+		&i.ProbabilityOverridden,
+		&i.Currency,
+		&i.LossReason,
+		&i.Competitor,
+		&i.ClosedAt,
+		&i.AmountLocked,
 	)
 	return i, err
 }
@@ -17,6 +17,16 @@ CREATE TABLE companies (
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Taxation details
+CREATE TABLE taxation_details (
+    id SERIAL PRIMARY KEY,
+    tax_id VARCHAR(50) NOT NULL,
+    tax_type VARCHAR(50),
+    country VARCHAR(100),
+    vat_registered BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
 
 -- Contacts
 CREATE TABLE contacts (
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS meeting_attendees;
+DROP TABLE IF EXISTS meetings;
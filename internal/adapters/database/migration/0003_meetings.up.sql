@@ -0,0 +1,29 @@
+-- Meetings
+CREATE TABLE meetings (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    meeting_link VARCHAR(500),
+    organizer_id INT NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'Scheduled',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_time > start_time)
+);
+
+-- Meeting attendees: each row is either a contact or a lead
+CREATE TABLE meeting_attendees (
+    id SERIAL PRIMARY KEY,
+    meeting_id INT NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+    contact_id INT REFERENCES contacts(id) ON DELETE CASCADE,
+    lead_id INT REFERENCES leads(id) ON DELETE CASCADE,
+    CHECK ((contact_id IS NULL) <> (lead_id IS NULL)),
+    UNIQUE (meeting_id, contact_id),
+    UNIQUE (meeting_id, lead_id)
+);
+
+CREATE INDEX idx_meetings_time_range ON meetings (start_time, end_time);
+CREATE INDEX idx_meeting_attendees_contact ON meeting_attendees (contact_id);
+CREATE INDEX idx_meeting_attendees_lead ON meeting_attendees (lead_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS proposal_line_items;
+DROP TABLE IF EXISTS proposals;
@@ -0,0 +1,35 @@
+-- Proposals
+CREATE TABLE proposals (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    amount NUMERIC(12,2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'sent', 'accepted', 'rejected', 'expired')),
+    contact_id INT NOT NULL REFERENCES contacts(id),
+    opportunity_id INT REFERENCES opportunities(id) ON DELETE SET NULL,
+    created_by INT NOT NULL,
+    valid_until TIMESTAMP,
+    sent_at TIMESTAMP,
+    responded_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Proposal line items; total is quantity * unit_price less discount plus tax
+CREATE TABLE proposal_line_items (
+    id SERIAL PRIMARY KEY,
+    proposal_id INT NOT NULL REFERENCES proposals(id) ON DELETE CASCADE,
+    description VARCHAR(500) NOT NULL,
+    quantity NUMERIC(12,2) NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(12,2) NOT NULL CHECK (unit_price >= 0),
+    discount NUMERIC(5,2) NOT NULL DEFAULT 0 CHECK (discount >= 0 AND discount <= 100),
+    tax_rate NUMERIC(5,2) NOT NULL DEFAULT 0 CHECK (tax_rate >= 0),
+    total NUMERIC(12,2) NOT NULL,
+    position INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_proposals_contact ON proposals (contact_id);
+CREATE INDEX idx_proposals_opportunity ON proposals (opportunity_id);
+CREATE INDEX idx_proposals_status_valid_until ON proposals (status, valid_until);
+CREATE INDEX idx_proposal_line_items_proposal ON proposal_line_items (proposal_id);
@@ -0,0 +1,26 @@
+-- Notification templates; the bodies live in template_versions so that a
+-- logged notification can always be re-rendered with the text it was sent with
+CREATE TABLE notification_templates (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    url VARCHAR(500),
+    current_version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One row per saved revision. variables maps every variable the bodies may
+-- reference to a sample value used for previews.
+CREATE TABLE template_versions (
+    id SERIAL PRIMARY KEY,
+    template_id INT NOT NULL REFERENCES notification_templates(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    email_subject TEXT NOT NULL DEFAULT '',
+    email_html TEXT NOT NULL DEFAULT '',
+    email_text TEXT NOT NULL DEFAULT '',
+    sms_text TEXT NOT NULL DEFAULT '',
+    in_app_text TEXT NOT NULL DEFAULT '',
+    variables JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (template_id, version)
+);
@@ -0,0 +1,25 @@
+-- One row per notification request, updated on every delivery attempt.
+-- data and template_version are kept so a retry renders the same message.
+CREATE TABLE notification_logs (
+    id VARCHAR(64) PRIMARY KEY,
+    notification_type VARCHAR(100) NOT NULL DEFAULT '',
+    channel VARCHAR(20) NOT NULL,
+    user_id VARCHAR(100) NOT NULL DEFAULT '',
+    recipient VARCHAR(255) NOT NULL,
+    template_name VARCHAR(255) NOT NULL,
+    template_version INT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sent', 'retrying', 'failed')),
+    error_message TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notification_logs_created_at ON notification_logs (created_at);
+CREATE INDEX idx_notification_logs_recipient ON notification_logs (recipient);
+CREATE INDEX idx_notification_logs_template ON notification_logs (template_name);
+CREATE INDEX idx_notification_logs_retry ON notification_logs (next_attempt_at) WHERE status = 'retrying';
@@ -0,0 +1,17 @@
+-- Per-user SMTP credentials. The password is stored encrypted: key_id names
+-- the configured key that wrapped the per-row data key.
+CREATE TABLE smtp_credentials (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL UNIQUE,
+    smtp_host VARCHAR(255) NOT NULL,
+    smtp_port INT NOT NULL CHECK (smtp_port > 0 AND smtp_port <= 65535),
+    smtp_username VARCHAR(255) NOT NULL DEFAULT '',
+    from_email VARCHAR(255) NOT NULL,
+    key_id VARCHAR(100) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    password_ciphertext BYTEA NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_smtp_credentials_key_id ON smtp_credentials (key_id);
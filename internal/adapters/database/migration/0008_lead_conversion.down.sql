@@ -0,0 +1,5 @@
+ALTER TABLE leads
+    DROP COLUMN IF EXISTS converted_opportunity_id,
+    DROP COLUMN IF EXISTS converted_company_id,
+    DROP COLUMN IF EXISTS converted_contact_id,
+    DROP COLUMN IF EXISTS converted_at;
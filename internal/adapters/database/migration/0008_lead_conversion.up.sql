@@ -0,0 +1,6 @@
+-- Lead conversion: links from a converted lead to the records created from it
+ALTER TABLE leads
+    ADD COLUMN converted_at TIMESTAMP,
+    ADD COLUMN converted_contact_id INT REFERENCES contacts(id) ON DELETE SET NULL,
+    ADD COLUMN converted_company_id INT REFERENCES companies(id) ON DELETE SET NULL,
+    ADD COLUMN converted_opportunity_id INT REFERENCES opportunities(id) ON DELETE SET NULL;
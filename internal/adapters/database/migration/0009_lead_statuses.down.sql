@@ -0,0 +1,4 @@
+ALTER TABLE leads DROP COLUMN IF EXISTS status_entered_at;
+DROP TABLE IF EXISTS lead_status_history;
+DROP TABLE IF EXISTS lead_status_transitions;
+DROP TABLE IF EXISTS lead_statuses;
@@ -0,0 +1,34 @@
+-- Per-organization lead workflows. Organizations without rows here use the
+-- built-in default workflow.
+CREATE TABLE lead_statuses (
+    id SERIAL PRIMARY KEY,
+    organization_id INT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    is_initial BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (organization_id, name)
+);
+
+CREATE TABLE lead_status_transitions (
+    organization_id INT NOT NULL,
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    PRIMARY KEY (organization_id, from_status, to_status)
+);
+
+-- Every status a lead entered, including the one it was created with
+CREATE TABLE lead_status_history (
+    id SERIAL PRIMARY KEY,
+    lead_id INT NOT NULL REFERENCES leads(id) ON DELETE CASCADE,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    entered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_lead_status_history_lead ON lead_status_history (lead_id, entered_at);
+
+ALTER TABLE leads ADD COLUMN status_entered_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+UPDATE leads SET status_entered_at = COALESCE(updated_at, created_at, CURRENT_TIMESTAMP);
+INSERT INTO lead_status_history (lead_id, to_status, entered_at)
+SELECT id, status, status_entered_at FROM leads;
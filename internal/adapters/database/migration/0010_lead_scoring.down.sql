@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_leads_score;
+ALTER TABLE leads
+    DROP COLUMN IF EXISTS score_updated_at,
+    DROP COLUMN IF EXISTS score;
+DROP TABLE IF EXISTS lead_scoring_rules;
@@ -0,0 +1,22 @@
+-- Lead scoring rules. Rules without an organization apply to every lead.
+CREATE TABLE lead_scoring_rules (
+    id SERIAL PRIMARY KEY,
+    organization_id INT,
+    name VARCHAR(100) NOT NULL,
+    rule_type VARCHAR(20) NOT NULL CHECK (rule_type IN ('field', 'activity_count', 'company_domain')),
+    field VARCHAR(50),
+    operator VARCHAR(20) NOT NULL DEFAULT '',
+    value VARCHAR(255) NOT NULL DEFAULT '',
+    weight INT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_lead_scoring_rules_org ON lead_scoring_rules (organization_id);
+
+ALTER TABLE leads
+    ADD COLUMN score INT NOT NULL DEFAULT 0,
+    ADD COLUMN score_updated_at TIMESTAMP;
+
+CREATE INDEX idx_leads_score ON leads (score DESC, id);
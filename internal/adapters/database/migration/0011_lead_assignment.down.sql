@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_leads_open_assigned_to;
+ALTER TABLE leads
+    DROP COLUMN IF EXISTS assignment_reason,
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS company_id,
+    DROP COLUMN IF EXISTS state,
+    DROP COLUMN IF EXISTS country;
+DROP TABLE IF EXISTS lead_assignment_rules;
+DROP TABLE IF EXISTS sales_team_members;
+DROP TABLE IF EXISTS sales_teams;
@@ -0,0 +1,51 @@
+-- Sales teams whose members receive automatically assigned leads.
+-- last_assigned_at drives round-robin: the member who waited longest is next.
+CREATE TABLE sales_teams (
+    id SERIAL PRIMARY KEY,
+    organization_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT sales_teams_org_name_key UNIQUE (organization_id, name)
+);
+
+CREATE TABLE sales_team_members (
+    team_id INT NOT NULL REFERENCES sales_teams(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    last_assigned_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, user_id)
+);
+
+-- Assignment rules are tried in priority order. Empty country, state and
+-- industry match any lead; the first matching rule picks a member of its
+-- team by its strategy.
+CREATE TABLE lead_assignment_rules (
+    id SERIAL PRIMARY KEY,
+    organization_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    strategy VARCHAR(20) NOT NULL CHECK (strategy IN ('round_robin', 'least_loaded')),
+    team_id INT NOT NULL REFERENCES sales_teams(id) ON DELETE CASCADE,
+    country VARCHAR(100) NOT NULL DEFAULT '',
+    state VARCHAR(100) NOT NULL DEFAULT '',
+    industry VARCHAR(100) NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_lead_assignment_rules_org ON lead_assignment_rules (organization_id, priority, id);
+
+-- Territory and industry come from the lead, falling back to its company.
+ALTER TABLE leads
+    ADD COLUMN country VARCHAR(100),
+    ADD COLUMN state VARCHAR(100),
+    ADD COLUMN company_id INT REFERENCES companies(id) ON DELETE SET NULL,
+    ADD COLUMN assigned_at TIMESTAMP,
+    ADD COLUMN assignment_reason VARCHAR(255);
+
+UPDATE leads SET assigned_at = created_at WHERE assigned_to IS NOT NULL;
+
+CREATE INDEX idx_leads_open_assigned_to ON leads (assigned_to) WHERE converted_at IS NULL;
@@ -0,0 +1,16 @@
+-- One row per record merged into a survivor. merged_record is the merged
+-- record as it was before it was deleted, filled_fields the survivor
+-- fields that were empty and taken from it, and repointed the number of
+-- related rows moved to the survivor, by table.
+CREATE TABLE merge_audits (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('contact', 'lead')),
+    survivor_id INT NOT NULL,
+    merged_id INT NOT NULL,
+    merged_record JSONB NOT NULL,
+    filled_fields JSONB NOT NULL DEFAULT '{}',
+    repointed JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_merge_audits_survivor ON merge_audits (entity_type, survivor_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_opportunities_pipeline;
+ALTER TABLE opportunities
+    DROP COLUMN IF EXISTS probability_overridden,
+    DROP COLUMN IF EXISTS pipeline_id;
+DROP TABLE IF EXISTS pipeline_stages;
+DROP TABLE IF EXISTS pipelines;
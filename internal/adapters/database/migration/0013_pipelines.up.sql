@@ -0,0 +1,38 @@
+-- Sales pipelines. An organization may mark one pipeline as its default;
+-- opportunities without a pipeline use the built-in default stages.
+CREATE TABLE pipelines (
+    id SERIAL PRIMARY KEY,
+    organization_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pipelines_org_name_key UNIQUE (organization_id, name)
+);
+
+CREATE UNIQUE INDEX idx_pipelines_one_default ON pipelines (organization_id) WHERE is_default;
+
+-- Stages in pipeline order. probability is the default win probability of
+-- opportunities in the stage.
+CREATE TABLE pipeline_stages (
+    id SERIAL PRIMARY KEY,
+    pipeline_id INT NOT NULL REFERENCES pipelines(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    position INT NOT NULL,
+    probability NUMERIC(5,2) NOT NULL CHECK (probability BETWEEN 0 AND 100),
+    is_won BOOLEAN NOT NULL DEFAULT FALSE,
+    is_lost BOOLEAN NOT NULL DEFAULT FALSE,
+    CHECK (NOT (is_won AND is_lost)),
+    UNIQUE (pipeline_id, name)
+);
+
+-- probability_overridden is set when the probability was entered by hand
+-- rather than taken from the stage.
+ALTER TABLE opportunities
+    ADD COLUMN pipeline_id INT REFERENCES pipelines(id),
+    ADD COLUMN probability_overridden BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing probabilities were all entered by hand.
+UPDATE opportunities SET probability_overridden = TRUE;
+
+CREATE INDEX idx_opportunities_pipeline ON opportunities (pipeline_id);
@@ -0,0 +1,24 @@
+-- Every change to an opportunity's stage, amount, probability or close
+-- date, including the values it was created with (old_value NULL). Values
+-- are stored as text: amounts and probabilities with two decimals, close
+-- dates as YYYY-MM-DD.
+CREATE TABLE opportunity_history (
+    id SERIAL PRIMARY KEY,
+    opportunity_id INT NOT NULL REFERENCES opportunities(id) ON DELETE CASCADE,
+    field VARCHAR(20) NOT NULL CHECK (field IN ('stage', 'amount', 'probability', 'close_date')),
+    old_value TEXT,
+    new_value TEXT,
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_opportunity_history_opportunity ON opportunity_history (opportunity_id, changed_at);
+CREATE INDEX idx_opportunity_history_field ON opportunity_history (field, changed_at);
+
+INSERT INTO opportunity_history (opportunity_id, field, new_value, changed_at)
+SELECT id, 'stage', stage, COALESCE(created_at, CURRENT_TIMESTAMP) FROM opportunities
+UNION ALL
+SELECT id, 'amount', to_char(amount, 'FM9999999990.00'), COALESCE(created_at, CURRENT_TIMESTAMP) FROM opportunities
+UNION ALL
+SELECT id, 'probability', to_char(probability, 'FM990.00'), COALESCE(created_at, CURRENT_TIMESTAMP) FROM opportunities
+UNION ALL
+SELECT id, 'close_date', to_char(close_date, 'YYYY-MM-DD'), COALESCE(created_at, CURRENT_TIMESTAMP) FROM opportunities;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_opportunities_close_date;
+DROP TABLE IF EXISTS forecast_snapshots;
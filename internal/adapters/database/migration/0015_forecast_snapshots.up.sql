@@ -0,0 +1,18 @@
+-- Forecasts saved for later comparison with actuals. The parameters the
+-- forecast was computed with are kept so it can be recomputed; rows holds
+-- the projected figures as JSON.
+CREATE TABLE forecast_snapshots (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    period VARCHAR(10) NOT NULL CHECK (period IN ('month', 'quarter')),
+    group_by VARCHAR(10) NOT NULL CHECK (group_by IN ('owner', 'pipeline', 'stage')),
+    close_from DATE NOT NULL,
+    close_to DATE NOT NULL,
+    owner_id INT NOT NULL DEFAULT 0,
+    pipeline_id INT NOT NULL DEFAULT 0,
+    rows JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_forecast_snapshots_created ON forecast_snapshots (created_at);
+CREATE INDEX idx_opportunities_close_date ON opportunities (close_date);
@@ -0,0 +1,5 @@
+ALTER TABLE forecast_snapshots DROP COLUMN IF EXISTS currency;
+DROP TABLE IF EXISTS organization_settings;
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE proposals DROP COLUMN IF EXISTS currency;
+ALTER TABLE opportunities DROP COLUMN IF EXISTS currency;
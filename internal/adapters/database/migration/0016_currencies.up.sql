@@ -0,0 +1,30 @@
+-- ISO 4217 currency codes on monetary fields. Existing amounts are taken to
+-- be in US dollars.
+ALTER TABLE opportunities
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD' CHECK (currency ~ '^[A-Z]{3}$');
+ALTER TABLE proposals
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD' CHECK (currency ~ '^[A-Z]{3}$');
+
+-- One unit of from_currency is worth rate units of to_currency from
+-- effective_date until the next rate for the pair.
+CREATE TABLE exchange_rates (
+    id SERIAL PRIMARY KEY,
+    from_currency CHAR(3) NOT NULL CHECK (from_currency ~ '^[A-Z]{3}$'),
+    to_currency CHAR(3) NOT NULL CHECK (to_currency ~ '^[A-Z]{3}$'),
+    rate NUMERIC(20,10) NOT NULL CHECK (rate > 0),
+    effective_date DATE NOT NULL,
+    source VARCHAR(20) NOT NULL DEFAULT 'api',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (from_currency <> to_currency),
+    CONSTRAINT exchange_rates_pair_date_key UNIQUE (from_currency, to_currency, effective_date)
+);
+
+-- The currency an organization's reports are converted to.
+CREATE TABLE organization_settings (
+    organization_id INT PRIMARY KEY,
+    reporting_currency CHAR(3) NOT NULL DEFAULT 'USD' CHECK (reporting_currency ~ '^[A-Z]{3}$'),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Currency the saved forecast figures are in.
+ALTER TABLE forecast_snapshots ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
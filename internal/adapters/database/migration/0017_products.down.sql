@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS opportunity_line_items;
+DROP TABLE IF EXISTS price_book_entries;
+DROP TABLE IF EXISTS price_books;
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,54 @@
+-- Product catalog.
+CREATE TABLE products (
+    id SERIAL PRIMARY KEY,
+    sku VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    list_price NUMERIC(12,2) NOT NULL CHECK (list_price >= 0),
+    currency CHAR(3) NOT NULL DEFAULT 'USD' CHECK (currency ~ '^[A-Z]{3}$'),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT products_sku_key UNIQUE (sku)
+);
+
+-- Prices of products for the opportunities of one pipeline, or for all
+-- pipelines without a book of their own when pipeline_id is NULL.
+CREATE TABLE price_books (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    pipeline_id INT REFERENCES pipelines(id) ON DELETE CASCADE,
+    currency CHAR(3) NOT NULL DEFAULT 'USD' CHECK (currency ~ '^[A-Z]{3}$'),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT price_books_name_key UNIQUE (name)
+);
+
+CREATE TABLE price_book_entries (
+    price_book_id INT NOT NULL REFERENCES price_books(id) ON DELETE CASCADE,
+    product_id INT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    unit_price NUMERIC(12,2) NOT NULL CHECK (unit_price >= 0),
+    PRIMARY KEY (price_book_id, product_id)
+);
+
+-- Products sold on an opportunity. total is quantity * unit_price less the
+-- discount, plus tax on the discounted price.
+CREATE TABLE opportunity_line_items (
+    id SERIAL PRIMARY KEY,
+    opportunity_id INT NOT NULL REFERENCES opportunities(id) ON DELETE CASCADE,
+    product_id INT NOT NULL REFERENCES products(id),
+    price_book_id INT REFERENCES price_books(id) ON DELETE SET NULL,
+    description TEXT,
+    quantity NUMERIC(12,2) NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(12,2) NOT NULL CHECK (unit_price >= 0),
+    discount_percent NUMERIC(5,2) NOT NULL DEFAULT 0 CHECK (discount_percent BETWEEN 0 AND 100),
+    tax_percent NUMERIC(5,2) NOT NULL DEFAULT 0 CHECK (tax_percent >= 0),
+    total NUMERIC(14,2) NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_opportunity_line_items_opportunity ON opportunity_line_items(opportunity_id, position);
+CREATE INDEX idx_opportunity_line_items_product ON opportunity_line_items(product_id);
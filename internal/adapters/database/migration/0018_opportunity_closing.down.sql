@@ -0,0 +1,6 @@
+ALTER TABLE opportunities
+    DROP COLUMN IF EXISTS amount_locked,
+    DROP COLUMN IF EXISTS closed_at,
+    DROP COLUMN IF EXISTS competitor,
+    DROP COLUMN IF EXISTS loss_reason;
+DROP TABLE IF EXISTS loss_reasons;
@@ -0,0 +1,20 @@
+-- Reasons an opportunity can be lost with, per organization. Organizations
+-- without any use the built-in list.
+CREATE TABLE loss_reasons (
+    id SERIAL PRIMARY KEY,
+    organization_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT loss_reasons_org_name_key UNIQUE (organization_id, name)
+);
+
+-- Outcome of closing an opportunity as won or lost. The amount of a closed
+-- opportunity is locked.
+ALTER TABLE opportunities
+    ADD COLUMN loss_reason VARCHAR(100),
+    ADD COLUMN competitor VARCHAR(255),
+    ADD COLUMN closed_at TIMESTAMP,
+    ADD COLUMN amount_locked BOOLEAN NOT NULL DEFAULT FALSE;
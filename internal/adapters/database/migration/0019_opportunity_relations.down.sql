@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS opportunity_contacts;
+DROP INDEX IF EXISTS idx_opportunities_account;
+ALTER TABLE opportunities DROP CONSTRAINT IF EXISTS opportunities_account_id_fkey;
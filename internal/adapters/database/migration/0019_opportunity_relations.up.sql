@@ -0,0 +1,21 @@
+-- Opportunities belong to a company. Accounts that no longer exist are
+-- cleared before the foreign key is added.
+UPDATE opportunities o SET account_id = NULL
+WHERE account_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM companies c WHERE c.id = o.account_id);
+
+ALTER TABLE opportunities
+    ADD CONSTRAINT opportunities_account_id_fkey FOREIGN KEY (account_id) REFERENCES companies(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_opportunities_account ON opportunities (account_id);
+
+-- Contacts involved in an opportunity and their roles in the deal. A
+-- contact can have several roles on one opportunity.
+CREATE TABLE opportunity_contacts (
+    opportunity_id INT NOT NULL REFERENCES opportunities(id) ON DELETE CASCADE,
+    contact_id INT NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('decision_maker', 'champion', 'influencer', 'blocker')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (opportunity_id, contact_id, role)
+);
+
+CREATE INDEX idx_opportunity_contacts_contact ON opportunity_contacts (contact_id);
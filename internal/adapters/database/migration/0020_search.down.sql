@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_leads_search_trgm;
+DROP INDEX IF EXISTS idx_leads_search;
+DROP INDEX IF EXISTS idx_companies_search_trgm;
+DROP INDEX IF EXISTS idx_companies_search;
+DROP INDEX IF EXISTS idx_contacts_search_trgm;
+DROP INDEX IF EXISTS idx_contacts_search;
+DROP FUNCTION IF EXISTS crm_search_text(text[]);
@@ -0,0 +1,25 @@
+-- Full-text and trigram search over contacts, companies and leads.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- The lower-cased text a record is searched by. Declared immutable so it
+-- can be indexed; it only joins its text arguments, skipping NULLs.
+CREATE OR REPLACE FUNCTION crm_search_text(VARIADIC parts text[]) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE
+    AS $$ SELECT lower(array_to_string(parts, ' ')) $$;
+
+-- The expressions must match those in queries/search.sql for the indexes
+-- to be used.
+CREATE INDEX idx_contacts_search ON contacts USING GIN
+    (to_tsvector('simple', crm_search_text(first_name, last_name, email, company_name, city, country, phone)));
+CREATE INDEX idx_contacts_search_trgm ON contacts USING GIN
+    (crm_search_text(first_name, last_name, email, company_name, city, country, phone) gin_trgm_ops);
+
+CREATE INDEX idx_companies_search ON companies USING GIN
+    (to_tsvector('simple', crm_search_text(name, industry, website, email, city, country, phone)));
+CREATE INDEX idx_companies_search_trgm ON companies USING GIN
+    (crm_search_text(name, industry, website, email, city, country, phone) gin_trgm_ops);
+
+CREATE INDEX idx_leads_search ON leads USING GIN
+    (to_tsvector('simple', crm_search_text(first_name, last_name, email, phone, status, country, state)));
+CREATE INDEX idx_leads_search_trgm ON leads USING GIN
+    (crm_search_text(first_name, last_name, email, phone, status, country, state) gin_trgm_ops);
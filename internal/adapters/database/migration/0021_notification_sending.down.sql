@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_notification_logs_retry;
+CREATE INDEX idx_notification_logs_retry ON notification_logs (next_attempt_at) WHERE status = 'retrying';
+
+UPDATE notification_logs SET status = 'retrying' WHERE status = 'sending';
+ALTER TABLE notification_logs DROP CONSTRAINT notification_logs_status_check;
+ALTER TABLE notification_logs ADD CONSTRAINT notification_logs_status_check
+    CHECK (status IN ('pending', 'sent', 'retrying', 'failed'));
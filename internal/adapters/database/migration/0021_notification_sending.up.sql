@@ -0,0 +1,9 @@
+-- A notification is sending while a worker delivers its first attempt.
+-- next_attempt_at holds the worker's lease so that a delivery interrupted by
+-- a crash is picked up by the retry job.
+ALTER TABLE notification_logs DROP CONSTRAINT notification_logs_status_check;
+ALTER TABLE notification_logs ADD CONSTRAINT notification_logs_status_check
+    CHECK (status IN ('pending', 'sending', 'sent', 'retrying', 'failed'));
+
+DROP INDEX IF EXISTS idx_notification_logs_retry;
+CREATE INDEX idx_notification_logs_retry ON notification_logs (next_attempt_at) WHERE status IN ('retrying', 'sending');
@@ -0,0 +1 @@
+ALTER TABLE lead_statuses DROP COLUMN IF EXISTS is_closed;
@@ -0,0 +1,12 @@
+-- Leads in a closed status no longer count towards their owner's open leads.
+-- Existing workflows keep treating Unqualified as closed, together with
+-- statuses a lead cannot leave.
+ALTER TABLE lead_statuses ADD COLUMN is_closed BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE lead_statuses s
+SET is_closed = TRUE
+WHERE s.name = 'Unqualified'
+   OR NOT EXISTS (
+       SELECT 1 FROM lead_status_transitions t
+       WHERE t.organization_id = s.organization_id AND t.from_status = s.name
+   );
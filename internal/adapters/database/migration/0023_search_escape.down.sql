@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS crm_html_escape(text);
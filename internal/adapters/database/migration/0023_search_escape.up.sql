@@ -0,0 +1,5 @@
+-- Escapes text for HTML so that search highlights only ever contain the
+-- <mark> tags added by ts_headline.
+CREATE OR REPLACE FUNCTION crm_html_escape(t text) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE
+    AS $$ SELECT replace(replace(replace(replace(replace(t, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;') $$;
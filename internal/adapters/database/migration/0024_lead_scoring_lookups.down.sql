@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_leads_converted_contact;
+DROP INDEX IF EXISTS idx_companies_org_website_domain;
+ALTER TABLE companies DROP COLUMN IF EXISTS website_domain;
@@ -0,0 +1,12 @@
+-- The host part of a company's website, lower-cased and without scheme or
+-- www., so lead scoring can match email domains through an index instead
+-- of parsing every website on each lookup.
+ALTER TABLE companies
+    ADD COLUMN website_domain TEXT GENERATED ALWAYS AS (
+        lower(regexp_replace(website, '^([a-z]+://)?(www\.)?([^/:?#]+).*$', '\3', 'i'))
+    ) STORED;
+
+CREATE INDEX idx_companies_org_website_domain ON companies (organization_id, website_domain);
+
+-- Activity events rescore the leads converted to the activity's contact.
+CREATE INDEX idx_leads_converted_contact ON leads (converted_contact_id);
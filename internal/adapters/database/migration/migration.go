@@ -0,0 +1,351 @@
+// Package migration embeds the schema migrations and applies them.
+//
+// Migrations are pairs of NNNN_name.up.sql and NNNN_name.down.sql files in
+// this directory, which is also the schema sqlc generates from. Versions
+// need not be contiguous. Applied versions are recorded in
+// schema_migrations together with a checksum of the up file, so an edited
+// migration shows up as drift in Status and Check.
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed *.sql
+var migrationFiles embed.FS
+
+//go:embed seed/seed.sql
+var seedSQL string
+
+var (
+	ErrInvalidMigrations = errors.New("invalid migration files")
+	ErrUnknownVersion    = errors.New("database has migrations this binary does not know")
+)
+
+// lockKey identifies the advisory lock held while migrating, so concurrent
+// runners, e.g. several replicas starting at once, apply migrations one
+// at a time.
+const lockKey int64 = 0x63726d5f6d6967 // "crm_mig"
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+const createTrackingTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    checksum VARCHAR(64) NOT NULL,
+    applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+// Migration is one embedded migration.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Status describes a migration as seen by the database.
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+	// Modified is set when the applied checksum differs from the embedded
+	// up file.
+	Modified bool
+}
+
+// Load returns the embedded migrations in version order. It fails when a
+// file is misnamed, a version is used twice or an up file has no down file
+// or vice versa.
+func Load() ([]Migration, error) {
+	return load(migrationFiles)
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	byVersion := map[int64]*Migration{}
+	for _, name := range names {
+		m := fileNamePattern.FindStringSubmatch(name)
+		if m == nil {
+			problems = append(problems, fmt.Sprintf("%s: expected NNNN_name.up.sql or NNNN_name.down.sql", name))
+			continue
+		}
+		version, _ := strconv.ParseInt(m[1], 10, 64)
+		body, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		} else if mig.Name != m[2] {
+			problems = append(problems, fmt.Sprintf("version %d is used by both %q and %q", version, mig.Name, m[2]))
+			continue
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+			sum := sha256.Sum256(body)
+			mig.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			problems = append(problems, fmt.Sprintf("%04d_%s: missing or empty up file", mig.Version, mig.Name))
+		}
+		if mig.Down == "" {
+			problems = append(problems, fmt.Sprintf("%04d_%s: missing or empty down file", mig.Version, mig.Name))
+		}
+		migrations = append(migrations, *mig)
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMigrations, problems)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Runner applies the embedded migrations to a database.
+type Runner struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewRunner(conn *sql.DB) (*Runner, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{db: conn, migrations: migrations}, nil
+}
+
+// Up applies up to steps pending migrations in version order, or all of
+// them when steps <= 0, and returns the ones applied.
+func (r *Runner) Up(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := r.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if unknown := r.unknown(applied); len(unknown) > 0 {
+			return fmt.Errorf("%w: %v", ErrUnknownVersion, unknown)
+		}
+
+		for _, mig := range r.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if steps > 0 && len(done) == steps {
+				break
+			}
+			if err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+					mig.Version, mig.Name, mig.Checksum)
+				return err
+			}); err != nil {
+				return fmt.Errorf("apply %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down rolls back the steps most recently applied migrations, or all of
+// them when steps <= 0, and returns the ones rolled back.
+func (r *Runner) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := r.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if unknown := r.unknown(applied); len(unknown) > 0 {
+			return fmt.Errorf("%w: %v", ErrUnknownVersion, unknown)
+		}
+
+		for i := len(r.migrations) - 1; i >= 0; i-- {
+			mig := r.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if steps > 0 && len(done) == steps {
+				break
+			}
+			if err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, mig.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("roll back %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Status reports every embedded migration and whether it is applied. The
+// second result lists applied versions this binary does not embed.
+func (r *Runner) Status(ctx context.Context) ([]Status, []int64, error) {
+	var (
+		statuses []Status
+		unknown  []int64
+	)
+	err := r.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range r.migrations {
+			st := Status{Migration: mig}
+			if rec, ok := applied[mig.Version]; ok {
+				st.Applied = true
+				st.AppliedAt = rec.appliedAt
+				st.Modified = rec.checksum != mig.Checksum
+			}
+			statuses = append(statuses, st)
+		}
+		unknown = r.unknown(applied)
+		return nil
+	})
+	return statuses, unknown, err
+}
+
+// Seed loads the development sample data. It expects every migration to be
+// applied and can be run repeatedly.
+func (r *Runner) Seed(ctx context.Context) error {
+	return r.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range r.migrations {
+			if _, ok := applied[mig.Version]; !ok {
+				return fmt.Errorf("migration %04d_%s is not applied; run migrate up first", mig.Version, mig.Name)
+			}
+		}
+		return inTx(ctx, conn, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, seedSQL)
+			return err
+		})
+	})
+}
+
+// locked runs fn on a single connection holding the migration advisory
+// lock, creating the tracking table first. Session-level advisory locks
+// belong to a connection, hence the dedicated one.
+func (r *Runner) locked(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so a cancelled run still releases the lock.
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey); unlockErr != nil && err == nil {
+			err = fmt.Errorf("release migration lock: %w", unlockErr)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createTrackingTable); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+type appliedRecord struct {
+	checksum  string
+	appliedAt time.Time
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]appliedRecord, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]appliedRecord{}
+	for rows.Next() {
+		var (
+			version int64
+			rec     appliedRecord
+		)
+		if err := rows.Scan(&version, &rec.checksum, &rec.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = rec
+	}
+	return applied, rows.Err()
+}
+
+func (r *Runner) unknown(applied map[int64]appliedRecord) []int64 {
+	known := make(map[int64]bool, len(r.migrations))
+	for _, mig := range r.migrations {
+		known[mig.Version] = true
+	}
+	var unknown []int64
+	for version := range applied {
+		if !known[version] {
+			unknown = append(unknown, version)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+	return unknown
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// FileName returns the up file name of m, e.g. 0003_meetings.up.sql.
+func (m Migration) FileName() string {
+	return fmt.Sprintf("%04d_%s.up.sql", m.Version, m.Name)
+}
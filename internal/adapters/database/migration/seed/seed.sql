@@ -0,0 +1,60 @@
+-- Sample data for development databases, applied by `migrate seed`. Every
+-- insert skips rows that already exist, so seeding twice is harmless.
+
+-- Companies
+INSERT INTO companies (name, industry, website, phone, email, country, organization_id)
+SELECT v.name, v.industry, v.website, v.phone, v.email, v.country, 1
+FROM (VALUES
+    ('Acme Corp', 'Manufacturing', 'https://acme.com', '123-456-7890', 'info@acme.com', 'USA'),
+    ('Globex Ltd', 'Finance', 'https://globex.com', '987-654-3210', 'contact@globex.com', 'UK')
+) AS v(name, industry, website, phone, email, country)
+WHERE NOT EXISTS (SELECT 1 FROM companies c WHERE c.name = v.name);
+
+-- Contacts
+INSERT INTO contacts (contact_type, first_name, last_name, email, phone, company_id, country)
+VALUES
+('individual', 'John', 'Doe', 'john.doe@example.com', '111-222-3333', (SELECT id FROM companies WHERE name = 'Acme Corp' ORDER BY id LIMIT 1), 'USA'),
+('individual', 'Jane', 'Smith', 'jane.smith@example.com', '444-555-6666', (SELECT id FROM companies WHERE name = 'Globex Ltd' ORDER BY id LIMIT 1), 'UK')
+ON CONFLICT (email) DO NOTHING;
+
+-- Leads
+INSERT INTO leads (first_name, last_name, email, phone, status, assigned_to, organization_id)
+VALUES
+('Alice', 'Johnson', 'alice.j@example.com', '555-123-4567', 'New', 101, 1),
+('Bob', 'Williams', 'bob.w@example.com', '555-987-6543', 'Contacted', 102, 1)
+ON CONFLICT (email) DO NOTHING;
+
+INSERT INTO lead_status_history (lead_id, to_status)
+SELECT l.id, l.status FROM leads l
+WHERE l.email IN ('alice.j@example.com', 'bob.w@example.com')
+  AND NOT EXISTS (SELECT 1 FROM lead_status_history h WHERE h.lead_id = l.id);
+
+-- Opportunities
+INSERT INTO opportunities (name, description, stage, amount, probability, lead_id, account_id, owner_id)
+SELECT v.name, v.description, v.stage, v.amount, v.probability,
+       (SELECT id FROM leads WHERE email = v.lead_email),
+       (SELECT id FROM companies WHERE name = v.company ORDER BY id LIMIT 1),
+       v.owner_id
+FROM (VALUES
+    ('CRM Upgrade Project', 'Upgrade CRM system for Acme Corp', 'Proposal', 50000.00, 60.0, 'alice.j@example.com', 'Acme Corp', 1001),
+    ('New Partnership', 'Potential partnership with Globex', 'Negotiation', 75000.00, 40.0, 'bob.w@example.com', 'Globex Ltd', 1002)
+) AS v(name, description, stage, amount, probability, lead_email, company, owner_id)
+WHERE NOT EXISTS (SELECT 1 FROM opportunities o WHERE o.name = v.name);
+
+-- Activities
+INSERT INTO activities (title, description, type, status, due_date, contact_id)
+VALUES
+('Intro Call', 'Initial introduction call with John Doe', 'Call', 'Completed', CURRENT_TIMESTAMP,
+    (SELECT id FROM contacts WHERE email = 'john.doe@example.com')),
+('Follow-up Meeting', 'Discuss proposal with Jane Smith', 'Meeting', 'Pending', CURRENT_TIMESTAMP + INTERVAL '3 days',
+    (SELECT id FROM contacts WHERE email = 'jane.smith@example.com'))
+ON CONFLICT (title) DO NOTHING;
+
+-- Tasks
+INSERT INTO tasks (title, description, status, priority, due_date, activity_id)
+VALUES
+('Send Proposal', 'Email CRM proposal to John Doe', 'Pending', 'High', CURRENT_TIMESTAMP + INTERVAL '1 day',
+    (SELECT id FROM activities WHERE title = 'Intro Call')),
+('Prepare Presentation', 'Prepare deck for meeting with Jane Smith', 'In Progress', 'Medium', CURRENT_TIMESTAMP + INTERVAL '2 days',
+    (SELECT id FROM activities WHERE title = 'Follow-up Meeting'))
+ON CONFLICT (title) DO NOTHING;
@@ -0,0 +1,26 @@
+-- name: UpsertExchangeRate :one
+INSERT INTO exchange_rates (from_currency, to_currency, rate, effective_date, source)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT ON CONSTRAINT exchange_rates_pair_date_key
+DO UPDATE SET rate = EXCLUDED.rate, source = EXCLUDED.source, created_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetExchangeRateOn :one
+SELECT * FROM exchange_rates
+WHERE from_currency = sqlc.arg(from_currency) AND to_currency = sqlc.arg(to_currency)
+  AND effective_date <= sqlc.arg(on_date)
+ORDER BY effective_date DESC
+LIMIT 1;
+
+-- name: DeleteExchangeRate :execrows
+DELETE FROM exchange_rates WHERE id = $1;
+
+-- name: GetReportingCurrency :one
+SELECT reporting_currency FROM organization_settings WHERE organization_id = $1;
+
+-- name: SetReportingCurrency :one
+INSERT INTO organization_settings (organization_id, reporting_currency)
+VALUES ($1, $2)
+ON CONFLICT (organization_id)
+DO UPDATE SET reporting_currency = EXCLUDED.reporting_currency, updated_at = CURRENT_TIMESTAMP
+RETURNING *;
@@ -0,0 +1,20 @@
+-- name: ListForecastOpportunities :many
+SELECT o.* FROM opportunities o
+LEFT JOIN pipelines p ON p.id = o.pipeline_id
+LEFT JOIN companies c ON c.id = o.account_id
+WHERE o.close_date >= sqlc.arg(close_from) AND o.close_date < sqlc.arg(close_to)
+  AND (sqlc.arg(owner_id)::int = 0 OR o.owner_id = sqlc.arg(owner_id))
+  AND (sqlc.arg(pipeline_id)::int = 0 OR o.pipeline_id = sqlc.arg(pipeline_id))
+  AND (sqlc.arg(organization_id)::int = 0 OR COALESCE(p.organization_id, c.organization_id) = sqlc.arg(organization_id))
+ORDER BY o.close_date, o.id;
+
+-- name: CreateForecastSnapshot :one
+INSERT INTO forecast_snapshots (name, period, group_by, close_from, close_to, owner_id, pipeline_id, rows, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetForecastSnapshot :one
+SELECT * FROM forecast_snapshots WHERE id = $1;
+
+-- name: DeleteForecastSnapshot :execrows
+DELETE FROM forecast_snapshots WHERE id = $1;
@@ -1,6 +1,8 @@
 -- name: CreateLead :one
-INSERT INTO leads (first_name, last_name, email, phone, status, assigned_to, organization_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO leads (first_name, last_name, email, phone, status, assigned_to, organization_id, country, state, company_id,
+                   assignment_reason, assigned_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+        CASE WHEN $6::int IS NULL THEN NULL ELSE CURRENT_TIMESTAMP END)
 RETURNING *;
 
 -- name: GetLeadById :one
@@ -16,9 +18,25 @@ LIMIT $1 OFFSET $2;
 
 -- name: UpdateLead :one
 UPDATE leads
-SET status=$2, assigned_to=$3, updated_at=CURRENT_TIMESTAMP
+SET status=$2, assigned_to=$3, updated_at=CURRENT_TIMESTAMP,
+    status_entered_at = CASE WHEN status = $2 THEN status_entered_at ELSE CURRENT_TIMESTAMP END
 WHERE id=$1
 RETURNING *;
 
 -- name: DeleteLead :exec
 DELETE FROM leads WHERE id = $1;
+
+-- name: GetLeadForUpdate :one
+SELECT * FROM leads WHERE id = $1 FOR UPDATE;
+
+-- name: MarkLeadConverted :one
+UPDATE leads
+SET status = sqlc.arg(status),
+    converted_at = CURRENT_TIMESTAMP,
+    status_entered_at = CURRENT_TIMESTAMP,
+    converted_contact_id = sqlc.arg(contact_id),
+    converted_company_id = sqlc.narg(company_id),
+    converted_opportunity_id = sqlc.narg(opportunity_id),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND converted_at IS NULL
+RETURNING *;
@@ -0,0 +1,87 @@
+-- name: CreateSalesTeam :one
+INSERT INTO sales_teams (organization_id, name)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetSalesTeam :one
+SELECT * FROM sales_teams WHERE id = $1;
+
+-- name: ListSalesTeams :many
+SELECT * FROM sales_teams
+WHERE organization_id = $1
+ORDER BY name;
+
+-- name: DeleteSalesTeam :execrows
+DELETE FROM sales_teams WHERE id = $1;
+
+-- name: LockSalesTeam :exec
+SELECT id FROM sales_teams WHERE id = $1 FOR UPDATE;
+
+-- name: UpsertSalesTeamMember :one
+INSERT INTO sales_team_members (team_id, user_id, active)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id, user_id) DO UPDATE SET active = EXCLUDED.active
+RETURNING *;
+
+-- name: DeleteSalesTeamMember :execrows
+DELETE FROM sales_team_members WHERE team_id = $1 AND user_id = $2;
+
+-- name: ListSalesTeamMembers :many
+SELECT * FROM sales_team_members
+WHERE team_id = $1
+ORDER BY user_id;
+
+-- name: NextRoundRobinMember :one
+SELECT user_id FROM sales_team_members
+WHERE team_id = $1 AND active
+ORDER BY last_assigned_at NULLS FIRST, user_id
+LIMIT 1;
+
+-- name: LeastLoadedMember :one
+SELECT m.user_id
+FROM sales_team_members m
+LEFT JOIN leads l
+  ON l.assigned_to = m.user_id
+ AND l.converted_at IS NULL
+ AND l.status <> ALL(sqlc.arg(closed_statuses)::text[])
+ AND l.organization_id = sqlc.arg(organization_id)
+WHERE m.team_id = sqlc.arg(team_id) AND m.active
+GROUP BY m.user_id, m.last_assigned_at
+ORDER BY COUNT(l.id), m.last_assigned_at NULLS FIRST, m.user_id
+LIMIT 1;
+
+-- name: TouchSalesTeamMember :exec
+UPDATE sales_team_members
+SET last_assigned_at = CURRENT_TIMESTAMP
+WHERE team_id = $1 AND user_id = $2;
+
+-- name: CreateLeadAssignmentRule :one
+INSERT INTO lead_assignment_rules (organization_id, name, priority, strategy, team_id, country, state, industry, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetLeadAssignmentRule :one
+SELECT * FROM lead_assignment_rules WHERE id = $1;
+
+-- name: UpdateLeadAssignmentRule :one
+UPDATE lead_assignment_rules
+SET name=$2, priority=$3, strategy=$4, team_id=$5, country=$6, state=$7, industry=$8, enabled=$9, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: DeleteLeadAssignmentRule :execrows
+DELETE FROM lead_assignment_rules WHERE id = $1;
+
+-- name: ListLeadAssignmentRules :many
+SELECT * FROM lead_assignment_rules
+WHERE organization_id = $1
+ORDER BY priority, id;
+
+-- name: AssignLead :one
+UPDATE leads
+SET assigned_to = sqlc.narg(assigned_to),
+    assigned_at = CASE WHEN sqlc.narg(assigned_to)::int IS NULL THEN NULL ELSE CURRENT_TIMESTAMP END,
+    assignment_reason = sqlc.narg(assignment_reason),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+RETURNING *;
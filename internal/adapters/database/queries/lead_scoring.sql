@@ -0,0 +1,43 @@
+-- name: CreateLeadScoringRule :one
+INSERT INTO lead_scoring_rules (organization_id, name, rule_type, field, operator, value, weight, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetLeadScoringRule :one
+SELECT * FROM lead_scoring_rules WHERE id = $1;
+
+-- name: UpdateLeadScoringRule :one
+UPDATE lead_scoring_rules
+SET name=$2, rule_type=$3, field=$4, operator=$5, value=$6, weight=$7, enabled=$8, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: DeleteLeadScoringRule :execrows
+DELETE FROM lead_scoring_rules WHERE id = $1;
+
+-- name: ListApplicableLeadScoringRules :many
+SELECT * FROM lead_scoring_rules
+WHERE enabled AND (organization_id IS NULL OR organization_id = sqlc.narg(organization_id))
+ORDER BY id;
+
+-- name: CountContactActivities :one
+SELECT COUNT(*) FROM activities WHERE contact_id = $1;
+
+-- name: CountCompaniesWithDomain :one
+SELECT COUNT(*) FROM companies
+WHERE organization_id = sqlc.arg(organization_id) AND website_domain = lower(sqlc.arg(domain)::text);
+
+-- name: UpdateLeadScore :exec
+UPDATE leads
+SET score = $2, score_updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ListLeadIDsConvertedToContact :many
+SELECT id FROM leads WHERE converted_contact_id = sqlc.arg(contact_id)::int ORDER BY id;
+
+-- name: ListLeadIDsForScoring :many
+SELECT id FROM leads
+WHERE (sqlc.narg(organization_id)::int IS NULL OR organization_id = sqlc.narg(organization_id))
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
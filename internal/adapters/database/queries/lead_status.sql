@@ -0,0 +1,34 @@
+-- name: ListLeadStatuses :many
+SELECT * FROM lead_statuses
+WHERE organization_id = $1
+ORDER BY position, id;
+
+-- name: ListLeadStatusTransitions :many
+SELECT * FROM lead_status_transitions
+WHERE organization_id = $1
+ORDER BY from_status, to_status;
+
+-- name: CreateLeadStatus :one
+INSERT INTO lead_statuses (organization_id, name, position, is_initial, is_closed)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: CreateLeadStatusTransition :exec
+INSERT INTO lead_status_transitions (organization_id, from_status, to_status)
+VALUES ($1, $2, $3);
+
+-- name: DeleteLeadStatuses :exec
+DELETE FROM lead_statuses WHERE organization_id = $1;
+
+-- name: DeleteLeadStatusTransitions :exec
+DELETE FROM lead_status_transitions WHERE organization_id = $1;
+
+-- name: CreateLeadStatusHistory :one
+INSERT INTO lead_status_history (lead_id, from_status, to_status)
+VALUES (sqlc.arg(lead_id), sqlc.narg(from_status), sqlc.arg(to_status))
+RETURNING *;
+
+-- name: ListLeadStatusHistory :many
+SELECT * FROM lead_status_history
+WHERE lead_id = $1
+ORDER BY entered_at, id;
@@ -0,0 +1,18 @@
+-- name: CreateLossReason :one
+INSERT INTO loss_reasons (organization_id, name, active, position)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateLossReason :one
+UPDATE loss_reasons
+SET name=$2, active=$3, position=$4, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: ListLossReasons :many
+SELECT * FROM loss_reasons
+WHERE organization_id = $1
+ORDER BY position, name;
+
+-- name: DeleteLossReason :execrows
+DELETE FROM loss_reasons WHERE id = $1;
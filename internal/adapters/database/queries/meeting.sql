@@ -0,0 +1,50 @@
+-- name: CreateMeeting :one
+INSERT INTO meetings (title, description, start_time, end_time, meeting_link, organizer_id, status)
+VALUES ($1,$2,$3,$4,$5,$6,$7)
+RETURNING *;
+
+-- name: GetMeeting :one
+SELECT * FROM meetings WHERE id = $1;
+
+-- name: UpdateMeeting :one
+UPDATE meetings
+SET title=$2, description=$3, start_time=$4, end_time=$5, meeting_link=$6, status=$7, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: DeleteMeeting :exec
+DELETE FROM meetings WHERE id = $1;
+
+-- name: AddMeetingAttendee :one
+INSERT INTO meeting_attendees (meeting_id, contact_id, lead_id)
+VALUES ($1,$2,$3)
+RETURNING *;
+
+-- name: ListMeetingAttendees :many
+SELECT * FROM meeting_attendees WHERE meeting_id = $1 ORDER BY id;
+
+-- name: DeleteMeetingAttendees :exec
+DELETE FROM meeting_attendees WHERE meeting_id = $1;
+
+-- name: ListOverlappingMeetingsForContact :many
+SELECT m.*
+FROM meetings m
+JOIN meeting_attendees a ON a.meeting_id = m.id
+WHERE a.contact_id = sqlc.arg(contact_id)
+  AND m.id <> sqlc.arg(exclude_meeting_id)
+  AND m.status <> 'Cancelled'
+  AND m.start_time < sqlc.arg(end_time)
+  AND m.end_time > sqlc.arg(start_time);
+
+-- name: LockMeetingAttendee :exec
+SELECT pg_advisory_xact_lock(sqlc.arg(attendee_kind)::int, sqlc.arg(attendee_id)::int);
+
+-- name: ListOverlappingMeetingsForLead :many
+SELECT m.*
+FROM meetings m
+JOIN meeting_attendees a ON a.meeting_id = m.id
+WHERE a.lead_id = sqlc.arg(lead_id)
+  AND m.id <> sqlc.arg(exclude_meeting_id)
+  AND m.status <> 'Cancelled'
+  AND m.start_time < sqlc.arg(end_time)
+  AND m.end_time > sqlc.arg(start_time);
@@ -0,0 +1,89 @@
+-- name: ListContactsForDedupe :many
+SELECT id, first_name, last_name, email, phone FROM contacts
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListLeadsForDedupe :many
+SELECT id, first_name, last_name, email, phone, organization_id FROM leads
+WHERE (sqlc.narg(organization_id)::int IS NULL OR organization_id = sqlc.narg(organization_id))
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: GetContactForUpdate :one
+SELECT * FROM contacts WHERE id = $1 FOR UPDATE;
+
+-- name: MergeContactFields :one
+UPDATE contacts
+SET first_name=$2, last_name=$3, company_name=$4, company_id=$5, phone=$6, address=$7, city=$8, state=$9,
+    country=$10, zipcode=$11, position=$12, social_media_profiles=$13, notes=$14, taxation_detail_id=$15,
+    updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: CountContactTasks :one
+SELECT COUNT(*) FROM tasks t
+JOIN activities a ON a.id = t.activity_id
+WHERE a.contact_id = $1;
+
+-- name: MoveContactActivities :execrows
+UPDATE activities SET contact_id = sqlc.arg(survivor_id), updated_at = CURRENT_TIMESTAMP
+WHERE contact_id = sqlc.arg(merged_id);
+
+-- name: MoveContactProposals :execrows
+UPDATE proposals SET contact_id = sqlc.arg(survivor_id), updated_at = CURRENT_TIMESTAMP
+WHERE contact_id = sqlc.arg(merged_id);
+
+-- name: DeleteSharedContactAttendees :execrows
+DELETE FROM meeting_attendees a
+WHERE a.contact_id = sqlc.arg(merged_id)
+  AND EXISTS (SELECT 1 FROM meeting_attendees s WHERE s.meeting_id = a.meeting_id AND s.contact_id = sqlc.arg(survivor_id));
+
+-- name: MoveContactAttendees :execrows
+UPDATE meeting_attendees SET contact_id = sqlc.arg(survivor_id)
+WHERE contact_id = sqlc.arg(merged_id);
+
+-- name: MoveContactConversions :execrows
+UPDATE leads SET converted_contact_id = sqlc.arg(survivor_id)
+WHERE converted_contact_id = sqlc.arg(merged_id);
+
+-- name: MergeLeadFields :one
+UPDATE leads
+SET phone=$2, assigned_to=$3, assigned_at=$4, assignment_reason=$5, organization_id=$6, country=$7, state=$8,
+    company_id=$9, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: MoveLeadOpportunities :execrows
+UPDATE opportunities SET lead_id = sqlc.arg(survivor_id), updated_at = CURRENT_TIMESTAMP
+WHERE lead_id = sqlc.arg(merged_id);
+
+-- name: DeleteSharedLeadAttendees :execrows
+DELETE FROM meeting_attendees a
+WHERE a.lead_id = sqlc.arg(merged_id)
+  AND EXISTS (SELECT 1 FROM meeting_attendees s WHERE s.meeting_id = a.meeting_id AND s.lead_id = sqlc.arg(survivor_id));
+
+-- name: MoveLeadAttendees :execrows
+UPDATE meeting_attendees SET lead_id = sqlc.arg(survivor_id)
+WHERE lead_id = sqlc.arg(merged_id);
+
+-- name: CreateMergeAudit :one
+INSERT INTO merge_audits (entity_type, survivor_id, merged_id, merged_record, filled_fields, repointed)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListMergeAudits :many
+SELECT * FROM merge_audits
+WHERE entity_type = $1 AND survivor_id = $2
+ORDER BY id;
+
+-- name: DeleteSharedContactOpportunityRoles :execrows
+DELETE FROM opportunity_contacts r
+WHERE r.contact_id = sqlc.arg(merged_id)
+  AND EXISTS (SELECT 1 FROM opportunity_contacts s
+              WHERE s.opportunity_id = r.opportunity_id AND s.role = r.role AND s.contact_id = sqlc.arg(survivor_id));
+
+-- name: MoveContactOpportunityRoles :execrows
+UPDATE opportunity_contacts SET contact_id = sqlc.arg(survivor_id)
+WHERE contact_id = sqlc.arg(merged_id);
@@ -0,0 +1,38 @@
+-- name: CreateNotificationLog :one
+INSERT INTO notification_logs (id, notification_type, channel, user_id, recipient, template_name, template_version, data)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+RETURNING *;
+
+-- name: GetNotificationLog :one
+SELECT * FROM notification_logs WHERE id = $1;
+
+-- name: ClaimPendingNotificationLog :one
+UPDATE notification_logs
+SET status = 'sending', next_attempt_at = sqlc.arg(lease_until)::timestamp, updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: MarkNotificationSent :one
+UPDATE notification_logs
+SET status='sent', attempts=attempts+1, error_message='', next_attempt_at=NULL,
+    sent_at=CURRENT_TIMESTAMP, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: MarkNotificationFailed :one
+UPDATE notification_logs
+SET status=$2, attempts=attempts+1, error_message=$3, next_attempt_at=$4, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: ClaimDueNotificationLogs :many
+UPDATE notification_logs
+SET next_attempt_at = sqlc.arg(lease_until)::timestamp, updated_at = CURRENT_TIMESTAMP
+WHERE id IN (
+    SELECT id FROM notification_logs
+    WHERE status IN ('retrying', 'sending') AND next_attempt_at <= sqlc.arg(as_of)::timestamp
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg(limit_count)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
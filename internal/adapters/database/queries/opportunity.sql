@@ -1,6 +1,7 @@
 -- name: CreateOpportunity :one
-INSERT INTO opportunities (name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id)
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+INSERT INTO opportunities (name, description, stage, amount, close_date, probability, lead_id, account_id, owner_id,
+                           pipeline_id, probability_overridden, currency)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
 RETURNING *;
 
 -- name: GetOpportunity :one
@@ -14,7 +15,7 @@ ORDER BY created_at DESC;
 
 -- name: UpdateOpportunity :one
 UPDATE opportunities
-SET stage=$2, amount=$3, probability=$4, updated_at=CURRENT_TIMESTAMP
+SET stage=$2, amount=$3, probability=$4, probability_overridden=$5, close_date=$6, updated_at=CURRENT_TIMESTAMP
 WHERE id=$1
 RETURNING *;
 
@@ -36,3 +37,13 @@ RETURNING *;
 
 -- name: DeleteOpportunity :exec
 DELETE FROM opportunities WHERE id = $1;
+
+-- name: GetOpportunityForUpdate :one
+SELECT * FROM opportunities WHERE id = $1 FOR UPDATE;
+
+-- name: CloseOpportunity :one
+UPDATE opportunities
+SET stage=$2, amount=$3, probability=$4, probability_overridden=FALSE, close_date=$5, loss_reason=$6, competitor=$7,
+    closed_at=CURRENT_TIMESTAMP, amount_locked=TRUE, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
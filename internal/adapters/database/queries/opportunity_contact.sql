@@ -0,0 +1,30 @@
+-- name: AddOpportunityContact :one
+INSERT INTO opportunity_contacts (opportunity_id, contact_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (opportunity_id, contact_id, role) DO UPDATE SET role = EXCLUDED.role
+RETURNING *;
+
+-- name: RemoveOpportunityContact :execrows
+DELETE FROM opportunity_contacts
+WHERE opportunity_id = sqlc.arg(opportunity_id) AND contact_id = sqlc.arg(contact_id)
+  AND (sqlc.arg(role)::text = '' OR role = sqlc.arg(role));
+
+-- name: ListOpportunityContacts :many
+SELECT * FROM opportunity_contacts
+WHERE opportunity_id = $1
+ORDER BY contact_id, role;
+
+-- name: ListContactOpportunityRoles :many
+SELECT * FROM opportunity_contacts
+WHERE contact_id = $1
+ORDER BY opportunity_id, role;
+
+-- name: ListContactOpportunities :many
+SELECT * FROM opportunities
+WHERE id IN (SELECT opportunity_id FROM opportunity_contacts WHERE contact_id = $1)
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListCompanyOpportunities :many
+SELECT * FROM opportunities
+WHERE account_id = $1
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,18 @@
+-- name: CreateOpportunityHistory :exec
+INSERT INTO opportunity_history (opportunity_id, field, old_value, new_value)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListOpportunityHistory :many
+SELECT * FROM opportunity_history
+WHERE opportunity_id = $1
+ORDER BY changed_at, id;
+
+-- name: ListStageHistory :many
+SELECT h.opportunity_id, o.owner_id, o.stage AS current_stage, h.new_value AS stage, h.changed_at
+FROM opportunity_history h
+JOIN opportunities o ON o.id = h.opportunity_id
+WHERE h.field = 'stage'
+  AND o.pipeline_id IS NOT DISTINCT FROM sqlc.narg(pipeline_id)
+  AND (sqlc.arg(owner_id)::int = 0 OR o.owner_id = sqlc.arg(owner_id))
+  AND (sqlc.narg(created_since)::timestamp IS NULL OR o.created_at >= sqlc.narg(created_since))
+ORDER BY h.opportunity_id, h.changed_at, h.id;
@@ -0,0 +1,30 @@
+-- name: CreateOpportunityLineItem :one
+INSERT INTO opportunity_line_items (opportunity_id, product_id, price_book_id, description, quantity, unit_price,
+                                    discount_percent, tax_percent, total, position)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9,
+        (SELECT COALESCE(MAX(position) + 1, 0) FROM opportunity_line_items WHERE opportunity_id = $1))
+RETURNING *;
+
+-- name: GetOpportunityLineItem :one
+SELECT * FROM opportunity_line_items WHERE id = $1;
+
+-- name: UpdateOpportunityLineItem :one
+UPDATE opportunity_line_items
+SET description=$2, quantity=$3, unit_price=$4, discount_percent=$5, tax_percent=$6, total=$7,
+    updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: DeleteOpportunityLineItem :execrows
+DELETE FROM opportunity_line_items WHERE id = $1;
+
+-- name: ListOpportunityLineItems :many
+SELECT * FROM opportunity_line_items
+WHERE opportunity_id = $1
+ORDER BY position, id;
+
+-- name: SetOpportunityAmount :one
+UPDATE opportunities
+SET amount=$2, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
@@ -0,0 +1,49 @@
+-- name: CreatePipeline :one
+INSERT INTO pipelines (organization_id, name, is_default)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UpdatePipeline :one
+UPDATE pipelines
+SET name=$2, is_default=$3, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: GetPipeline :one
+SELECT * FROM pipelines WHERE id = $1;
+
+-- name: GetDefaultPipeline :one
+SELECT * FROM pipelines WHERE organization_id = $1 AND is_default;
+
+-- name: ListPipelines :many
+SELECT * FROM pipelines
+WHERE organization_id = $1
+ORDER BY name;
+
+-- name: ClearDefaultPipeline :exec
+UPDATE pipelines SET is_default = FALSE, updated_at = CURRENT_TIMESTAMP
+WHERE organization_id = sqlc.arg(organization_id) AND is_default AND id <> sqlc.arg(keep_id);
+
+-- name: DeletePipeline :execrows
+DELETE FROM pipelines WHERE id = $1;
+
+-- name: CountPipelineOpportunities :one
+SELECT COUNT(*) FROM opportunities WHERE pipeline_id = $1;
+
+-- name: CreatePipelineStage :one
+INSERT INTO pipeline_stages (pipeline_id, name, position, probability, is_won, is_lost)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListPipelineStages :many
+SELECT * FROM pipeline_stages
+WHERE pipeline_id = $1
+ORDER BY position, id;
+
+-- name: DeletePipelineStages :exec
+DELETE FROM pipeline_stages WHERE pipeline_id = $1;
+
+-- name: ListPipelineStagesInUse :many
+SELECT DISTINCT stage FROM opportunities
+WHERE pipeline_id = $1 AND stage IS NOT NULL
+ORDER BY stage;
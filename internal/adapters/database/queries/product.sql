@@ -0,0 +1,64 @@
+-- name: CreateProduct :one
+INSERT INTO products (sku, name, description, list_price, currency, active)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetProduct :one
+SELECT * FROM products WHERE id = $1;
+
+-- name: UpdateProduct :one
+UPDATE products
+SET sku=$2, name=$3, description=$4, list_price=$5, currency=$6, active=$7, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: DeleteProduct :execrows
+DELETE FROM products WHERE id = $1;
+
+-- name: CountProductLineItems :one
+SELECT COUNT(*) FROM opportunity_line_items WHERE product_id = $1;
+
+-- name: CreatePriceBook :one
+INSERT INTO price_books (name, pipeline_id, currency, active)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetPriceBook :one
+SELECT * FROM price_books WHERE id = $1;
+
+-- name: UpdatePriceBook :one
+UPDATE price_books
+SET name=$2, pipeline_id=$3, currency=$4, active=$5, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: ListPriceBooks :many
+SELECT * FROM price_books
+WHERE (sqlc.arg(pipeline_id)::int = 0 OR pipeline_id = sqlc.arg(pipeline_id))
+ORDER BY name, id;
+
+-- name: DeletePriceBook :execrows
+DELETE FROM price_books WHERE id = $1;
+
+-- name: UpsertPriceBookEntry :one
+INSERT INTO price_book_entries (price_book_id, product_id, unit_price)
+VALUES ($1, $2, $3)
+ON CONFLICT (price_book_id, product_id)
+DO UPDATE SET unit_price = EXCLUDED.unit_price
+RETURNING *;
+
+-- name: DeletePriceBookEntry :execrows
+DELETE FROM price_book_entries WHERE price_book_id = $1 AND product_id = $2;
+
+-- name: ListPriceBookEntries :many
+SELECT * FROM price_book_entries
+WHERE price_book_id = $1
+ORDER BY product_id;
+
+-- name: FindProductPrice :one
+SELECT e.* FROM price_book_entries e
+JOIN price_books b ON b.id = e.price_book_id
+WHERE e.product_id = sqlc.arg(product_id) AND b.active AND b.currency = sqlc.arg(currency)
+  AND (b.pipeline_id = sqlc.narg(pipeline_id) OR b.pipeline_id IS NULL)
+ORDER BY b.pipeline_id IS NULL, b.id
+LIMIT 1;
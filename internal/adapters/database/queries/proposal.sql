@@ -0,0 +1,52 @@
+-- name: CreateProposal :one
+INSERT INTO proposals (title, description, status, contact_id, opportunity_id, created_by, valid_until, currency)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+RETURNING *;
+
+-- name: GetProposal :one
+SELECT * FROM proposals WHERE id = $1;
+
+-- name: GetProposalForUpdate :one
+SELECT * FROM proposals WHERE id = $1 FOR UPDATE;
+
+-- name: UpdateProposal :one
+UPDATE proposals
+SET title=$2, description=$3, contact_id=$4, opportunity_id=$5, valid_until=$6, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: UpdateProposalAmount :one
+UPDATE proposals
+SET amount=$2, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: UpdateProposalStatus :one
+UPDATE proposals
+SET
+  status       = sqlc.arg(status),
+  sent_at      = CASE WHEN sqlc.arg(status) = 'sent' THEN CURRENT_TIMESTAMP ELSE sent_at END,
+  responded_at = CASE WHEN sqlc.arg(status) IN ('accepted', 'rejected') THEN CURRENT_TIMESTAMP ELSE responded_at END,
+  updated_at   = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: ExpireSentProposals :many
+UPDATE proposals
+SET status='expired', updated_at=CURRENT_TIMESTAMP
+WHERE status = 'sent' AND valid_until < sqlc.arg(as_of)::timestamp
+RETURNING *;
+
+-- name: DeleteProposal :exec
+DELETE FROM proposals WHERE id = $1;
+
+-- name: AddProposalLineItem :one
+INSERT INTO proposal_line_items (proposal_id, description, quantity, unit_price, discount, tax_rate, total, position)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+RETURNING *;
+
+-- name: ListProposalLineItems :many
+SELECT * FROM proposal_line_items WHERE proposal_id = $1 ORDER BY position, id;
+
+-- name: DeleteProposalLineItems :exec
+DELETE FROM proposal_line_items WHERE proposal_id = $1;
@@ -0,0 +1,52 @@
+-- name: SearchRecords :many
+WITH matches AS (
+    SELECT 'contact'::text AS entity_type, c.id,
+           concat_ws(' ', c.first_name, c.last_name) AS title,
+           concat_ws(', ', c.email, COALESCE(co.name, c.company_name), c.city) AS subtitle,
+           c.country, co.industry, NULL::varchar AS status,
+           crm_search_text(c.first_name, c.last_name, c.email, c.company_name, c.city, c.country, c.phone) AS document
+    FROM contacts c
+    LEFT JOIN companies co ON co.id = c.company_id
+    WHERE sqlc.arg(include_contacts)::bool
+      AND (sqlc.arg(organization_id)::int = 0 OR co.organization_id = sqlc.arg(organization_id))
+      AND (to_tsvector('simple', crm_search_text(c.first_name, c.last_name, c.email, c.company_name, c.city, c.country, c.phone))
+               @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+           OR lower(sqlc.arg(query)::text) <% crm_search_text(c.first_name, c.last_name, c.email, c.company_name, c.city, c.country, c.phone))
+    UNION ALL
+    SELECT 'company'::text, co.id,
+           co.name,
+           concat_ws(', ', co.website, co.email, co.city),
+           co.country, co.industry, NULL::varchar,
+           crm_search_text(co.name, co.industry, co.website, co.email, co.city, co.country, co.phone)
+    FROM companies co
+    WHERE sqlc.arg(include_companies)::bool
+      AND (sqlc.arg(organization_id)::int = 0 OR co.organization_id = sqlc.arg(organization_id))
+      AND (to_tsvector('simple', crm_search_text(co.name, co.industry, co.website, co.email, co.city, co.country, co.phone))
+               @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+           OR lower(sqlc.arg(query)::text) <% crm_search_text(co.name, co.industry, co.website, co.email, co.city, co.country, co.phone))
+    UNION ALL
+    SELECT 'lead'::text, l.id,
+           concat_ws(' ', l.first_name, l.last_name),
+           concat_ws(', ', l.email, co.name, l.state),
+           COALESCE(l.country, co.country), co.industry, l.status,
+           crm_search_text(l.first_name, l.last_name, l.email, l.phone, l.status, l.country, l.state)
+    FROM leads l
+    LEFT JOIN companies co ON co.id = l.company_id
+    WHERE sqlc.arg(include_leads)::bool
+      AND (sqlc.arg(organization_id)::int = 0 OR l.organization_id = sqlc.arg(organization_id))
+      AND (to_tsvector('simple', crm_search_text(l.first_name, l.last_name, l.email, l.phone, l.status, l.country, l.state))
+               @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+           OR lower(sqlc.arg(query)::text) <% crm_search_text(l.first_name, l.last_name, l.email, l.phone, l.status, l.country, l.state))
+)
+SELECT m.entity_type, m.id, m.title, m.subtitle, m.country, m.industry, m.status,
+       (ts_rank(to_tsvector('simple', m.document), websearch_to_tsquery('simple', sqlc.arg(query)::text))
+        + word_similarity(lower(sqlc.arg(query)::text), m.document))::float8 AS rank,
+       ts_headline('simple', crm_html_escape(m.title || ' | ' || m.subtitle), websearch_to_tsquery('simple', sqlc.arg(query)::text),
+                   'StartSel=<mark>, StopSel=</mark>, HighlightAll=TRUE') AS highlight,
+       count(*) OVER () AS total
+FROM matches m
+WHERE (sqlc.arg(country)::text = '' OR lower(m.country) = lower(sqlc.arg(country)::text))
+  AND (sqlc.arg(industry)::text = '' OR lower(m.industry) = lower(sqlc.arg(industry)::text))
+  AND (sqlc.arg(status)::text = '' OR lower(m.status) = lower(sqlc.arg(status)::text))
+ORDER BY rank DESC, m.entity_type, m.id
+LIMIT sqlc.arg(limit_count);
@@ -0,0 +1,37 @@
+-- name: CreateSMTPCredential :one
+INSERT INTO smtp_credentials (user_id, smtp_host, smtp_port, smtp_username, from_email, key_id, wrapped_key, password_ciphertext)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+RETURNING *;
+
+-- name: GetSMTPCredential :one
+SELECT * FROM smtp_credentials WHERE id = $1;
+
+-- name: GetSMTPCredentialByUser :one
+SELECT * FROM smtp_credentials WHERE user_id = $1;
+
+-- name: UpdateSMTPCredential :one
+UPDATE smtp_credentials
+SET smtp_host=$2, smtp_port=$3, smtp_username=$4, from_email=$5, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: UpdateSMTPCredentialSecret :exec
+UPDATE smtp_credentials
+SET key_id=$2, wrapped_key=$3, password_ciphertext=$4, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1;
+
+-- name: RotateSMTPCredentialSecret :execrows
+UPDATE smtp_credentials
+SET key_id = sqlc.arg(key_id), wrapped_key = sqlc.arg(wrapped_key),
+    password_ciphertext = sqlc.arg(password_ciphertext), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND key_id = sqlc.arg(old_key_id) AND wrapped_key = sqlc.arg(old_wrapped_key);
+
+-- name: DeleteSMTPCredential :execrows
+DELETE FROM smtp_credentials WHERE id = $1;
+
+-- name: ListSMTPCredentialsNotUsingKey :many
+SELECT *
+FROM smtp_credentials
+WHERE key_id <> sqlc.arg(key_id) AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
@@ -0,0 +1,27 @@
+-- name: CreateTemplate :one
+INSERT INTO notification_templates (name, url)
+VALUES ($1,$2)
+RETURNING *;
+
+-- name: GetTemplate :one
+SELECT * FROM notification_templates WHERE id = $1;
+
+-- name: GetTemplateByName :one
+SELECT * FROM notification_templates WHERE name = $1;
+
+-- name: GetTemplateForUpdate :one
+SELECT * FROM notification_templates WHERE id = $1 FOR UPDATE;
+
+-- name: UpdateTemplate :one
+UPDATE notification_templates
+SET name=$2, url=$3, current_version=$4, updated_at=CURRENT_TIMESTAMP
+WHERE id=$1
+RETURNING *;
+
+-- name: CreateTemplateVersion :one
+INSERT INTO template_versions (template_id, version, email_subject, email_html, email_text, sms_text, in_app_text, variables)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+RETURNING *;
+
+-- name: GetTemplateVersion :one
+SELECT * FROM template_versions WHERE template_id = $1 AND version = $2;
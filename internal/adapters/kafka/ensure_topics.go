@@ -10,30 +10,57 @@ import (
 // EnsureTopics ensures that the specified Kafka topics exist.
 // It connects to the Kafka controller and creates topics if missing.
 func EnsureTopics(brokers []string, topics []kafka.TopicConfig) error {
+	controllerConn, err := dialController(context.Background(), brokers)
+	if err != nil {
+		return err
+	}
+	defer controllerConn.Close()
+
+	// Create topics if not already present
+	err = controllerConn.CreateTopics(topics...)
+	if err != nil && err.Error() != "Topic with this name already exists" {
+		return fmt.Errorf("failed to create Kafka topics: %w", err)
+	}
+
+	return nil
+}
+
+// Ping checks that the cluster is reachable by dialing the controller the
+// same way EnsureTopics does.
+func Ping(ctx context.Context, brokers []string) error {
+	conn, err := dialController(ctx, brokers)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// dialController connects to the first broker and from there to the
+// controller, the broker responsible for topic management.
+func dialController(ctx context.Context, brokers []string) (*kafka.Conn, error) {
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("no Kafka brokers configured")
+	}
+
 	// Connect to the first broker
-	conn, err := kafka.DialContext(context.Background(), "tcp", brokers[0])
+	conn, err := kafka.DialContext(ctx, "tcp", brokers[0])
 	if err != nil {
-		return fmt.Errorf("failed to dial Kafka broker %s: %w", brokers[0], err)
+		return nil, fmt.Errorf("failed to dial Kafka broker %s: %w", brokers[0], err)
 	}
 	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
 
 	// Get controller (the broker responsible for topic management)
 	controller, err := conn.Controller()
 	if err != nil {
-		return fmt.Errorf("failed to get Kafka controller: %w", err)
+		return nil, fmt.Errorf("failed to get Kafka controller: %w", err)
 	}
 
-	controllerConn, err := kafka.Dial("tcp", fmt.Sprintf("%s:%d", controller.Host, controller.Port))
+	controllerConn, err := kafka.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", controller.Host, controller.Port))
 	if err != nil {
-		return fmt.Errorf("failed to dial Kafka controller %s:%d: %w", controller.Host, controller.Port, err)
-	}
-	defer controllerConn.Close()
-
-	// Create topics if not already present
-	err = controllerConn.CreateTopics(topics...)
-	if err != nil && err.Error() != "Topic with this name already exists" {
-		return fmt.Errorf("failed to create Kafka topics: %w", err)
+		return nil, fmt.Errorf("failed to dial Kafka controller %s:%d: %w", controller.Host, controller.Port, err)
 	}
-
-	return nil
+	return controllerConn, nil
 }
@@ -10,7 +10,6 @@ const (
 
 	//task-management
 
-	
 	TopicTaskCreated = "task-created"
 	TopicTaskUpdated = "task-updated"
 	TopicTaskDeleted = "task-deleted"
@@ -19,19 +18,71 @@ const (
 	TopicContactCreated = "contact-created"
 	TopicContactUpdated = "contact-updated"
 	TopicContactDeleted = "contact-deleted"
-	
+	TopicContactMerged  = "contact-merged"
+
 	//company-management
 	TopicCompanyCreated = "company-created"
 	TopicCompanyUpdated = "company-updated"
 	TopicCompanyDeleted = "company-deleted"
 
 	//lead-management
-	TopicLeadCreated = "lead-created"
-	TopicLeadUpdated = "lead-updated"
-	TopicLeadDeleted = "lead-deleted"
+	TopicLeadCreated       = "lead-created"
+	TopicLeadUpdated       = "lead-updated"
+	TopicLeadDeleted       = "lead-deleted"
+	TopicLeadConverted     = "lead-converted"
+	TopicLeadStatusChanged = "lead-status-changed"
+	TopicLeadAssigned      = "lead-assigned"
+	TopicLeadMerged        = "lead-merged"
 
 	//opportunity-management
 	TopicOpportunityCreated = "opportunity-created"
 	TopicOpportunityUpdated = "opportunity-updated"
 	TopicOpportunityDeleted = "opportunity-deleted"
+	TopicOpportunityWon     = "opportunity-won"
+	TopicOpportunityLost    = "opportunity-lost"
+
+	//meeting-management
+	TopicMeetingScheduled = "meeting-scheduled"
+	TopicMeetingUpdated   = "meeting-updated"
+	TopicMeetingDeleted   = "meeting-deleted"
+
+	//proposal-management
+	TopicProposalCreated  = "proposal-created"
+	TopicProposalUpdated  = "proposal-updated"
+	TopicProposalDeleted  = "proposal-deleted"
+	TopicProposalSent     = "proposal-sent"
+	TopicProposalAccepted = "proposal-accepted"
+	TopicProposalRejected = "proposal-rejected"
+	TopicProposalExpired  = "proposal-expired"
+
+	//notification-management
+	TopicNotificationRequested = "notification-requested"
+	TopicNotificationSent      = "notification-sent"
+	TopicNotificationFailed    = "notification-failed"
+
+	//template-management
+	TopicTemplateCreated = "template-created"
+	TopicTemplateUpdated = "template-updated"
+
+	//smtp-management
+	TopicSMTPCreated = "smtp-created"
+	TopicSMTPUpdated = "smtp-updated"
+	TopicSMTPDeleted = "smtp-deleted"
 )
+
+// AllTopics lists every topic the service publishes to, so they can be
+// created up front with EnsureTopics.
+var AllTopics = []string{
+	TopicActivityCreated, TopicActivityUpdated, TopicActivityDeleted,
+	TopicTaskCreated, TopicTaskUpdated, TopicTaskDeleted,
+	TopicContactCreated, TopicContactUpdated, TopicContactDeleted, TopicContactMerged,
+	TopicCompanyCreated, TopicCompanyUpdated, TopicCompanyDeleted,
+	TopicLeadCreated, TopicLeadUpdated, TopicLeadDeleted, TopicLeadConverted, TopicLeadStatusChanged, TopicLeadAssigned, TopicLeadMerged,
+	TopicOpportunityCreated, TopicOpportunityUpdated, TopicOpportunityDeleted, TopicOpportunityWon, TopicOpportunityLost,
+	TopicMeetingScheduled, TopicMeetingUpdated, TopicMeetingDeleted,
+	TopicProposalCreated, TopicProposalUpdated, TopicProposalDeleted,
+	TopicProposalSent, TopicProposalAccepted, TopicProposalRejected, TopicProposalExpired,
+	TopicNotificationRequested, TopicNotificationSent, TopicNotificationFailed,
+	TopicTemplateCreated, TopicTemplateUpdated,
+	TopicSMTPCreated, TopicSMTPUpdated, TopicSMTPDeleted,
+}
@@ -0,0 +1,43 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// RunWorker reads messages from topic as part of groupID and hands each
+// value to handle until ctx is cancelled. Offsets are committed after handle
+// returns, so a crash mid-message redelivers it; handle errors are logged
+// and the message is skipped.
+func RunWorker(ctx context.Context, brokers []string, topic, groupID string, logger *zap.Logger, handle func(ctx context.Context, value []byte) error) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		Topic:    topic,
+		GroupID:  groupID,
+		MinBytes: 1,
+		MaxBytes: 10e6,
+	})
+	defer r.Close()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || ctx.Err() != nil {
+				return
+			}
+			logger.Error("consumer read error", zap.String("topic", topic), zap.Error(err))
+			continue
+		}
+
+		if err := handle(ctx, m.Value); err != nil {
+			logger.Error("failed to handle kafka message",
+				zap.String("topic", topic), zap.Int64("offset", m.Offset), zap.Error(err))
+		}
+		if err := r.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			logger.Error("failed to commit kafka offset", zap.String("topic", topic), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Package notify delivers rendered notifications over email, SMS and the
+// in-app websocket feed.
+package notify
+
+import (
+	"context"
+	"errors"
+)
+
+// Channels a notification can be delivered on.
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelInApp = "in_app"
+)
+
+var ErrNoRecipient = errors.New("notification has no recipient")
+
+// Message is a fully rendered notification ready to hand to a Sender.
+type Message struct {
+	ID        string `json:"id"`
+	Channel   string `json:"channel"`
+	UserID    string `json:"user_id,omitempty"`
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject,omitempty"`
+	Text      string `json:"text"`
+	HTML      string `json:"html,omitempty"`
+}
+
+// Sender delivers a message on one channel.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,33 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+
+	"crm/internal/transport/websockets"
+)
+
+// InAppSender pushes notifications to the websocket connections of their
+// recipient. A recipient without a connection fails the send so that the
+// notification is retried.
+type InAppSender struct {
+	ws *websockets.Server
+}
+
+func NewInAppSender(ws *websockets.Server) *InAppSender {
+	return &InAppSender{ws: ws}
+}
+
+func (s *InAppSender) Send(_ context.Context, msg Message) error {
+	if msg.Recipient == "" {
+		return ErrNoRecipient
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"type":         "notification",
+		"notification": msg,
+	})
+	if err != nil {
+		return err
+	}
+	return s.ws.SendToUser(msg.Recipient, payload)
+}
@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SMSProvider is implemented by SMS gateways.
+type SMSProvider interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+// SMSSender delivers the text body of a message through an SMSProvider.
+type SMSSender struct {
+	provider SMSProvider
+}
+
+func NewSMSSender(provider SMSProvider) *SMSSender {
+	return &SMSSender{provider: provider}
+}
+
+func (s *SMSSender) Send(ctx context.Context, msg Message) error {
+	if msg.Recipient == "" {
+		return ErrNoRecipient
+	}
+	return s.provider.SendSMS(ctx, msg.Recipient, msg.Text)
+}
+
+// FileSMSProvider appends each SMS as a JSON line to a local file. It stands
+// in for a real gateway in development.
+type FileSMSProvider struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSMSProvider(path string) *FileSMSProvider {
+	return &FileSMSProvider{path: path}
+}
+
+func (p *FileSMSProvider) SendSMS(_ context.Context, to, body string) error {
+	line, err := json.Marshal(map[string]string{
+		"to":      to,
+		"body":    body,
+		"sent_at": time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f, err := os.OpenFile(p.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("sms outbox: %w", err)
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
+
+// LogSMSProvider writes each SMS to the logger instead of sending it.
+type LogSMSProvider struct {
+	logger *zap.Logger
+}
+
+func NewLogSMSProvider(logger *zap.Logger) *LogSMSProvider {
+	return &LogSMSProvider{logger: logger}
+}
+
+func (p *LogSMSProvider) SendSMS(_ context.Context, to, body string) error {
+	p.logger.Info("sms", zap.String("to", to), zap.String("body", body))
+	return nil
+}
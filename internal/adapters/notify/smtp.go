@@ -0,0 +1,173 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPCredentials are the settings needed to relay mail through a server.
+type SMTPCredentials struct {
+	Host     string
+	Port     int32
+	Username string
+	Password string
+	From     string
+}
+
+// ErrNoCredentials is returned by a CredentialStore that has nothing for
+// the user, letting ChainCredentials fall through to the next store.
+var ErrNoCredentials = errors.New("no SMTP credentials")
+
+// CredentialStore resolves the SMTP credentials to send as a given user.
+type CredentialStore interface {
+	SMTPCredentials(ctx context.Context, userID string) (SMTPCredentials, error)
+}
+
+// StaticCredentials serves the same credentials for every user.
+type StaticCredentials SMTPCredentials
+
+func (c StaticCredentials) SMTPCredentials(context.Context, string) (SMTPCredentials, error) {
+	if c.Host == "" {
+		return SMTPCredentials{}, ErrNoCredentials
+	}
+	return SMTPCredentials(c), nil
+}
+
+// ChainCredentials asks each store in turn, moving on while they report
+// ErrNoCredentials.
+type ChainCredentials []CredentialStore
+
+func (c ChainCredentials) SMTPCredentials(ctx context.Context, userID string) (SMTPCredentials, error) {
+	for _, store := range c {
+		creds, err := store.SMTPCredentials(ctx, userID)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return creds, err
+	}
+	return SMTPCredentials{}, ErrNoCredentials
+}
+
+// SMTPSender delivers email through the sending user's SMTP server.
+type SMTPSender struct {
+	store   CredentialStore
+	timeout time.Duration
+}
+
+func NewSMTPSender(store CredentialStore) *SMTPSender {
+	return &SMTPSender{store: store, timeout: 30 * time.Second}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if msg.Recipient == "" {
+		return ErrNoRecipient
+	}
+	creds, err := s.store.SMTPCredentials(ctx, msg.UserID)
+	if err != nil {
+		return fmt.Errorf("smtp credentials: %w", err)
+	}
+
+	client, err := DialSMTP(ctx, creds, s.timeout)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(creds.From); err != nil {
+		return fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	if err := client.Rcpt(msg.Recipient); err != nil {
+		return fmt.Errorf("smtp RCPT TO: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := w.Write(buildMIME(creds.From, msg)); err != nil {
+		return fmt.Errorf("smtp write: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	return client.Quit()
+}
+
+// DialSMTP connects to the server, upgrades to TLS when offered and
+// authenticates when a username is set. The caller must close the client.
+func DialSMTP(ctx context.Context, creds SMTPCredentials, timeout time.Duration) (*smtp.Client, error) {
+	addr := net.JoinHostPort(creds.Host, strconv.Itoa(int(creds.Port)))
+
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("smtp dial %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(timeout))
+	}
+
+	client, err := smtp.NewClient(conn, creds.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp handshake with %s: %w", addr, err)
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: creds.Host}); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp STARTTLS: %w", err)
+		}
+	}
+	if creds.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", creds.Username, creds.Password, creds.Host)); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	return client, nil
+}
+
+// buildMIME renders the message as plain text, HTML, or a
+// multipart/alternative body carrying both.
+func buildMIME(from string, msg Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.Recipient)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+
+	switch {
+	case msg.HTML == "":
+		b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		b.WriteString(msg.Text)
+	case msg.Text == "":
+		b.WriteString("Content-Type: text/html; charset=utf-8\r\n\r\n")
+		b.WriteString(msg.HTML)
+	default:
+		boundary := newBoundary()
+		fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+		fmt.Fprintf(&b, "--%s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n", boundary, msg.Text)
+		fmt.Fprintf(&b, "--%s\r\nContent-Type: text/html; charset=utf-8\r\n\r\n%s\r\n", boundary, msg.HTML)
+		fmt.Fprintf(&b, "--%s--", boundary)
+	}
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
+
+func newBoundary() string {
+	buf := make([]byte, 12)
+	_, _ = rand.Read(buf)
+	return "crm-" + hex.EncodeToString(buf)
+}
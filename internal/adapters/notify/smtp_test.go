@@ -0,0 +1,159 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSMTP is a plain-text SMTP server on a loopback port that accepts one
+// username and password and records the mail it is given.
+type fakeSMTP struct {
+	ln       net.Listener
+	username string
+	password string
+
+	mu    sync.Mutex
+	mails []string
+}
+
+func startFakeSMTP(t *testing.T, username, password string) *fakeSMTP {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeSMTP{ln: ln, username: username, password: password}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTP) creds() SMTPCredentials {
+	addr := s.ln.Addr().(*net.TCPAddr)
+	return SMTPCredentials{Host: "127.0.0.1", Port: int32(addr.Port), Username: s.username, Password: s.password, From: "crm@example.com"}
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		switch verb := strings.ToUpper(strings.SplitN(cmd, " ", 2)[0]); verb {
+		case "EHLO":
+			reply("250-fake")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			want := base64.StdEncoding.EncodeToString([]byte("\x00" + s.username + "\x00" + s.password))
+			if cmd == "AUTH PLAIN "+want {
+				reply("235 ok")
+			} else {
+				reply("535 bad credentials")
+			}
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			var mail strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				mail.WriteString(line)
+			}
+			s.mu.Lock()
+			s.mails = append(s.mails, mail.String())
+			s.mu.Unlock()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 " + verb + " not implemented")
+		}
+	}
+}
+
+func TestDialSMTP(t *testing.T) {
+	server := startFakeSMTP(t, "crm", "hunter2")
+	ctx := context.Background()
+
+	client, err := DialSMTP(ctx, server.creds(), time.Second)
+	if err != nil {
+		t.Fatalf("DialSMTP: %v", err)
+	}
+	if err := client.Quit(); err != nil {
+		t.Errorf("Quit: %v", err)
+	}
+	client.Close()
+
+	wrong := server.creds()
+	wrong.Password = "wrong"
+	if _, err := DialSMTP(ctx, wrong, time.Second); err == nil || !strings.Contains(err.Error(), "smtp auth") {
+		t.Errorf("DialSMTP with a wrong password error = %v, want an auth failure", err)
+	}
+
+	closed := server.creds()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	closed.Port = int32(ln.Addr().(*net.TCPAddr).Port)
+	ln.Close()
+	if _, err := DialSMTP(ctx, closed, time.Second); err == nil || !strings.Contains(err.Error(), "smtp dial 127.0.0.1:"+strconv.Itoa(int(closed.Port))) {
+		t.Errorf("DialSMTP to a closed port error = %v, want a dial failure", err)
+	}
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	server := startFakeSMTP(t, "crm", "hunter2")
+	sender := NewSMTPSender(StaticCredentials(server.creds()))
+
+	err := sender.Send(context.Background(), Message{
+		Channel:   ChannelEmail,
+		Recipient: "ada@example.com",
+		Subject:   "Welcome",
+		Text:      "Hello Ada",
+		HTML:      "<p>Hello Ada</p>",
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.mails) != 1 {
+		t.Fatalf("server received %d mails, want 1", len(server.mails))
+	}
+	mail := server.mails[0]
+	for _, want := range []string{"From: crm@example.com", "To: ada@example.com", "multipart/alternative", "Hello Ada", "<p>Hello Ada</p>"} {
+		if !strings.Contains(mail, want) {
+			t.Errorf("mail does not contain %q:\n%s", want, mail)
+		}
+	}
+}
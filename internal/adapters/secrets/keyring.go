@@ -0,0 +1,140 @@
+// Package secrets encrypts small secrets such as SMTP passwords with
+// envelope encryption: every secret gets its own random data key, and the
+// data key is stored wrapped by a key-encryption key from the config.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeySize is the length of key-encryption keys and data keys (AES-256).
+const KeySize = 32
+
+var (
+	ErrNoKeys     = errors.New("no encryption keys configured")
+	ErrUnknownKey = errors.New("unknown encryption key")
+	ErrDecrypt    = errors.New("failed to decrypt secret")
+)
+
+// Sealed is an encrypted secret as stored in the database.
+type Sealed struct {
+	// KeyID names the key-encryption key that wrapped the data key.
+	KeyID      string
+	WrappedKey []byte
+	Ciphertext []byte
+}
+
+// Keyring holds the key-encryption keys. New secrets are sealed with the
+// primary key; older keys stay available so existing secrets can still be
+// opened until they are rotated.
+type Keyring struct {
+	primary string
+	keys    map[string][]byte
+}
+
+// NewKeyring builds a keyring whose primary key is keys[primary]. Every key
+// must be KeySize bytes.
+func NewKeyring(primary string, keys map[string][]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("%w: primary key %q", ErrUnknownKey, primary)
+	}
+	for id, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %q is %d bytes, want %d", id, len(key), KeySize)
+		}
+	}
+	return &Keyring{primary: primary, keys: keys}, nil
+}
+
+// Primary returns the ID of the key new secrets are sealed with.
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Seal encrypts plaintext under a fresh data key wrapped by the primary key.
+func (k *Keyring) Seal(plaintext []byte) (Sealed, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return Sealed{}, err
+	}
+
+	ciphertext, err := seal(dataKey, plaintext, nil)
+	if err != nil {
+		return Sealed{}, err
+	}
+	// The key ID is bound as additional data so a wrapped key cannot be
+	// passed off as belonging to another key.
+	wrapped, err := seal(k.keys[k.primary], dataKey, []byte(k.primary))
+	if err != nil {
+		return Sealed{}, err
+	}
+	return Sealed{KeyID: k.primary, WrappedKey: wrapped, Ciphertext: ciphertext}, nil
+}
+
+// Open decrypts a sealed secret with whichever key wrapped it.
+func (k *Keyring) Open(s Sealed) ([]byte, error) {
+	kek, ok := k.keys[s.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, s.KeyID)
+	}
+	dataKey, err := open(kek, s.WrappedKey, []byte(s.KeyID))
+	if err != nil {
+		return nil, err
+	}
+	return open(dataKey, s.Ciphertext, nil)
+}
+
+// Reseal re-encrypts a secret under a new data key wrapped by the primary
+// key.
+func (k *Keyring) Reseal(s Sealed) (Sealed, error) {
+	plaintext, err := k.Open(s)
+	if err != nil {
+		return Sealed{}, err
+	}
+	return k.Seal(plaintext)
+}
+
+// seal encrypts with AES-GCM and prefixes the random nonce.
+func seal(key, plaintext, additional []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additional), nil
+}
+
+func open(key, sealed, additional []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, additional)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, KeySize)
+}
+
+func TestSealOpen(t *testing.T) {
+	k, err := NewKeyring("k1", map[string][]byte{"k1": testKey(1)})
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+
+	sealed, err := k.Seal([]byte("hunter2"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if sealed.KeyID != "k1" {
+		t.Errorf("KeyID = %q, want k1", sealed.KeyID)
+	}
+	if bytes.Contains(sealed.Ciphertext, []byte("hunter2")) {
+		t.Error("ciphertext contains the plaintext")
+	}
+	got, err := k.Open(sealed)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if string(got) != "hunter2" {
+		t.Errorf("Open = %q, want hunter2", got)
+	}
+
+	again, err := k.Seal([]byte("hunter2"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if bytes.Equal(again.WrappedKey, sealed.WrappedKey) || bytes.Equal(again.Ciphertext, sealed.Ciphertext) {
+		t.Error("sealing twice reused the data key or nonce")
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	k, err := NewKeyring("k1", map[string][]byte{"k1": testKey(1), "k2": testKey(2)})
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	sealed, err := k.Seal([]byte("hunter2"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	flip := func(b []byte) []byte {
+		b = bytes.Clone(b)
+		b[len(b)-1] ^= 1
+		return b
+	}
+
+	tests := []struct {
+		name   string
+		sealed Sealed
+		want   error
+	}{
+		{"unknown key", Sealed{KeyID: "k9", WrappedKey: sealed.WrappedKey, Ciphertext: sealed.Ciphertext}, ErrUnknownKey},
+		{"wrapped key claimed by another key", Sealed{KeyID: "k2", WrappedKey: sealed.WrappedKey, Ciphertext: sealed.Ciphertext}, ErrDecrypt},
+		{"tampered wrapped key", Sealed{KeyID: "k1", WrappedKey: flip(sealed.WrappedKey), Ciphertext: sealed.Ciphertext}, ErrDecrypt},
+		{"tampered ciphertext", Sealed{KeyID: "k1", WrappedKey: sealed.WrappedKey, Ciphertext: flip(sealed.Ciphertext)}, ErrDecrypt},
+		{"truncated ciphertext", Sealed{KeyID: "k1", WrappedKey: sealed.WrappedKey, Ciphertext: sealed.Ciphertext[:4]}, ErrDecrypt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := k.Open(tt.sealed); !errors.Is(err, tt.want) {
+				t.Errorf("Open error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotation(t *testing.T) {
+	old, err := NewKeyring("k1", map[string][]byte{"k1": testKey(1)})
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	sealed, err := old.Seal([]byte("hunter2"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+
+	// A new primary key is added while the old one stays configured.
+	rotated, err := NewKeyring("k2", map[string][]byte{"k1": testKey(1), "k2": testKey(2)})
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	if got, err := rotated.Open(sealed); err != nil || string(got) != "hunter2" {
+		t.Fatalf("Open under the old key = %q, %v", got, err)
+	}
+
+	resealed, err := rotated.Reseal(sealed)
+	if err != nil {
+		t.Fatalf("Reseal: %v", err)
+	}
+	if resealed.KeyID != "k2" {
+		t.Errorf("resealed KeyID = %q, want k2", resealed.KeyID)
+	}
+
+	// Once the old key is retired only the resealed secret opens.
+	retired, err := NewKeyring("k2", map[string][]byte{"k2": testKey(2)})
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	if got, err := retired.Open(resealed); err != nil || string(got) != "hunter2" {
+		t.Errorf("Open resealed = %q, %v", got, err)
+	}
+	if _, err := retired.Open(sealed); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Open under a retired key error = %v, want ErrUnknownKey", err)
+	}
+	if _, err := retired.Reseal(sealed); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Reseal under a retired key error = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestNewKeyringRejects(t *testing.T) {
+	if _, err := NewKeyring("k1", nil); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("no keys error = %v, want ErrNoKeys", err)
+	}
+	if _, err := NewKeyring("k2", map[string][]byte{"k1": testKey(1)}); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("missing primary error = %v, want ErrUnknownKey", err)
+	}
+	if _, err := NewKeyring("k1", map[string][]byte{"k1": []byte("short")}); err == nil {
+		t.Error("short key accepted")
+	}
+}
@@ -0,0 +1,442 @@
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every setting the CRM service reads at startup.
+//
+// Values are resolved in increasing order of precedence: built-in defaults,
+// the config file (YAML or TOML), CRM_* environment variables and finally
+// command-line flags.
+type Config struct {
+	Database     DatabaseConfig     `yaml:"database" toml:"database"`
+	Kafka        KafkaConfig        `yaml:"kafka" toml:"kafka"`
+	GRPC         ServerConfig       `yaml:"grpc" toml:"grpc"`
+	HTTP         ServerConfig       `yaml:"http" toml:"http"`
+	WebSocket    WebSocketConfig    `yaml:"websocket" toml:"websocket"`
+	Pagination   PaginationConfig   `yaml:"pagination" toml:"pagination"`
+	Notification NotificationConfig `yaml:"notification" toml:"notification"`
+	Secrets      SecretsConfig      `yaml:"secrets" toml:"secrets"`
+	LogLevel     string             `yaml:"log_level" toml:"log_level"`
+
+	// path and args are kept so the same sources can be re-read on reload.
+	path string
+	args []string
+}
+
+type DatabaseConfig struct {
+	DSN string `yaml:"dsn" toml:"dsn"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers" toml:"brokers"`
+	// ConsumerGroups maps a consumer name to its Kafka group ID.
+	ConsumerGroups map[string]string `yaml:"consumer_groups" toml:"consumer_groups"`
+}
+
+type ServerConfig struct {
+	Addr string `yaml:"addr" toml:"addr"`
+}
+
+type WebSocketConfig struct {
+	// AllowedOrigins restricts which Origin headers may open a socket.
+	// An empty list accepts every origin.
+	AllowedOrigins []string `yaml:"allowed_origins" toml:"allowed_origins"`
+}
+
+type PaginationConfig struct {
+	DefaultPageSize int32 `yaml:"default_page_size" toml:"default_page_size"`
+	MaxPageSize     int32 `yaml:"max_page_size" toml:"max_page_size"`
+}
+
+type NotificationConfig struct {
+	SMTP  SMTPConfig  `yaml:"smtp" toml:"smtp"`
+	SMS   SMSConfig   `yaml:"sms" toml:"sms"`
+	Retry RetryConfig `yaml:"retry" toml:"retry"`
+}
+
+// SMTPConfig is the mail server used for users without SMTP credentials of
+// their own. An empty host disables the fallback.
+type SMTPConfig struct {
+	Host     string `yaml:"host" toml:"host"`
+	Port     int32  `yaml:"port" toml:"port"`
+	Username string `yaml:"username" toml:"username"`
+	Password string `yaml:"password" toml:"password"`
+	From     string `yaml:"from" toml:"from"`
+}
+
+type SMSConfig struct {
+	// OutboxPath is a file each SMS is appended to instead of being sent.
+	// When empty, messages are only logged.
+	OutboxPath string `yaml:"outbox_path" toml:"outbox_path"`
+}
+
+// RetryConfig controls how failed notifications are retried. The delay
+// doubles after every attempt, starting at BaseDelaySeconds and capped at
+// MaxDelaySeconds; due retries are picked up every IntervalSeconds.
+type RetryConfig struct {
+	MaxAttempts      int32 `yaml:"max_attempts" toml:"max_attempts"`
+	BaseDelaySeconds int32 `yaml:"base_delay_seconds" toml:"base_delay_seconds"`
+	MaxDelaySeconds  int32 `yaml:"max_delay_seconds" toml:"max_delay_seconds"`
+	IntervalSeconds  int32 `yaml:"interval_seconds" toml:"interval_seconds"`
+}
+
+// SecretsConfig holds the key-encryption keys used to encrypt stored
+// secrets such as SMTP passwords. Keys maps a key ID to a base64-encoded
+// 32-byte key; KeyID picks the one new secrets are encrypted with. Older
+// keys must stay listed until the secrets using them have been rotated.
+type SecretsConfig struct {
+	KeyID string            `yaml:"key_id" toml:"key_id"`
+	Keys  map[string]string `yaml:"keys" toml:"keys"`
+}
+
+// DecodedKeys returns Keys decoded from base64. It is only meaningful on a
+// validated config.
+func (s SecretsConfig) DecodedKeys() map[string][]byte {
+	keys := make(map[string][]byte, len(s.Keys))
+	for id, encoded := range s.Keys {
+		key, _ := base64.StdEncoding.DecodeString(encoded)
+		keys[id] = key
+	}
+	return keys
+}
+
+// Reloadable is the subset of Config that can change without a restart.
+type Reloadable struct {
+	LogLevel   string
+	Pagination PaginationConfig
+}
+
+// ValidationError lists every configuration key that is missing or invalid.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Default returns the built-in configuration. DSN and brokers have no
+// default and must be provided by one of the sources.
+func Default() *Config {
+	return &Config{
+		GRPC:     ServerConfig{Addr: ":50051"},
+		HTTP:     ServerConfig{Addr: ":8080"},
+		LogLevel: "info",
+		Pagination: PaginationConfig{
+			DefaultPageSize: 10,
+			MaxPageSize:     100,
+		},
+		Notification: NotificationConfig{
+			SMTP: SMTPConfig{Port: 587},
+			Retry: RetryConfig{
+				MaxAttempts:      5,
+				BaseDelaySeconds: 30,
+				MaxDelaySeconds:  3600,
+				IntervalSeconds:  15,
+			},
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the config file named by
+// -config (or CRM_CONFIG), the environment and the given command-line args.
+// Every problem found is reported at once in a *ValidationError.
+func Load(args []string) (*Config, error) {
+	return load(args, (*Config).validate)
+}
+
+// LoadDatabase is Load for commands that only talk to the database, such as
+// migrate: the sources are read the same way, but only database.dsn is
+// validated.
+func LoadDatabase(args []string) (*Config, error) {
+	return load(args, (*Config).validateDatabase)
+}
+
+func load(args []string, validate func(*Config) []string) (*Config, error) {
+	cfg := Default()
+	cfg.args = args
+
+	fs := flag.NewFlagSet("crm", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv("CRM_CONFIG"), "path to a YAML or TOML config file")
+	dsn := fs.String("db-dsn", "", "Postgres connection string")
+	brokers := fs.String("kafka-brokers", "", "comma-separated Kafka broker addresses")
+	grpcAddr := fs.String("grpc-addr", "", "gRPC listen address")
+	httpAddr := fs.String("http-addr", "", "HTTP listen address")
+	origins := fs.String("ws-allowed-origins", "", "comma-separated websocket origin allowlist")
+	logLevel := fs.String("log-level", "", "log level (debug, info, warn, error)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	cfg.path = *path
+
+	var problems []string
+	if cfg.path != "" {
+		if err := cfg.loadFile(cfg.path); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	problems = append(problems, cfg.applyEnv(os.LookupEnv)...)
+
+	// Flags win over every other source, but only when explicitly set.
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "db-dsn":
+			cfg.Database.DSN = *dsn
+		case "kafka-brokers":
+			cfg.Kafka.Brokers = splitList(*brokers)
+		case "grpc-addr":
+			cfg.GRPC.Addr = *grpcAddr
+		case "http-addr":
+			cfg.HTTP.Addr = *httpAddr
+		case "ws-allowed-origins":
+			cfg.WebSocket.AllowedOrigins = splitList(*origins)
+		case "log-level":
+			cfg.LogLevel = *logLevel
+		}
+	})
+
+	problems = append(problems, validate(cfg)...)
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return cfg, nil
+}
+
+// Reload re-reads the same file, environment and flags used by Load.
+func (c *Config) Reload() (*Config, error) {
+	return Load(c.args)
+}
+
+// Path returns the config file in use, or "" when none was given.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Reloadable returns the settings that may be applied at runtime.
+func (c *Config) Reloadable() Reloadable {
+	return Reloadable{LogLevel: c.LogLevel, Pagination: c.Pagination}
+}
+
+// ConsumerGroup returns the group ID configured for the named consumer,
+// defaulting to "crm-<name>".
+func (c *Config) ConsumerGroup(name string) string {
+	if id := c.Kafka.ConsumerGroups[name]; id != "" {
+		return id
+	}
+	return "crm-" + name
+}
+
+// ---------------- Sources ----------------
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		// An empty document decodes to io.EOF and leaves the defaults alone.
+		if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("config file %s: %w", path, err)
+		}
+	case ".toml":
+		dec := toml.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(c); err != nil {
+			return fmt.Errorf("config file %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("config file %s: unsupported extension %q (want .yaml, .yml or .toml)", path, ext)
+	}
+	return nil
+}
+
+// applyEnv overlays CRM_* environment variables and returns parse problems.
+func (c *Config) applyEnv(lookup func(string) (string, bool)) []string {
+	var problems []string
+
+	str := func(key string, dst *string) {
+		if v, ok := lookup(key); ok && v != "" {
+			*dst = v
+		}
+	}
+	list := func(key string, dst *[]string) {
+		if v, ok := lookup(key); ok && v != "" {
+			*dst = splitList(v)
+		}
+	}
+	num := func(key string, dst *int32) {
+		if v, ok := lookup(key); ok && v != "" {
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %q is not an integer", key, v))
+				return
+			}
+			*dst = int32(n)
+		}
+	}
+
+	str("CRM_DATABASE_DSN", &c.Database.DSN)
+	list("CRM_KAFKA_BROKERS", &c.Kafka.Brokers)
+	str("CRM_GRPC_ADDR", &c.GRPC.Addr)
+	str("CRM_HTTP_ADDR", &c.HTTP.Addr)
+	list("CRM_WEBSOCKET_ALLOWED_ORIGINS", &c.WebSocket.AllowedOrigins)
+	str("CRM_LOG_LEVEL", &c.LogLevel)
+	num("CRM_PAGINATION_DEFAULT_PAGE_SIZE", &c.Pagination.DefaultPageSize)
+	num("CRM_PAGINATION_MAX_PAGE_SIZE", &c.Pagination.MaxPageSize)
+	str("CRM_NOTIFICATION_SMTP_HOST", &c.Notification.SMTP.Host)
+	num("CRM_NOTIFICATION_SMTP_PORT", &c.Notification.SMTP.Port)
+	str("CRM_NOTIFICATION_SMTP_USERNAME", &c.Notification.SMTP.Username)
+	str("CRM_NOTIFICATION_SMTP_PASSWORD", &c.Notification.SMTP.Password)
+	str("CRM_NOTIFICATION_SMTP_FROM", &c.Notification.SMTP.From)
+	str("CRM_NOTIFICATION_SMS_OUTBOX_PATH", &c.Notification.SMS.OutboxPath)
+	num("CRM_NOTIFICATION_RETRY_MAX_ATTEMPTS", &c.Notification.Retry.MaxAttempts)
+	num("CRM_NOTIFICATION_RETRY_BASE_DELAY_SECONDS", &c.Notification.Retry.BaseDelaySeconds)
+	num("CRM_NOTIFICATION_RETRY_MAX_DELAY_SECONDS", &c.Notification.Retry.MaxDelaySeconds)
+	num("CRM_NOTIFICATION_RETRY_INTERVAL_SECONDS", &c.Notification.Retry.IntervalSeconds)
+	str("CRM_SECRETS_KEY_ID", &c.Secrets.KeyID)
+
+	// CRM_KAFKA_CONSUMER_GROUPS=name=group,other=group2
+	if v, ok := lookup("CRM_KAFKA_CONSUMER_GROUPS"); ok && v != "" {
+		if c.Kafka.ConsumerGroups == nil {
+			c.Kafka.ConsumerGroups = map[string]string{}
+		}
+		for _, pair := range splitList(v) {
+			name, group, found := strings.Cut(pair, "=")
+			if !found || name == "" || group == "" {
+				problems = append(problems, fmt.Sprintf("CRM_KAFKA_CONSUMER_GROUPS: %q is not name=group", pair))
+				continue
+			}
+			c.Kafka.ConsumerGroups[name] = group
+		}
+	}
+
+	// CRM_SECRETS_KEYS=id=base64key,other=base64key
+	if v, ok := lookup("CRM_SECRETS_KEYS"); ok && v != "" {
+		if c.Secrets.Keys == nil {
+			c.Secrets.Keys = map[string]string{}
+		}
+		for _, pair := range splitList(v) {
+			id, key, found := strings.Cut(pair, "=")
+			if !found || id == "" || key == "" {
+				problems = append(problems, "CRM_SECRETS_KEYS: entries must be id=key")
+				continue
+			}
+			c.Secrets.Keys[id] = key
+		}
+	}
+
+	return problems
+}
+
+// ---------------- Validation ----------------
+
+func (c *Config) validate() []string {
+	problems := c.validateDatabase()
+
+	if len(c.Kafka.Brokers) == 0 {
+		problems = append(problems, "kafka.brokers is required")
+	}
+	for _, b := range c.Kafka.Brokers {
+		if _, _, err := net.SplitHostPort(b); err != nil {
+			problems = append(problems, fmt.Sprintf("kafka.brokers: %q is not host:port", b))
+		}
+	}
+
+	if _, _, err := net.SplitHostPort(c.GRPC.Addr); err != nil {
+		problems = append(problems, fmt.Sprintf("grpc.addr: %q is not a listen address", c.GRPC.Addr))
+	}
+	if _, _, err := net.SplitHostPort(c.HTTP.Addr); err != nil {
+		problems = append(problems, fmt.Sprintf("http.addr: %q is not a listen address", c.HTTP.Addr))
+	}
+
+	if _, err := zapcore.ParseLevel(c.LogLevel); err != nil {
+		problems = append(problems, fmt.Sprintf("log_level: %q is not a valid level", c.LogLevel))
+	}
+
+	if c.Pagination.DefaultPageSize <= 0 {
+		problems = append(problems, "pagination.default_page_size must be positive")
+	}
+	if c.Pagination.MaxPageSize <= 0 {
+		problems = append(problems, "pagination.max_page_size must be positive")
+	} else if c.Pagination.DefaultPageSize > c.Pagination.MaxPageSize {
+		problems = append(problems, "pagination.default_page_size must not exceed pagination.max_page_size")
+	}
+
+	if smtp := c.Notification.SMTP; smtp.Host != "" {
+		if smtp.Port <= 0 || smtp.Port > 65535 {
+			problems = append(problems, fmt.Sprintf("notification.smtp.port: %d is not a valid port", smtp.Port))
+		}
+		if smtp.From == "" {
+			problems = append(problems, "notification.smtp.from is required when notification.smtp.host is set")
+		}
+	}
+
+	retry := c.Notification.Retry
+	if retry.MaxAttempts <= 0 {
+		problems = append(problems, "notification.retry.max_attempts must be positive")
+	}
+	if retry.BaseDelaySeconds <= 0 {
+		problems = append(problems, "notification.retry.base_delay_seconds must be positive")
+	} else if retry.MaxDelaySeconds < retry.BaseDelaySeconds {
+		problems = append(problems, "notification.retry.max_delay_seconds must not be less than notification.retry.base_delay_seconds")
+	}
+	if retry.IntervalSeconds <= 0 {
+		problems = append(problems, "notification.retry.interval_seconds must be positive")
+	}
+
+	// Key material is never echoed back in problems.
+	for id, encoded := range c.Secrets.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("secrets.keys.%s is not valid base64", id))
+		} else if len(key) != 32 {
+			problems = append(problems, fmt.Sprintf("secrets.keys.%s must decode to 32 bytes, got %d", id, len(key)))
+		}
+	}
+	if len(c.Secrets.Keys) > 0 {
+		if _, ok := c.Secrets.Keys[c.Secrets.KeyID]; !ok {
+			problems = append(problems, fmt.Sprintf("secrets.key_id: %q is not one of secrets.keys", c.Secrets.KeyID))
+		}
+	} else if c.Secrets.KeyID != "" {
+		problems = append(problems, "secrets.key_id is set but secrets.keys is empty")
+	}
+
+	return problems
+}
+
+func (c *Config) validateDatabase() []string {
+	if strings.TrimSpace(c.Database.DSN) == "" {
+		return []string{"database.dsn is required"}
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
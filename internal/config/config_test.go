@@ -0,0 +1,321 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// clearEnv unsets the CRM_* variables Load reads so the host environment
+// cannot leak into a test.
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{
+		"CRM_CONFIG", "CRM_DATABASE_DSN", "CRM_KAFKA_BROKERS", "CRM_GRPC_ADDR", "CRM_HTTP_ADDR",
+		"CRM_WEBSOCKET_ALLOWED_ORIGINS", "CRM_LOG_LEVEL", "CRM_PAGINATION_DEFAULT_PAGE_SIZE",
+		"CRM_PAGINATION_MAX_PAGE_SIZE", "CRM_NOTIFICATION_SMTP_HOST", "CRM_NOTIFICATION_SMTP_PORT",
+		"CRM_NOTIFICATION_SMTP_FROM", "CRM_NOTIFICATION_RETRY_MAX_ATTEMPTS", "CRM_KAFKA_CONSUMER_GROUPS",
+		"CRM_SECRETS_KEY_ID", "CRM_SECRETS_KEYS",
+	} {
+		t.Setenv(key, "")
+	}
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string // config file name; its extension picks the format
+		content  string
+		env      map[string]string
+		args     []string
+		grpcAddr string
+		logLevel string
+		pageSize int32
+	}{
+		{
+			name:     "defaults",
+			grpcAddr: ":50051",
+			logLevel: "info",
+			pageSize: 10,
+		},
+		{
+			name:     "yaml file over defaults",
+			file:     "crm.yaml",
+			content:  "grpc:\n  addr: \":1000\"\nlog_level: warn\npagination:\n  default_page_size: 20\n",
+			grpcAddr: ":1000",
+			logLevel: "warn",
+			pageSize: 20,
+		},
+		{
+			name:     "toml file over defaults",
+			file:     "crm.toml",
+			content:  "log_level = \"warn\"\n[grpc]\naddr = \":1000\"\n[pagination]\ndefault_page_size = 20\n",
+			grpcAddr: ":1000",
+			logLevel: "warn",
+			pageSize: 20,
+		},
+		{
+			name:     "env over file",
+			file:     "crm.yaml",
+			content:  "grpc:\n  addr: \":1000\"\nlog_level: warn\npagination:\n  default_page_size: 20\n",
+			env:      map[string]string{"CRM_GRPC_ADDR": ":2000", "CRM_PAGINATION_DEFAULT_PAGE_SIZE": "30"},
+			grpcAddr: ":2000",
+			logLevel: "warn",
+			pageSize: 30,
+		},
+		{
+			name:     "flags over env",
+			file:     "crm.yaml",
+			content:  "grpc:\n  addr: \":1000\"\nlog_level: warn\n",
+			env:      map[string]string{"CRM_GRPC_ADDR": ":2000", "CRM_LOG_LEVEL": "error"},
+			args:     []string{"-grpc-addr", ":3000", "-log-level", "debug"},
+			grpcAddr: ":3000",
+			logLevel: "debug",
+			pageSize: 10,
+		},
+		{
+			name:     "empty env keeps the file value",
+			file:     "crm.yaml",
+			content:  "grpc:\n  addr: \":1000\"\n",
+			env:      map[string]string{"CRM_GRPC_ADDR": ""},
+			grpcAddr: ":1000",
+			logLevel: "info",
+			pageSize: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+			t.Setenv("CRM_DATABASE_DSN", "postgres://localhost/crm")
+			t.Setenv("CRM_KAFKA_BROKERS", "localhost:9092")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			args := tt.args
+			if tt.file != "" {
+				args = append([]string{"-config", writeFile(t, t.TempDir(), tt.file, tt.content)}, args...)
+			}
+
+			cfg, err := Load(args)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if cfg.GRPC.Addr != tt.grpcAddr {
+				t.Errorf("grpc.addr = %q, want %q", cfg.GRPC.Addr, tt.grpcAddr)
+			}
+			if cfg.LogLevel != tt.logLevel {
+				t.Errorf("log_level = %q, want %q", cfg.LogLevel, tt.logLevel)
+			}
+			if cfg.Pagination.DefaultPageSize != tt.pageSize {
+				t.Errorf("pagination.default_page_size = %d, want %d", cfg.Pagination.DefaultPageSize, tt.pageSize)
+			}
+		})
+	}
+}
+
+func TestLoadValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		env      map[string]string
+		problems []string // {path} stands for the config file
+	}{
+		{
+			name: "missing required settings",
+			problems: []string{
+				"database.dsn is required",
+				"kafka.brokers is required",
+			},
+		},
+		{
+			name: "every problem at once",
+			content: "database:\n  dsn: postgres://localhost/crm\n" +
+				"kafka:\n  brokers: [localhost]\n" +
+				"grpc:\n  addr: nowhere\n" +
+				"log_level: loud\n" +
+				"pagination:\n  default_page_size: 200\n  max_page_size: 100\n" +
+				"notification:\n  smtp:\n    host: mail.example.com\n    port: 70000\n",
+			env: map[string]string{"CRM_NOTIFICATION_RETRY_MAX_ATTEMPTS": "many"},
+			problems: []string{
+				`CRM_NOTIFICATION_RETRY_MAX_ATTEMPTS: "many" is not an integer`,
+				`kafka.brokers: "localhost" is not host:port`,
+				`grpc.addr: "nowhere" is not a listen address`,
+				`log_level: "loud" is not a valid level`,
+				"pagination.default_page_size must not exceed pagination.max_page_size",
+				"notification.smtp.port: 70000 is not a valid port",
+				"notification.smtp.from is required when notification.smtp.host is set",
+			},
+		},
+		{
+			name:    "unknown file keys",
+			content: "database:\n  dsn: postgres://localhost/crm\nkafka:\n  brokers: [localhost:9092]\ncolour: blue\n",
+			problems: []string{
+				"config file {path}: yaml: unmarshal errors:\n  line 5: field colour not found in type config.Config",
+			},
+		},
+		{
+			name:    "secrets",
+			content: "database:\n  dsn: postgres://localhost/crm\nkafka:\n  brokers: [localhost:9092]\nsecrets:\n  key_id: v2\n  keys:\n    v1: c2hvcnQ=\n",
+			problems: []string{
+				"secrets.keys.v1 must decode to 32 bytes, got 5",
+				`secrets.key_id: "v2" is not one of secrets.keys`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			var args []string
+			path := ""
+			if tt.content != "" {
+				path = writeFile(t, t.TempDir(), "crm.yaml", tt.content)
+				args = []string{"-config", path}
+			}
+
+			_, err := Load(args)
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Load error = %v, want a *ValidationError", err)
+			}
+			want := make([]string, len(tt.problems))
+			for i, p := range tt.problems {
+				want[i] = strings.ReplaceAll(p, "{path}", path)
+			}
+			got := append([]string(nil), verr.Problems...)
+			sort.Strings(got)
+			sort.Strings(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("problems =\n  %q\nwant\n  %q", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadDatabaseOnlyChecksDSN(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("CRM_DATABASE_DSN", "postgres://localhost/crm")
+	t.Setenv("CRM_LOG_LEVEL", "loud")
+
+	if _, err := LoadDatabase(nil); err != nil {
+		t.Fatalf("LoadDatabase: %v", err)
+	}
+	if _, err := Load(nil); err == nil {
+		t.Fatal("Load accepted a config without brokers and with an invalid log level")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	const base = "database:\n  dsn: postgres://localhost/crm\nkafka:\n  brokers: [localhost:9092]\n"
+	steps := []struct {
+		name    string
+		content string
+		want    *Reloadable // nil when nothing may be applied
+	}{
+		{
+			name:    "log level and page limits change",
+			content: base + "log_level: debug\npagination:\n  default_page_size: 25\n  max_page_size: 50\n",
+			want:    &Reloadable{LogLevel: "debug", Pagination: PaginationConfig{DefaultPageSize: 25, MaxPageSize: 50}},
+		},
+		{
+			name:    "invalid reload is ignored",
+			content: base + "log_level: loud\npagination:\n  default_page_size: 25\n  max_page_size: 50\n",
+		},
+		{
+			name:    "structural change alone applies nothing",
+			content: base + "grpc:\n  addr: \":6000\"\nlog_level: debug\npagination:\n  default_page_size: 25\n  max_page_size: 50\n",
+		},
+		{
+			name:    "next valid reload is compared with the last applied",
+			content: base + "log_level: warn\npagination:\n  default_page_size: 25\n  max_page_size: 50\n",
+			want:    &Reloadable{LogLevel: "warn", Pagination: PaginationConfig{DefaultPageSize: 25, MaxPageSize: 50}},
+		},
+	}
+
+	clearEnv(t)
+	path := writeFile(t, t.TempDir(), "crm.yaml", base)
+	cfg, err := Load([]string{"-config", path})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	applied := make(chan Reloadable, len(steps))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Watch(ctx, cfg, 5*time.Millisecond, zap.NewNop(), func(r Reloadable) { applied <- r })
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+	// Let Watch record the initial modification time before the first
+	// change.
+	time.Sleep(50 * time.Millisecond)
+
+	modified := time.Now()
+	for _, step := range steps {
+		// Replace the file in one step so Watch never reads it half
+		// written, and move its modification time forward explicitly so
+		// coarse file system timestamps cannot hide the change.
+		next := writeFile(t, filepath.Dir(path), "next.yaml", step.content)
+		modified = modified.Add(time.Second)
+		if err := os.Chtimes(next, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(next, path); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case got := <-applied:
+			if step.want == nil {
+				t.Fatalf("%s: applied %+v, want nothing", step.name, got)
+			}
+			if got != *step.want {
+				t.Fatalf("%s: applied %+v, want %+v", step.name, got, *step.want)
+			}
+		case <-time.After(200 * time.Millisecond):
+			if step.want != nil {
+				t.Fatalf("%s: nothing applied, want %+v", step.name, *step.want)
+			}
+		}
+	}
+}
+
+func TestWatchWithoutFileReturns(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Watch(context.Background(), Default(), time.Millisecond, zap.NewNop(), func(Reloadable) {
+			t.Error("apply called without a config file")
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Watch did not return without a config file")
+	}
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Watch polls the config file every interval and, when it changes, reloads
+// the configuration and passes the reloadable settings to apply. Structural
+// settings (DSN, brokers, listen addresses, origins) are only read at
+// startup; a change to them is logged as requiring a restart.
+//
+// Watch blocks until ctx is cancelled and is a no-op without a config file.
+func Watch(ctx context.Context, cfg *Config, interval time.Duration, logger *zap.Logger, apply func(Reloadable)) {
+	if cfg.path == "" {
+		return
+	}
+
+	lastMod := modTime(cfg.path)
+	current := cfg
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		mod := modTime(cfg.path)
+		if mod.Equal(lastMod) {
+			continue
+		}
+		lastMod = mod
+
+		next, err := current.Reload()
+		if err != nil {
+			logger.Error("config reload rejected, keeping previous settings", zap.Error(err))
+			continue
+		}
+		if current.structural() != next.structural() {
+			logger.Warn("structural config changed, restart required to apply it", zap.String("path", cfg.path))
+		}
+		if current.Reloadable() != next.Reloadable() {
+			logger.Info("applying reloaded config",
+				zap.String("log_level", next.LogLevel),
+				zap.Int32("default_page_size", next.Pagination.DefaultPageSize),
+				zap.Int32("max_page_size", next.Pagination.MaxPageSize),
+			)
+			apply(next.Reloadable())
+		}
+		current = next
+	}
+}
+
+// structural flattens the settings that need a restart into a comparable value.
+func (c *Config) structural() string {
+	return c.Database.DSN + "|" + c.GRPC.Addr + "|" + c.HTTP.Addr + "|" +
+		strings.Join(c.Kafka.Brokers, ",") + "|" + strings.Join(c.WebSocket.AllowedOrigins, ",")
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
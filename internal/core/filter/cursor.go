@@ -0,0 +1,162 @@
+package filter
+
+import (
+	"bytes"
+	"crm/internal/adapters/database/db"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pageToken points into the rows of a query: at the rows after the row
+// with sort key Key, or before it when Prev is set. Tokens are opaque to
+// clients.
+type pageToken struct {
+	Key         json.RawMessage `json:"k"`
+	Prev        bool            `json:"p,omitempty"`
+	Fingerprint string          `json:"f"`
+}
+
+// Token returns the page token for the rows after the row with sort key
+// key, or before it when prev is set.
+func (q *Query) Token(key string, prev bool) string {
+	data, _ := json.Marshal(pageToken{Key: json.RawMessage(key), Prev: prev, Fingerprint: q.fingerprint})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Seek restricts the query to the page a token returned by Token points
+// at. The token must have been made for the same filter and sort. Pages
+// before a row are read backwards, see Backward.
+func (q *Query) Seek(token string) error {
+	invalid := fmt.Errorf("%w: page token is invalid", ErrInvalid)
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return invalid
+	}
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil {
+		return invalid
+	}
+	if t.Fingerprint != q.fingerprint {
+		return fmt.Errorf("%w: page token does not match the filter and sort of the request", ErrInvalid)
+	}
+	values, err := keyValues(t.Key)
+	if err != nil || len(values) != len(q.terms) {
+		return invalid
+	}
+
+	// Placeholders of the key values; NULLs have none.
+	placeholders := make([]string, len(values))
+	for i, v := range values {
+		if v != nil {
+			q.Args = append(q.Args, *v)
+			placeholders[i] = fmt.Sprintf("$%d", len(q.Args))
+		}
+	}
+
+	// Rows past the key: equal on the first i terms and past it on term i,
+	// for some i.
+	var alternatives []string
+	for i, term := range q.terms {
+		var equal []string
+		for j := 0; j < i; j++ {
+			if values[j] == nil {
+				equal = append(equal, q.terms[j].column+" IS NULL")
+			} else {
+				equal = append(equal, fmt.Sprintf("%s = %s", q.terms[j].column, placeholders[j]))
+			}
+		}
+		past := pastKey(term, placeholders[i], values[i] == nil, t.Prev)
+		if past == "" {
+			continue
+		}
+		alternatives = append(alternatives, "("+strings.Join(append(equal, past), " AND ")+")")
+	}
+	seek := "(" + strings.Join(alternatives, " OR ") + ")"
+	if q.Where == "" {
+		q.Where = seek
+	} else {
+		q.Where += " AND " + seek
+	}
+
+	q.backward = t.Prev
+	q.OrderBy = orderBy(q.terms, t.Prev)
+	return nil
+}
+
+// Backward reports whether the query reads the page before a page token
+// in reverse order; its rows must be reversed to be in sort order.
+func (q *Query) Backward() bool {
+	return q.backward
+}
+
+// ----------------- Helpers -----------------
+
+// pastKey is the condition that term is after the key value in the sort
+// order, or before it when before is set. NULLs sort last; nothing is after
+// NULL, so the condition is empty then.
+func pastKey(term sortTerm, placeholder string, null, before bool) string {
+	if null {
+		if before {
+			return term.column + " IS NOT NULL"
+		}
+		return ""
+	}
+	op := ">"
+	if term.descending != before {
+		op = "<"
+	}
+	if before {
+		return fmt.Sprintf("%s %s %s", term.column, op, placeholder)
+	}
+	return fmt.Sprintf("(%s %s %s OR %s IS NULL)", term.column, op, placeholder, term.column)
+}
+
+// sortKey is the SQL expression of a row's sort key: a JSON array of its
+// values of the sort terms.
+func sortKey(terms []sortTerm) string {
+	columns := make([]string, 0, len(terms))
+	for _, t := range terms {
+		columns = append(columns, t.column)
+	}
+	return "json_build_array(" + strings.Join(columns, ", ") + ")::text"
+}
+
+// keyValues decodes a sort key into the text form of its values, which
+// PostgreSQL parses as the type of the column they are compared with.
+func keyValues(key json.RawMessage) ([]*string, error) {
+	dec := json.NewDecoder(bytes.NewReader(key))
+	dec.UseNumber()
+	var raw []interface{}
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	values := make([]*string, len(raw))
+	for i, v := range raw {
+		var text string
+		switch v := v.(type) {
+		case nil:
+			continue
+		case string:
+			text = v
+		case json.Number:
+			text = v.String()
+		case bool:
+			text = strconv.FormatBool(v)
+		default:
+			return nil, fmt.Errorf("unexpected key value %v", v)
+		}
+		values[i] = &text
+	}
+	return values, nil
+}
+
+func fingerprint(lq db.ListQuery) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%v", lq.Where, lq.OrderBy, lq.Args)
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
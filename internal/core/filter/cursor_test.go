@@ -0,0 +1,187 @@
+package filter
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSeek(t *testing.T) {
+	sorts := []Sort{{Field: "created_at", Descending: true}}
+	tests := []struct {
+		name     string
+		groups   []Group
+		key      string
+		prev     bool
+		where    string
+		orderBy  string
+		args     []interface{}
+		backward bool
+	}{
+		{
+			name:    "next page",
+			key:     `["2024-03-01T00:00:00",7]`,
+			where:   "(((created_at < $1 OR created_at IS NULL)) OR (created_at = $1 AND (id > $2 OR id IS NULL)))",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{"2024-03-01T00:00:00", "7"},
+		},
+		{
+			name:     "previous page",
+			key:      `["2024-03-01T00:00:00",7]`,
+			prev:     true,
+			where:    "((created_at > $1) OR (created_at = $1 AND id < $2))",
+			orderBy:  "created_at ASC NULLS FIRST, id DESC NULLS FIRST",
+			args:     []interface{}{"2024-03-01T00:00:00", "7"},
+			backward: true,
+		},
+		{
+			name:    "next page after a NULL",
+			key:     `[null,7]`,
+			where:   "((created_at IS NULL AND (id > $1 OR id IS NULL)))",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{"7"},
+		},
+		{
+			name:     "previous page before a NULL",
+			key:      `[null,7]`,
+			prev:     true,
+			where:    "((created_at IS NOT NULL) OR (created_at IS NULL AND id < $1))",
+			orderBy:  "created_at ASC NULLS FIRST, id DESC NULLS FIRST",
+			args:     []interface{}{"7"},
+			backward: true,
+		},
+		{
+			name:    "placeholders follow the filter's",
+			groups:  []Group{Where(Eq("name", "Acme"))},
+			key:     `["2024-03-01T00:00:00",7]`,
+			where:   "(name = $1) AND (((created_at < $2 OR created_at IS NULL)) OR (created_at = $2 AND (id > $3 OR id IS NULL)))",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{"Acme", "2024-03-01T00:00:00", "7"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, err := testSchema.Build(sorts, tt.groups...)
+			if err != nil {
+				t.Fatalf("Build: %v", err)
+			}
+			token := first.Token(tt.key, tt.prev)
+
+			q, err := testSchema.Build(sorts, tt.groups...)
+			if err != nil {
+				t.Fatalf("Build: %v", err)
+			}
+			if err := q.Seek(token); err != nil {
+				t.Fatalf("Seek: %v", err)
+			}
+			if q.Where != tt.where {
+				t.Errorf("Where = %q, want %q", q.Where, tt.where)
+			}
+			if q.OrderBy != tt.orderBy {
+				t.Errorf("OrderBy = %q, want %q", q.OrderBy, tt.orderBy)
+			}
+			if !reflect.DeepEqual(q.Args, tt.args) {
+				t.Errorf("Args = %#v, want %#v", q.Args, tt.args)
+			}
+			if q.Backward() != tt.backward {
+				t.Errorf("Backward = %v, want %v", q.Backward(), tt.backward)
+			}
+		})
+	}
+}
+
+func TestSeekRejects(t *testing.T) {
+	build := func(sorts []Sort, groups ...Group) *Query {
+		q, err := testSchema.Build(sorts, groups...)
+		if err != nil {
+			t.Fatalf("Build: %v", err)
+		}
+		return q
+	}
+	key := `["2024-03-01T00:00:00",7]`
+	byName := Where(Eq("name", "Acme"))
+	token := build(nil, byName).Token(key, false)
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	fingerprint := build(nil, byName).fingerprint
+
+	tests := []struct {
+		name  string
+		query *Query
+		token string
+		want  string
+	}{
+		{
+			name:  "different filter value",
+			query: build(nil, Where(Eq("name", "Other"))),
+			token: token,
+			want:  "page token does not match the filter and sort of the request",
+		},
+		{
+			name:  "different filter field",
+			query: build(nil, Where(Eq("id", 1))),
+			token: token,
+			want:  "page token does not match the filter and sort of the request",
+		},
+		{
+			name:  "no filter",
+			query: build(nil),
+			token: token,
+			want:  "page token does not match the filter and sort of the request",
+		},
+		{
+			name:  "different sort",
+			query: build(SortBy("created_at", true), byName),
+			token: token,
+			want:  "page token does not match the filter and sort of the request",
+		},
+		{
+			name:  "not base64",
+			query: build(nil, byName),
+			token: "not a token!",
+			want:  "page token is invalid",
+		},
+		{
+			name:  "not JSON",
+			query: build(nil, byName),
+			token: encode("{"),
+			want:  "page token is invalid",
+		},
+		{
+			name:  "key of the wrong length",
+			query: build(nil, byName),
+			token: encode(`{"k":[7],"f":"` + fingerprint + `"}`),
+			want:  "page token is invalid",
+		},
+		{
+			name:  "key that is not an array",
+			query: build(nil, byName),
+			token: encode(`{"k":{"id":7},"f":"` + fingerprint + `"}`),
+			want:  "page token is invalid",
+		},
+		{
+			name:  "key with a nested value",
+			query: build(nil, byName),
+			token: encode(`{"k":[[1],7],"f":"` + fingerprint + `"}`),
+			want:  "page token is invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where := tt.query.Where
+			err := tt.query.Seek(tt.token)
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Seek error = %v, want ErrInvalid", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Seek error = %q, want it to contain %q", err, tt.want)
+			}
+			if tt.query.Where != where {
+				t.Errorf("Seek changed Where to %q", tt.query.Where)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+// Package filter turns the filter and sort expressions of List requests
+// into SQL. Only fields a Schema whitelists can be used; values are always
+// passed as query arguments, never spliced into the SQL.
+package filter
+
+import (
+	"crm/internal/adapters/database/db"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalid = errors.New("invalid filter")
+
+// Operators a Condition can use.
+const (
+	OpEq         = "eq"
+	OpNe         = "ne"
+	OpLt         = "lt"
+	OpLte        = "lte"
+	OpGt         = "gt"
+	OpGte        = "gte"
+	OpIn         = "in"
+	OpNotIn      = "not_in"
+	OpContains   = "contains"
+	OpStartsWith = "starts_with"
+	OpIsNull     = "is_null"
+	OpNotNull    = "not_null"
+)
+
+// Logic of a Group.
+const (
+	And = "and"
+	Or  = "or"
+)
+
+// Limits on the size of an expression.
+const (
+	maxDepth      = 4
+	maxConditions = 50
+	maxValues     = 100
+	maxSortFields = 5
+)
+
+// Condition compares a field with one or more values. Values are given as
+// text and parsed according to the field's kind; in and not_in take one or
+// more values, is_null and not_null none, the other operators exactly one.
+type Condition struct {
+	Field    string
+	Operator string
+	Values   []string
+}
+
+// Group combines conditions and nested groups with and (the default) or
+// or. An empty group matches everything.
+type Group struct {
+	Logic      string
+	Conditions []Condition
+	Groups     []Group
+}
+
+// Sort orders by a field. Rows with the same values are ordered by id.
+type Sort struct {
+	Field      string
+	Descending bool
+}
+
+// Where returns a group of conditions that must all hold.
+func Where(conditions ...Condition) Group {
+	return Group{Logic: And, Conditions: conditions}
+}
+
+// Eq returns a condition that field equals value.
+func Eq(field string, value interface{}) Condition {
+	return Condition{Field: field, Operator: OpEq, Values: []string{fmt.Sprint(value)}}
+}
+
+// SortBy returns the single sort of the sort_by/ascending request fields
+// that predate Sort, or nil when field is empty.
+func SortBy(field string, ascending bool) []Sort {
+	if field == "" {
+		return nil
+	}
+	return []Sort{{Field: field, Descending: !ascending}}
+}
+
+// Kind is the type of a field's values.
+type Kind int
+
+const (
+	Text Kind = iota
+	Int
+	Float
+	Bool
+	Time
+)
+
+// Field is a column a Schema allows filtering and sorting on.
+type Field struct {
+	Column string
+	Kind   Kind
+}
+
+// Schema whitelists the fields of one List request.
+type Schema struct {
+	Fields map[string]Field
+	// DefaultSort applies when a request sorts by nothing.
+	DefaultSort []Sort
+}
+
+// Query is the db.ListQuery of a List request. Its rows are returned with
+// their sort key, from which page tokens are made.
+type Query struct {
+	db.ListQuery
+	terms []sortTerm
+	// fingerprint identifies the filter and sort a page token was made for.
+	fingerprint string
+	backward    bool
+}
+
+// Build returns the query for the rows matching all of groups, sorted by
+// sorts. The caller sets its Limit and Offset.
+func (s *Schema) Build(sorts []Sort, groups ...Group) (*Query, error) {
+	b := &builder{schema: s}
+	var where []string
+	for _, g := range groups {
+		clause, err := b.group(g, 0)
+		if err != nil {
+			return nil, err
+		}
+		if clause != "" {
+			where = append(where, clause)
+		}
+	}
+	terms, err := s.sortTerms(sorts)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{
+		ListQuery: db.ListQuery{
+			Where:   strings.Join(where, " AND "),
+			OrderBy: orderBy(terms, false),
+			Key:     sortKey(terms),
+			Args:    b.args,
+		},
+		terms: terms,
+	}
+	q.fingerprint = fingerprint(q.ListQuery)
+	return q, nil
+}
+
+func (s *Schema) field(name string) (Field, error) {
+	f, ok := s.Fields[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Field{}, fmt.Errorf("%w: unknown field %q, use one of %s", ErrInvalid, name, strings.Join(s.names(), ", "))
+	}
+	return f, nil
+}
+
+func (s *Schema) names() []string {
+	names := make([]string, 0, len(s.Fields))
+	for name := range s.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortTerm is a column rows are ordered by, NULLs last.
+type sortTerm struct {
+	column     string
+	descending bool
+}
+
+// sortTerms resolves sorts to columns, ending with id so that the order is
+// total.
+func (s *Schema) sortTerms(sorts []Sort) ([]sortTerm, error) {
+	if len(sorts) == 0 {
+		sorts = s.DefaultSort
+	}
+	if len(sorts) > maxSortFields {
+		return nil, fmt.Errorf("%w: at most %d sort fields", ErrInvalid, maxSortFields)
+	}
+	seen := map[string]bool{}
+	var terms []sortTerm
+	for _, srt := range sorts {
+		f, err := s.field(srt.Field)
+		if err != nil {
+			return nil, err
+		}
+		if seen[f.Column] {
+			return nil, fmt.Errorf("%w: sorted by %q twice", ErrInvalid, srt.Field)
+		}
+		seen[f.Column] = true
+		terms = append(terms, sortTerm{column: f.Column, descending: srt.Descending})
+	}
+	if !seen["id"] {
+		terms = append(terms, sortTerm{column: "id"})
+	}
+	return terms, nil
+}
+
+// orderBy renders terms as an ORDER BY list, or the opposite order when
+// reverse is set.
+func orderBy(terms []sortTerm, reverse bool) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		direction, nulls := "ASC", "LAST"
+		if t.descending != reverse {
+			direction = "DESC"
+		}
+		if reverse {
+			nulls = "FIRST"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s NULLS %s", t.column, direction, nulls))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ----------------- Helpers -----------------
+
+type builder struct {
+	schema     *Schema
+	args       []interface{}
+	conditions int
+}
+
+func (b *builder) group(g Group, depth int) (string, error) {
+	if depth >= maxDepth {
+		return "", fmt.Errorf("%w: groups nest at most %d deep", ErrInvalid, maxDepth)
+	}
+	joiner := " AND "
+	switch strings.ToLower(g.Logic) {
+	case "", And:
+	case Or:
+		joiner = " OR "
+	default:
+		return "", fmt.Errorf("%w: logic %q must be and or or", ErrInvalid, g.Logic)
+	}
+
+	var clauses []string
+	for _, c := range g.Conditions {
+		clause, err := b.condition(c)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, clause)
+	}
+	for _, sub := range g.Groups {
+		clause, err := b.group(sub, depth+1)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return "(" + strings.Join(clauses, joiner) + ")", nil
+}
+
+func (b *builder) condition(c Condition) (string, error) {
+	if b.conditions++; b.conditions > maxConditions {
+		return "", fmt.Errorf("%w: at most %d conditions", ErrInvalid, maxConditions)
+	}
+	f, err := b.schema.field(c.Field)
+	if err != nil {
+		return "", err
+	}
+	op := strings.ToLower(strings.TrimSpace(c.Operator))
+	if op == "" {
+		op = OpEq
+	}
+
+	switch op {
+	case OpIsNull, OpNotNull:
+		if len(c.Values) > 0 {
+			return "", fmt.Errorf("%w: %s %s takes no value", ErrInvalid, c.Field, op)
+		}
+		if op == OpIsNull {
+			return f.Column + " IS NULL", nil
+		}
+		return f.Column + " IS NOT NULL", nil
+	case OpIn, OpNotIn:
+		if len(c.Values) == 0 || len(c.Values) > maxValues {
+			return "", fmt.Errorf("%w: %s %s takes 1-%d values", ErrInvalid, c.Field, op, maxValues)
+		}
+		placeholders := make([]string, 0, len(c.Values))
+		for _, v := range c.Values {
+			p, err := b.arg(c.Field, f.Kind, v)
+			if err != nil {
+				return "", err
+			}
+			placeholders = append(placeholders, p)
+		}
+		if op == OpIn {
+			return fmt.Sprintf("%s IN (%s)", f.Column, strings.Join(placeholders, ", ")), nil
+		}
+		return fmt.Sprintf("(%s IS NULL OR %s NOT IN (%s))", f.Column, f.Column, strings.Join(placeholders, ", ")), nil
+	}
+
+	if len(c.Values) != 1 {
+		return "", fmt.Errorf("%w: %s %s takes one value", ErrInvalid, c.Field, op)
+	}
+	value := c.Values[0]
+
+	switch op {
+	case OpContains, OpStartsWith:
+		if f.Kind != Text {
+			return "", fmt.Errorf("%w: %s is not a text field", ErrInvalid, c.Field)
+		}
+		pattern := escapeLike(value) + "%"
+		if op == OpContains {
+			pattern = "%" + pattern
+		}
+		b.args = append(b.args, pattern)
+		return fmt.Sprintf("%s ILIKE $%d", f.Column, len(b.args)), nil
+	case OpLt, OpLte, OpGt, OpGte:
+		if f.Kind == Bool {
+			return "", fmt.Errorf("%w: %s cannot be compared with %s", ErrInvalid, c.Field, op)
+		}
+	case OpEq, OpNe:
+	default:
+		return "", fmt.Errorf("%w: unknown operator %q", ErrInvalid, c.Operator)
+	}
+
+	p, err := b.arg(c.Field, f.Kind, value)
+	if err != nil {
+		return "", err
+	}
+	comparison := map[string]string{
+		OpEq:  "=",
+		OpNe:  "IS DISTINCT FROM",
+		OpLt:  "<",
+		OpLte: "<=",
+		OpGt:  ">",
+		OpGte: ">=",
+	}[op]
+	return fmt.Sprintf("%s %s %s", f.Column, comparison, p), nil
+}
+
+// arg adds value, parsed as kind, to the arguments and returns its
+// placeholder.
+func (b *builder) arg(field string, kind Kind, value string) (string, error) {
+	if kind != Text {
+		value = strings.TrimSpace(value)
+	}
+	parsed, err := parseValue(kind, value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrInvalid, field, err)
+	}
+	b.args = append(b.args, parsed)
+	return fmt.Sprintf("$%d", len(b.args)), nil
+}
+
+func parseValue(kind Kind, value string) (interface{}, error) {
+	switch kind {
+	case Int:
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return int32(n), nil
+	case Float:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return n, nil
+	case Bool:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not true or false", value)
+		}
+		return v, nil
+	case Time:
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, nil
+		}
+		t, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a date (YYYY-MM-DD) or RFC 3339 time", value)
+		}
+		return t, nil
+	}
+	return value, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
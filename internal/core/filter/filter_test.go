@@ -0,0 +1,294 @@
+package filter
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSchema = &Schema{
+	Fields: map[string]Field{
+		"id":         {"id", Int},
+		"name":       {"name", Text},
+		"amount":     {"amount", Float},
+		"active":     {"active", Bool},
+		"created_at": {"created_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+func TestBuild(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		sorts   []Sort
+		groups  []Group
+		where   string
+		orderBy string
+		args    []interface{}
+	}{
+		{
+			name:    "no filter uses the default sort",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+		},
+		{
+			name:    "eq is the default operator",
+			groups:  []Group{{Conditions: []Condition{{Field: "name", Values: []string{"Acme"}}}}},
+			where:   "(name = $1)",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{"Acme"},
+		},
+		{
+			name:    "field names ignore case and spaces",
+			groups:  []Group{Where(Eq(" ID ", 7))},
+			where:   "(id = $1)",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{int32(7)},
+		},
+		{
+			name: "comparisons",
+			groups: []Group{Where(
+				Condition{Field: "amount", Operator: OpGte, Values: []string{"10.5"}},
+				Condition{Field: "created_at", Operator: OpLt, Values: []string{"2024-03-01"}},
+				Condition{Field: "active", Operator: OpNe, Values: []string{"true"}},
+			)},
+			where:   "(amount >= $1 AND created_at < $2 AND active IS DISTINCT FROM $3)",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{10.5, day, true},
+		},
+		{
+			name: "in and not_in",
+			groups: []Group{Where(
+				Condition{Field: "id", Operator: OpIn, Values: []string{"1", "2"}},
+				Condition{Field: "name", Operator: OpNotIn, Values: []string{"x"}},
+			)},
+			where:   "(id IN ($1, $2) AND (name IS NULL OR name NOT IN ($3)))",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{int32(1), int32(2), "x"},
+		},
+		{
+			name: "text matching escapes wildcards",
+			groups: []Group{Where(
+				Condition{Field: "name", Operator: OpContains, Values: []string{"50%_off"}},
+				Condition{Field: "name", Operator: OpStartsWith, Values: []string{"Ac"}},
+			)},
+			where:   `(name ILIKE $1 AND name ILIKE $2)`,
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{`%50\%\_off%`, "Ac%"},
+		},
+		{
+			name: "null checks",
+			groups: []Group{Where(
+				Condition{Field: "amount", Operator: OpIsNull},
+				Condition{Field: "name", Operator: OpNotNull},
+			)},
+			where:   "(amount IS NULL AND name IS NOT NULL)",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+		},
+		{
+			name: "nested or group and several groups",
+			groups: []Group{
+				{Logic: "OR", Conditions: []Condition{Eq("name", "a")}, Groups: []Group{Where(Eq("id", 1), Eq("active", true))}},
+				Where(Eq("id", 2)),
+			},
+			where:   "(name = $1 OR (id = $2 AND active = $3)) AND (id = $4)",
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+			args:    []interface{}{"a", int32(1), true, int32(2)},
+		},
+		{
+			name:    "empty groups match everything",
+			groups:  []Group{{}, {Logic: Or, Groups: []Group{{}}}},
+			orderBy: "created_at DESC NULLS LAST, id ASC NULLS LAST",
+		},
+		{
+			name:    "sorts end with id",
+			sorts:   []Sort{{Field: "amount", Descending: true}, {Field: "name"}},
+			orderBy: "amount DESC NULLS LAST, name ASC NULLS LAST, id ASC NULLS LAST",
+		},
+		{
+			name:    "sorting by id needs no tiebreaker",
+			sorts:   SortBy("id", false),
+			orderBy: "id DESC NULLS LAST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := testSchema.Build(tt.sorts, tt.groups...)
+			if err != nil {
+				t.Fatalf("Build: %v", err)
+			}
+			if q.Where != tt.where {
+				t.Errorf("Where = %q, want %q", q.Where, tt.where)
+			}
+			if q.OrderBy != tt.orderBy {
+				t.Errorf("OrderBy = %q, want %q", q.OrderBy, tt.orderBy)
+			}
+			if len(q.Args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(q.Args, tt.args) {
+					t.Errorf("Args = %#v, want %#v", q.Args, tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	nested := Group{}
+	for i := 0; i < maxDepth; i++ {
+		nested = Group{Groups: []Group{nested}}
+	}
+	many := make([]Condition, maxConditions+1)
+	for i := range many {
+		many[i] = Eq("id", i)
+	}
+	values := make([]string, maxValues+1)
+	for i := range values {
+		values[i] = "1"
+	}
+
+	tests := []struct {
+		name   string
+		sorts  []Sort
+		groups []Group
+		want   string
+	}{
+		{
+			name:   "unknown field",
+			groups: []Group{Where(Eq("password", "x"))},
+			want:   `unknown field "password", use one of active, amount, created_at, id, name`,
+		},
+		{
+			name:  "unknown sort field",
+			sorts: SortBy("password", true),
+			want:  `unknown field "password"`,
+		},
+		{
+			name:   "unknown operator",
+			groups: []Group{Where(Condition{Field: "name", Operator: "like", Values: []string{"x"}})},
+			want:   `unknown operator "like"`,
+		},
+		{
+			name:   "bad logic",
+			groups: []Group{{Logic: "xor", Conditions: []Condition{Eq("id", 1)}}},
+			want:   `logic "xor" must be and or or`,
+		},
+		{
+			name:   "not an integer",
+			groups: []Group{Where(Eq("id", "1; DROP TABLE x"))},
+			want:   `id: "1; DROP TABLE x" is not an integer`,
+		},
+		{
+			name:   "not a number",
+			groups: []Group{Where(Eq("amount", "lots"))},
+			want:   `amount: "lots" is not a number`,
+		},
+		{
+			name:   "not a bool",
+			groups: []Group{Where(Eq("active", "maybe"))},
+			want:   `active: "maybe" is not true or false`,
+		},
+		{
+			name:   "not a date",
+			groups: []Group{Where(Eq("created_at", "yesterday"))},
+			want:   `created_at: "yesterday" is not a date`,
+		},
+		{
+			name:   "contains on a number",
+			groups: []Group{Where(Condition{Field: "amount", Operator: OpContains, Values: []string{"1"}})},
+			want:   "amount is not a text field",
+		},
+		{
+			name:   "ordering a bool",
+			groups: []Group{Where(Condition{Field: "active", Operator: OpGt, Values: []string{"true"}})},
+			want:   "active cannot be compared with gt",
+		},
+		{
+			name:   "is_null with a value",
+			groups: []Group{Where(Condition{Field: "name", Operator: OpIsNull, Values: []string{"x"}})},
+			want:   "name is_null takes no value",
+		},
+		{
+			name:   "eq without a value",
+			groups: []Group{Where(Condition{Field: "name"})},
+			want:   "name eq takes one value",
+		},
+		{
+			name:   "in without values",
+			groups: []Group{Where(Condition{Field: "id", Operator: OpIn})},
+			want:   "id in takes 1-100 values",
+		},
+		{
+			name:   "in with too many values",
+			groups: []Group{Where(Condition{Field: "id", Operator: OpIn, Values: values})},
+			want:   "id in takes 1-100 values",
+		},
+		{
+			name:   "too deep",
+			groups: []Group{nested},
+			want:   "groups nest at most 4 deep",
+		},
+		{
+			name:   "too many conditions",
+			groups: []Group{Where(many...)},
+			want:   "at most 50 conditions",
+		},
+		{
+			name:  "too many sort fields",
+			sorts: []Sort{{Field: "id"}, {Field: "name"}, {Field: "amount"}, {Field: "active"}, {Field: "created_at"}, {Field: "id"}},
+			want:  "at most 5 sort fields",
+		},
+		{
+			name:  "sorted twice",
+			sorts: []Sort{{Field: "name"}, {Field: "NAME", Descending: true}},
+			want:  `sorted by "NAME" twice`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testSchema.Build(tt.sorts, tt.groups...)
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Build error = %v, want ErrInvalid", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Build error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemasAllowOnlyColumns(t *testing.T) {
+	schemas := map[string]*Schema{
+		"contacts":      Contacts,
+		"companies":     Companies,
+		"leads":         Leads,
+		"opportunities": Opportunities,
+		"activities":    Activities,
+		"tasks":         Tasks,
+		"meetings":      Meetings,
+		"proposals":     Proposals,
+		"templates":     Templates,
+		"logs":          NotificationLogs,
+		"smtp":          SMTPCredentials,
+		"products":      Products,
+		"rates":         ExchangeRates,
+		"forecasts":     ForecastSnapshots,
+		"scoring rules": LeadScoringRules,
+	}
+	for name, s := range schemas {
+		if _, ok := s.Fields["id"]; !ok {
+			t.Errorf("%s: no id field to break sort ties", name)
+		}
+		for field, f := range s.Fields {
+			if f.Column != field {
+				t.Errorf("%s: field %q maps to column %q", name, field, f.Column)
+			}
+		}
+		if _, err := s.Build(nil); err != nil {
+			t.Errorf("%s: default sort: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package filter
+
+// The fields each List request can filter and sort on.
+
+var Contacts = &Schema{
+	Fields: map[string]Field{
+		"id":           {"id", Int},
+		"contact_type": {"contact_type", Text},
+		"first_name":   {"first_name", Text},
+		"last_name":    {"last_name", Text},
+		"company_name": {"company_name", Text},
+		"company_id":   {"company_id", Int},
+		"email":        {"email", Text},
+		"phone":        {"phone", Text},
+		"city":         {"city", Text},
+		"state":        {"state", Text},
+		"country":      {"country", Text},
+		"zipcode":      {"zipcode", Text},
+		"position":     {"position", Text},
+		"created_at":   {"created_at", Time},
+		"updated_at":   {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Companies = &Schema{
+	Fields: map[string]Field{
+		"id":              {"id", Int},
+		"name":            {"name", Text},
+		"industry":        {"industry", Text},
+		"website":         {"website", Text},
+		"phone":           {"phone", Text},
+		"email":           {"email", Text},
+		"city":            {"city", Text},
+		"state":           {"state", Text},
+		"country":         {"country", Text},
+		"zipcode":         {"zipcode", Text},
+		"created_by":      {"created_by", Int},
+		"organization_id": {"organization_id", Int},
+		"created_at":      {"created_at", Time},
+		"updated_at":      {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Leads = &Schema{
+	Fields: map[string]Field{
+		"id":              {"id", Int},
+		"first_name":      {"first_name", Text},
+		"last_name":       {"last_name", Text},
+		"email":           {"email", Text},
+		"phone":           {"phone", Text},
+		"status":          {"status", Text},
+		"assigned_to":     {"assigned_to", Int},
+		"organization_id": {"organization_id", Int},
+		"company_id":      {"company_id", Int},
+		"country":         {"country", Text},
+		"state":           {"state", Text},
+		"score":           {"score", Int},
+		"created_at":      {"created_at", Time},
+		"updated_at":      {"updated_at", Time},
+		"converted_at":    {"converted_at", Time},
+		"assigned_at":     {"assigned_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Opportunities = &Schema{
+	Fields: map[string]Field{
+		"id":            {"id", Int},
+		"name":          {"name", Text},
+		"stage":         {"stage", Text},
+		"amount":        {"amount", Float},
+		"currency":      {"currency", Text},
+		"probability":   {"probability", Float},
+		"close_date":    {"close_date", Time},
+		"lead_id":       {"lead_id", Int},
+		"account_id":    {"account_id", Int},
+		"owner_id":      {"owner_id", Int},
+		"pipeline_id":   {"pipeline_id", Int},
+		"loss_reason":   {"loss_reason", Text},
+		"competitor":    {"competitor", Text},
+		"amount_locked": {"amount_locked", Bool},
+		"closed_at":     {"closed_at", Time},
+		"created_at":    {"created_at", Time},
+		"updated_at":    {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Activities = &Schema{
+	Fields: map[string]Field{
+		"id":         {"id", Int},
+		"title":      {"title", Text},
+		"type":       {"type", Text},
+		"status":     {"status", Text},
+		"due_date":   {"due_date", Time},
+		"contact_id": {"contact_id", Int},
+		"created_at": {"created_at", Time},
+		"updated_at": {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Tasks = &Schema{
+	Fields: map[string]Field{
+		"id":          {"id", Int},
+		"title":       {"title", Text},
+		"status":      {"status", Text},
+		"priority":    {"priority", Text},
+		"due_date":    {"due_date", Time},
+		"activity_id": {"activity_id", Int},
+		"created_at":  {"created_at", Time},
+		"updated_at":  {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Meetings = &Schema{
+	Fields: map[string]Field{
+		"id":           {"id", Int},
+		"title":        {"title", Text},
+		"status":       {"status", Text},
+		"organizer_id": {"organizer_id", Int},
+		"start_time":   {"start_time", Time},
+		"end_time":     {"end_time", Time},
+		"created_at":   {"created_at", Time},
+		"updated_at":   {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "start_time", Descending: true}},
+}
+
+var Proposals = &Schema{
+	Fields: map[string]Field{
+		"id":             {"id", Int},
+		"title":          {"title", Text},
+		"status":         {"status", Text},
+		"amount":         {"amount", Float},
+		"currency":       {"currency", Text},
+		"contact_id":     {"contact_id", Int},
+		"opportunity_id": {"opportunity_id", Int},
+		"created_by":     {"created_by", Int},
+		"valid_until":    {"valid_until", Time},
+		"sent_at":        {"sent_at", Time},
+		"responded_at":   {"responded_at", Time},
+		"created_at":     {"created_at", Time},
+		"updated_at":     {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+var Templates = &Schema{
+	Fields: map[string]Field{
+		"id":              {"id", Int},
+		"name":            {"name", Text},
+		"current_version": {"current_version", Int},
+		"created_at":      {"created_at", Time},
+		"updated_at":      {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "name"}},
+}
+
+var NotificationLogs = &Schema{
+	Fields: map[string]Field{
+		"id":                {"id", Text},
+		"notification_type": {"notification_type", Text},
+		"channel":           {"channel", Text},
+		"user_id":           {"user_id", Text},
+		"recipient":         {"recipient", Text},
+		"template_name":     {"template_name", Text},
+		"template_version":  {"template_version", Int},
+		"status":            {"status", Text},
+		"attempts":          {"attempts", Int},
+		"next_attempt_at":   {"next_attempt_at", Time},
+		"sent_at":           {"sent_at", Time},
+		"created_at":        {"created_at", Time},
+		"updated_at":        {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}},
+}
+
+// SMTPCredentials leaves out the key material.
+var SMTPCredentials = &Schema{
+	Fields: map[string]Field{
+		"id":            {"id", Int},
+		"user_id":       {"user_id", Text},
+		"smtp_host":     {"smtp_host", Text},
+		"smtp_port":     {"smtp_port", Int},
+		"smtp_username": {"smtp_username", Text},
+		"from_email":    {"from_email", Text},
+		"created_at":    {"created_at", Time},
+		"updated_at":    {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "id"}},
+}
+
+var Products = &Schema{
+	Fields: map[string]Field{
+		"id":         {"id", Int},
+		"sku":        {"sku", Text},
+		"name":       {"name", Text},
+		"list_price": {"list_price", Float},
+		"currency":   {"currency", Text},
+		"active":     {"active", Bool},
+		"created_at": {"created_at", Time},
+		"updated_at": {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "name"}},
+}
+
+var ExchangeRates = &Schema{
+	Fields: map[string]Field{
+		"id":             {"id", Int},
+		"from_currency":  {"from_currency", Text},
+		"to_currency":    {"to_currency", Text},
+		"rate":           {"rate", Float},
+		"effective_date": {"effective_date", Time},
+		"source":         {"source", Text},
+		"created_at":     {"created_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "from_currency"}, {Field: "to_currency"}, {Field: "effective_date", Descending: true}},
+}
+
+var ForecastSnapshots = &Schema{
+	Fields: map[string]Field{
+		"id":          {"id", Int},
+		"name":        {"name", Text},
+		"period":      {"period", Text},
+		"group_by":    {"group_by", Text},
+		"currency":    {"currency", Text},
+		"close_from":  {"close_from", Time},
+		"close_to":    {"close_to", Time},
+		"owner_id":    {"owner_id", Int},
+		"pipeline_id": {"pipeline_id", Int},
+		"created_at":  {"created_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "created_at", Descending: true}, {Field: "id", Descending: true}},
+}
+
+var LeadScoringRules = &Schema{
+	Fields: map[string]Field{
+		"id":              {"id", Int},
+		"organization_id": {"organization_id", Int},
+		"name":            {"name", Text},
+		"rule_type":       {"rule_type", Text},
+		"field":           {"field", Text},
+		"operator":        {"operator", Text},
+		"weight":          {"weight", Int},
+		"enabled":         {"enabled", Bool},
+		"created_at":      {"created_at", Time},
+		"updated_at":      {"updated_at", Time},
+	},
+	DefaultSort: []Sort{{Field: "id"}},
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"errors"
 )
 
@@ -58,15 +59,13 @@ func (r *companyRepository) DeleteCompany(ctx context.Context, id int32) error {
 }
 
 func (r *companyRepository) ListCompanies(ctx context.Context, organizationID int32, limit, offset int32, sortBy string, asc bool) ([]db.Company, error) {
-	// sqlc doesn’t support dynamic ORDER BY out of the box
-	// So, best is to write multiple queries (e.g., ListCompaniesAsc, ListCompaniesDesc)
-	// or just pick one default order in your SQL.
+	lq, err := filter.Companies.Build(filter.SortBy(sortBy, asc), filter.Where(filter.Eq("organization_id", organizationID)))
+	if err != nil {
+		return nil, err
+	}
+	lq.Limit, lq.Offset = limit, offset
 
-	companies, err := r.q.ListCompanies(ctx, db.ListCompaniesParams{
-		OrganizationID: organizationID,
-		Limit:          limit,
-		Offset:         offset,
-	})
+	companies, _, err := r.q.FilterCompanies(ctx, lq.ListQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"database/sql"
 	"errors"
 
@@ -82,12 +83,13 @@ func (r *contactRepository) Delete(ctx context.Context, id int32) error {
 }
 
 func (r *contactRepository) List(ctx context.Context, limit, offset int32, sortBy string, ascending bool) ([]db.Contact, error) {
-	// sqlc normally generates `ListContacts(limit, offset)` without sorting.
-	// If you need sorting, modify your SQL in `contacts.sql` to accept ORDER BY dynamically.
-	contacts, err := r.q.ListContacts(ctx, db.ListContactsParams{
-		Limit:  limit,
-		Offset: offset,
-	})
+	lq, err := filter.Contacts.Build(filter.SortBy(sortBy, ascending))
+	if err != nil {
+		return nil, err
+	}
+	lq.Limit, lq.Offset = limit, offset
+
+	contacts, _, err := r.q.FilterContacts(ctx, lq.ListQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crm/internal/adapters/database/db"
 	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
 	"database/sql"
 	"errors"
 	"time"
@@ -20,7 +21,7 @@ type ActivityService interface {
 	GetActivity(ctx context.Context, id int32) (*db.Activity, error)
 	UpdateActivity(ctx context.Context, params db.UpdateActivityParams) (*db.Activity, error)
 	DeleteActivity(ctx context.Context, id int32) error
-	ListActivities(ctx context.Context, pageNumber, pageSize uint) ([]db.Activity, error)
+	ListActivities(ctx context.Context, contactID int32, opts ListOptions) (*Page[db.Activity], error)
 }
 
 type activityService struct {
@@ -53,8 +54,9 @@ func (s *activityService) CreateActivity(ctx context.Context, activity *db.Creat
 
 	// Publish Kafka Event
 	_ = s.kafka.Publish(ctx, kafka.TopicActivityCreated, "activity_created", map[string]interface{}{
-		"id":    createdActivity.ID,
-		"title": createdActivity.Title,
+		"id":         createdActivity.ID,
+		"title":      createdActivity.Title,
+		"contact_id": createdActivity.ContactID,
 	})
 
 	return &createdActivity, nil
@@ -100,8 +102,9 @@ func (s *activityService) UpdateActivity(ctx context.Context, params db.UpdateAc
 
 	// Publish Kafka Event
 	_ = s.kafka.Publish(ctx, kafka.TopicActivityUpdated, "activity_updated", map[string]interface{}{
-		"id":    updatedActivity.ID,
-		"title": updatedActivity.Title,
+		"id":         updatedActivity.ID,
+		"title":      updatedActivity.Title,
+		"contact_id": updatedActivity.ContactID,
 	})
 
 	return &updatedActivity, nil
@@ -109,35 +112,30 @@ func (s *activityService) UpdateActivity(ctx context.Context, params db.UpdateAc
 
 // DeleteActivity removes an activity by Id.
 func (s *activityService) DeleteActivity(ctx context.Context, id int32) error {
-	err := s.queries.DeleteActivity(ctx, id)
+	activity, err := s.queries.GetActivity(ctx, id)
+	if err != nil {
+		return ErrActivityNotFound
+	}
+	err = s.queries.DeleteActivity(ctx, id)
 	if err != nil {
 		return ErrActivityNotFound
 	}
 
 	// Publish Kafka Event
 	_ = s.kafka.Publish(ctx, kafka.TopicActivityDeleted, "activity_deleted", map[string]interface{}{
-		"id": id,
+		"id":         id,
+		"contact_id": activity.ContactID,
 	})
 
 	return nil
 }
 
-// ListActivities retrieves activities with pagination.
-func (s *activityService) ListActivities(ctx context.Context, pageNumber, pageSize uint) ([]db.Activity, error) {
-	if pageNumber == 0 {
-		pageNumber = 1
-	}
-	if pageSize == 0 {
-		pageSize = 10
-	}
-	offset := (pageNumber - 1) * pageSize
-
-	activities, err := s.queries.ListActivities(ctx, db.ListActivitiesParams{
-		Limit:  int32(pageSize),
-		Offset: int32(offset),
-	})
-	if err != nil {
-		return nil, err
+// ListActivities retrieves a filtered, sorted page of activities, of one
+// contact unless contactID is 0.
+func (s *activityService) ListActivities(ctx context.Context, contactID int32, opts ListOptions) (*Page[db.Activity], error) {
+	var conditions []filter.Condition
+	if contactID != 0 {
+		conditions = append(conditions, filter.Eq("contact_id", contactID))
 	}
-	return activities, nil
+	return listPage(ctx, filter.Activities, opts, s.queries.FilterActivities, s.queries.CountActivities, filter.Where(conditions...))
 }
@@ -1,6 +1,17 @@
 package services
 
-import "regexp"
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"database/sql"
+	"errors"
+	"regexp"
+	"slices"
+	"sync/atomic"
+
+	"github.com/jackc/pgconn"
+)
 
 // isValidEmail validates the email format using a regular expression.
 func isValidEmail(email string) bool {
@@ -8,3 +19,160 @@ func isValidEmail(email string) bool {
 	re := regexp.MustCompile(regex)
 	return re.MatchString(email)
 }
+
+// Page size limits shared by every List method. They are swapped atomically
+// so a config reload can change them while requests are in flight.
+var (
+	defaultPageSize atomic.Int32
+	maxPageSize     atomic.Int32
+)
+
+func init() {
+	SetPageLimits(10, 100)
+}
+
+// SetPageLimits configures the page size used when a request omits one and
+// the largest page size a request may ask for.
+func SetPageLimits(defaultSize, maxSize int32) {
+	defaultPageSize.Store(defaultSize)
+	maxPageSize.Store(maxSize)
+}
+
+// paginate turns a 1-based page number and page size into LIMIT/OFFSET,
+// applying the configured default and cap.
+func paginate(pageNumber, pageSize int32) (limit, offset int32) {
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize.Load()
+	}
+	if max := maxPageSize.Load(); pageSize > max {
+		pageSize = max
+	}
+	return pageSize, (pageNumber - 1) * pageSize
+}
+
+// ListOptions filter, sort and page a List method. Filter and Sort may only
+// use the fields of the method's filter.Schema; without Sort the schema's
+// default order applies.
+type ListOptions struct {
+	Filter filter.Group
+	Sort   []filter.Sort
+	// PageToken is the next or previous page token of an earlier page with
+	// the same filter and sort. It takes precedence over PageNumber.
+	PageToken  string
+	PageNumber int32
+	PageSize   int32
+	// IncludeTotal counts all matching records, which is slow on large
+	// tables.
+	IncludeTotal bool
+}
+
+// Page is a page of the records of a List method in sort order.
+type Page[T any] struct {
+	Items []T
+	// NextPageToken and PrevPageToken are empty on the last and first page.
+	NextPageToken string
+	PrevPageToken string
+	// TotalCount is set when ListOptions.IncludeTotal is.
+	TotalCount int64
+}
+
+// listPage reads a page of records with list, keyset paged when opts has a
+// page token, and counts all matching records with count when asked to.
+// Records must also match all of where, which the List method imposes
+// itself.
+func listPage[T any](
+	ctx context.Context,
+	schema *filter.Schema,
+	opts ListOptions,
+	list func(context.Context, db.ListQuery) ([]T, []string, error),
+	count func(context.Context, db.ListQuery) (int64, error),
+	where ...filter.Group,
+) (*Page[T], error) {
+	q, err := schema.Build(opts.Sort, append([]filter.Group{opts.Filter}, where...)...)
+	if err != nil {
+		return nil, err
+	}
+	unpaged := q.ListQuery
+
+	limit, offset := paginate(opts.PageNumber, opts.PageSize)
+	if opts.PageToken != "" {
+		if err := q.Seek(opts.PageToken); err != nil {
+			return nil, err
+		}
+		offset = 0
+	}
+	// One row more than the page tells whether there is another page.
+	q.Limit, q.Offset = limit+1, offset
+
+	items, keys, err := list(ctx, q.ListQuery)
+	if err != nil {
+		return nil, err
+	}
+	more := len(items) > int(limit)
+	if more {
+		items, keys = items[:limit], keys[:limit]
+	}
+	if q.Backward() {
+		slices.Reverse(items)
+		slices.Reverse(keys)
+	}
+
+	page := &Page[T]{Items: items}
+	if len(items) > 0 {
+		// A page read backwards was reached from the page after it.
+		if more || q.Backward() {
+			page.NextPageToken = q.Token(keys[len(keys)-1], false)
+		}
+		if (q.Backward() && more) || (!q.Backward() && (opts.PageToken != "" || offset > 0)) {
+			page.PrevPageToken = q.Token(keys[0], true)
+		}
+	}
+	if opts.IncludeTotal {
+		if page.TotalCount, err = count(ctx, unpaged); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// convertPage returns page with its items converted by convert.
+func convertPage[T, U any](page *Page[T], convert func(T) (U, error)) (*Page[U], error) {
+	items := make([]U, 0, len(page.Items))
+	for _, item := range page.Items {
+		u, err := convert(item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, u)
+	}
+	return &Page[U]{
+		Items:         items,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// withTx runs fn against queries bound to a new transaction, committing when
+// fn succeeds and rolling back otherwise.
+func withTx(ctx context.Context, conn *sql.DB, queries *db.Queries, fn func(q *db.Queries) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint
+// violation of the named constraint.
+func isUniqueViolation(err error, constraintName string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraintName
+}
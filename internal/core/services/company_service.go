@@ -4,6 +4,8 @@ import (
 	"context"
 	"crm/internal/adapters/database/db"
 	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
+	"database/sql"
 	"errors"
 	"strings"
 )
@@ -18,7 +20,8 @@ type CompanyServiceInterface interface {
 	GetCompany(ctx context.Context, id int32) (*db.Company, error)
 	UpdateCompany(ctx context.Context, company db.UpdateCompanyParams) (*db.Company, error)
 	DeleteCompany(ctx context.Context, id int32) error
-	ListCompanies(ctx context.Context, orgID int32, page, size int32) ([]db.Company, error)
+	ListCompanies(ctx context.Context, orgID int32, opts ListOptions) (*Page[db.Company], error)
+	ListCompanyOpportunities(ctx context.Context, id int32) ([]db.Opportunity, error)
 }
 type CompanyService struct {
 	queries *db.Queries
@@ -89,23 +92,18 @@ func (s *CompanyService) DeleteCompany(ctx context.Context, id int32) error {
 	return nil
 }
 
-func (s *CompanyService) ListCompanies(ctx context.Context, orgID int32, page, size uint) ([]db.Company, error) {
-	if page == 0 {
-		page = 1
-	}
-	if size == 0 {
-		size = 10
-	}
+// ListCompanies retrieves a filtered, sorted page of an organization's
+// companies.
+func (s *CompanyService) ListCompanies(ctx context.Context, orgID int32, opts ListOptions) (*Page[db.Company], error) {
+	return listPage(ctx, filter.Companies, opts, s.queries.FilterCompanies, s.queries.CountCompanies,
+		filter.Where(filter.Eq("organization_id", orgID)))
+}
 
-	offset := (page - 1) * size
-	companies, err := s.queries.ListCompanies(ctx, db.ListCompaniesParams{
-		OrganizationID: orgID,
-		Limit:          int32(size),
-		Offset:         int32(offset),
-	})
-	if err != nil {
+// ListCompanyOpportunities returns the opportunities whose account is the
+// company, most recent first.
+func (s *CompanyService) ListCompanyOpportunities(ctx context.Context, id int32) ([]db.Opportunity, error) {
+	if _, err := s.GetCompany(ctx, id); err != nil {
 		return nil, err
 	}
-
-	return companies, nil
+	return s.queries.ListCompanyOpportunities(ctx, sql.NullInt32{Int32: id, Valid: true})
 }
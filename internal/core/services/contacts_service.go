@@ -4,6 +4,7 @@ import (
 	"context"
 	"crm/internal/adapters/database/db"
 	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
 	"errors"
 	"strings"
 )
@@ -19,7 +20,8 @@ type ContactServiceInterface interface {
 	GetContact(ctx context.Context, id int32) (*db.Contact, error)
 	UpdateContact(ctx context.Context, contact db.UpdateContactParams) (*db.Contact, error)
 	DeleteContact(ctx context.Context, id int32) error
-	ListContacts(ctx context.Context, pageNumber, pageSize int32) ([]db.Contact, error)
+	ListContacts(ctx context.Context, opts ListOptions) (*Page[db.Contact], error)
+	ListContactOpportunities(ctx context.Context, id int32) ([]ContactOpportunity, error)
 }
 
 type ContactService struct {
@@ -119,22 +121,16 @@ func (s *ContactService) DeleteContact(ctx context.Context, id int32) error {
 	return nil
 }
 
-// ListContacts retrieves contacts with pagination and sorting.
-func (s *ContactService) ListContacts(ctx context.Context, pageNumber, pageSize int32) ([]db.Contact, error) {
-	if pageNumber == 0 {
-		pageNumber = 1
-	}
-	if pageSize == 0 {
-		pageSize = 10
-	}
-	offset := (pageNumber - 1) * pageSize
+// ListContacts retrieves a filtered, sorted page of contacts.
+func (s *ContactService) ListContacts(ctx context.Context, opts ListOptions) (*Page[db.Contact], error) {
+	return listPage(ctx, filter.Contacts, opts, s.queries.FilterContacts, s.queries.CountContacts)
+}
 
-	contacts, err := s.queries.ListContacts(ctx, db.ListContactsParams{
-		Limit:  pageSize,
-		Offset: offset,
-	})
-	if err != nil {
+// ListContactOpportunities returns the opportunities a contact has roles on,
+// most recent first.
+func (s *ContactService) ListContactOpportunities(ctx context.Context, id int32) ([]ContactOpportunity, error) {
+	if _, err := s.GetContact(ctx, id); err != nil {
 		return nil, err
 	}
-	return contacts, nil
+	return contactOpportunities(ctx, s.queries, id)
 }
@@ -0,0 +1,311 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCurrency      = errors.New("invalid currency")
+	ErrInvalidExchangeRate  = errors.New("invalid exchange rate")
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+	ErrNoExchangeRate       = errors.New("no exchange rate")
+)
+
+// DefaultCurrency is used for amounts entered without a currency and by
+// organizations that have not chosen a reporting currency.
+const DefaultCurrency = "USD"
+
+// Sources recorded with exchange rates.
+const (
+	ExchangeRateSourceAPI    = "api"
+	ExchangeRateSourceImport = "import"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ExchangeRate says one unit of From is worth Rate units of To from
+// EffectiveDate on.
+type ExchangeRate struct {
+	From          string
+	To            string
+	Rate          float64
+	EffectiveDate time.Time
+}
+
+type CurrencyServiceInterface interface {
+	SetExchangeRates(ctx context.Context, rates []ExchangeRate) ([]db.ExchangeRate, error)
+	ImportExchangeRates(ctx context.Context, r io.Reader) (int, error)
+	ListExchangeRates(ctx context.Context, currency string, opts ListOptions) (*Page[db.ExchangeRate], error)
+	DeleteExchangeRate(ctx context.Context, id int32) error
+	GetReportingCurrency(ctx context.Context, organizationID int32) (string, error)
+	SetReportingCurrency(ctx context.Context, organizationID int32, currency string) (string, error)
+	Convert(ctx context.Context, amount float64, from, to string, on time.Time) (float64, error)
+}
+
+type CurrencyService struct {
+	conn    *sql.DB
+	queries *db.Queries
+}
+
+func NewCurrencyService(conn *sql.DB, queries *db.Queries) *CurrencyService {
+	return &CurrencyService{conn: conn, queries: queries}
+}
+
+// SetExchangeRates stores rates, replacing any rate for the same pair and
+// date. Either all rates are stored or none.
+func (s *CurrencyService) SetExchangeRates(ctx context.Context, rates []ExchangeRate) ([]db.ExchangeRate, error) {
+	return s.storeExchangeRates(ctx, rates, ExchangeRateSourceAPI)
+}
+
+// ImportExchangeRates reads rates from CSV with the columns from_currency,
+// to_currency, rate and effective_date (YYYY-MM-DD); a header row is
+// optional. Nothing is stored when any row is invalid.
+func (s *CurrencyService) ImportExchangeRates(ctx context.Context, r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var rates []ExchangeRate
+	var problems []string
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrInvalidExchangeRate, err)
+		}
+		if first && strings.EqualFold(record[0], "from_currency") {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		rate, rateErr := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		date, dateErr := time.Parse("2006-01-02", strings.TrimSpace(record[3]))
+		switch {
+		case rateErr != nil:
+			problems = append(problems, fmt.Sprintf("line %d: rate %q is not a number", line, record[2]))
+		case dateErr != nil:
+			problems = append(problems, fmt.Sprintf("line %d: effective_date %q is not YYYY-MM-DD", line, record[3]))
+		default:
+			rates = append(rates, ExchangeRate{
+				From:          strings.ToUpper(strings.TrimSpace(record[0])),
+				To:            strings.ToUpper(strings.TrimSpace(record[1])),
+				Rate:          rate,
+				EffectiveDate: date,
+			})
+		}
+	}
+	if len(problems) > 0 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidExchangeRate, strings.Join(problems, "; "))
+	}
+
+	stored, err := s.storeExchangeRates(ctx, rates, ExchangeRateSourceImport)
+	if err != nil {
+		return 0, err
+	}
+	return len(stored), nil
+}
+
+// ListExchangeRates returns a filtered, sorted page of exchange rates, of
+// pairs with currency on either side unless it is empty.
+func (s *CurrencyService) ListExchangeRates(ctx context.Context, currency string, opts ListOptions) (*Page[db.ExchangeRate], error) {
+	var where []filter.Group
+	if currency = strings.ToUpper(strings.TrimSpace(currency)); currency != "" {
+		where = append(where, filter.Group{Logic: filter.Or, Conditions: []filter.Condition{
+			filter.Eq("from_currency", currency),
+			filter.Eq("to_currency", currency),
+		}})
+	}
+	return listPage(ctx, filter.ExchangeRates, opts, s.queries.FilterExchangeRates, s.queries.CountExchangeRates, where...)
+}
+
+// DeleteExchangeRate removes a rate.
+func (s *CurrencyService) DeleteExchangeRate(ctx context.Context, id int32) error {
+	deleted, err := s.queries.DeleteExchangeRate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrExchangeRateNotFound
+	}
+	return nil
+}
+
+// GetReportingCurrency returns the currency an organization's reports are
+// converted to.
+func (s *CurrencyService) GetReportingCurrency(ctx context.Context, organizationID int32) (string, error) {
+	return reportingCurrency(ctx, s.queries, organizationID)
+}
+
+// SetReportingCurrency changes the currency an organization's reports are
+// converted to.
+func (s *CurrencyService) SetReportingCurrency(ctx context.Context, organizationID int32, currency string) (string, error) {
+	if organizationID <= 0 {
+		return "", fmt.Errorf("%w: organization_id is required", ErrInvalidCurrency)
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if err := validateCurrency(currency); err != nil {
+		return "", err
+	}
+	settings, err := s.queries.SetReportingCurrency(ctx, db.SetReportingCurrencyParams{
+		OrganizationID:    organizationID,
+		ReportingCurrency: currency,
+	})
+	if err != nil {
+		return "", err
+	}
+	return settings.ReportingCurrency, nil
+}
+
+// Convert converts an amount with the rate in effect on the given date.
+func (s *CurrencyService) Convert(ctx context.Context, amount float64, from, to string, on time.Time) (float64, error) {
+	if err := validateCurrency(from); err != nil {
+		return 0, err
+	}
+	if err := validateCurrency(to); err != nil {
+		return 0, err
+	}
+	return newCurrencyConverter(s.queries).convert(ctx, amount, from, to, on)
+}
+
+// ----------------- Helpers -----------------
+
+func (s *CurrencyService) storeExchangeRates(ctx context.Context, rates []ExchangeRate, source string) ([]db.ExchangeRate, error) {
+	var problems []string
+	for i, rate := range rates {
+		switch {
+		case !currencyCode.MatchString(rate.From) || !currencyCode.MatchString(rate.To):
+			problems = append(problems, fmt.Sprintf("rate %d: currencies must be ISO 4217 codes", i+1))
+		case rate.From == rate.To:
+			problems = append(problems, fmt.Sprintf("rate %d: from and to currency are the same", i+1))
+		case rate.Rate <= 0:
+			problems = append(problems, fmt.Sprintf("rate %d: rate must be positive", i+1))
+		case rate.EffectiveDate.IsZero():
+			problems = append(problems, fmt.Sprintf("rate %d: effective_date is required", i+1))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExchangeRate, strings.Join(problems, "; "))
+	}
+
+	stored := make([]db.ExchangeRate, 0, len(rates))
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		for _, rate := range rates {
+			row, err := q.UpsertExchangeRate(ctx, db.UpsertExchangeRateParams{
+				FromCurrency:  rate.From,
+				ToCurrency:    rate.To,
+				Rate:          rate.Rate,
+				EffectiveDate: rate.EffectiveDate,
+				Source:        source,
+			})
+			if err != nil {
+				return err
+			}
+			stored = append(stored, row)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func validateCurrency(currency string) error {
+	if !currencyCode.MatchString(currency) {
+		return fmt.Errorf("%w: %q is not an ISO 4217 code", ErrInvalidCurrency, currency)
+	}
+	return nil
+}
+
+// reportingCurrency returns the reporting currency of an organization, or
+// DefaultCurrency when it has not chosen one.
+func reportingCurrency(ctx context.Context, q *db.Queries, organizationID int32) (string, error) {
+	currency, err := q.GetReportingCurrency(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultCurrency, nil
+	}
+	return strings.TrimSpace(currency), err
+}
+
+// targetCurrency picks the currency a report is converted to: currency when
+// given, otherwise the reporting currency of organizationID, otherwise
+// DefaultCurrency.
+func targetCurrency(ctx context.Context, q *db.Queries, organizationID int32, currency string) (string, error) {
+	if currency != "" {
+		currency = strings.ToUpper(currency)
+		return currency, validateCurrency(currency)
+	}
+	if organizationID > 0 {
+		return reportingCurrency(ctx, q, organizationID)
+	}
+	return DefaultCurrency, nil
+}
+
+type rateKey struct {
+	from, to string
+	on       string
+}
+
+// currencyConverter converts amounts between currencies, remembering the
+// rates it looked up. Rates are used in either direction; when a pair has
+// rates both ways the one that took effect last wins.
+type currencyConverter struct {
+	q     *db.Queries
+	rates map[rateKey]float64
+}
+
+func newCurrencyConverter(q *db.Queries) *currencyConverter {
+	return &currencyConverter{q: q, rates: map[rateKey]float64{}}
+}
+
+func (c *currencyConverter) convert(ctx context.Context, amount float64, from, to string, on time.Time) (float64, error) {
+	if from == to {
+		return amount, nil
+	}
+	key := rateKey{from: from, to: to, on: on.Format("2006-01-02")}
+	rate, ok := c.rates[key]
+	if !ok {
+		var err error
+		if rate, err = c.lookup(ctx, from, to, on); err != nil {
+			return 0, err
+		}
+		c.rates[key] = rate
+	}
+	return roundCents(amount * rate), nil
+}
+
+func (c *currencyConverter) lookup(ctx context.Context, from, to string, on time.Time) (float64, error) {
+	direct, err := c.q.GetExchangeRateOn(ctx, db.GetExchangeRateOnParams{FromCurrency: from, ToCurrency: to, OnDate: on})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	hasDirect := err == nil
+	inverse, err := c.q.GetExchangeRateOn(ctx, db.GetExchangeRateOnParams{FromCurrency: to, ToCurrency: from, OnDate: on})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	hasInverse := err == nil
+
+	switch {
+	case hasDirect && (!hasInverse || !inverse.EffectiveDate.After(direct.EffectiveDate)):
+		return direct.Rate, nil
+	case hasInverse:
+		return 1 / inverse.Rate, nil
+	default:
+		return 0, fmt.Errorf("%w: %s to %s on %s", ErrNoExchangeRate, from, to, on.Format("2006-01-02"))
+	}
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCurrencyConverterLookup(t *testing.T) {
+	on := date(2024, 6, 1)
+	rate := func(from, to string, value float64, effective time.Time) db.ExchangeRate {
+		return db.ExchangeRate{FromCurrency: from, ToCurrency: to, Rate: value, EffectiveDate: effective}
+	}
+
+	tests := []struct {
+		name  string
+		rates []db.ExchangeRate
+		fail  bool    // the rate query fails
+		want  float64 // the EUR to USD rate
+		err   error
+	}{
+		{
+			name:  "direct rate",
+			rates: []db.ExchangeRate{rate("EUR", "USD", 1.1, date(2024, 5, 1))},
+			want:  1.1,
+		},
+		{
+			name:  "inverse only",
+			rates: []db.ExchangeRate{rate("USD", "EUR", 0.8, date(2024, 5, 1))},
+			want:  1.25,
+		},
+		{
+			name: "later direct rate wins",
+			rates: []db.ExchangeRate{
+				rate("EUR", "USD", 1.1, date(2024, 5, 2)),
+				rate("USD", "EUR", 0.8, date(2024, 5, 1)),
+			},
+			want: 1.1,
+		},
+		{
+			name: "later inverse rate wins",
+			rates: []db.ExchangeRate{
+				rate("EUR", "USD", 1.1, date(2024, 5, 1)),
+				rate("USD", "EUR", 0.8, date(2024, 5, 2)),
+			},
+			want: 1.25,
+		},
+		{
+			name: "direct rate wins a tie",
+			rates: []db.ExchangeRate{
+				rate("EUR", "USD", 1.1, date(2024, 5, 1)),
+				rate("USD", "EUR", 0.8, date(2024, 5, 1)),
+			},
+			want: 1.1,
+		},
+		{
+			name:  "rates after the date are ignored",
+			rates: []db.ExchangeRate{rate("EUR", "USD", 1.1, date(2024, 6, 2))},
+			err:   ErrNoExchangeRate,
+		},
+		{
+			name:  "other pairs are ignored",
+			rates: []db.ExchangeRate{rate("EUR", "GBP", 0.85, date(2024, 5, 1))},
+			err:   ErrNoExchangeRate,
+		},
+		{
+			name: "missing rate",
+			err:  ErrNoExchangeRate,
+		},
+		{
+			name: "query errors are returned",
+			fail: true,
+			err:  errFakeRateQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := sql.OpenDB(&fakeRateDB{rates: tt.rates, fail: tt.fail})
+			defer conn.Close()
+
+			got, err := newCurrencyConverter(db.New(conn)).lookup(context.Background(), "EUR", "USD", on)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("lookup error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("lookup: %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("lookup = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyConverterConvert(t *testing.T) {
+	fake := &fakeRateDB{rates: []db.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "EUR", Rate: 0.8, EffectiveDate: date(2024, 5, 1)},
+	}}
+	conn := sql.OpenDB(fake)
+	defer conn.Close()
+	c := newCurrencyConverter(db.New(conn))
+	ctx := context.Background()
+
+	if got, err := c.convert(ctx, 10, "EUR", "EUR", date(2024, 6, 1)); err != nil || got != 10 {
+		t.Fatalf("convert to the same currency = %v, %v, want 10", got, err)
+	}
+	if fake.queries != 0 {
+		t.Fatalf("convert to the same currency ran %d queries, want none", fake.queries)
+	}
+	for i := 0; i < 2; i++ {
+		got, err := c.convert(ctx, 10.01, "EUR", "USD", time.Date(2024, 6, 1, 15, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("convert: %v", err)
+		}
+		if got != 12.51 {
+			t.Errorf("convert = %v, want 12.51", got)
+		}
+	}
+	if fake.queries != 2 {
+		t.Errorf("two conversions on one day ran %d queries, want the 2 of one lookup", fake.queries)
+	}
+}
+
+var errFakeRateQuery = errors.New("rate query failed")
+
+// fakeRateDB is a database/sql driver answering GetExchangeRateOn from
+// rates: the latest rate of the pair that took effect on or before the
+// date.
+type fakeRateDB struct {
+	rates   []db.ExchangeRate
+	fail    bool
+	queries int
+}
+
+func (f *fakeRateDB) Connect(context.Context) (driver.Conn, error) { return fakeRateConn{f}, nil }
+func (f *fakeRateDB) Driver() driver.Driver                        { return nil }
+
+type fakeRateConn struct{ db *fakeRateDB }
+
+func (c fakeRateConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeRateConn) Close() error              { return nil }
+func (c fakeRateConn) Begin() (driver.Tx, error) { return nil, errors.New("begin is not supported") }
+
+func (c fakeRateConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if !strings.Contains(query, "GetExchangeRateOn") {
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	c.db.queries++
+	if c.db.fail {
+		return nil, errFakeRateQuery
+	}
+	from, to, on := args[0].Value.(string), args[1].Value.(string), args[2].Value.(time.Time)
+	rows := &fakeRateRows{}
+	for _, r := range c.db.rates {
+		if r.FromCurrency != from || r.ToCurrency != to || r.EffectiveDate.After(on) {
+			continue
+		}
+		if len(rows.rates) == 0 || r.EffectiveDate.After(rows.rates[0].EffectiveDate) {
+			rows.rates = []db.ExchangeRate{r}
+		}
+	}
+	return rows, nil
+}
+
+type fakeRateRows struct {
+	rates []db.ExchangeRate
+	next  int
+}
+
+func (r *fakeRateRows) Columns() []string {
+	return []string{"id", "from_currency", "to_currency", "rate", "effective_date", "source", "created_at"}
+}
+
+func (r *fakeRateRows) Close() error { return nil }
+
+func (r *fakeRateRows) Next(dest []driver.Value) error {
+	if r.next == len(r.rates) {
+		return io.EOF
+	}
+	e := r.rates[r.next]
+	r.next++
+	copy(dest, []driver.Value{int64(e.ID), e.FromCurrency, e.ToCurrency, e.Rate, e.EffectiveDate, e.Source, e.EffectiveDate})
+	return nil
+}
@@ -0,0 +1,734 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidMerge      = errors.New("invalid merge")
+	ErrUnknownEntityType = errors.New("unknown entity type")
+)
+
+// Entity types that can be deduplicated.
+const (
+	EntityContact = "contact"
+	EntityLead    = "lead"
+)
+
+// Reasons two records are reported as duplicates.
+const (
+	MatchEmailCase      = "email_case"      // emails differ only in case
+	MatchPlusAddressing = "plus_addressing" // emails differ by a +tag or, for Gmail, dots
+	MatchPhone          = "phone"
+	MatchSimilarName    = "similar_name"
+)
+
+const (
+	// dedupeBatch is how many records FindDuplicates loads at a time.
+	dedupeBatch = 1000
+	// maxDedupeBlock skips keys shared by more records than this, e.g. a
+	// switchboard number, which say little about identity and would make
+	// the pairwise comparison quadratic.
+	maxDedupeBlock = 50
+	// minNameSimilarity is the normalized edit-distance similarity above
+	// which two full names match.
+	minNameSimilarity = 0.85
+	// maxMergeRecords caps the records merged into a survivor at once.
+	maxMergeRecords = 20
+)
+
+// matchWeights is how strongly each reason suggests two records are the
+// same person. similar_name is further scaled by the name similarity.
+var matchWeights = map[string]float64{
+	MatchEmailCase:      1.0,
+	MatchPlusAddressing: 0.95,
+	MatchPhone:          0.8,
+	MatchSimilarName:    0.7,
+}
+
+// DuplicateCandidate is the part of a contact or lead compared for
+// duplicates. Only candidates of the same organization are compared;
+// contacts have none.
+type DuplicateCandidate struct {
+	ID             int32
+	OrganizationID sql.NullInt32
+	FirstName      string
+	LastName       string
+	Email          string
+	Phone          string
+}
+
+// DuplicateMatch explains why two records of a cluster match. Score is in
+// (0, 1]; several reasons combine to a higher score.
+type DuplicateMatch struct {
+	A, B    int32
+	Reasons []string
+	Score   float64
+}
+
+// DuplicateCluster is a group of records connected by matches. Score is
+// that of its strongest match.
+type DuplicateCluster struct {
+	Records []DuplicateCandidate
+	Matches []DuplicateMatch
+	Score   float64
+}
+
+// ContactMerge is a survivor contact with one audit entry per merged
+// contact.
+type ContactMerge struct {
+	Contact db.Contact
+	Audits  []db.MergeAudit
+}
+
+// LeadMerge is a survivor lead with one audit entry per merged lead.
+type LeadMerge struct {
+	Lead   db.Lead
+	Audits []db.MergeAudit
+}
+
+type DuplicateServiceInterface interface {
+	FindDuplicates(ctx context.Context, entityType string, organizationID sql.NullInt32, maxClusters int) ([]DuplicateCluster, error)
+	MergeContacts(ctx context.Context, survivorID int32, mergedIDs []int32) (*ContactMerge, error)
+	MergeLeads(ctx context.Context, survivorID int32, mergedIDs []int32) (*LeadMerge, error)
+	ListMergeAudits(ctx context.Context, entityType string, survivorID int32) ([]db.MergeAudit, error)
+}
+
+// DuplicateService finds near-duplicate contacts and leads that the unique
+// email constraints let through, and merges them.
+type DuplicateService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	kafka   *kafka.Producer
+}
+
+func NewDuplicateService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *DuplicateService {
+	return &DuplicateService{conn: conn, queries: queries, kafka: producer}
+}
+
+// FindDuplicates returns clusters of likely duplicate contacts or leads,
+// strongest first. organizationID narrows leads; without it leads are
+// still only matched within their own organization, since MergeLeads
+// refuses anything else. Contacts have no organization. maxClusters <= 0
+// returns every cluster.
+func (s *DuplicateService) FindDuplicates(ctx context.Context, entityType string, organizationID sql.NullInt32, maxClusters int) ([]DuplicateCluster, error) {
+	var candidates []DuplicateCandidate
+	var afterID int32
+	for {
+		var batch []DuplicateCandidate
+		switch entityType {
+		case EntityContact:
+			rows, err := s.queries.ListContactsForDedupe(ctx, db.ListContactsForDedupeParams{AfterID: afterID, LimitCount: dedupeBatch})
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range rows {
+				batch = append(batch, DuplicateCandidate{ID: r.ID, FirstName: r.FirstName.String, LastName: r.LastName.String, Email: r.Email, Phone: r.Phone.String})
+			}
+		case EntityLead:
+			rows, err := s.queries.ListLeadsForDedupe(ctx, db.ListLeadsForDedupeParams{OrganizationID: organizationID, AfterID: afterID, LimitCount: dedupeBatch})
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range rows {
+				batch = append(batch, DuplicateCandidate{ID: r.ID, OrganizationID: r.OrganizationID, FirstName: r.FirstName, LastName: r.LastName, Email: r.Email, Phone: r.Phone.String})
+			}
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrUnknownEntityType, entityType)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		candidates = append(candidates, batch...)
+		afterID = batch[len(batch)-1].ID
+	}
+
+	clusters := findDuplicates(candidates)
+	if maxClusters > 0 && len(clusters) > maxClusters {
+		clusters = clusters[:maxClusters]
+	}
+	return clusters, nil
+}
+
+// MergeContacts merges contacts into the survivor and deletes them. Empty
+// survivor fields are filled from the merged contacts in the given order;
+// its email is kept. Activities (and with them their tasks), proposals,
+// meeting attendance and lead conversions move to the survivor.
+func (s *DuplicateService) MergeContacts(ctx context.Context, survivorID int32, mergedIDs []int32) (*ContactMerge, error) {
+	if err := validateMerge(survivorID, mergedIDs); err != nil {
+		return nil, err
+	}
+
+	result := &ContactMerge{}
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		contacts := map[int32]db.Contact{}
+		for _, id := range lockOrder(survivorID, mergedIDs) {
+			c, err := q.GetContactForUpdate(ctx, id)
+			if err != nil {
+				return fmt.Errorf("%w: %d", ErrContactNotFound, id)
+			}
+			contacts[id] = c
+		}
+
+		survivor := contacts[survivorID]
+		for _, id := range mergedIDs {
+			merged := contacts[id]
+			filled := fillContact(&survivor, merged)
+
+			tasks, err := q.CountContactTasks(ctx, id)
+			if err != nil {
+				return err
+			}
+			move := db.MoveContactActivitiesParams{SurvivorID: survivorID, MergedID: id}
+			repointed := map[string]int64{"tasks": tasks}
+			if repointed["activities"], err = q.MoveContactActivities(ctx, move); err != nil {
+				return err
+			}
+			if repointed["proposals"], err = q.MoveContactProposals(ctx, db.MoveContactProposalsParams(move)); err != nil {
+				return err
+			}
+			shared, err := q.DeleteSharedContactAttendees(ctx, db.DeleteSharedContactAttendeesParams{MergedID: id, SurvivorID: survivorID})
+			if err != nil {
+				return err
+			}
+			moved, err := q.MoveContactAttendees(ctx, db.MoveContactAttendeesParams(move))
+			if err != nil {
+				return err
+			}
+			repointed["meeting_attendees"] = shared + moved
+			if repointed["converted_leads"], err = q.MoveContactConversions(ctx, db.MoveContactConversionsParams(move)); err != nil {
+				return err
+			}
+			shared, err = q.DeleteSharedContactOpportunityRoles(ctx, db.DeleteSharedContactOpportunityRolesParams{MergedID: id, SurvivorID: survivorID})
+			if err != nil {
+				return err
+			}
+			if moved, err = q.MoveContactOpportunityRoles(ctx, db.MoveContactOpportunityRolesParams(move)); err != nil {
+				return err
+			}
+			repointed["opportunity_contacts"] = shared + moved
+
+			if err := q.DeleteContact(ctx, id); err != nil {
+				return err
+			}
+			audit, err := createMergeAudit(ctx, q, EntityContact, survivorID, id, contactSnapshot(merged), filled, repointed)
+			if err != nil {
+				return err
+			}
+			result.Audits = append(result.Audits, audit)
+		}
+
+		var err error
+		result.Contact, err = q.MergeContactFields(ctx, db.MergeContactFieldsParams{
+			ID:                  survivor.ID,
+			FirstName:           survivor.FirstName,
+			LastName:            survivor.LastName,
+			CompanyName:         survivor.CompanyName,
+			CompanyID:           survivor.CompanyID,
+			Phone:               survivor.Phone,
+			Address:             survivor.Address,
+			City:                survivor.City,
+			State:               survivor.State,
+			Country:             survivor.Country,
+			Zipcode:             survivor.Zipcode,
+			Position:            survivor.Position,
+			SocialMediaProfiles: survivor.SocialMediaProfiles,
+			Notes:               survivor.Notes,
+			TaxationDetailID:    survivor.TaxationDetailID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicContactMerged, "contact_merged", mergeEvent(survivorID, result.Contact.Email, result.Audits))
+
+	return result, nil
+}
+
+// MergeLeads merges leads into the survivor and deletes them. Empty
+// survivor fields are filled from the merged leads in the given order; its
+// email and status are kept. Opportunities and meeting attendance move to
+// the survivor. All leads must belong to the survivor's organization.
+// Converted leads can only survive, never be merged away.
+func (s *DuplicateService) MergeLeads(ctx context.Context, survivorID int32, mergedIDs []int32) (*LeadMerge, error) {
+	if err := validateMerge(survivorID, mergedIDs); err != nil {
+		return nil, err
+	}
+
+	result := &LeadMerge{}
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		leads := map[int32]db.Lead{}
+		for _, id := range lockOrder(survivorID, mergedIDs) {
+			l, err := q.GetLeadForUpdate(ctx, id)
+			if err != nil {
+				return fmt.Errorf("%w: %d", ErrLeadNotFound, id)
+			}
+			leads[id] = l
+		}
+		for _, id := range mergedIDs {
+			if leads[id].OrganizationID != leads[survivorID].OrganizationID {
+				return fmt.Errorf("%w: lead %d belongs to another organization", ErrInvalidMerge, id)
+			}
+			if leads[id].ConvertedAt.Valid {
+				return fmt.Errorf("%w: lead %d is converted; make it the survivor instead", ErrInvalidMerge, id)
+			}
+		}
+
+		survivor := leads[survivorID]
+		for _, id := range mergedIDs {
+			merged := leads[id]
+			filled := fillLead(&survivor, merged)
+
+			move := db.MoveLeadOpportunitiesParams{SurvivorID: survivorID, MergedID: id}
+			repointed := map[string]int64{}
+			var err error
+			if repointed["opportunities"], err = q.MoveLeadOpportunities(ctx, move); err != nil {
+				return err
+			}
+			shared, err := q.DeleteSharedLeadAttendees(ctx, db.DeleteSharedLeadAttendeesParams{MergedID: id, SurvivorID: survivorID})
+			if err != nil {
+				return err
+			}
+			moved, err := q.MoveLeadAttendees(ctx, db.MoveLeadAttendeesParams(move))
+			if err != nil {
+				return err
+			}
+			repointed["meeting_attendees"] = shared + moved
+
+			if err := q.DeleteLead(ctx, id); err != nil {
+				return err
+			}
+			audit, err := createMergeAudit(ctx, q, EntityLead, survivorID, id, leadSnapshot(merged), filled, repointed)
+			if err != nil {
+				return err
+			}
+			result.Audits = append(result.Audits, audit)
+		}
+
+		var err error
+		result.Lead, err = q.MergeLeadFields(ctx, db.MergeLeadFieldsParams{
+			ID:               survivor.ID,
+			Phone:            survivor.Phone,
+			AssignedTo:       survivor.AssignedTo,
+			AssignedAt:       survivor.AssignedAt,
+			AssignmentReason: survivor.AssignmentReason,
+			OrganizationID:   survivor.OrganizationID,
+			Country:          survivor.Country,
+			State:            survivor.State,
+			CompanyID:        survivor.CompanyID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicLeadMerged, "lead_merged", mergeEvent(survivorID, result.Lead.Email, result.Audits))
+
+	return result, nil
+}
+
+// ListMergeAudits returns the records merged into a survivor, oldest
+// first.
+func (s *DuplicateService) ListMergeAudits(ctx context.Context, entityType string, survivorID int32) ([]db.MergeAudit, error) {
+	if entityType != EntityContact && entityType != EntityLead {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEntityType, entityType)
+	}
+	return s.queries.ListMergeAudits(ctx, db.ListMergeAuditsParams{EntityType: entityType, SurvivorID: survivorID})
+}
+
+// ----------------- Matching -----------------
+
+// findDuplicates compares candidates of the same organization that share a
+// canonical email, a phone number or a name prefix, links the matching
+// pairs and returns the connected groups, strongest first.
+func findDuplicates(candidates []DuplicateCandidate) []DuplicateCluster {
+	type pairKey struct{ a, b int }
+	pairs := map[pairKey]*DuplicateMatch{}
+	link := func(i, j int, reason string, weight float64) {
+		if i > j {
+			i, j = j, i
+		}
+		key := pairKey{i, j}
+		m, ok := pairs[key]
+		if !ok {
+			m = &DuplicateMatch{A: candidates[i].ID, B: candidates[j].ID}
+			pairs[key] = m
+		}
+		for _, r := range m.Reasons {
+			if r == reason {
+				return
+			}
+		}
+		m.Reasons = append(m.Reasons, reason)
+		m.Score = 1 - (1-m.Score)*(1-weight)
+	}
+
+	byEmail, byPhone, byName := map[string][]int{}, map[string][]int{}, map[string][]int{}
+	names := make([]string, len(candidates))
+	for i, c := range candidates {
+		org := "-"
+		if c.OrganizationID.Valid {
+			org = strconv.Itoa(int(c.OrganizationID.Int32))
+		}
+		if key := canonicalEmail(c.Email); key != "" {
+			byEmail[org+"|"+key] = append(byEmail[org+"|"+key], i)
+		}
+		if key := normalizePhone(c.Phone); key != "" {
+			byPhone[org+"|"+key] = append(byPhone[org+"|"+key], i)
+		}
+		names[i] = normalizeName(c.FirstName + " " + c.LastName)
+		if key := nameBlockKey(c.FirstName, c.LastName); key != "" {
+			byName[org+"|"+key] = append(byName[org+"|"+key], i)
+		}
+	}
+
+	eachPair := func(blocks map[string][]int, fn func(i, j int)) {
+		for _, block := range blocks {
+			if len(block) < 2 || len(block) > maxDedupeBlock {
+				continue
+			}
+			for x := 0; x < len(block); x++ {
+				for y := x + 1; y < len(block); y++ {
+					fn(block[x], block[y])
+				}
+			}
+		}
+	}
+	eachPair(byEmail, func(i, j int) {
+		if strings.EqualFold(strings.TrimSpace(candidates[i].Email), strings.TrimSpace(candidates[j].Email)) {
+			link(i, j, MatchEmailCase, matchWeights[MatchEmailCase])
+		} else {
+			link(i, j, MatchPlusAddressing, matchWeights[MatchPlusAddressing])
+		}
+	})
+	eachPair(byPhone, func(i, j int) {
+		link(i, j, MatchPhone, matchWeights[MatchPhone])
+	})
+	eachPair(byName, func(i, j int) {
+		if sim := similarity(names[i], names[j]); sim >= minNameSimilarity {
+			link(i, j, MatchSimilarName, matchWeights[MatchSimilarName]*sim)
+		}
+	})
+
+	// Union the matched pairs into clusters.
+	parent := make([]int, len(candidates))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for key := range pairs {
+		if ra, rb := find(key.a), find(key.b); ra != rb {
+			parent[rb] = ra
+		}
+	}
+
+	byRoot := map[int]*DuplicateCluster{}
+	for key, m := range pairs {
+		root := find(key.a)
+		cluster, ok := byRoot[root]
+		if !ok {
+			cluster = &DuplicateCluster{}
+			byRoot[root] = cluster
+		}
+		cluster.Matches = append(cluster.Matches, *m)
+		if m.Score > cluster.Score {
+			cluster.Score = m.Score
+		}
+	}
+	for i, c := range candidates {
+		if cluster, ok := byRoot[find(i)]; ok {
+			cluster.Records = append(cluster.Records, c)
+		}
+	}
+
+	clusters := make([]DuplicateCluster, 0, len(byRoot))
+	for _, cluster := range byRoot {
+		sort.Slice(cluster.Matches, func(i, j int) bool {
+			a, b := cluster.Matches[i], cluster.Matches[j]
+			if a.A != b.A {
+				return a.A < b.A
+			}
+			return a.B < b.B
+		})
+		clusters = append(clusters, *cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Score != clusters[j].Score {
+			return clusters[i].Score > clusters[j].Score
+		}
+		return clusters[i].Records[0].ID < clusters[j].Records[0].ID
+	})
+	return clusters
+}
+
+// canonicalEmail lowercases an address and drops a +tag from its local
+// part. Gmail addresses also lose their dots, which Gmail ignores.
+func canonicalEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return ""
+	}
+	local, domain := email[:at], email[at+1:]
+	if plus := strings.Index(local, "+"); plus > 0 {
+		local = local[:plus]
+	}
+	if domain == "gmail.com" || domain == "googlemail.com" {
+		local, domain = strings.ReplaceAll(local, ".", ""), "gmail.com"
+	}
+	return local + "@" + domain
+}
+
+// normalizePhone keeps the last ten digits, so a number matches with and
+// without its country code. Numbers under seven digits are ignored.
+func normalizePhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) < 7 {
+		return ""
+	}
+	if len(digits) > 10 {
+		digits = digits[len(digits)-10:]
+	}
+	return digits
+}
+
+// normalizeName lowercases a name and keeps only its letters.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// nameBlockKey groups names worth comparing: the same first initial and
+// the same first two letters of the last name.
+func nameBlockKey(first, last string) string {
+	f, l := []rune(normalizeName(first)), []rune(normalizeName(last))
+	if len(f) == 0 || len(l) < 2 {
+		return ""
+	}
+	return string(f[:1]) + "|" + string(l[:2])
+}
+
+// similarity is 1 minus the edit distance of a and b relative to the
+// longer one.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// ----------------- Merging -----------------
+
+func validateMerge(survivorID int32, mergedIDs []int32) error {
+	if survivorID <= 0 || len(mergedIDs) == 0 {
+		return fmt.Errorf("%w: a survivor and at least one record to merge are required", ErrInvalidMerge)
+	}
+	if len(mergedIDs) > maxMergeRecords {
+		return fmt.Errorf("%w: at most %d records can be merged at once", ErrInvalidMerge, maxMergeRecords)
+	}
+	seen := map[int32]bool{survivorID: true}
+	for _, id := range mergedIDs {
+		if seen[id] {
+			return fmt.Errorf("%w: record %d is listed twice or is the survivor", ErrInvalidMerge, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// lockOrder returns every ID in ascending order, the order rows are locked
+// in so concurrent merges cannot deadlock.
+func lockOrder(survivorID int32, mergedIDs []int32) []int32 {
+	ids := append([]int32{survivorID}, mergedIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// fillString copies from into an empty *to and records it under field.
+func fillString(filled map[string]string, field string, to *sql.NullString, from sql.NullString) {
+	if strings.TrimSpace(to.String) == "" && strings.TrimSpace(from.String) != "" {
+		*to = from
+		filled[field] = from.String
+	}
+}
+
+func fillInt32(filled map[string]string, field string, to *sql.NullInt32, from sql.NullInt32) bool {
+	if !to.Valid && from.Valid {
+		*to = from
+		filled[field] = fmt.Sprint(from.Int32)
+		return true
+	}
+	return false
+}
+
+// fillContact fills the empty fields of survivor from merged and returns
+// the fields it took.
+func fillContact(survivor *db.Contact, merged db.Contact) map[string]string {
+	filled := map[string]string{}
+	fillString(filled, "first_name", &survivor.FirstName, merged.FirstName)
+	fillString(filled, "last_name", &survivor.LastName, merged.LastName)
+	fillString(filled, "company_name", &survivor.CompanyName, merged.CompanyName)
+	fillInt32(filled, "company_id", &survivor.CompanyID, merged.CompanyID)
+	fillString(filled, "phone", &survivor.Phone, merged.Phone)
+	fillString(filled, "address", &survivor.Address, merged.Address)
+	fillString(filled, "city", &survivor.City, merged.City)
+	fillString(filled, "state", &survivor.State, merged.State)
+	fillString(filled, "country", &survivor.Country, merged.Country)
+	fillString(filled, "zipcode", &survivor.Zipcode, merged.Zipcode)
+	fillString(filled, "position", &survivor.Position, merged.Position)
+	fillString(filled, "social_media_profiles", &survivor.SocialMediaProfiles, merged.SocialMediaProfiles)
+	fillString(filled, "notes", &survivor.Notes, merged.Notes)
+	fillInt32(filled, "taxation_detail_id", &survivor.TaxationDetailID, merged.TaxationDetailID)
+	return filled
+}
+
+// fillLead fills the empty fields of survivor from merged and returns the
+// fields it took. An assignee comes with its assignment time and reason.
+func fillLead(survivor *db.Lead, merged db.Lead) map[string]string {
+	filled := map[string]string{}
+	fillString(filled, "phone", &survivor.Phone, merged.Phone)
+	if fillInt32(filled, "assigned_to", &survivor.AssignedTo, merged.AssignedTo) {
+		survivor.AssignedAt, survivor.AssignmentReason = merged.AssignedAt, merged.AssignmentReason
+	}
+	fillString(filled, "country", &survivor.Country, merged.Country)
+	fillString(filled, "state", &survivor.State, merged.State)
+	fillInt32(filled, "company_id", &survivor.CompanyID, merged.CompanyID)
+	return filled
+}
+
+func contactSnapshot(c db.Contact) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    c.ID,
+		"contact_type":          c.ContactType,
+		"first_name":            c.FirstName.String,
+		"last_name":             c.LastName.String,
+		"company_name":          c.CompanyName.String,
+		"company_id":            c.CompanyID.Int32,
+		"email":                 c.Email,
+		"phone":                 c.Phone.String,
+		"address":               c.Address.String,
+		"city":                  c.City.String,
+		"state":                 c.State.String,
+		"country":               c.Country.String,
+		"zipcode":               c.Zipcode.String,
+		"position":              c.Position.String,
+		"social_media_profiles": c.SocialMediaProfiles.String,
+		"notes":                 c.Notes.String,
+		"taxation_detail_id":    c.TaxationDetailID.Int32,
+		"created_at":            c.CreatedAt.Time,
+	}
+}
+
+func leadSnapshot(l db.Lead) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                l.ID,
+		"first_name":        l.FirstName,
+		"last_name":         l.LastName,
+		"email":             l.Email,
+		"phone":             l.Phone.String,
+		"status":            l.Status,
+		"assigned_to":       l.AssignedTo.Int32,
+		"assignment_reason": l.AssignmentReason.String,
+		"organization_id":   l.OrganizationID.Int32,
+		"country":           l.Country.String,
+		"state":             l.State.String,
+		"company_id":        l.CompanyID.Int32,
+		"score":             l.Score,
+		"created_at":        l.CreatedAt.Time,
+	}
+}
+
+func createMergeAudit(ctx context.Context, q *db.Queries, entityType string, survivorID, mergedID int32, record map[string]interface{}, filled map[string]string, repointed map[string]int64) (db.MergeAudit, error) {
+	recordJSON, err := json.Marshal(record)
+	if err != nil {
+		return db.MergeAudit{}, err
+	}
+	filledJSON, err := json.Marshal(filled)
+	if err != nil {
+		return db.MergeAudit{}, err
+	}
+	repointedJSON, err := json.Marshal(repointed)
+	if err != nil {
+		return db.MergeAudit{}, err
+	}
+	return q.CreateMergeAudit(ctx, db.CreateMergeAuditParams{
+		EntityType:   entityType,
+		SurvivorID:   survivorID,
+		MergedID:     mergedID,
+		MergedRecord: recordJSON,
+		FilledFields: filledJSON,
+		Repointed:    repointedJSON,
+	})
+}
+
+// mergeEvent is the payload of the merge topics. id is the survivor, so
+// consumers keyed on the record ID refresh it.
+func mergeEvent(survivorID int32, email string, audits []db.MergeAudit) map[string]interface{} {
+	merged := make([]int32, 0, len(audits))
+	auditIDs := make([]int32, 0, len(audits))
+	for _, a := range audits {
+		merged = append(merged, a.MergedID)
+		auditIDs = append(auditIDs, a.ID)
+	}
+	return map[string]interface{}{
+		"id":         survivorID,
+		"email":      email,
+		"merged_ids": merged,
+		"audit_ids":  auditIDs,
+	}
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"Jane.Doe@Example.com", "jane.doe@example.com"},
+		{"  jane@example.com ", "jane@example.com"},
+		{"jane+newsletter@example.com", "jane@example.com"},
+		{"jane+a+b@example.com", "jane@example.com"},
+		{"j.a.n.e@gmail.com", "jane@gmail.com"},
+		{"J.Ane+crm@GoogleMail.com", "jane@gmail.com"},
+		{"j.ane@example.com", "j.ane@example.com"},
+		{"+tag@example.com", "+tag@example.com"},
+		{"not-an-email", ""},
+		{"@example.com", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := canonicalEmail(tt.email); got != tt.want {
+			t.Errorf("canonicalEmail(%q) = %q, want %q", tt.email, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		want  string
+	}{
+		{"(555) 123-4567", "5551234567"},
+		{"555.123.4567", "5551234567"},
+		{"+1 555 123 4567", "5551234567"},
+		{"001-555-123-4567", "5551234567"},
+		{"123 4567", "1234567"},
+		{"12345", ""},
+		{"ext. 42", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizePhone(tt.phone); got != tt.want {
+			t.Errorf("normalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+		}
+	}
+}
+
+func TestNameBlockKey(t *testing.T) {
+	tests := []struct {
+		first, last string
+		want        string
+	}{
+		{"Jane", "Doe", "j|do"},
+		{"jane", "DOE", "j|do"},
+		{" Jane ", "O'Brien", "j|ob"},
+		{"Émile", "Zola", "é|zo"},
+		{"", "Doe", ""},
+		{"Jane", "D", ""},
+		{"Jane", "", ""},
+	}
+	for _, tt := range tests {
+		if got := nameBlockKey(tt.first, tt.last); got != tt.want {
+			t.Errorf("nameBlockKey(%q, %q) = %q, want %q", tt.first, tt.last, got, tt.want)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"janedoe", "janedoe", 1},
+		{"janedoe", "janedoo", 1 - 1.0/7},
+		{"jondoe", "johndoe", 1 - 1.0/7},
+		{"kitten", "sitting", 1 - 3.0/7},
+		{"abc", "", 0},
+		{"", "", 0},
+		{"zoë", "zoe", 1 - 1.0/3},
+	}
+	for _, tt := range tests {
+		if got := similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got, back := similarity(tt.a, tt.b), similarity(tt.b, tt.a); got != back {
+			t.Errorf("similarity is not symmetric for %q and %q: %v, %v", tt.a, tt.b, got, back)
+		}
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	org := func(id int32) sql.NullInt32 { return sql.NullInt32{Int32: id, Valid: true} }
+	candidate := func(id int32, first, last, email, phone string) DuplicateCandidate {
+		return DuplicateCandidate{ID: id, FirstName: first, LastName: last, Email: email, Phone: phone}
+	}
+
+	// A block one larger than the cap: everyone shares a switchboard number.
+	var switchboard []DuplicateCandidate
+	for i := int32(1); i <= maxDedupeBlock+1; i++ {
+		switchboard = append(switchboard, candidate(i, fmt.Sprint("First", i), fmt.Sprint("Last", i), fmt.Sprintf("user%d@example.com", i), "555 000 0000"))
+	}
+
+	type cluster struct {
+		ids     []int32
+		reasons [][]string // per match, ordered by match
+	}
+	tests := []struct {
+		name       string
+		candidates []DuplicateCandidate
+		want       []cluster
+	}{
+		{
+			name: "email case",
+			candidates: []DuplicateCandidate{
+				candidate(1, "Jane", "Doe", "jane@example.com", ""),
+				candidate(2, "Janet", "Smith", "JANE@example.com", ""),
+			},
+			want: []cluster{{ids: []int32{1, 2}, reasons: [][]string{{MatchEmailCase}}}},
+		},
+		{
+			name: "plus addressing",
+			candidates: []DuplicateCandidate{
+				candidate(1, "Jane", "Doe", "jane+crm@example.com", ""),
+				candidate(2, "Bob", "Smith", "jane@example.com", ""),
+			},
+			want: []cluster{{ids: []int32{1, 2}, reasons: [][]string{{MatchPlusAddressing}}}},
+		},
+		{
+			name: "phone formats",
+			candidates: []DuplicateCandidate{
+				candidate(1, "Jane", "Doe", "a@example.com", "+1 (555) 123-4567"),
+				candidate(2, "Bob", "Smith", "b@example.com", "555.123.4567"),
+			},
+			want: []cluster{{ids: []int32{1, 2}, reasons: [][]string{{MatchPhone}}}},
+		},
+		{
+			name: "fuzzy names",
+			candidates: []DuplicateCandidate{
+				candidate(1, "Jonathan", "Doe", "a@example.com", ""),
+				candidate(2, "Jonathon", "Doe", "b@example.com", ""),
+				candidate(3, "Jane", "Dodd", "c@example.com", ""),
+			},
+			want: []cluster{{ids: []int32{1, 2}, reasons: [][]string{{MatchSimilarName}}}},
+		},
+		{
+			name: "reasons combine and clusters are transitive",
+			candidates: []DuplicateCandidate{
+				candidate(1, "Jane", "Doe", "jane@example.com", "555 123 4567"),
+				candidate(2, "Jane", "Doe", "JANE@example.com", "5551234567"),
+				candidate(3, "Robert", "Smith", "bob@example.com", "(555) 123-4567"),
+			},
+			want: []cluster{{
+				ids: []int32{1, 2, 3},
+				reasons: [][]string{
+					{MatchEmailCase, MatchPhone, MatchSimilarName},
+					{MatchPhone},
+					{MatchPhone},
+				},
+			}},
+		},
+		{
+			name: "leads of different organizations never match",
+			candidates: []DuplicateCandidate{
+				{ID: 1, OrganizationID: org(1), FirstName: "Jane", LastName: "Doe", Email: "jane@example.com", Phone: "555 123 4567"},
+				{ID: 2, OrganizationID: org(2), FirstName: "Jane", LastName: "Doe", Email: "jane@example.com", Phone: "555 123 4567"},
+				{ID: 3, FirstName: "Jane", LastName: "Doe", Email: "jane@example.com"},
+				{ID: 4, OrganizationID: org(2), FirstName: "Jane", LastName: "Doe", Email: "Jane@example.com"},
+			},
+			want: []cluster{{ids: []int32{2, 4}, reasons: [][]string{{MatchEmailCase, MatchSimilarName}}}},
+		},
+		{
+			name:       "blocks over the size cap are skipped",
+			candidates: switchboard,
+		},
+		{
+			name: "no matches",
+			candidates: []DuplicateCandidate{
+				candidate(1, "Jane", "Doe", "jane@example.com", "555 123 4567"),
+				candidate(2, "Bob", "Smith", "bob@example.com", "555 765 4321"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters := findDuplicates(tt.candidates)
+			var got []cluster
+			for _, c := range clusters {
+				var ids []int32
+				for _, r := range c.Records {
+					ids = append(ids, r.ID)
+				}
+				var reasons [][]string
+				for _, m := range c.Matches {
+					reasons = append(reasons, m.Reasons)
+					if m.Score <= 0 || m.Score > 1 || m.Score > c.Score {
+						t.Errorf("match %d-%d score %v outside (0, cluster score %v]", m.A, m.B, m.Score, c.Score)
+					}
+				}
+				got = append(got, cluster{ids: ids, reasons: reasons})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clusters = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindDuplicatesOrdersByScore(t *testing.T) {
+	clusters := findDuplicates([]DuplicateCandidate{
+		{ID: 1, FirstName: "Ann", LastName: "Lee", Email: "a@example.com", Phone: "555 111 2222"},
+		{ID: 2, FirstName: "Bo", LastName: "Kim", Email: "b@example.com", Phone: "5551112222"},
+		{ID: 3, FirstName: "Cy", LastName: "Ng", Email: "cy@example.com"},
+		{ID: 4, FirstName: "Di", LastName: "Oz", Email: "CY@example.com"},
+	})
+	if len(clusters) != 2 {
+		t.Fatalf("got %d clusters, want 2", len(clusters))
+	}
+	if clusters[0].Records[0].ID != 3 || clusters[0].Score != matchWeights[MatchEmailCase] {
+		t.Errorf("first cluster = %+v, want the email match of 3 and 4", clusters[0])
+	}
+	if clusters[1].Records[0].ID != 1 || clusters[1].Score != matchWeights[MatchPhone] {
+		t.Errorf("second cluster = %+v, want the phone match of 1 and 2", clusters[1])
+	}
+}
@@ -0,0 +1,387 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidForecast          = errors.New("invalid forecast request")
+	ErrForecastSnapshotNotFound = errors.New("forecast snapshot not found")
+)
+
+const (
+	ForecastPeriodMonth   = "month"
+	ForecastPeriodQuarter = "quarter"
+
+	ForecastGroupOwner    = "owner"
+	ForecastGroupPipeline = "pipeline"
+	ForecastGroupStage    = "stage"
+)
+
+// Forecast categories of open opportunities, by win probability.
+const (
+	forecastCommitProbability   = 75
+	forecastBestCaseProbability = 50
+)
+
+// maxForecastPeriods bounds the close date range of a forecast.
+const maxForecastPeriods = 60
+
+// ForecastRequest selects the opportunities a forecast covers and how they
+// are bucketed. From and To are inclusive close dates; From is moved back to
+// the first day of its period and To forward to the last day of its.
+// A non-zero OrganizationID limits the forecast to that organization's
+// opportunities: those in its pipelines and, without a pipeline, those of
+// its accounts. Amounts are converted to Currency, or when it is empty to
+// the reporting currency of OrganizationID, at the rate in effect on each
+// close date.
+type ForecastRequest struct {
+	Period         string
+	GroupBy        string
+	From           time.Time
+	To             time.Time
+	OwnerID        int32
+	PipelineID     int32
+	OrganizationID int32
+	Currency       string
+}
+
+// ForecastRow holds the totals of one group in one period. Lost
+// opportunities are left out. Open opportunities fall into the commit,
+// best case or pipeline category by probability; the category totals are
+// cumulative, as forecasts are usually read:
+//
+//	Closed   won opportunities
+//	Commit   Closed plus open commit opportunities
+//	BestCase Commit plus open best case opportunities
+//	Pipeline all open opportunities
+type ForecastRow struct {
+	PeriodStart    time.Time `json:"period_start"`
+	Period         string    `json:"period"`
+	GroupKey       string    `json:"group_key"`
+	GroupLabel     string    `json:"group_label"`
+	Count          int       `json:"count"`
+	Amount         float64   `json:"amount"`
+	WeightedAmount float64   `json:"weighted_amount"`
+	Closed         float64   `json:"closed"`
+	Commit         float64   `json:"commit"`
+	BestCase       float64   `json:"best_case"`
+	Pipeline       float64   `json:"pipeline"`
+}
+
+type Forecast struct {
+	ForecastRequest
+	Rows []ForecastRow
+}
+
+// ForecastSnapshot is a forecast saved as it was projected at CreatedAt.
+type ForecastSnapshot struct {
+	ID        int32
+	Name      string
+	CreatedAt time.Time
+	Forecast  Forecast
+}
+
+type ForecastServiceInterface interface {
+	Forecast(ctx context.Context, req ForecastRequest) (*Forecast, error)
+	CreateSnapshot(ctx context.Context, name string, req ForecastRequest) (*ForecastSnapshot, error)
+	GetSnapshot(ctx context.Context, id int32) (*ForecastSnapshot, error)
+	ListSnapshots(ctx context.Context, opts ListOptions) (*Page[ForecastSnapshot], error)
+	DeleteSnapshot(ctx context.Context, id int32) error
+}
+
+type ForecastService struct {
+	queries *db.Queries
+}
+
+func NewForecastService(queries *db.Queries) *ForecastService {
+	return &ForecastService{queries: queries}
+}
+
+// Forecast aggregates the amounts of opportunities closing in the requested
+// range by period of close date and group.
+func (s *ForecastService) Forecast(ctx context.Context, req ForecastRequest) (*Forecast, error) {
+	if err := req.normalize(); err != nil {
+		return nil, err
+	}
+	currency, err := targetCurrency(ctx, s.queries, req.OrganizationID, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	req.Currency = currency
+
+	opportunities, err := s.queries.ListForecastOpportunities(ctx, db.ListForecastOpportunitiesParams{
+		CloseFrom:      sql.NullTime{Time: req.From, Valid: true},
+		CloseTo:        sql.NullTime{Time: req.To.AddDate(0, 0, 1), Valid: true},
+		OwnerID:        req.OwnerID,
+		PipelineID:     req.PipelineID,
+		OrganizationID: req.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	converter := newCurrencyConverter(s.queries)
+	pipelines := map[int32]*Pipeline{}
+	rows := map[string]*ForecastRow{}
+	for _, o := range opportunities {
+		pipeline, ok := pipelines[o.PipelineID.Int32]
+		if !ok {
+			if pipeline, err = loadPipeline(ctx, s.queries, o.PipelineID); err != nil {
+				return nil, err
+			}
+			pipelines[o.PipelineID.Int32] = pipeline
+		}
+		stage, _ := pipeline.stage(o.Stage.String)
+		if stage.Lost {
+			continue
+		}
+		if o.Amount, err = converter.convert(ctx, o.Amount, o.Currency, req.Currency, o.CloseDate.Time); err != nil {
+			return nil, err
+		}
+
+		start := req.periodStart(o.CloseDate.Time)
+		key, label := req.group(o, pipeline)
+		bucket := start.Format("2006-01-02") + "/" + key
+		row := rows[bucket]
+		if row == nil {
+			row = &ForecastRow{
+				PeriodStart: start,
+				Period:      req.periodLabel(start),
+				GroupKey:    key,
+				GroupLabel:  label,
+			}
+			rows[bucket] = row
+		}
+		row.add(o, stage)
+	}
+
+	forecast := &Forecast{ForecastRequest: req}
+	for _, row := range rows {
+		row.round()
+		forecast.Rows = append(forecast.Rows, *row)
+	}
+	sort.Slice(forecast.Rows, func(i, j int) bool {
+		a, b := forecast.Rows[i], forecast.Rows[j]
+		if !a.PeriodStart.Equal(b.PeriodStart) {
+			return a.PeriodStart.Before(b.PeriodStart)
+		}
+		return a.GroupKey < b.GroupKey
+	})
+	return forecast, nil
+}
+
+// CreateSnapshot computes a forecast and saves it.
+func (s *ForecastService) CreateSnapshot(ctx context.Context, name string, req ForecastRequest) (*ForecastSnapshot, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, fmt.Errorf("%w: snapshot name must be 1-100 characters", ErrInvalidForecast)
+	}
+	forecast, err := s.Forecast(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := json.Marshal(forecast.Rows)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.queries.CreateForecastSnapshot(ctx, db.CreateForecastSnapshotParams{
+		Name:       name,
+		Period:     forecast.Period,
+		GroupBy:    forecast.GroupBy,
+		CloseFrom:  forecast.From,
+		CloseTo:    forecast.To,
+		OwnerID:    forecast.OwnerID,
+		PipelineID: forecast.PipelineID,
+		Rows:       rows,
+		Currency:   forecast.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertForecastSnapshot(snapshot)
+}
+
+// GetSnapshot returns a saved forecast as it was projected.
+func (s *ForecastService) GetSnapshot(ctx context.Context, id int32) (*ForecastSnapshot, error) {
+	snapshot, err := s.queries.GetForecastSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrForecastSnapshotNotFound
+		}
+		return nil, err
+	}
+	return convertForecastSnapshot(snapshot)
+}
+
+// ListSnapshots returns a filtered, sorted page of saved forecasts, newest
+// first by default.
+func (s *ForecastService) ListSnapshots(ctx context.Context, opts ListOptions) (*Page[ForecastSnapshot], error) {
+	page, err := listPage(ctx, filter.ForecastSnapshots, opts, s.queries.FilterForecastSnapshots, s.queries.CountForecastSnapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPage(page, func(row db.ForecastSnapshot) (ForecastSnapshot, error) {
+		snapshot, err := convertForecastSnapshot(row)
+		if err != nil {
+			return ForecastSnapshot{}, err
+		}
+		return *snapshot, nil
+	})
+}
+
+// DeleteSnapshot removes a saved forecast.
+func (s *ForecastService) DeleteSnapshot(ctx context.Context, id int32) error {
+	deleted, err := s.queries.DeleteForecastSnapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrForecastSnapshotNotFound
+	}
+	return nil
+}
+
+// ----------------- Helpers -----------------
+
+// normalize validates a request and widens its range to whole periods.
+func (r *ForecastRequest) normalize() error {
+	if r.Period == "" {
+		r.Period = ForecastPeriodMonth
+	}
+	if r.GroupBy == "" {
+		r.GroupBy = ForecastGroupOwner
+	}
+
+	var problems []string
+	if r.Period != ForecastPeriodMonth && r.Period != ForecastPeriodQuarter {
+		problems = append(problems, fmt.Sprintf("period must be %s or %s", ForecastPeriodMonth, ForecastPeriodQuarter))
+	}
+	switch r.GroupBy {
+	case ForecastGroupOwner, ForecastGroupPipeline, ForecastGroupStage:
+	default:
+		problems = append(problems, fmt.Sprintf("group_by must be %s, %s or %s", ForecastGroupOwner, ForecastGroupPipeline, ForecastGroupStage))
+	}
+	if r.From.IsZero() || r.To.IsZero() {
+		problems = append(problems, "from and to close dates are required")
+	} else if r.To.Before(r.From) {
+		problems = append(problems, "to must not be before from")
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidForecast, strings.Join(problems, "; "))
+	}
+
+	r.From = r.periodStart(r.From)
+	r.To = r.nextPeriod(r.periodStart(r.To)).AddDate(0, 0, -1)
+	if periods := r.periods(); periods > maxForecastPeriods {
+		return fmt.Errorf("%w: range covers %d periods, at most %d allowed", ErrInvalidForecast, periods, maxForecastPeriods)
+	}
+	return nil
+}
+
+func (r *ForecastRequest) periodStart(t time.Time) time.Time {
+	month := t.Month()
+	if r.Period == ForecastPeriodQuarter {
+		month -= (month - 1) % 3
+	}
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (r *ForecastRequest) nextPeriod(start time.Time) time.Time {
+	if r.Period == ForecastPeriodQuarter {
+		return start.AddDate(0, 3, 0)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+func (r *ForecastRequest) periods() int {
+	n := 0
+	for t := r.From; !t.After(r.To); t = r.nextPeriod(t) {
+		n++
+	}
+	return n
+}
+
+// periodLabel names a period as 2024-03 or 2024-Q1.
+func (r *ForecastRequest) periodLabel(start time.Time) string {
+	if r.Period == ForecastPeriodQuarter {
+		return fmt.Sprintf("%d-Q%d", start.Year(), (int(start.Month())-1)/3+1)
+	}
+	return start.Format("2006-01")
+}
+
+// group returns the key and label of the group an opportunity falls in.
+func (r *ForecastRequest) group(o db.Opportunity, pipeline *Pipeline) (string, string) {
+	switch r.GroupBy {
+	case ForecastGroupPipeline:
+		return strconv.Itoa(int(pipeline.ID)), pipeline.Name
+	case ForecastGroupStage:
+		return o.Stage.String, o.Stage.String
+	default:
+		return strconv.Itoa(int(o.OwnerID.Int32)), ""
+	}
+}
+
+func (row *ForecastRow) add(o db.Opportunity, stage PipelineStage) {
+	row.Count++
+	row.Amount += o.Amount
+	if stage.Won {
+		row.WeightedAmount += o.Amount
+		row.Closed += o.Amount
+		row.Commit += o.Amount
+		row.BestCase += o.Amount
+		return
+	}
+
+	row.WeightedAmount += o.Amount * o.Probability / 100
+	row.Pipeline += o.Amount
+	switch {
+	case o.Probability >= forecastCommitProbability:
+		row.Commit += o.Amount
+		row.BestCase += o.Amount
+	case o.Probability >= forecastBestCaseProbability:
+		row.BestCase += o.Amount
+	}
+}
+
+func (row *ForecastRow) round() {
+	row.Amount = roundCents(row.Amount)
+	row.WeightedAmount = roundCents(row.WeightedAmount)
+	row.Closed = roundCents(row.Closed)
+	row.Commit = roundCents(row.Commit)
+	row.BestCase = roundCents(row.BestCase)
+	row.Pipeline = roundCents(row.Pipeline)
+}
+
+func convertForecastSnapshot(row db.ForecastSnapshot) (*ForecastSnapshot, error) {
+	snapshot := &ForecastSnapshot{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+		Forecast: Forecast{ForecastRequest: ForecastRequest{
+			Period:     row.Period,
+			GroupBy:    row.GroupBy,
+			From:       row.CloseFrom,
+			To:         row.CloseTo,
+			OwnerID:    row.OwnerID,
+			PipelineID: row.PipelineID,
+			Currency:   strings.TrimSpace(row.Currency),
+		}},
+	}
+	if err := json.Unmarshal(row.Rows, &snapshot.Forecast.Rows); err != nil {
+		return nil, fmt.Errorf("snapshot %d: %w", row.ID, err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"crm/internal/adapters/database/db"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestForecastRequestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ForecastRequest
+		from    time.Time
+		to      time.Time
+		periods int
+		err     string // empty when the request is valid
+	}{
+		{
+			name:    "months widen to whole months",
+			req:     ForecastRequest{From: date(2024, 3, 15), To: date(2024, 5, 2)},
+			from:    date(2024, 3, 1),
+			to:      date(2024, 5, 31),
+			periods: 3,
+		},
+		{
+			name:    "quarters widen to whole quarters",
+			req:     ForecastRequest{Period: ForecastPeriodQuarter, From: date(2024, 2, 10), To: date(2024, 8, 1)},
+			from:    date(2024, 1, 1),
+			to:      date(2024, 9, 30),
+			periods: 3,
+		},
+		{
+			name:    "quarters across a year end",
+			req:     ForecastRequest{Period: ForecastPeriodQuarter, From: date(2024, 12, 31), To: date(2025, 1, 1)},
+			from:    date(2024, 10, 1),
+			to:      date(2025, 3, 31),
+			periods: 2,
+		},
+		{
+			name:    "one day is one period",
+			req:     ForecastRequest{From: date(2024, 2, 29), To: date(2024, 2, 29)},
+			from:    date(2024, 2, 1),
+			to:      date(2024, 2, 29),
+			periods: 1,
+		},
+		{
+			name:    "largest range",
+			req:     ForecastRequest{From: date(2020, 1, 1), To: date(2024, 12, 31)},
+			from:    date(2020, 1, 1),
+			to:      date(2024, 12, 31),
+			periods: maxForecastPeriods,
+		},
+		{
+			name: "too many periods",
+			req:  ForecastRequest{From: date(2020, 1, 1), To: date(2025, 1, 1)},
+			err:  "range covers 61 periods, at most 60 allowed",
+		},
+		{
+			name: "every problem at once",
+			req:  ForecastRequest{Period: "week", GroupBy: "region"},
+			err:  "period must be month or quarter; group_by must be owner, pipeline or stage; from and to close dates are required",
+		},
+		{
+			name: "to before from",
+			req:  ForecastRequest{From: date(2024, 3, 1), To: date(2024, 2, 1)},
+			err:  "to must not be before from",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			err := req.normalize()
+			if tt.err != "" {
+				if !errors.Is(err, ErrInvalidForecast) || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("normalize error = %v, want ErrInvalidForecast with %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalize: %v", err)
+			}
+			if tt.req.Period == "" && req.Period != ForecastPeriodMonth {
+				t.Errorf("period = %q, want the %q default", req.Period, ForecastPeriodMonth)
+			}
+			if req.GroupBy != ForecastGroupOwner {
+				t.Errorf("group_by = %q, want the %q default", req.GroupBy, ForecastGroupOwner)
+			}
+			if !req.From.Equal(tt.from) || !req.To.Equal(tt.to) {
+				t.Errorf("range = %s..%s, want %s..%s", req.From.Format(time.DateOnly), req.To.Format(time.DateOnly),
+					tt.from.Format(time.DateOnly), tt.to.Format(time.DateOnly))
+			}
+			if got := req.periods(); got != tt.periods {
+				t.Errorf("periods = %d, want %d", got, tt.periods)
+			}
+		})
+	}
+}
+
+func TestForecastPeriods(t *testing.T) {
+	tests := []struct {
+		period string
+		day    time.Time
+		start  time.Time
+		label  string
+	}{
+		{ForecastPeriodMonth, date(2024, 1, 1), date(2024, 1, 1), "2024-01"},
+		{ForecastPeriodMonth, date(2024, 12, 31), date(2024, 12, 1), "2024-12"},
+		{ForecastPeriodMonth, time.Date(2024, 3, 31, 23, 59, 0, 0, time.UTC), date(2024, 3, 1), "2024-03"},
+		{ForecastPeriodQuarter, date(2024, 1, 1), date(2024, 1, 1), "2024-Q1"},
+		{ForecastPeriodQuarter, date(2024, 3, 31), date(2024, 1, 1), "2024-Q1"},
+		{ForecastPeriodQuarter, date(2024, 4, 1), date(2024, 4, 1), "2024-Q2"},
+		{ForecastPeriodQuarter, date(2024, 8, 15), date(2024, 7, 1), "2024-Q3"},
+		{ForecastPeriodQuarter, date(2024, 12, 31), date(2024, 10, 1), "2024-Q4"},
+	}
+	for _, tt := range tests {
+		req := ForecastRequest{Period: tt.period}
+		start := req.periodStart(tt.day)
+		if !start.Equal(tt.start) {
+			t.Errorf("%s periodStart(%s) = %s, want %s", tt.period, tt.day, start, tt.start)
+		}
+		if label := req.periodLabel(start); label != tt.label {
+			t.Errorf("%s periodLabel(%s) = %q, want %q", tt.period, start, label, tt.label)
+		}
+	}
+}
+
+func TestForecastRowAdd(t *testing.T) {
+	open := PipelineStage{Name: "Negotiation", Probability: 60}
+	won := PipelineStage{Name: "Closed Won", Probability: 100, Won: true}
+	opportunity := func(amount, probability float64) db.Opportunity {
+		return db.Opportunity{Amount: amount, Probability: probability}
+	}
+
+	tests := []struct {
+		name  string
+		adds  []db.Opportunity
+		stage PipelineStage
+		want  ForecastRow
+	}{
+		{
+			name:  "won counts towards every closed category",
+			adds:  []db.Opportunity{opportunity(1000, 100)},
+			stage: won,
+			want:  ForecastRow{Count: 1, Amount: 1000, WeightedAmount: 1000, Closed: 1000, Commit: 1000, BestCase: 1000},
+		},
+		{
+			name:  "commit at the commit probability",
+			adds:  []db.Opportunity{opportunity(1000, forecastCommitProbability)},
+			stage: open,
+			want:  ForecastRow{Count: 1, Amount: 1000, WeightedAmount: 750, Commit: 1000, BestCase: 1000, Pipeline: 1000},
+		},
+		{
+			name:  "best case at the best case probability",
+			adds:  []db.Opportunity{opportunity(1000, forecastBestCaseProbability)},
+			stage: open,
+			want:  ForecastRow{Count: 1, Amount: 1000, WeightedAmount: 500, BestCase: 1000, Pipeline: 1000},
+		},
+		{
+			name:  "pipeline only below best case",
+			adds:  []db.Opportunity{opportunity(1000, forecastBestCaseProbability-1)},
+			stage: open,
+			want:  ForecastRow{Count: 1, Amount: 1000, WeightedAmount: 490, Pipeline: 1000},
+		},
+		{
+			name:  "categories accumulate",
+			adds:  []db.Opportunity{opportunity(100, 90), opportunity(200, 60), opportunity(400, 10)},
+			stage: open,
+			want:  ForecastRow{Count: 3, Amount: 700, WeightedAmount: 250, Commit: 100, BestCase: 300, Pipeline: 700},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var row ForecastRow
+			for _, o := range tt.adds {
+				row.add(o, tt.stage)
+			}
+			row.round()
+			if row != tt.want {
+				t.Errorf("row = %+v, want %+v", row, tt.want)
+			}
+		})
+	}
+}
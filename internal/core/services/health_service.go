@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/kafka"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrUnknownProbe = errors.New("unknown health probe")
+
+// Health probes. Liveness only runs the checks for the process itself, so
+// an orchestrator does not restart the service because a dependency is
+// down; readiness runs every check.
+const (
+	ProbeLiveness  = "liveness"
+	ProbeReadiness = "readiness"
+)
+
+// healthCheckTimeout bounds each dependency check.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthCheck checks one dependency. Liveness marks checks that also count
+// towards liveness.
+type HealthCheck struct {
+	Name     string
+	Liveness bool
+	Check    func(ctx context.Context) error
+}
+
+// DependencyHealth is the outcome of one check.
+type DependencyHealth struct {
+	Name    string
+	Healthy bool
+	Message string
+	Latency time.Duration
+}
+
+// HealthReport is the outcome of a probe. It is healthy when every check
+// that ran is.
+type HealthReport struct {
+	Healthy      bool
+	Dependencies []DependencyHealth
+	CheckedAt    time.Time
+}
+
+// Err summarises the failed checks, or returns nil when the report is
+// healthy.
+func (r *HealthReport) Err() error {
+	var failed []string
+	for _, dep := range r.Dependencies {
+		if !dep.Healthy {
+			failed = append(failed, fmt.Sprintf("%s: %s", dep.Name, dep.Message))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unhealthy dependencies: %s", strings.Join(failed, "; "))
+}
+
+type HealthServiceInterface interface {
+	Check(ctx context.Context, probe string) (*HealthReport, error)
+}
+
+type HealthService struct {
+	checks []HealthCheck
+}
+
+func NewHealthService(checks ...HealthCheck) *HealthService {
+	return &HealthService{checks: checks}
+}
+
+// Check runs the checks for probe concurrently. An empty probe means
+// readiness.
+func (s *HealthService) Check(ctx context.Context, probe string) (*HealthReport, error) {
+	var liveness bool
+	switch probe {
+	case ProbeLiveness:
+		liveness = true
+	case ProbeReadiness, "":
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProbe, probe)
+	}
+
+	var checks []HealthCheck
+	for _, check := range s.checks {
+		if !liveness || check.Liveness {
+			checks = append(checks, check)
+		}
+	}
+
+	report := &HealthReport{
+		Healthy:      true,
+		Dependencies: make([]DependencyHealth, len(checks)),
+		CheckedAt:    time.Now(),
+	}
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Dependencies[i] = runHealthCheck(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	for _, dep := range report.Dependencies {
+		if !dep.Healthy {
+			report.Healthy = false
+		}
+	}
+	return report, nil
+}
+
+func runHealthCheck(ctx context.Context, check HealthCheck) DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Check(ctx)
+	dep := DependencyHealth{Name: check.Name, Healthy: err == nil, Latency: time.Since(start)}
+	if err != nil {
+		dep.Message = err.Error()
+	} else {
+		dep.Message = "ok"
+	}
+	return dep
+}
+
+// ----------------- Checks -----------------
+
+// DatabaseHealthCheck pings Postgres.
+func DatabaseHealthCheck(conn *sql.DB) HealthCheck {
+	return HealthCheck{Name: "postgres", Check: conn.PingContext}
+}
+
+// KafkaHealthCheck dials the Kafka controller.
+func KafkaHealthCheck(brokers []string) HealthCheck {
+	return HealthCheck{Name: "kafka", Check: func(ctx context.Context) error {
+		return kafka.Ping(ctx, brokers)
+	}}
+}
+
+// WebSocketHealthCheck checks that the websocket broadcast loop is running.
+// It runs in-process, so it also counts towards liveness.
+func WebSocketHealthCheck(hub interface{ Running() bool }) HealthCheck {
+	return HealthCheck{Name: "websocket_hub", Liveness: true, Check: func(ctx context.Context) error {
+		if !hub.Running() {
+			return errors.New("broadcast loop is not running")
+		}
+		return nil
+	}}
+}
@@ -0,0 +1,426 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrSalesTeamNotFound      = errors.New("sales team not found")
+	ErrSalesTeamExists        = errors.New("sales team already exists")
+	ErrInvalidSalesTeam       = errors.New("invalid sales team")
+	ErrAssignmentRuleNotFound = errors.New("assignment rule not found")
+	ErrInvalidAssignmentRule  = errors.New("invalid assignment rule")
+	ErrNoAssignmentMatch      = errors.New("no assignment rule matched the lead")
+)
+
+// Assignment strategies pick a member of a rule's team. Round-robin picks
+// the active member who has waited longest since their last lead;
+// least-loaded picks the one with the fewest open leads.
+const (
+	AssignmentRoundRobin  = "round_robin"
+	AssignmentLeastLoaded = "least_loaded"
+)
+
+// assignmentReasonManual is recorded when the caller chose the assignee.
+const assignmentReasonManual = "assigned manually"
+
+// maxAssignmentReason is the size of leads.assignment_reason.
+const maxAssignmentReason = 255
+
+// AssignmentRule is the caller-supplied part of a rule. Country, State and
+// Industry narrow the leads it applies to; empty ones match any lead.
+type AssignmentRule struct {
+	OrganizationID int32
+	Name           string
+	Priority       int32
+	Strategy       string
+	TeamID         int32
+	Country        string
+	State          string
+	Industry       string
+	Enabled        bool
+}
+
+// SalesTeamDetails bundles a team with its members.
+type SalesTeamDetails struct {
+	Team    db.SalesTeam
+	Members []db.SalesTeamMember
+}
+
+type LeadAssignmentServiceInterface interface {
+	CreateTeam(ctx context.Context, organizationID int32, name string) (*SalesTeamDetails, error)
+	ListTeams(ctx context.Context, organizationID int32) ([]SalesTeamDetails, error)
+	DeleteTeam(ctx context.Context, id int32) error
+	SetTeamMember(ctx context.Context, teamID, userID int32, active bool) (*db.SalesTeamMember, error)
+	RemoveTeamMember(ctx context.Context, teamID, userID int32) error
+	CreateRule(ctx context.Context, rule AssignmentRule) (*db.LeadAssignmentRule, error)
+	UpdateRule(ctx context.Context, id int32, rule AssignmentRule) (*db.LeadAssignmentRule, error)
+	DeleteRule(ctx context.Context, id int32) error
+	ListRules(ctx context.Context, organizationID int32) ([]db.LeadAssignmentRule, error)
+	AssignLead(ctx context.Context, leadID int32) (*db.Lead, error)
+}
+
+// LeadAssignmentService manages sales teams and assignment rules. The
+// rules are applied by CreateLead to leads created without an assignee,
+// and by AssignLead on demand.
+type LeadAssignmentService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	kafka   *kafka.Producer
+}
+
+func NewLeadAssignmentService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *LeadAssignmentService {
+	return &LeadAssignmentService{conn: conn, queries: queries, kafka: producer}
+}
+
+// CreateTeam creates an empty team. Names are unique per organization.
+func (s *LeadAssignmentService) CreateTeam(ctx context.Context, organizationID int32, name string) (*SalesTeamDetails, error) {
+	name = strings.TrimSpace(name)
+	if organizationID <= 0 || name == "" {
+		return nil, fmt.Errorf("%w: organization_id and name are required", ErrInvalidSalesTeam)
+	}
+
+	team, err := s.queries.CreateSalesTeam(ctx, db.CreateSalesTeamParams{OrganizationID: organizationID, Name: name})
+	if err != nil {
+		if isUniqueViolation(err, "sales_teams_org_name_key") {
+			return nil, fmt.Errorf("%w: %q", ErrSalesTeamExists, name)
+		}
+		return nil, err
+	}
+	return &SalesTeamDetails{Team: team}, nil
+}
+
+// ListTeams returns the teams of an organization with their members.
+func (s *LeadAssignmentService) ListTeams(ctx context.Context, organizationID int32) ([]SalesTeamDetails, error) {
+	teams, err := s.queries.ListSalesTeams(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]SalesTeamDetails, 0, len(teams))
+	for _, team := range teams {
+		members, err := s.queries.ListSalesTeamMembers(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		details = append(details, SalesTeamDetails{Team: team, Members: members})
+	}
+	return details, nil
+}
+
+// DeleteTeam removes a team together with its members and the rules that
+// assign to it.
+func (s *LeadAssignmentService) DeleteTeam(ctx context.Context, id int32) error {
+	n, err := s.queries.DeleteSalesTeam(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrSalesTeamNotFound
+	}
+	return nil
+}
+
+// SetTeamMember adds a user to a team or changes whether they receive
+// leads. Inactive members keep their place in the round-robin.
+func (s *LeadAssignmentService) SetTeamMember(ctx context.Context, teamID, userID int32, active bool) (*db.SalesTeamMember, error) {
+	if userID <= 0 {
+		return nil, fmt.Errorf("%w: user_id is required", ErrInvalidSalesTeam)
+	}
+	if _, err := s.queries.GetSalesTeam(ctx, teamID); err != nil {
+		return nil, ErrSalesTeamNotFound
+	}
+
+	member, err := s.queries.UpsertSalesTeamMember(ctx, db.UpsertSalesTeamMemberParams{
+		TeamID: teamID,
+		UserID: userID,
+		Active: active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// RemoveTeamMember takes a user off a team.
+func (s *LeadAssignmentService) RemoveTeamMember(ctx context.Context, teamID, userID int32) error {
+	n, err := s.queries.DeleteSalesTeamMember(ctx, db.DeleteSalesTeamMemberParams{TeamID: teamID, UserID: userID})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: user %d is not a member of team %d", ErrSalesTeamNotFound, userID, teamID)
+	}
+	return nil
+}
+
+// CreateRule validates and stores a rule. Its team must belong to the
+// same organization.
+func (s *LeadAssignmentService) CreateRule(ctx context.Context, rule AssignmentRule) (*db.LeadAssignmentRule, error) {
+	if err := s.validateRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateLeadAssignmentRule(ctx, db.CreateLeadAssignmentRuleParams{
+		OrganizationID: rule.OrganizationID,
+		Name:           rule.Name,
+		Priority:       rule.Priority,
+		Strategy:       rule.Strategy,
+		TeamID:         rule.TeamID,
+		Country:        rule.Country,
+		State:          rule.State,
+		Industry:       rule.Industry,
+		Enabled:        rule.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateRule replaces a rule. Its organization cannot change.
+func (s *LeadAssignmentService) UpdateRule(ctx context.Context, id int32, rule AssignmentRule) (*db.LeadAssignmentRule, error) {
+	current, err := s.queries.GetLeadAssignmentRule(ctx, id)
+	if err != nil {
+		return nil, ErrAssignmentRuleNotFound
+	}
+	rule.OrganizationID = current.OrganizationID
+	if err := s.validateRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.UpdateLeadAssignmentRule(ctx, db.UpdateLeadAssignmentRuleParams{
+		ID:       id,
+		Name:     rule.Name,
+		Priority: rule.Priority,
+		Strategy: rule.Strategy,
+		TeamID:   rule.TeamID,
+		Country:  rule.Country,
+		State:    rule.State,
+		Industry: rule.Industry,
+		Enabled:  rule.Enabled,
+	})
+	if err != nil {
+		return nil, ErrAssignmentRuleNotFound
+	}
+	return &updated, nil
+}
+
+// DeleteRule removes a rule.
+func (s *LeadAssignmentService) DeleteRule(ctx context.Context, id int32) error {
+	n, err := s.queries.DeleteLeadAssignmentRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAssignmentRuleNotFound
+	}
+	return nil
+}
+
+// ListRules returns the rules of an organization in the order they are
+// tried.
+func (s *LeadAssignmentService) ListRules(ctx context.Context, organizationID int32) ([]db.LeadAssignmentRule, error) {
+	return s.queries.ListLeadAssignmentRules(ctx, organizationID)
+}
+
+// AssignLead runs the assignment rules for an existing lead, replacing its
+// current assignee.
+func (s *LeadAssignmentService) AssignLead(ctx context.Context, leadID int32) (*db.Lead, error) {
+	var (
+		assigned db.Lead
+		previous sql.NullInt32
+	)
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		lead, err := q.GetLeadForUpdate(ctx, leadID)
+		if err != nil {
+			return ErrLeadNotFound
+		}
+		if lead.ConvertedAt.Valid {
+			return ErrLeadAlreadyConverted
+		}
+		previous = lead.AssignedTo
+
+		assignment, err := pickAssignee(ctx, q, &lead)
+		if err != nil {
+			return err
+		}
+		if assignment == nil {
+			return ErrNoAssignmentMatch
+		}
+		assigned, err = q.AssignLead(ctx, *assignment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishLeadAssigned(ctx, s.kafka, assigned, previous)
+	return &assigned, nil
+}
+
+func (s *LeadAssignmentService) validateRule(ctx context.Context, rule *AssignmentRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.Country = strings.TrimSpace(rule.Country)
+	rule.State = strings.TrimSpace(rule.State)
+	rule.Industry = strings.TrimSpace(rule.Industry)
+
+	switch {
+	case rule.OrganizationID <= 0:
+		return fmt.Errorf("%w: organization_id is required", ErrInvalidAssignmentRule)
+	case rule.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidAssignmentRule)
+	case rule.Strategy != AssignmentRoundRobin && rule.Strategy != AssignmentLeastLoaded:
+		return fmt.Errorf("%w: unknown strategy %q", ErrInvalidAssignmentRule, rule.Strategy)
+	case rule.State != "" && rule.Country == "":
+		return fmt.Errorf("%w: state requires a country", ErrInvalidAssignmentRule)
+	}
+
+	team, err := s.queries.GetSalesTeam(ctx, rule.TeamID)
+	if err != nil || team.OrganizationID != rule.OrganizationID {
+		return fmt.Errorf("%w: team %d does not belong to organization %d", ErrInvalidAssignmentRule, rule.TeamID, rule.OrganizationID)
+	}
+	return nil
+}
+
+// ----------------- Assignment engine -----------------
+
+// pickAssignee tries the enabled rules of the lead's organization in
+// priority order. The first rule that matches the lead's territory and
+// industry and whose team has an active member decides; the member's
+// round-robin position is advanced. It returns nil when no rule applies.
+func pickAssignee(ctx context.Context, q *db.Queries, lead *db.Lead) (*db.AssignLeadParams, error) {
+	if !lead.OrganizationID.Valid {
+		return nil, nil
+	}
+	rules, err := q.ListLeadAssignmentRules(ctx, lead.OrganizationID.Int32)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	var company db.Company
+	if lead.CompanyID.Valid {
+		company, err = q.GetCompany(ctx, lead.CompanyID.Int32)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+	country, state, industry := assignmentCriteria(lead, company)
+
+	var workflow *LeadWorkflow
+	for _, rule := range rules {
+		if !rule.Enabled || !matchesCriterion(rule.Country, country) ||
+			!matchesCriterion(rule.State, state) || !matchesCriterion(rule.Industry, industry) {
+			continue
+		}
+
+		// Serialize picks within a team so concurrent leads do not land
+		// on the same member.
+		if err := q.LockSalesTeam(ctx, rule.TeamID); err != nil {
+			return nil, err
+		}
+		var userID int32
+		switch rule.Strategy {
+		case AssignmentLeastLoaded:
+			// Only the organization's leads count. Besides converted
+			// leads, those in a closed status of its workflow are no
+			// longer open.
+			if workflow == nil {
+				if workflow, err = loadLeadWorkflow(ctx, q, lead.OrganizationID.Int32); err != nil {
+					return nil, err
+				}
+			}
+			userID, err = q.LeastLoadedMember(ctx, db.LeastLoadedMemberParams{
+				ClosedStatuses: workflow.closedStatuses(),
+				OrganizationID: lead.OrganizationID,
+				TeamID:         rule.TeamID,
+			})
+		default:
+			userID, err = q.NextRoundRobinMember(ctx, rule.TeamID)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			// No active members; let a later rule try.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := q.TouchSalesTeamMember(ctx, db.TouchSalesTeamMemberParams{TeamID: rule.TeamID, UserID: userID}); err != nil {
+			return nil, err
+		}
+
+		return &db.AssignLeadParams{
+			ID:               lead.ID,
+			AssignedTo:       sql.NullInt32{Int32: userID, Valid: true},
+			AssignmentReason: sql.NullString{String: assignmentReason(rule), Valid: true},
+		}, nil
+	}
+	return nil, nil
+}
+
+// assignmentCriteria returns the country, state and industry rules are
+// matched against. The lead's country and state are used as a pair: when
+// the lead has no country both come from its company. company is the zero
+// value when the lead has none.
+func assignmentCriteria(lead *db.Lead, company db.Company) (country, state, industry string) {
+	country, state, industry = lead.Country.String, lead.State.String, company.Industry.String
+	if country == "" {
+		country, state = company.Country.String, company.State.String
+	}
+	return country, state, industry
+}
+
+func matchesCriterion(want, actual string) bool {
+	return want == "" || strings.EqualFold(want, strings.TrimSpace(actual))
+}
+
+// assignmentReason describes why a rule picked an assignee, e.g.
+// `rule "West coast" (country=US, state=CA): round_robin in team 3`.
+func assignmentReason(rule db.LeadAssignmentRule) string {
+	var criteria []string
+	for _, c := range []struct{ name, value string }{
+		{"country", rule.Country},
+		{"state", rule.State},
+		{"industry", rule.Industry},
+	} {
+		if c.value != "" {
+			criteria = append(criteria, c.name+"="+c.value)
+		}
+	}
+	scope := "any lead"
+	if len(criteria) > 0 {
+		scope = strings.Join(criteria, ", ")
+	}
+
+	reason := fmt.Sprintf("rule %q (%s): %s in team %d", rule.Name, scope, rule.Strategy, rule.TeamID)
+	if len(reason) > maxAssignmentReason {
+		reason = strings.ToValidUTF8(reason[:maxAssignmentReason], "")
+	}
+	return reason
+}
+
+// publishLeadAssigned tells the new assignee about a lead. previous is the
+// assignee it had before, if any.
+func publishLeadAssigned(ctx context.Context, producer *kafka.Producer, lead db.Lead, previous sql.NullInt32) {
+	if !lead.AssignedTo.Valid {
+		return
+	}
+	// Kafka event
+	_ = producer.Publish(ctx, kafka.TopicLeadAssigned, "lead_assigned", map[string]interface{}{
+		"id":                   lead.ID,
+		"email":                lead.Email,
+		"first_name":           lead.FirstName,
+		"last_name":            lead.LastName,
+		"organization_id":      lead.OrganizationID.Int32,
+		"assigned_to":          lead.AssignedTo.Int32,
+		"previous_assigned_to": previous.Int32,
+		"reason":               lead.AssignmentReason.String,
+		"assigned_at":          lead.AssignedAt.Time,
+	})
+}
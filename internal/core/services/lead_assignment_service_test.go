@@ -0,0 +1,146 @@
+package services
+
+import (
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestMatchesCriterion(t *testing.T) {
+	tests := []struct {
+		want   string
+		actual string
+		match  bool
+	}{
+		{"", "", true},
+		{"", "US", true},
+		{"US", "US", true},
+		{"us", "US", true},
+		{"Software", "  software ", true},
+		{"US", "", false},
+		{"US", "USA", false},
+		{"CA", "US", false},
+	}
+	for _, tt := range tests {
+		if got := matchesCriterion(tt.want, tt.actual); got != tt.match {
+			t.Errorf("matchesCriterion(%q, %q) = %v, want %v", tt.want, tt.actual, got, tt.match)
+		}
+	}
+}
+
+func TestAssignmentCriteria(t *testing.T) {
+	str := func(s string) sql.NullString { return sql.NullString{String: s, Valid: s != ""} }
+	lead := func(country, state string) *db.Lead { return &db.Lead{Country: str(country), State: str(state)} }
+	company := db.Company{Country: str("US"), State: str("CA"), Industry: str("Software")}
+
+	tests := []struct {
+		name     string
+		lead     *db.Lead
+		company  db.Company
+		country  string
+		state    string
+		industry string
+	}{
+		{
+			name:     "lead territory wins",
+			lead:     lead("DE", "BY"),
+			company:  company,
+			country:  "DE",
+			state:    "BY",
+			industry: "Software",
+		},
+		{
+			name:     "lead country without a state keeps no state",
+			lead:     lead("US", ""),
+			company:  company,
+			country:  "US",
+			industry: "Software",
+		},
+		{
+			name:     "company territory without a lead country",
+			lead:     lead("", ""),
+			company:  company,
+			country:  "US",
+			state:    "CA",
+			industry: "Software",
+		},
+		{
+			name:     "lead state alone is replaced by the company's",
+			lead:     lead("", "NY"),
+			company:  company,
+			country:  "US",
+			state:    "CA",
+			industry: "Software",
+		},
+		{
+			name:    "no company",
+			lead:    lead("DE", "BY"),
+			country: "DE",
+			state:   "BY",
+		},
+		{
+			name: "neither has a territory",
+			lead: lead("", ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			country, state, industry := assignmentCriteria(tt.lead, tt.company)
+			if country != tt.country || state != tt.state || industry != tt.industry {
+				t.Errorf("assignmentCriteria = (%q, %q, %q), want (%q, %q, %q)",
+					country, state, industry, tt.country, tt.state, tt.industry)
+			}
+		})
+	}
+}
+
+func TestAssignmentReason(t *testing.T) {
+	tests := []struct {
+		name string
+		rule db.LeadAssignmentRule
+		want string
+	}{
+		{
+			name: "any lead",
+			rule: db.LeadAssignmentRule{Name: "Catch all", Strategy: AssignmentRoundRobin, TeamID: 1},
+			want: `rule "Catch all" (any lead): round_robin in team 1`,
+		},
+		{
+			name: "territory",
+			rule: db.LeadAssignmentRule{Name: "West coast", Strategy: AssignmentRoundRobin, TeamID: 3, Country: "US", State: "CA"},
+			want: `rule "West coast" (country=US, state=CA): round_robin in team 3`,
+		},
+		{
+			name: "industry",
+			rule: db.LeadAssignmentRule{Name: "Tech", Strategy: AssignmentLeastLoaded, TeamID: 2, Industry: "Software"},
+			want: `rule "Tech" (industry=Software): least_loaded in team 2`,
+		},
+		{
+			name: "long names are cut at the column size",
+			rule: db.LeadAssignmentRule{Name: strings.Repeat("x", 300), Strategy: AssignmentRoundRobin, TeamID: 1},
+			want: `rule "` + strings.Repeat("x", maxAssignmentReason-len(`rule "`)),
+		},
+		{
+			// `rule "` is 6 bytes, so the cut falls inside the 125th é.
+			name: "cuts never split a character",
+			rule: db.LeadAssignmentRule{Name: strings.Repeat("é", 200), Strategy: AssignmentRoundRobin, TeamID: 1},
+			want: `rule "` + strings.Repeat("é", 124),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := assignmentReason(tt.rule)
+			if got != tt.want {
+				t.Errorf("assignmentReason = %q, want %q", got, tt.want)
+			}
+			if len(got) > maxAssignmentReason || !utf8.ValidString(got) {
+				t.Errorf("assignmentReason = %d bytes, valid UTF-8 %v; want at most %d bytes of valid UTF-8",
+					len(got), utf8.ValidString(got), maxAssignmentReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,409 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrScoringRuleNotFound = errors.New("scoring rule not found")
+	ErrInvalidScoringRule  = errors.New("invalid scoring rule")
+)
+
+// Scoring rule types. A field rule compares a lead field with Value, an
+// activity_count rule compares the number of activities on the contact the
+// lead was converted to, and a company_domain rule matches when the lead's
+// email domain is the website domain of a company in the lead's
+// organization.
+const (
+	ScoringRuleField         = "field"
+	ScoringRuleActivityCount = "activity_count"
+	ScoringRuleCompanyDomain = "company_domain"
+)
+
+// leadScoringBatch is how many leads one recompute pass loads at a time.
+const leadScoringBatch = 500
+
+// scoringFields are the lead fields a field rule may test. email_domain is
+// the part of the email after the @.
+var scoringFields = map[string]func(l *db.Lead) string{
+	"first_name":   func(l *db.Lead) string { return l.FirstName },
+	"last_name":    func(l *db.Lead) string { return l.LastName },
+	"email":        func(l *db.Lead) string { return l.Email },
+	"email_domain": func(l *db.Lead) string { return emailDomain(l.Email) },
+	"phone":        func(l *db.Lead) string { return l.Phone.String },
+	"status":       func(l *db.Lead) string { return l.Status },
+	"assigned_to":  func(l *db.Lead) string { return nullInt32String(l.AssignedTo) },
+	"organization_id": func(l *db.Lead) string {
+		return nullInt32String(l.OrganizationID)
+	},
+}
+
+// Operators by rule type. String comparisons ignore case.
+var (
+	fieldOperators = map[string]bool{
+		"equals": true, "not_equals": true, "contains": true,
+		"starts_with": true, "ends_with": true, "is_set": true, "is_not_set": true,
+	}
+	countOperators = map[string]bool{
+		"equals": true, "gt": true, "gte": true, "lt": true, "lte": true,
+	}
+)
+
+// ScoringRule is the caller-supplied part of a rule.
+type ScoringRule struct {
+	OrganizationID sql.NullInt32
+	Name           string
+	RuleType       string
+	Field          string
+	Operator       string
+	Value          string
+	Weight         int32
+	Enabled        bool
+}
+
+type LeadScoringServiceInterface interface {
+	CreateRule(ctx context.Context, rule ScoringRule) (*db.LeadScoringRule, error)
+	GetRule(ctx context.Context, id int32) (*db.LeadScoringRule, error)
+	UpdateRule(ctx context.Context, id int32, rule ScoringRule) (*db.LeadScoringRule, error)
+	DeleteRule(ctx context.Context, id int32) error
+	ListRules(ctx context.Context, organizationID sql.NullInt32, opts ListOptions) (*Page[db.LeadScoringRule], error)
+	ScoreLead(ctx context.Context, leadID int32) (int32, error)
+	RecomputeScores(ctx context.Context, organizationID sql.NullInt32) (int, error)
+	HandleLeadEvent(ctx context.Context, value []byte) error
+	HandleActivityEvent(ctx context.Context, value []byte) error
+}
+
+// LeadScoringService keeps lead scores up to date. Scores are the sum of
+// the weights of the enabled rules a lead matches; they are recomputed
+// from the lead and activity topics and stored on the lead.
+type LeadScoringService struct {
+	queries *db.Queries
+	kafka   *kafka.Producer
+}
+
+func NewLeadScoringService(queries *db.Queries, producer *kafka.Producer) *LeadScoringService {
+	return &LeadScoringService{queries: queries, kafka: producer}
+}
+
+// CreateRule validates and stores a new rule. Existing scores are not
+// recomputed; call RecomputeScores for that.
+func (s *LeadScoringService) CreateRule(ctx context.Context, rule ScoringRule) (*db.LeadScoringRule, error) {
+	if err := validateScoringRule(&rule); err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateLeadScoringRule(ctx, db.CreateLeadScoringRuleParams{
+		OrganizationID: rule.OrganizationID,
+		Name:           rule.Name,
+		RuleType:       rule.RuleType,
+		Field:          sql.NullString{String: rule.Field, Valid: rule.Field != ""},
+		Operator:       rule.Operator,
+		Value:          rule.Value,
+		Weight:         rule.Weight,
+		Enabled:        rule.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetRule retrieves a rule by ID.
+func (s *LeadScoringService) GetRule(ctx context.Context, id int32) (*db.LeadScoringRule, error) {
+	rule, err := s.queries.GetLeadScoringRule(ctx, id)
+	if err != nil {
+		return nil, ErrScoringRuleNotFound
+	}
+	return &rule, nil
+}
+
+// UpdateRule replaces a rule. Its organization cannot change.
+func (s *LeadScoringService) UpdateRule(ctx context.Context, id int32, rule ScoringRule) (*db.LeadScoringRule, error) {
+	if err := validateScoringRule(&rule); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.UpdateLeadScoringRule(ctx, db.UpdateLeadScoringRuleParams{
+		ID:       id,
+		Name:     rule.Name,
+		RuleType: rule.RuleType,
+		Field:    sql.NullString{String: rule.Field, Valid: rule.Field != ""},
+		Operator: rule.Operator,
+		Value:    rule.Value,
+		Weight:   rule.Weight,
+		Enabled:  rule.Enabled,
+	})
+	if err != nil {
+		return nil, ErrScoringRuleNotFound
+	}
+	return &updated, nil
+}
+
+// DeleteRule removes a rule.
+func (s *LeadScoringService) DeleteRule(ctx context.Context, id int32) error {
+	n, err := s.queries.DeleteLeadScoringRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrScoringRuleNotFound
+	}
+	return nil
+}
+
+// ListRules returns a filtered, sorted page of rules, of one organization
+// unless organizationID is not set.
+func (s *LeadScoringService) ListRules(ctx context.Context, organizationID sql.NullInt32, opts ListOptions) (*Page[db.LeadScoringRule], error) {
+	var conditions []filter.Condition
+	if organizationID.Valid {
+		conditions = append(conditions, filter.Eq("organization_id", organizationID.Int32))
+	}
+	return listPage(ctx, filter.LeadScoringRules, opts, s.queries.FilterLeadScoringRules, s.queries.CountLeadScoringRules, filter.Where(conditions...))
+}
+
+// ScoreLead recomputes and stores the score of one lead.
+func (s *LeadScoringService) ScoreLead(ctx context.Context, leadID int32) (int32, error) {
+	lead, err := s.queries.GetLeadById(ctx, leadID)
+	if err != nil {
+		return 0, ErrLeadNotFound
+	}
+	rules, err := s.queries.ListApplicableLeadScoringRules(ctx, lead.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return s.score(ctx, &lead, rules)
+}
+
+// RecomputeScores rescores every lead, or only those of one organization,
+// e.g. after the rules changed. It returns how many leads were scored.
+func (s *LeadScoringService) RecomputeScores(ctx context.Context, organizationID sql.NullInt32) (int, error) {
+	var (
+		scored  int
+		afterID int32
+	)
+	for {
+		ids, err := s.queries.ListLeadIDsForScoring(ctx, db.ListLeadIDsForScoringParams{
+			OrganizationID: organizationID,
+			AfterID:        afterID,
+			LimitCount:     leadScoringBatch,
+		})
+		if err != nil {
+			return scored, err
+		}
+		if len(ids) == 0 {
+			return scored, nil
+		}
+		for _, id := range ids {
+			afterID = id
+			if _, err := s.ScoreLead(ctx, id); err != nil {
+				if errors.Is(err, ErrLeadNotFound) {
+					continue
+				}
+				return scored, fmt.Errorf("score lead %d: %w", id, err)
+			}
+			scored++
+		}
+	}
+}
+
+// HandleLeadEvent rescores the lead named by a lead topic event. Events for
+// leads that no longer exist are ignored.
+func (s *LeadScoringService) HandleLeadEvent(ctx context.Context, value []byte) error {
+	var event struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal(value, &event); err != nil {
+		return fmt.Errorf("decode lead event: %w", err)
+	}
+	if event.ID == 0 {
+		return nil
+	}
+	if _, err := s.ScoreLead(ctx, event.ID); err != nil && !errors.Is(err, ErrLeadNotFound) {
+		return err
+	}
+	return nil
+}
+
+// HandleActivityEvent rescores the leads converted to the contact of an
+// activity topic event, whose activity_count rules may now match
+// differently.
+func (s *LeadScoringService) HandleActivityEvent(ctx context.Context, value []byte) error {
+	var event struct {
+		ContactID int32 `json:"contact_id"`
+	}
+	if err := json.Unmarshal(value, &event); err != nil {
+		return fmt.Errorf("decode activity event: %w", err)
+	}
+	if event.ContactID == 0 {
+		return nil
+	}
+	ids, err := s.queries.ListLeadIDsConvertedToContact(ctx, event.ContactID)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := s.ScoreLead(ctx, id); err != nil && !errors.Is(err, ErrLeadNotFound) {
+			return fmt.Errorf("score lead %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// score evaluates rules against lead and stores the result. Writing the
+// score does not publish a lead event, so scoring cannot loop.
+func (s *LeadScoringService) score(ctx context.Context, lead *db.Lead, rules []db.LeadScoringRule) (int32, error) {
+	var total int32
+	for _, rule := range rules {
+		matched, err := s.matches(ctx, lead, rule)
+		if err != nil {
+			return 0, fmt.Errorf("rule %d: %w", rule.ID, err)
+		}
+		if matched {
+			total += rule.Weight
+		}
+	}
+
+	if total != lead.Score || !lead.ScoreUpdatedAt.Valid {
+		if err := s.queries.UpdateLeadScore(ctx, db.UpdateLeadScoreParams{ID: lead.ID, Score: total}); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+func (s *LeadScoringService) matches(ctx context.Context, lead *db.Lead, rule db.LeadScoringRule) (bool, error) {
+	switch rule.RuleType {
+	case ScoringRuleField:
+		get, ok := scoringFields[rule.Field.String]
+		if !ok {
+			return false, nil
+		}
+		return matchString(get(lead), rule.Operator, rule.Value), nil
+
+	case ScoringRuleActivityCount:
+		var count int64
+		if lead.ConvertedContactID.Valid {
+			var err error
+			count, err = s.queries.CountContactActivities(ctx, lead.ConvertedContactID.Int32)
+			if err != nil {
+				return false, err
+			}
+		}
+		want, _ := strconv.ParseInt(rule.Value, 10, 64)
+		return matchCount(count, rule.Operator, want), nil
+
+	case ScoringRuleCompanyDomain:
+		domain := emailDomain(lead.Email)
+		if domain == "" || !lead.OrganizationID.Valid {
+			return false, nil
+		}
+		n, err := s.queries.CountCompaniesWithDomain(ctx, db.CountCompaniesWithDomainParams{
+			OrganizationID: lead.OrganizationID.Int32,
+			Domain:         domain,
+		})
+		if err != nil {
+			return false, err
+		}
+		return n > 0, nil
+	}
+	return false, nil
+}
+
+// ----------------- Helpers -----------------
+
+func validateScoringRule(rule *ScoringRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidScoringRule)
+	}
+	if rule.Weight == 0 {
+		return fmt.Errorf("%w: weight must not be zero", ErrInvalidScoringRule)
+	}
+
+	switch rule.RuleType {
+	case ScoringRuleField:
+		if _, ok := scoringFields[rule.Field]; !ok {
+			return fmt.Errorf("%w: unknown field %q", ErrInvalidScoringRule, rule.Field)
+		}
+		if !fieldOperators[rule.Operator] {
+			return fmt.Errorf("%w: unknown operator %q for field rules", ErrInvalidScoringRule, rule.Operator)
+		}
+		if rule.Value == "" && rule.Operator != "is_set" && rule.Operator != "is_not_set" {
+			return fmt.Errorf("%w: value is required for %s", ErrInvalidScoringRule, rule.Operator)
+		}
+	case ScoringRuleActivityCount:
+		rule.Field = ""
+		if !countOperators[rule.Operator] {
+			return fmt.Errorf("%w: unknown operator %q for activity_count rules", ErrInvalidScoringRule, rule.Operator)
+		}
+		if n, err := strconv.ParseInt(rule.Value, 10, 64); err != nil || n < 0 {
+			return fmt.Errorf("%w: value must be a non-negative count", ErrInvalidScoringRule)
+		}
+	case ScoringRuleCompanyDomain:
+		rule.Field, rule.Operator, rule.Value = "", "", ""
+	default:
+		return fmt.Errorf("%w: unknown rule type %q", ErrInvalidScoringRule, rule.RuleType)
+	}
+	return nil
+}
+
+func matchString(actual, operator, value string) bool {
+	actual, value = strings.ToLower(strings.TrimSpace(actual)), strings.ToLower(value)
+	switch operator {
+	case "equals":
+		return actual == value
+	case "not_equals":
+		return actual != value
+	case "contains":
+		return strings.Contains(actual, value)
+	case "starts_with":
+		return strings.HasPrefix(actual, value)
+	case "ends_with":
+		return strings.HasSuffix(actual, value)
+	case "is_set":
+		return actual != ""
+	case "is_not_set":
+		return actual == ""
+	}
+	return false
+}
+
+func matchCount(actual int64, operator string, value int64) bool {
+	switch operator {
+	case "equals":
+		return actual == value
+	case "gt":
+		return actual > value
+	case "gte":
+		return actual >= value
+	case "lt":
+		return actual < value
+	case "lte":
+		return actual <= value
+	}
+	return false
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+func nullInt32String(v sql.NullInt32) string {
+	if !v.Valid {
+		return ""
+	}
+	return strconv.Itoa(int(v.Int32))
+}
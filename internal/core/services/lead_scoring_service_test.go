@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateScoringRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule ScoringRule
+		want ScoringRule // the rule after validation, when it is valid
+		err  string      // empty when the rule is valid
+	}{
+		{
+			name: "field rule",
+			rule: ScoringRule{Name: " Corporate email ", RuleType: ScoringRuleField, Field: "email_domain", Operator: "equals", Value: "acme.com", Weight: 10},
+			want: ScoringRule{Name: "Corporate email", RuleType: ScoringRuleField, Field: "email_domain", Operator: "equals", Value: "acme.com", Weight: 10},
+		},
+		{
+			name: "is_set needs no value",
+			rule: ScoringRule{Name: "Has phone", RuleType: ScoringRuleField, Field: "phone", Operator: "is_set", Weight: 5},
+			want: ScoringRule{Name: "Has phone", RuleType: ScoringRuleField, Field: "phone", Operator: "is_set", Weight: 5},
+		},
+		{
+			name: "negative weights are allowed",
+			rule: ScoringRule{Name: "Unassigned", RuleType: ScoringRuleField, Field: "assigned_to", Operator: "is_not_set", Weight: -5},
+			want: ScoringRule{Name: "Unassigned", RuleType: ScoringRuleField, Field: "assigned_to", Operator: "is_not_set", Weight: -5},
+		},
+		{
+			name: "activity count rule drops the field",
+			rule: ScoringRule{Name: "Engaged", RuleType: ScoringRuleActivityCount, Field: "email", Operator: "gte", Value: "3", Weight: 20},
+			want: ScoringRule{Name: "Engaged", RuleType: ScoringRuleActivityCount, Operator: "gte", Value: "3", Weight: 20},
+		},
+		{
+			name: "company domain rule drops field, operator and value",
+			rule: ScoringRule{Name: "Known company", RuleType: ScoringRuleCompanyDomain, Field: "email", Operator: "equals", Value: "x", Weight: 15},
+			want: ScoringRule{Name: "Known company", RuleType: ScoringRuleCompanyDomain, Weight: 15},
+		},
+		{
+			name: "blank name",
+			rule: ScoringRule{Name: "  ", RuleType: ScoringRuleField, Field: "email", Operator: "is_set", Weight: 1},
+			err:  "name is required",
+		},
+		{
+			name: "zero weight",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleField, Field: "email", Operator: "is_set"},
+			err:  "weight must not be zero",
+		},
+		{
+			name: "unknown field",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleField, Field: "salary", Operator: "equals", Value: "1", Weight: 1},
+			err:  `unknown field "salary"`,
+		},
+		{
+			name: "count operator on a field rule",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleField, Field: "email", Operator: "gt", Value: "1", Weight: 1},
+			err:  `unknown operator "gt" for field rules`,
+		},
+		{
+			name: "field rule without a value",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleField, Field: "email", Operator: "contains", Weight: 1},
+			err:  "value is required for contains",
+		},
+		{
+			name: "string operator on an activity count rule",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleActivityCount, Operator: "contains", Value: "1", Weight: 1},
+			err:  `unknown operator "contains" for activity_count rules`,
+		},
+		{
+			name: "negative count",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleActivityCount, Operator: "gte", Value: "-1", Weight: 1},
+			err:  "value must be a non-negative count",
+		},
+		{
+			name: "count that is not a number",
+			rule: ScoringRule{Name: "Rule", RuleType: ScoringRuleActivityCount, Operator: "gte", Value: "many", Weight: 1},
+			err:  "value must be a non-negative count",
+		},
+		{
+			name: "unknown rule type",
+			rule: ScoringRule{Name: "Rule", RuleType: "page_views", Weight: 1},
+			err:  `unknown rule type "page_views"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := tt.rule
+			err := validateScoringRule(&rule)
+			if tt.err != "" {
+				if !errors.Is(err, ErrInvalidScoringRule) || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("validateScoringRule error = %v, want ErrInvalidScoringRule with %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateScoringRule: %v", err)
+			}
+			if rule != tt.want {
+				t.Errorf("rule = %+v, want %+v", rule, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchString(t *testing.T) {
+	tests := []struct {
+		actual   string
+		operator string
+		value    string
+		want     bool
+	}{
+		{"Acme.com", "equals", "acme.COM", true},
+		{"  acme.com ", "equals", "acme.com", true},
+		{"acme.com", "equals", "acme.org", false},
+		{"Acme.com", "not_equals", "ACME.COM", false},
+		{"acme.com", "not_equals", "acme.org", true},
+		{"Jane Doe", "contains", "E D", true},
+		{"Jane Doe", "contains", "smith", false},
+		{"Jane", "starts_with", "JA", true},
+		{"Jane", "starts_with", "ne", false},
+		{"jane@ACME.com", "ends_with", "@acme.com", true},
+		{"jane@acme.com", "ends_with", "acme", false},
+		{"555 1234", "is_set", "", true},
+		{"   ", "is_set", "", false},
+		{"", "is_not_set", "", true},
+		{"x", "is_not_set", "", false},
+		// A missing field is the empty string.
+		{"", "equals", "acme.com", false},
+		{"", "not_equals", "acme.com", true},
+		{"", "contains", "a", false},
+		{"", "starts_with", "a", false},
+		{"acme.com", "matches", "acme.com", false},
+	}
+	for _, tt := range tests {
+		if got := matchString(tt.actual, tt.operator, tt.value); got != tt.want {
+			t.Errorf("matchString(%q, %q, %q) = %v, want %v", tt.actual, tt.operator, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMatchCount(t *testing.T) {
+	tests := []struct {
+		actual   int64
+		operator string
+		value    int64
+		want     bool
+	}{
+		{3, "equals", 3, true},
+		{2, "equals", 3, false},
+		{4, "gt", 3, true},
+		{3, "gt", 3, false},
+		{3, "gte", 3, true},
+		{2, "gte", 3, false},
+		{2, "lt", 3, true},
+		{3, "lt", 3, false},
+		{3, "lte", 3, true},
+		{4, "lte", 3, false},
+		{0, "lt", 1, true},
+		{3, "contains", 3, false},
+	}
+	for _, tt := range tests {
+		if got := matchCount(tt.actual, tt.operator, tt.value); got != tt.want {
+			t.Errorf("matchCount(%d, %q, %d) = %v, want %v", tt.actual, tt.operator, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestEmailDomain(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"jane@acme.com", "acme.com"},
+		{"Jane@ACME.com", "acme.com"},
+		{"\"a@b\"@acme.com", "acme.com"},
+		{"jane@", ""},
+		{"jane", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := emailDomain(tt.email); got != tt.want {
+			t.Errorf("emailDomain(%q) = %q, want %q", tt.email, got, tt.want)
+		}
+	}
+}
+
+// TestMatchesMissingFields covers rules that need no database: field rules
+// against a lead with empty optional fields, and activity and company rules
+// that have nothing to look up.
+func TestMatchesMissingFields(t *testing.T) {
+	lead := &db.Lead{FirstName: "Jane", LastName: "Doe", Status: "new"}
+	rule := func(ruleType, field, operator, value string) db.LeadScoringRule {
+		return db.LeadScoringRule{
+			RuleType: ruleType,
+			Field:    sql.NullString{String: field, Valid: field != ""},
+			Operator: operator,
+			Value:    value,
+		}
+	}
+
+	tests := []struct {
+		name string
+		rule db.LeadScoringRule
+		want bool
+	}{
+		{"equals on a missing phone", rule(ScoringRuleField, "phone", "equals", "555"), false},
+		{"not_equals on a missing phone", rule(ScoringRuleField, "phone", "not_equals", "555"), true},
+		{"is_not_set on a missing phone", rule(ScoringRuleField, "phone", "is_not_set", ""), true},
+		{"is_set on a missing owner", rule(ScoringRuleField, "assigned_to", "is_set", ""), false},
+		{"equals on a missing organization", rule(ScoringRuleField, "organization_id", "equals", "0"), false},
+		{"domain of a missing email", rule(ScoringRuleField, "email_domain", "ends_with", ".com"), false},
+		{"present field ignores case", rule(ScoringRuleField, "status", "equals", "NEW"), true},
+		{"field no longer supported", rule(ScoringRuleField, "salary", "is_not_set", ""), false},
+		{"no converted contact counts zero activities", rule(ScoringRuleActivityCount, "", "equals", "0"), true},
+		{"no converted contact is below any positive count", rule(ScoringRuleActivityCount, "", "gte", "1"), false},
+		{"company domain without an email", rule(ScoringRuleCompanyDomain, "", "", ""), false},
+		{"unknown rule type", rule("page_views", "", "", ""), false},
+	}
+
+	s := &LeadScoringService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.matches(context.Background(), lead, tt.rule)
+			if err != nil {
+				t.Fatalf("matches: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
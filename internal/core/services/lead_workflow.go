@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidLeadWorkflow   = errors.New("invalid lead workflow")
+	ErrUnknownLeadStatus     = errors.New("unknown lead status")
+	ErrIllegalLeadTransition = errors.New("lead status transition not allowed")
+)
+
+// LeadWorkflow is the set of statuses a lead can be in and the transitions
+// allowed between them. Initial statuses are the ones a lead may be created
+// with; leads in closed statuses no longer count as open.
+type LeadWorkflow struct {
+	OrganizationID int32
+	Statuses       []LeadStatusDefinition
+	Transitions    []LeadStatusTransition
+	// Default is set when the organization has no workflow of its own.
+	Default bool
+}
+
+type LeadStatusDefinition struct {
+	Name    string
+	Initial bool
+	Closed  bool
+}
+
+type LeadStatusTransition struct {
+	From string
+	To   string
+}
+
+// DefaultLeadWorkflow is used by organizations that have not configured
+// their own.
+var DefaultLeadWorkflow = LeadWorkflow{
+	Statuses: []LeadStatusDefinition{
+		{Name: "New", Initial: true},
+		{Name: "Contacted"},
+		{Name: "Qualified"},
+		{Name: "Unqualified", Closed: true},
+		{Name: LeadStatusConverted, Closed: true},
+	},
+	Transitions: []LeadStatusTransition{
+		{From: "New", To: "Contacted"},
+		{From: "New", To: "Qualified"},
+		{From: "New", To: "Unqualified"},
+		{From: "Contacted", To: "Qualified"},
+		{From: "Contacted", To: "Unqualified"},
+		{From: "Qualified", To: LeadStatusConverted},
+		{From: "Qualified", To: "Unqualified"},
+		{From: "Unqualified", To: "New"},
+	},
+	Default: true,
+}
+
+func (w *LeadWorkflow) has(status string) bool {
+	for _, s := range w.Statuses {
+		if s.Name == status {
+			return true
+		}
+	}
+	return false
+}
+
+// initialStatus returns the status a lead created without one gets.
+func (w *LeadWorkflow) initialStatus() string {
+	for _, s := range w.Statuses {
+		if s.Initial {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+func (w *LeadWorkflow) isInitial(status string) bool {
+	for _, s := range w.Statuses {
+		if s.Name == status {
+			return s.Initial
+		}
+	}
+	return false
+}
+
+// closedStatuses returns the names of the closed statuses.
+func (w *LeadWorkflow) closedStatuses() []string {
+	closed := []string{}
+	for _, s := range w.Statuses {
+		if s.Closed {
+			closed = append(closed, s.Name)
+		}
+	}
+	return closed
+}
+
+// checkTransition reports whether a lead may move from one status to
+// another. A lead whose current status is not part of the workflow, e.g.
+// one created before the workflow was configured, may move to any status.
+func (w *LeadWorkflow) checkTransition(from, to string) error {
+	if !w.has(to) {
+		return fmt.Errorf("%w: %q", ErrUnknownLeadStatus, to)
+	}
+	if !w.has(from) {
+		return nil
+	}
+	for _, t := range w.Transitions {
+		if t.From == from && t.To == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrIllegalLeadTransition, from, to)
+}
+
+// validate checks a workflow before it is stored.
+func (w *LeadWorkflow) validate() error {
+	var problems []string
+	seen := map[string]bool{}
+	initial := false
+	for _, s := range w.Statuses {
+		name := strings.TrimSpace(s.Name)
+		switch {
+		case name == "" || name != s.Name || len(name) > 50:
+			problems = append(problems, fmt.Sprintf("status %q must be 1-50 characters without surrounding spaces", s.Name))
+		case seen[name]:
+			problems = append(problems, fmt.Sprintf("status %q is listed twice", name))
+		}
+		seen[name] = true
+		initial = initial || s.Initial
+	}
+	if !initial {
+		problems = append(problems, "at least one status must be initial")
+	}
+	if !seen[LeadStatusConverted] {
+		problems = append(problems, fmt.Sprintf("status %q is required for lead conversion", LeadStatusConverted))
+	}
+
+	pairs := map[LeadStatusTransition]bool{}
+	for _, t := range w.Transitions {
+		switch {
+		case !seen[t.From] || !seen[t.To]:
+			problems = append(problems, fmt.Sprintf("transition %s -> %s uses an undeclared status", t.From, t.To))
+		case t.From == t.To:
+			problems = append(problems, fmt.Sprintf("transition %s -> %s does not change status", t.From, t.To))
+		case pairs[t]:
+			problems = append(problems, fmt.Sprintf("transition %s -> %s is listed twice", t.From, t.To))
+		}
+		pairs[t] = true
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidLeadWorkflow, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// GetLeadWorkflow returns the workflow of an organization, or the default
+// one when it has none.
+func (s *LeadService) GetLeadWorkflow(ctx context.Context, organizationID int32) (*LeadWorkflow, error) {
+	return loadLeadWorkflow(ctx, s.queries, organizationID)
+}
+
+// SetLeadWorkflow replaces the workflow of an organization. An empty
+// workflow reverts it to the default. Leads in statuses the new workflow
+// drops keep them and may move to any status.
+func (s *LeadService) SetLeadWorkflow(ctx context.Context, workflow LeadWorkflow) (*LeadWorkflow, error) {
+	if workflow.OrganizationID <= 0 {
+		return nil, fmt.Errorf("%w: organization_id is required", ErrInvalidLeadWorkflow)
+	}
+	reset := len(workflow.Statuses) == 0 && len(workflow.Transitions) == 0
+	if !reset {
+		if err := workflow.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		if err := q.DeleteLeadStatusTransitions(ctx, workflow.OrganizationID); err != nil {
+			return err
+		}
+		if err := q.DeleteLeadStatuses(ctx, workflow.OrganizationID); err != nil {
+			return err
+		}
+		for i, status := range workflow.Statuses {
+			if _, err := q.CreateLeadStatus(ctx, db.CreateLeadStatusParams{
+				OrganizationID: workflow.OrganizationID,
+				Name:           status.Name,
+				Position:       int32(i),
+				IsInitial:      status.Initial,
+				IsClosed:       status.Closed,
+			}); err != nil {
+				return err
+			}
+		}
+		for _, t := range workflow.Transitions {
+			if err := q.CreateLeadStatusTransition(ctx, db.CreateLeadStatusTransitionParams{
+				OrganizationID: workflow.OrganizationID,
+				FromStatus:     t.From,
+				ToStatus:       t.To,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetLeadWorkflow(ctx, workflow.OrganizationID)
+}
+
+// GetLeadStatusHistory lists the statuses a lead entered, oldest first.
+func (s *LeadService) GetLeadStatusHistory(ctx context.Context, id int32) ([]db.LeadStatusHistory, error) {
+	if _, err := s.queries.GetLeadById(ctx, id); err != nil {
+		return nil, ErrLeadNotFound
+	}
+	return s.queries.ListLeadStatusHistory(ctx, id)
+}
+
+func loadLeadWorkflow(ctx context.Context, q *db.Queries, organizationID int32) (*LeadWorkflow, error) {
+	statuses, err := q.ListLeadStatuses(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		workflow := DefaultLeadWorkflow
+		workflow.OrganizationID = organizationID
+		return &workflow, nil
+	}
+
+	transitions, err := q.ListLeadStatusTransitions(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	workflow := &LeadWorkflow{OrganizationID: organizationID}
+	for _, s := range statuses {
+		workflow.Statuses = append(workflow.Statuses, LeadStatusDefinition{Name: s.Name, Initial: s.IsInitial, Closed: s.IsClosed})
+	}
+	for _, t := range transitions {
+		workflow.Transitions = append(workflow.Transitions, LeadStatusTransition{From: t.FromStatus, To: t.ToStatus})
+	}
+	return workflow, nil
+}
+
+// recordLeadStatus appends a status change to the lead's history. from is
+// empty for the status a lead is created with.
+func recordLeadStatus(ctx context.Context, q *db.Queries, leadID int32, from, to string) (db.LeadStatusHistory, error) {
+	return q.CreateLeadStatusHistory(ctx, db.CreateLeadStatusHistoryParams{
+		LeadID:     leadID,
+		FromStatus: sql.NullString{String: from, Valid: from != ""},
+		ToStatus:   to,
+	})
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLeadWorkflowValidate(t *testing.T) {
+	status := func(name string) LeadStatusDefinition { return LeadStatusDefinition{Name: name} }
+	initial := LeadStatusDefinition{Name: "Open", Initial: true}
+	converted := LeadStatusDefinition{Name: LeadStatusConverted, Closed: true}
+
+	tests := []struct {
+		name     string
+		workflow LeadWorkflow
+		problems []string // empty when the workflow is valid
+	}{
+		{
+			name:     "default workflow",
+			workflow: DefaultLeadWorkflow,
+		},
+		{
+			name: "smallest workflow",
+			workflow: LeadWorkflow{
+				Statuses:    []LeadStatusDefinition{initial, converted},
+				Transitions: []LeadStatusTransition{{From: "Open", To: LeadStatusConverted}},
+			},
+		},
+		{
+			name:     "no initial status",
+			workflow: LeadWorkflow{Statuses: []LeadStatusDefinition{status("Open"), converted}},
+			problems: []string{"at least one status must be initial"},
+		},
+		{
+			name:     "no converted status",
+			workflow: LeadWorkflow{Statuses: []LeadStatusDefinition{initial}},
+			problems: []string{`status "Converted" is required for lead conversion`},
+		},
+		{
+			name: "bad status names",
+			workflow: LeadWorkflow{Statuses: []LeadStatusDefinition{
+				initial, converted, status(""), status(" Lost"), status(strings.Repeat("x", 51)), status("Open"),
+			}},
+			problems: []string{
+				`status "" must be 1-50 characters without surrounding spaces`,
+				`status " Lost" must be 1-50 characters without surrounding spaces`,
+				`status "` + strings.Repeat("x", 51) + `" must be 1-50 characters without surrounding spaces`,
+				`status "Open" is listed twice`,
+			},
+		},
+		{
+			name: "bad transitions",
+			workflow: LeadWorkflow{
+				Statuses: []LeadStatusDefinition{initial, converted},
+				Transitions: []LeadStatusTransition{
+					{From: "Open", To: LeadStatusConverted},
+					{From: "Open", To: "Lost"},
+					{From: "Open", To: "Open"},
+					{From: "Open", To: LeadStatusConverted},
+				},
+			},
+			problems: []string{
+				"transition Open -> Lost uses an undeclared status",
+				"transition Open -> Open does not change status",
+				"transition Open -> Converted is listed twice",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.workflow.validate()
+			if len(tt.problems) == 0 {
+				if err != nil {
+					t.Fatalf("validate: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidLeadWorkflow) {
+				t.Fatalf("validate error = %v, want ErrInvalidLeadWorkflow", err)
+			}
+			want := ErrInvalidLeadWorkflow.Error() + ": " + strings.Join(tt.problems, "; ")
+			if err.Error() != want {
+				t.Errorf("validate error =\n  %q\nwant\n  %q", err, want)
+			}
+		})
+	}
+}
+
+func TestLeadWorkflowCheckTransition(t *testing.T) {
+	custom := LeadWorkflow{
+		Statuses: []LeadStatusDefinition{
+			{Name: "Open", Initial: true},
+			{Name: "Working"},
+			{Name: "Lost", Closed: true},
+			{Name: LeadStatusConverted, Closed: true},
+		},
+		Transitions: []LeadStatusTransition{
+			{From: "Open", To: "Working"},
+			{From: "Working", To: LeadStatusConverted},
+			{From: "Working", To: "Lost"},
+			{From: "Lost", To: "Open"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		workflow LeadWorkflow
+		from, to string
+		err      error
+	}{
+		{"default: new to contacted", DefaultLeadWorkflow, "New", "Contacted", nil},
+		{"default: qualified to converted", DefaultLeadWorkflow, "Qualified", LeadStatusConverted, nil},
+		{"default: unqualified reopens", DefaultLeadWorkflow, "Unqualified", "New", nil},
+		{"default: new cannot skip to converted", DefaultLeadWorkflow, "New", LeadStatusConverted, ErrIllegalLeadTransition},
+		{"default: no going back to new", DefaultLeadWorkflow, "Contacted", "New", ErrIllegalLeadTransition},
+		{"default: converted is final", DefaultLeadWorkflow, LeadStatusConverted, "Qualified", ErrIllegalLeadTransition},
+		{"default: converted cannot be lost", DefaultLeadWorkflow, LeadStatusConverted, "Unqualified", ErrIllegalLeadTransition},
+		{"default: staying put is a transition", DefaultLeadWorkflow, "New", "New", ErrIllegalLeadTransition},
+		{"default: unknown target", DefaultLeadWorkflow, "New", "Working", ErrUnknownLeadStatus},
+		{"default: target names are case sensitive", DefaultLeadWorkflow, "New", "contacted", ErrUnknownLeadStatus},
+		{"custom: declared transition", custom, "Working", "Lost", nil},
+		{"custom: closed status reopens", custom, "Lost", "Open", nil},
+		{"custom: closed status only where declared", custom, "Lost", "Working", ErrIllegalLeadTransition},
+		{"custom: default statuses are unknown", custom, "Open", "Qualified", ErrUnknownLeadStatus},
+		{"custom: undeclared current status moves anywhere", custom, "Qualified", LeadStatusConverted, nil},
+		{"custom: undeclared current status still needs a known target", custom, "Qualified", "New", ErrUnknownLeadStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.workflow.checkTransition(tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("checkTransition(%q, %q) = %v, want %v", tt.from, tt.to, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestLeadWorkflowStatuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow LeadWorkflow
+		initial  string
+		initials []string
+		closed   []string
+	}{
+		{
+			name:     "default workflow",
+			workflow: DefaultLeadWorkflow,
+			initial:  "New",
+			initials: []string{"New"},
+			closed:   []string{"Unqualified", LeadStatusConverted},
+		},
+		{
+			name: "first initial status is the default",
+			workflow: LeadWorkflow{Statuses: []LeadStatusDefinition{
+				{Name: "Inbound"},
+				{Name: "Web", Initial: true},
+				{Name: "Referral", Initial: true},
+				{Name: LeadStatusConverted, Closed: true},
+			}},
+			initial:  "Web",
+			initials: []string{"Web", "Referral"},
+			closed:   []string{LeadStatusConverted},
+		},
+		{
+			name:     "empty workflow",
+			workflow: LeadWorkflow{},
+			closed:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.workflow.initialStatus(); got != tt.initial {
+				t.Errorf("initialStatus = %q, want %q", got, tt.initial)
+			}
+			var initials []string
+			for _, s := range append(tt.workflow.Statuses, LeadStatusDefinition{Name: "Unknown"}) {
+				if tt.workflow.isInitial(s.Name) {
+					initials = append(initials, s.Name)
+				}
+			}
+			if !reflect.DeepEqual(initials, tt.initials) {
+				t.Errorf("initial statuses = %q, want %q", initials, tt.initials)
+			}
+			if got := tt.workflow.closedStatuses(); !reflect.DeepEqual(got, tt.closed) {
+				t.Errorf("closedStatuses = %q, want %q", got, tt.closed)
+			}
+		})
+	}
+}
+
+func TestSetLeadWorkflowValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow LeadWorkflow
+		want     string
+	}{
+		{
+			name:     "no organization",
+			workflow: DefaultLeadWorkflow,
+			want:     "organization_id is required",
+		},
+		{
+			name:     "invalid workflow",
+			workflow: LeadWorkflow{OrganizationID: 1, Statuses: []LeadStatusDefinition{{Name: LeadStatusConverted}}},
+			want:     "at least one status must be initial",
+		},
+		{
+			name: "transitions without statuses",
+			workflow: LeadWorkflow{
+				OrganizationID: 1,
+				Transitions:    []LeadStatusTransition{{From: "New", To: "Contacted"}},
+			},
+			want: "transition New -> Contacted uses an undeclared status",
+		},
+	}
+
+	s := NewLeadService(nil, nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SetLeadWorkflow(context.Background(), tt.workflow)
+			if !errors.Is(err, ErrInvalidLeadWorkflow) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("SetLeadWorkflow error = %v, want ErrInvalidLeadWorkflow with %q", err, tt.want)
+			}
+		})
+	}
+}
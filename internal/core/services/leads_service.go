@@ -4,41 +4,75 @@ import (
 	"context"
 	"crm/internal/adapters/database/db"
 	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 )
 
 var (
-	ErrLeadNotFound    = errors.New("lead not found")
-	ErrInvalidLeadData = errors.New("invalid lead data")
-	ErrInvalidEmail    = errors.New("invalid email format")
+	ErrLeadNotFound          = errors.New("lead not found")
+	ErrInvalidLeadData       = errors.New("invalid lead data")
+	ErrInvalidEmail          = errors.New("invalid email format")
+	ErrLeadAlreadyConverted  = errors.New("lead is already converted")
+	ErrInvalidLeadConversion = errors.New("invalid lead conversion")
 )
 
+// LeadStatusConverted is the status a lead is given by ConvertLead.
+const LeadStatusConverted = "Converted"
+
+// LeadConversion describes the records ConvertLead creates. Contact fields
+// left empty are taken from the lead. Company is created when set;
+// otherwise a non-zero CompanyID links an existing company. Opportunity is
+// created when set, with lead_id pointing at the lead.
+type LeadConversion struct {
+	Contact     db.CreateContactParams
+	Company     *db.CreateCompanyParams
+	CompanyID   int32
+	Opportunity *db.CreateOpportunityParams
+}
+
+// ConvertedLead is a converted lead with the records created from it.
+// Company is also set when an existing company was linked.
+type ConvertedLead struct {
+	Lead        db.Lead
+	Contact     db.Contact
+	Company     *db.Company
+	Opportunity *db.Opportunity
+}
+
 type LeadServiceInterface interface {
 	CreateLead(ctx context.Context, lead db.CreateLeadParams) (*db.Lead, error)
 	GetLead(ctx context.Context, id int32) (*db.Lead, error)
 	UpdateLead(ctx context.Context, lead db.UpdateLeadParams) (*db.Lead, error)
 	DeleteLead(ctx context.Context, id int32) error
-	GetAllLeads(ctx context.Context, pageNumber, pageSize int32) ([]db.Lead, error)
+	GetAllLeads(ctx context.Context, opts ListOptions) (*Page[db.Lead], error)
 	GetLeadByEmail(ctx context.Context, email string) (*db.Lead, error)
+	ConvertLead(ctx context.Context, id int32, conversion LeadConversion) (*ConvertedLead, error)
+	GetLeadWorkflow(ctx context.Context, organizationID int32) (*LeadWorkflow, error)
+	SetLeadWorkflow(ctx context.Context, workflow LeadWorkflow) (*LeadWorkflow, error)
+	GetLeadStatusHistory(ctx context.Context, id int32) ([]db.LeadStatusHistory, error)
 }
 
 type LeadService struct {
+	conn    *sql.DB
 	queries *db.Queries
 	kafka   *kafka.Producer
 }
 
-func NewLeadService(queries *db.Queries, producer *kafka.Producer) *LeadService {
-	return &LeadService{queries: queries, kafka: producer}
+func NewLeadService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *LeadService {
+	return &LeadService{conn: conn, queries: queries, kafka: producer}
 }
 
-// CreateLead validates and creates a new lead.
+// CreateLead validates and creates a new lead. Without a status the lead
+// starts in the first initial status of its organization's workflow.
+// Without an assignee the organization's assignment rules pick one.
 func (s *LeadService) CreateLead(ctx context.Context, lead db.CreateLeadParams) (*db.Lead, error) {
 	// Required fields
 	if strings.TrimSpace(lead.FirstName) == "" ||
 		strings.TrimSpace(lead.LastName) == "" ||
-		strings.TrimSpace(lead.Email) == "" ||
-		strings.TrimSpace(lead.Status) == "" {
+		strings.TrimSpace(lead.Email) == "" {
 		return nil, ErrInvalidLeadData
 	}
 
@@ -47,7 +81,53 @@ func (s *LeadService) CreateLead(ctx context.Context, lead db.CreateLeadParams)
 		return nil, ErrInvalidEmail
 	}
 
-	created, err := s.queries.CreateLead(ctx, lead)
+	var created db.Lead
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		workflow, err := loadLeadWorkflow(ctx, q, lead.OrganizationID.Int32)
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.TrimSpace(lead.Status) == "":
+			lead.Status = workflow.initialStatus()
+		case !workflow.has(lead.Status):
+			return fmt.Errorf("%w: %q", ErrUnknownLeadStatus, lead.Status)
+		case !workflow.isInitial(lead.Status):
+			return fmt.Errorf("%w: leads cannot start as %q", ErrIllegalLeadTransition, lead.Status)
+		}
+
+		if lead.CompanyID.Valid {
+			company, err := q.GetCompany(ctx, lead.CompanyID.Int32)
+			if err != nil {
+				return fmt.Errorf("%w: company %d does not exist", ErrInvalidLeadData, lead.CompanyID.Int32)
+			}
+			if lead.OrganizationID.Valid && company.OrganizationID != lead.OrganizationID.Int32 {
+				return fmt.Errorf("%w: company %d belongs to another organization", ErrInvalidLeadData, lead.CompanyID.Int32)
+			}
+		}
+		lead.AssignmentReason = sql.NullString{}
+		if lead.AssignedTo.Valid {
+			lead.AssignmentReason = sql.NullString{String: assignmentReasonManual, Valid: true}
+		}
+
+		created, err = q.CreateLead(ctx, lead)
+		if err != nil {
+			return err
+		}
+		if _, err := recordLeadStatus(ctx, q, created.ID, "", created.Status); err != nil {
+			return err
+		}
+
+		if created.AssignedTo.Valid {
+			return nil
+		}
+		assignment, err := pickAssignee(ctx, q, &created)
+		if err != nil || assignment == nil {
+			return err
+		}
+		created, err = q.AssignLead(ctx, *assignment)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +138,7 @@ func (s *LeadService) CreateLead(ctx context.Context, lead db.CreateLeadParams)
 		"email":  created.Email,
 		"status": created.Status,
 	})
+	publishLeadAssigned(ctx, s.kafka, created, sql.NullInt32{})
 
 	return &created, nil
 }
@@ -71,19 +152,73 @@ func (s *LeadService) GetLead(ctx context.Context, id int32) (*db.Lead, error) {
 	return &lead, nil
 }
 
-// UpdateLead validates and updates an existing lead.
-// NOTE: sqlc UpdateLead sets status and assigned_to; ensure ID and (optionally) status sanity.
+// UpdateLead updates the status and assignee of a lead. An empty status
+// keeps the current one; a new status must be reachable from the current
+// one in the organization's workflow. Leads become Converted only through
+// ConvertLead and stay that way.
 func (s *LeadService) UpdateLead(ctx context.Context, lead db.UpdateLeadParams) (*db.Lead, error) {
 	if lead.ID == 0 {
 		return nil, ErrInvalidLeadData
 	}
-	if strings.TrimSpace(lead.Status) == "" {
-		return nil, ErrInvalidLeadData
-	}
 
-	updated, err := s.queries.UpdateLead(ctx, lead)
+	var (
+		updated  db.Lead
+		change   *db.LeadStatusHistory
+		from     string
+		previous sql.NullInt32
+	)
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		current, err := q.GetLeadForUpdate(ctx, lead.ID)
+		if err != nil {
+			return ErrLeadNotFound
+		}
+		from, previous = current.Status, current.AssignedTo
+		if strings.TrimSpace(lead.Status) == "" {
+			lead.Status = current.Status
+		}
+
+		if lead.Status != current.Status {
+			if current.ConvertedAt.Valid {
+				return ErrLeadAlreadyConverted
+			}
+			if lead.Status == LeadStatusConverted {
+				return fmt.Errorf("%w: use ConvertLead to convert a lead", ErrIllegalLeadTransition)
+			}
+			workflow, err := loadLeadWorkflow(ctx, q, current.OrganizationID.Int32)
+			if err != nil {
+				return err
+			}
+			if err := workflow.checkTransition(current.Status, lead.Status); err != nil {
+				return err
+			}
+		}
+
+		updated, err = q.UpdateLead(ctx, lead)
+		if err != nil {
+			return err
+		}
+		if updated.Status != from {
+			entry, err := recordLeadStatus(ctx, q, updated.ID, from, updated.Status)
+			if err != nil {
+				return err
+			}
+			change = &entry
+		}
+		if updated.AssignedTo != previous {
+			reason := sql.NullString{String: assignmentReasonManual, Valid: updated.AssignedTo.Valid}
+			updated, err = q.AssignLead(ctx, db.AssignLeadParams{
+				ID:               updated.ID,
+				AssignedTo:       updated.AssignedTo,
+				AssignmentReason: reason,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, ErrLeadNotFound
+		return nil, err
 	}
 
 	// Kafka event
@@ -92,6 +227,12 @@ func (s *LeadService) UpdateLead(ctx context.Context, lead db.UpdateLeadParams)
 		"email":  updated.Email,
 		"status": updated.Status,
 	})
+	if change != nil {
+		s.publishStatusChange(ctx, updated, *change)
+	}
+	if updated.AssignedTo != previous {
+		publishLeadAssigned(ctx, s.kafka, updated, previous)
+	}
 
 	return &updated, nil
 }
@@ -110,20 +251,10 @@ func (s *LeadService) DeleteLead(ctx context.Context, id int32) error {
 	return nil
 }
 
-// GetAllLeads returns a paginated list of leads.
-func (s *LeadService) GetAllLeads(ctx context.Context, pageNumber, pageSize int32) ([]db.Lead, error) {
-	if pageNumber == 0 {
-		pageNumber = 1
-	}
-	if pageSize == 0 {
-		pageSize = 10
-	}
-	offset := (pageNumber - 1) * pageSize
-
-	return s.queries.GetAll(ctx, db.GetAllParams{
-		Limit:  pageSize,
-		Offset: offset,
-	})
+// GetAllLeads returns a filtered, sorted page of leads, newest first by
+// default. Sorting by score descending lists the hottest leads first.
+func (s *LeadService) GetAllLeads(ctx context.Context, opts ListOptions) (*Page[db.Lead], error) {
+	return listPage(ctx, filter.Leads, opts, s.queries.FilterLeads, s.queries.CountLeads)
 }
 
 // GetLeadByEmail retrieves a lead by email.
@@ -141,3 +272,195 @@ func (s *LeadService) GetLeadByEmail(ctx context.Context, email string) (*db.Lea
 	}
 	return &lead, nil
 }
+
+// ConvertLead turns a lead into a contact and, optionally, a company and an
+// opportunity, all in one transaction. The lead is marked Converted and
+// linked to the new records; a lead can only be converted once.
+func (s *LeadService) ConvertLead(ctx context.Context, id int32, conversion LeadConversion) (*ConvertedLead, error) {
+	var (
+		result         ConvertedLead
+		statusChange   db.LeadStatusHistory
+		createdCompany bool
+	)
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		lead, err := q.GetLeadForUpdate(ctx, id)
+		if err != nil {
+			return ErrLeadNotFound
+		}
+		if lead.ConvertedAt.Valid {
+			return ErrLeadAlreadyConverted
+		}
+		workflow, err := loadLeadWorkflow(ctx, q, lead.OrganizationID.Int32)
+		if err != nil {
+			return err
+		}
+		if err := workflow.checkTransition(lead.Status, LeadStatusConverted); err != nil {
+			return err
+		}
+
+		// Company
+		switch {
+		case conversion.Company != nil:
+			params := *conversion.Company
+			if params.OrganizationID == 0 {
+				params.OrganizationID = lead.OrganizationID.Int32
+			}
+			if strings.TrimSpace(params.Name) == "" || params.OrganizationID == 0 {
+				return fmt.Errorf("%w: company name and organization are required", ErrInvalidLeadConversion)
+			}
+			if lead.OrganizationID.Valid && params.OrganizationID != lead.OrganizationID.Int32 {
+				return fmt.Errorf("%w: company must belong to the lead's organization", ErrInvalidLeadConversion)
+			}
+			company, err := q.CreateCompany(ctx, params)
+			if err != nil {
+				return err
+			}
+			result.Company = &company
+			createdCompany = true
+		case conversion.CompanyID != 0:
+			company, err := q.GetCompany(ctx, conversion.CompanyID)
+			if err != nil {
+				return fmt.Errorf("%w: company %d not found", ErrInvalidLeadConversion, conversion.CompanyID)
+			}
+			if lead.OrganizationID.Valid && company.OrganizationID != lead.OrganizationID.Int32 {
+				return fmt.Errorf("%w: company %d belongs to another organization", ErrInvalidLeadConversion, conversion.CompanyID)
+			}
+			result.Company = &company
+		}
+
+		// Contact
+		contact := leadContactParams(lead, conversion.Contact, result.Company)
+		if !isValidEmail(contact.Email) {
+			return ErrInvalidEmail
+		}
+		created, err := q.CreateContact(ctx, contact)
+		if err != nil {
+			if isUniqueViolation(err, "contacts_email_key") {
+				return ErrContactExists
+			}
+			return err
+		}
+		result.Contact = created
+
+		// Opportunity
+		var opportunityID sql.NullInt32
+		if conversion.Opportunity != nil {
+			params := *conversion.Opportunity
+			params.LeadID = sql.NullInt32{Int32: lead.ID, Valid: true}
+			if !params.AccountID.Valid && result.Company != nil {
+				params.AccountID = sql.NullInt32{Int32: result.Company.ID, Valid: true}
+			}
+			if !params.OwnerID.Valid {
+				params.OwnerID = lead.AssignedTo
+			}
+			if !params.Name.Valid || strings.TrimSpace(params.Name.String) == "" ||
+				params.Amount <= 0 || params.Probability < 0 || params.Probability > 100 {
+				return fmt.Errorf("%w: opportunity needs a name, positive amount and probability between 0 and 100", ErrInvalidLeadConversion)
+			}
+			if err := prepareOpportunity(ctx, q, &params, lead.OrganizationID); err != nil {
+				return err
+			}
+			opportunity, err := q.CreateOpportunity(ctx, params)
+			if err != nil {
+				return err
+			}
+			if err := recordOpportunityChanges(ctx, q, nil, opportunity); err != nil {
+				return err
+			}
+			result.Opportunity = &opportunity
+			opportunityID = sql.NullInt32{Int32: opportunity.ID, Valid: true}
+		}
+
+		var companyID sql.NullInt32
+		if result.Company != nil {
+			companyID = sql.NullInt32{Int32: result.Company.ID, Valid: true}
+		}
+		result.Lead, err = q.MarkLeadConverted(ctx, db.MarkLeadConvertedParams{
+			ID:            lead.ID,
+			Status:        LeadStatusConverted,
+			ContactID:     sql.NullInt32{Int32: result.Contact.ID, Valid: true},
+			CompanyID:     companyID,
+			OpportunityID: opportunityID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrLeadAlreadyConverted
+		}
+		if err != nil {
+			return err
+		}
+		statusChange, err = recordLeadStatus(ctx, q, lead.ID, lead.Status, result.Lead.Status)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka events
+	_ = s.kafka.Publish(ctx, kafka.TopicContactCreated, "contact_created", map[string]interface{}{
+		"id":    result.Contact.ID,
+		"email": result.Contact.Email,
+	})
+	if createdCompany {
+		_ = s.kafka.Publish(ctx, kafka.TopicCompanyCreated, "company_created", map[string]interface{}{
+			"id":   result.Company.ID,
+			"name": result.Company.Name,
+		})
+	}
+	if result.Opportunity != nil {
+		_ = s.kafka.Publish(ctx, kafka.TopicOpportunityCreated, "opportunity_created", map[string]interface{}{
+			"id":       result.Opportunity.ID,
+			"name":     result.Opportunity.Name.String,
+			"amount":   result.Opportunity.Amount,
+			"currency": result.Opportunity.Currency,
+		})
+	}
+	_ = s.kafka.Publish(ctx, kafka.TopicLeadConverted, "lead_converted", map[string]interface{}{
+		"id":              result.Lead.ID,
+		"email":           result.Lead.Email,
+		"contact_id":      result.Lead.ConvertedContactID.Int32,
+		"company_id":      result.Lead.ConvertedCompanyID.Int32,
+		"opportunity_id":  result.Lead.ConvertedOpportunityID.Int32,
+		"created_company": createdCompany,
+	})
+	s.publishStatusChange(ctx, result.Lead, statusChange)
+
+	return &result, nil
+}
+
+func (s *LeadService) publishStatusChange(ctx context.Context, lead db.Lead, change db.LeadStatusHistory) {
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicLeadStatusChanged, "lead_status_changed", map[string]interface{}{
+		"id":              lead.ID,
+		"organization_id": lead.OrganizationID.Int32,
+		"from":            change.FromStatus.String,
+		"to":              change.ToStatus,
+		"entered_at":      change.EnteredAt,
+	})
+}
+
+// leadContactParams fills the contact fields the caller left empty from the
+// lead and the company it is converted with.
+func leadContactParams(lead db.Lead, contact db.CreateContactParams, company *db.Company) db.CreateContactParams {
+	if contact.ContactType == "" {
+		contact.ContactType = "individual"
+	}
+	if !contact.FirstName.Valid {
+		contact.FirstName = sql.NullString{String: lead.FirstName, Valid: true}
+	}
+	if !contact.LastName.Valid {
+		contact.LastName = sql.NullString{String: lead.LastName, Valid: true}
+	}
+	if contact.Email == "" {
+		contact.Email = lead.Email
+	}
+	if !contact.Phone.Valid {
+		contact.Phone = lead.Phone
+	}
+	if company != nil {
+		contact.CompanyID = sql.NullInt32{Int32: company.ID, Valid: true}
+		if !contact.CompanyName.Valid {
+			contact.CompanyName = sql.NullString{String: company.Name, Valid: true}
+		}
+	}
+	return contact
+}
@@ -0,0 +1,342 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrMeetingNotFound    = errors.New("meeting not found")
+	ErrInvalidMeetingData = errors.New("invalid meeting data")
+	ErrMeetingConflict    = errors.New("meeting overlaps an existing meeting for the same attendee")
+)
+
+var validMeetingStatuses = map[string]bool{
+	"Scheduled": true,
+	"Completed": true,
+	"Cancelled": true,
+}
+
+// MeetingDetails bundles a meeting with its attendees.
+type MeetingDetails struct {
+	Meeting   db.Meeting
+	Attendees []db.MeetingAttendee
+}
+
+type MeetingServiceInterface interface {
+	ScheduleMeeting(ctx context.Context, meeting db.CreateMeetingParams, attendees []db.MeetingAttendee) (*MeetingDetails, error)
+	GetMeeting(ctx context.Context, id int32) (*MeetingDetails, error)
+	UpdateMeeting(ctx context.Context, meeting db.UpdateMeetingParams, attendees []db.MeetingAttendee) (*MeetingDetails, error)
+	DeleteMeeting(ctx context.Context, id int32) error
+	ListMeetings(ctx context.Context, organizerID int32, opts ListOptions) (*Page[MeetingDetails], error)
+}
+
+type MeetingService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	kafka   *kafka.Producer
+}
+
+func NewMeetingService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *MeetingService {
+	return &MeetingService{conn: conn, queries: queries, kafka: producer}
+}
+
+// ScheduleMeeting validates the meeting, rejects it when any attendee is
+// already booked for an overlapping time slot, and stores it with its
+// attendees in a single transaction.
+func (s *MeetingService) ScheduleMeeting(ctx context.Context, meeting db.CreateMeetingParams, attendees []db.MeetingAttendee) (*MeetingDetails, error) {
+	if meeting.Status == "" {
+		meeting.Status = "Scheduled"
+	}
+	if strings.TrimSpace(meeting.Title) == "" || meeting.OrganizerID == 0 || !validMeetingStatuses[meeting.Status] {
+		return nil, ErrInvalidMeetingData
+	}
+	if !meeting.EndTime.After(meeting.StartTime) {
+		return nil, fmt.Errorf("%w: end time must be after start time", ErrInvalidMeetingData)
+	}
+	if err := validateAttendees(attendees); err != nil {
+		return nil, err
+	}
+
+	var details MeetingDetails
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		probe := db.Meeting{StartTime: meeting.StartTime, EndTime: meeting.EndTime, Status: meeting.Status}
+		if err := checkMeetingConflicts(ctx, q, probe, attendees); err != nil {
+			return err
+		}
+
+		created, err := q.CreateMeeting(ctx, meeting)
+		if err != nil {
+			return err
+		}
+		details.Meeting = created
+
+		details.Attendees, err = addMeetingAttendees(ctx, q, created.ID, attendees)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicMeetingScheduled, "meeting_scheduled", meetingEvent(&details))
+
+	return &details, nil
+}
+
+// GetMeeting retrieves a meeting and its attendees by ID.
+func (s *MeetingService) GetMeeting(ctx context.Context, id int32) (*MeetingDetails, error) {
+	meeting, err := s.queries.GetMeeting(ctx, id)
+	if err != nil {
+		return nil, ErrMeetingNotFound
+	}
+
+	attendees, err := s.queries.ListMeetingAttendees(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &MeetingDetails{Meeting: meeting, Attendees: attendees}, nil
+}
+
+// UpdateMeeting updates a meeting. When attendees is nil the existing
+// attendees are kept; otherwise they are replaced.
+func (s *MeetingService) UpdateMeeting(ctx context.Context, meeting db.UpdateMeetingParams, attendees []db.MeetingAttendee) (*MeetingDetails, error) {
+	if meeting.ID == 0 || strings.TrimSpace(meeting.Title) == "" || !validMeetingStatuses[meeting.Status] {
+		return nil, ErrInvalidMeetingData
+	}
+	if !meeting.EndTime.After(meeting.StartTime) {
+		return nil, fmt.Errorf("%w: end time must be after start time", ErrInvalidMeetingData)
+	}
+	if attendees != nil {
+		if err := validateAttendees(attendees); err != nil {
+			return nil, err
+		}
+	}
+
+	var details MeetingDetails
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		if _, err := q.GetMeeting(ctx, meeting.ID); err != nil {
+			return ErrMeetingNotFound
+		}
+
+		checkAgainst := attendees
+		if checkAgainst == nil {
+			existing, err := q.ListMeetingAttendees(ctx, meeting.ID)
+			if err != nil {
+				return err
+			}
+			checkAgainst = existing
+		}
+		probe := db.Meeting{ID: meeting.ID, StartTime: meeting.StartTime, EndTime: meeting.EndTime, Status: meeting.Status}
+		if err := checkMeetingConflicts(ctx, q, probe, checkAgainst); err != nil {
+			return err
+		}
+
+		updated, err := q.UpdateMeeting(ctx, meeting)
+		if err != nil {
+			return err
+		}
+		details.Meeting = updated
+
+		if attendees == nil {
+			details.Attendees = checkAgainst
+			return nil
+		}
+		if err := q.DeleteMeetingAttendees(ctx, meeting.ID); err != nil {
+			return err
+		}
+		details.Attendees, err = addMeetingAttendees(ctx, q, meeting.ID, attendees)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicMeetingUpdated, "meeting_updated", meetingEvent(&details))
+
+	return &details, nil
+}
+
+// DeleteMeeting removes a meeting and, through the cascade, its attendees.
+func (s *MeetingService) DeleteMeeting(ctx context.Context, id int32) error {
+	if err := s.queries.DeleteMeeting(ctx, id); err != nil {
+		return ErrMeetingNotFound
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicMeetingDeleted, "meeting_deleted", map[string]interface{}{
+		"id": id,
+	})
+
+	return nil
+}
+
+// ListMeetings returns a filtered, sorted page of meetings with their
+// attendees, of one organizer unless organizerID is 0.
+func (s *MeetingService) ListMeetings(ctx context.Context, organizerID int32, opts ListOptions) (*Page[MeetingDetails], error) {
+	var conditions []filter.Condition
+	if organizerID != 0 {
+		conditions = append(conditions, filter.Eq("organizer_id", organizerID))
+	}
+	page, err := listPage(ctx, filter.Meetings, opts, s.queries.FilterMeetings, s.queries.CountMeetings, filter.Where(conditions...))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPage(page, func(m db.Meeting) (MeetingDetails, error) {
+		attendees, err := s.queries.ListMeetingAttendees(ctx, m.ID)
+		return MeetingDetails{Meeting: m, Attendees: attendees}, err
+	})
+}
+
+// ----------------- Helpers -----------------
+
+// validateAttendees checks that each attendee is exactly one contact or lead
+// and that nobody is listed twice.
+func validateAttendees(attendees []db.MeetingAttendee) error {
+	seen := make(map[string]bool, len(attendees))
+	for _, a := range attendees {
+		if a.ContactID.Valid == a.LeadID.Valid {
+			return fmt.Errorf("%w: each attendee must be either a contact or a lead", ErrInvalidMeetingData)
+		}
+		key := attendeeLabel(a)
+		if seen[key] {
+			return fmt.Errorf("%w: %s is listed more than once", ErrInvalidMeetingData, key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// checkMeetingConflicts returns ErrMeetingConflict, naming the clashing
+// meetings, when any attendee already has a meeting overlapping probe.
+// Cancelled meetings never conflict. The attendees stay locked until the
+// transaction q runs in ends, so a concurrent booking of the same attendee
+// waits and then sees this one.
+func checkMeetingConflicts(ctx context.Context, q *db.Queries, probe db.Meeting, attendees []db.MeetingAttendee) error {
+	if probe.Status == "Cancelled" {
+		return nil
+	}
+	if err := lockMeetingAttendees(ctx, q, attendees); err != nil {
+		return err
+	}
+
+	var conflicts []string
+	for _, a := range attendees {
+		var (
+			overlapping []db.Meeting
+			err         error
+		)
+		if a.ContactID.Valid {
+			overlapping, err = q.ListOverlappingMeetingsForContact(ctx, db.ListOverlappingMeetingsForContactParams{
+				ContactID:        a.ContactID,
+				ExcludeMeetingID: probe.ID,
+				EndTime:          probe.EndTime,
+				StartTime:        probe.StartTime,
+			})
+		} else {
+			overlapping, err = q.ListOverlappingMeetingsForLead(ctx, db.ListOverlappingMeetingsForLeadParams{
+				LeadID:           a.LeadID,
+				ExcludeMeetingID: probe.ID,
+				EndTime:          probe.EndTime,
+				StartTime:        probe.StartTime,
+			})
+		}
+		if err != nil {
+			return err
+		}
+		for _, m := range overlapping {
+			conflicts = append(conflicts, fmt.Sprintf("%s is booked in meeting %d", attendeeLabel(a), m.ID))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%w: %s", ErrMeetingConflict, strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+// Advisory lock namespaces of meeting attendees, keeping contact and lead
+// IDs apart.
+const (
+	attendeeLockContact int32 = iota + 1
+	attendeeLockLead
+)
+
+// lockMeetingAttendees takes a transaction-scoped advisory lock on each
+// attendee, in ascending order so that two transactions locking the same
+// attendees cannot deadlock.
+func lockMeetingAttendees(ctx context.Context, q *db.Queries, attendees []db.MeetingAttendee) error {
+	locks := make([]db.LockMeetingAttendeeParams, 0, len(attendees))
+	for _, a := range attendees {
+		if a.ContactID.Valid {
+			locks = append(locks, db.LockMeetingAttendeeParams{AttendeeKind: attendeeLockContact, AttendeeID: a.ContactID.Int32})
+		} else {
+			locks = append(locks, db.LockMeetingAttendeeParams{AttendeeKind: attendeeLockLead, AttendeeID: a.LeadID.Int32})
+		}
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].AttendeeKind != locks[j].AttendeeKind {
+			return locks[i].AttendeeKind < locks[j].AttendeeKind
+		}
+		return locks[i].AttendeeID < locks[j].AttendeeID
+	})
+	for _, l := range locks {
+		if err := q.LockMeetingAttendee(ctx, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addMeetingAttendees(ctx context.Context, q *db.Queries, meetingID int32, attendees []db.MeetingAttendee) ([]db.MeetingAttendee, error) {
+	added := make([]db.MeetingAttendee, 0, len(attendees))
+	for _, a := range attendees {
+		row, err := q.AddMeetingAttendee(ctx, db.AddMeetingAttendeeParams{
+			MeetingID: meetingID,
+			ContactID: a.ContactID,
+			LeadID:    a.LeadID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		added = append(added, row)
+	}
+	return added, nil
+}
+
+func attendeeLabel(a db.MeetingAttendee) string {
+	if a.ContactID.Valid {
+		return fmt.Sprintf("contact %d", a.ContactID.Int32)
+	}
+	return fmt.Sprintf("lead %d", a.LeadID.Int32)
+}
+
+func meetingEvent(details *MeetingDetails) map[string]interface{} {
+	var contactIDs, leadIDs []int32
+	for _, a := range details.Attendees {
+		if a.ContactID.Valid {
+			contactIDs = append(contactIDs, a.ContactID.Int32)
+		} else {
+			leadIDs = append(leadIDs, a.LeadID.Int32)
+		}
+	}
+	return map[string]interface{}{
+		"id":           details.Meeting.ID,
+		"title":        details.Meeting.Title,
+		"start_time":   details.Meeting.StartTime,
+		"end_time":     details.Meeting.EndTime,
+		"organizer_id": details.Meeting.OrganizerID,
+		"status":       details.Meeting.Status,
+		"contact_ids":  contactIDs,
+		"lead_ids":     leadIDs,
+	}
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckMeetingConflicts(t *testing.T) {
+	nine := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	booked := db.Meeting{ID: 7, Title: "Demo", StartTime: nine, EndTime: nine.Add(time.Hour), OrganizerID: 1, Status: "Scheduled"}
+	contact := func(id int32) db.MeetingAttendee {
+		return db.MeetingAttendee{ContactID: sql.NullInt32{Int32: id, Valid: true}}
+	}
+	lead := func(id int32) db.MeetingAttendee {
+		return db.MeetingAttendee{LeadID: sql.NullInt32{Int32: id, Valid: true}}
+	}
+
+	tests := []struct {
+		name      string
+		status    string
+		attendees []db.MeetingAttendee
+		want      string // empty when there is no conflict
+		locks     [][2]int64
+	}{
+		{
+			name:      "free attendees",
+			attendees: []db.MeetingAttendee{contact(2), lead(3)},
+			locks:     [][2]int64{{1, 2}, {2, 3}},
+		},
+		{
+			name:      "booked contact",
+			attendees: []db.MeetingAttendee{contact(1), contact(2)},
+			want:      "contact 1 is booked in meeting 7",
+			locks:     [][2]int64{{1, 1}, {1, 2}},
+		},
+		{
+			name:      "booked lead",
+			attendees: []db.MeetingAttendee{lead(5)},
+			want:      "lead 5 is booked in meeting 7",
+			locks:     [][2]int64{{2, 5}},
+		},
+		{
+			name:      "locks are taken in ascending order",
+			attendees: []db.MeetingAttendee{lead(4), contact(9), contact(3)},
+			locks:     [][2]int64{{1, 3}, {1, 9}, {2, 4}},
+		},
+		{
+			name:      "cancelled meetings never conflict",
+			status:    "Cancelled",
+			attendees: []db.MeetingAttendee{contact(1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeMeetingDB{booked: map[string][]db.Meeting{
+				"contact 1": {booked},
+				"lead 5":    {booked},
+			}}
+			conn := sql.OpenDB(fake)
+			defer conn.Close()
+
+			status := tt.status
+			if status == "" {
+				status = "Scheduled"
+			}
+			probe := db.Meeting{StartTime: nine.Add(30 * time.Minute), EndTime: nine.Add(90 * time.Minute), Status: status}
+			err := checkMeetingConflicts(context.Background(), db.New(conn), probe, tt.attendees)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("checkMeetingConflicts: %v", err)
+				}
+			} else {
+				if !errors.Is(err, ErrMeetingConflict) {
+					t.Fatalf("checkMeetingConflicts error = %v, want ErrMeetingConflict", err)
+				}
+				if !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("checkMeetingConflicts error = %q, want it to name %q", err, tt.want)
+				}
+			}
+			if !reflect.DeepEqual(fake.locks, tt.locks) {
+				t.Errorf("locks = %v, want %v", fake.locks, tt.locks)
+			}
+		})
+	}
+}
+
+func TestScheduleMeetingValidation(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	contact := db.MeetingAttendee{ContactID: sql.NullInt32{Int32: 1, Valid: true}}
+
+	tests := []struct {
+		name      string
+		meeting   db.CreateMeetingParams
+		attendees []db.MeetingAttendee
+		want      string
+	}{
+		{
+			name:    "no title",
+			meeting: db.CreateMeetingParams{OrganizerID: 1, StartTime: start, EndTime: start.Add(time.Hour)},
+			want:    "invalid meeting data",
+		},
+		{
+			name:    "ends before it starts",
+			meeting: db.CreateMeetingParams{Title: "Demo", OrganizerID: 1, StartTime: start, EndTime: start},
+			want:    "end time must be after start time",
+		},
+		{
+			name:      "attendee is neither contact nor lead",
+			meeting:   db.CreateMeetingParams{Title: "Demo", OrganizerID: 1, StartTime: start, EndTime: start.Add(time.Hour)},
+			attendees: []db.MeetingAttendee{{}},
+			want:      "each attendee must be either a contact or a lead",
+		},
+		{
+			name:    "attendee is both contact and lead",
+			meeting: db.CreateMeetingParams{Title: "Demo", OrganizerID: 1, StartTime: start, EndTime: start.Add(time.Hour)},
+			attendees: []db.MeetingAttendee{{
+				ContactID: sql.NullInt32{Int32: 1, Valid: true},
+				LeadID:    sql.NullInt32{Int32: 1, Valid: true},
+			}},
+			want: "each attendee must be either a contact or a lead",
+		},
+		{
+			name:      "attendee listed twice",
+			meeting:   db.CreateMeetingParams{Title: "Demo", OrganizerID: 1, StartTime: start, EndTime: start.Add(time.Hour)},
+			attendees: []db.MeetingAttendee{contact, contact},
+			want:      "contact 1 is listed more than once",
+		},
+	}
+
+	s := NewMeetingService(nil, nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ScheduleMeeting(context.Background(), tt.meeting, tt.attendees)
+			if !errors.Is(err, ErrInvalidMeetingData) {
+				t.Fatalf("ScheduleMeeting error = %v, want ErrInvalidMeetingData", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ScheduleMeeting error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// fakeMeetingDB is a database/sql driver answering the attendee lock and
+// overlap queries of checkMeetingConflicts. booked holds the overlapping
+// meetings of each attendee by attendeeLabel.
+type fakeMeetingDB struct {
+	booked map[string][]db.Meeting
+	locks  [][2]int64
+}
+
+func (f *fakeMeetingDB) Connect(context.Context) (driver.Conn, error) { return fakeMeetingConn{f}, nil }
+func (f *fakeMeetingDB) Driver() driver.Driver                        { return nil }
+
+type fakeMeetingConn struct{ db *fakeMeetingDB }
+
+func (c fakeMeetingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeMeetingConn) Close() error              { return nil }
+func (c fakeMeetingConn) Begin() (driver.Tx, error) { return nil, errors.New("begin is not supported") }
+
+func (c fakeMeetingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if !strings.Contains(query, "LockMeetingAttendee") {
+		return nil, fmt.Errorf("unexpected exec %q", query)
+	}
+	c.db.locks = append(c.db.locks, [2]int64{args[0].Value.(int64), args[1].Value.(int64)})
+	return driver.RowsAffected(0), nil
+}
+
+func (c fakeMeetingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	var kind string
+	switch {
+	case strings.Contains(query, "ListOverlappingMeetingsForContact"):
+		kind = "contact"
+	case strings.Contains(query, "ListOverlappingMeetingsForLead"):
+		kind = "lead"
+	default:
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	meetings := c.db.booked[fmt.Sprintf("%s %d", kind, args[0].Value.(int64))]
+	return &fakeMeetingRows{meetings: meetings}, nil
+}
+
+type fakeMeetingRows struct {
+	meetings []db.Meeting
+	next     int
+}
+
+func (r *fakeMeetingRows) Columns() []string {
+	return []string{"id", "title", "description", "start_time", "end_time", "meeting_link", "organizer_id", "status", "created_at", "updated_at"}
+}
+
+func (r *fakeMeetingRows) Close() error { return nil }
+
+func (r *fakeMeetingRows) Next(dest []driver.Value) error {
+	if r.next == len(r.meetings) {
+		return io.EOF
+	}
+	m := r.meetings[r.next]
+	r.next++
+	copy(dest, []driver.Value{int64(m.ID), m.Title, nil, m.StartTime, m.EndTime, nil, int64(m.OrganizerID), m.Status, nil, nil})
+	return nil
+}
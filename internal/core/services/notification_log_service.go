@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrNotificationLogNotFound = errors.New("notification log not found")
+	ErrInvalidLogFilter        = errors.New("invalid notification log filter")
+)
+
+var validNotificationStatuses = map[string]bool{
+	NotificationStatusPending:  true,
+	NotificationStatusSending:  true,
+	NotificationStatusSent:     true,
+	NotificationStatusRetrying: true,
+	NotificationStatusFailed:   true,
+}
+
+// NotificationLogFilter narrows ListLogs on top of its opts.Filter. Empty
+// fields match everything; CreatedFrom is inclusive and CreatedTo exclusive.
+type NotificationLogFilter struct {
+	Status       string
+	Channel      string
+	Recipient    string
+	TemplateName string
+	CreatedFrom  sql.NullTime
+	CreatedTo    sql.NullTime
+}
+
+type NotificationLogServiceInterface interface {
+	GetLog(ctx context.Context, id string) (*db.NotificationLog, error)
+	ListLogs(ctx context.Context, where NotificationLogFilter, opts ListOptions) (*Page[db.NotificationLog], error)
+}
+
+type NotificationLogService struct {
+	queries *db.Queries
+}
+
+func NewNotificationLogService(queries *db.Queries) *NotificationLogService {
+	return &NotificationLogService{queries: queries}
+}
+
+// GetLog retrieves one delivery log entry by notification ID.
+func (s *NotificationLogService) GetLog(ctx context.Context, id string) (*db.NotificationLog, error) {
+	entry, err := s.queries.GetNotificationLog(ctx, id)
+	if err != nil {
+		return nil, ErrNotificationLogNotFound
+	}
+	return &entry, nil
+}
+
+// ListLogs returns a filtered, sorted page of delivery log entries, newest
+// first by default. where holds the filters that predate opts.Filter.
+func (s *NotificationLogService) ListLogs(ctx context.Context, where NotificationLogFilter, opts ListOptions) (*Page[db.NotificationLog], error) {
+	if where.Status != "" && !validNotificationStatuses[where.Status] {
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidLogFilter, where.Status)
+	}
+	if where.CreatedFrom.Valid && where.CreatedTo.Valid && !where.CreatedTo.Time.After(where.CreatedFrom.Time) {
+		return nil, fmt.Errorf("%w: created_to must be after created_from", ErrInvalidLogFilter)
+	}
+
+	var conditions []filter.Condition
+	for _, c := range []filter.Condition{
+		filter.Eq("status", where.Status),
+		filter.Eq("channel", where.Channel),
+		filter.Eq("recipient", where.Recipient),
+		filter.Eq("template_name", where.TemplateName),
+	} {
+		if c.Values[0] != "" {
+			conditions = append(conditions, c)
+		}
+	}
+	if where.CreatedFrom.Valid {
+		conditions = append(conditions, filter.Condition{Field: "created_at", Operator: filter.OpGte, Values: []string{where.CreatedFrom.Time.Format(time.RFC3339Nano)}})
+	}
+	if where.CreatedTo.Valid {
+		conditions = append(conditions, filter.Condition{Field: "created_at", Operator: filter.OpLt, Values: []string{where.CreatedTo.Time.Format(time.RFC3339Nano)}})
+	}
+	return listPage(ctx, filter.NotificationLogs, opts, s.queries.FilterNotificationLogs, s.queries.CountNotificationLogs, filter.Where(conditions...))
+}
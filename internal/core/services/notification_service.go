@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/adapters/notify"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidNotification = errors.New("invalid notification request")
+	ErrUnknownChannel      = errors.New("unknown notification channel")
+	ErrDeliveryFailed      = errors.New("notification delivery failed")
+)
+
+// Notification statuses, as stored in the delivery log. A request is pending
+// until its first attempt, sending while a worker makes it, retrying while
+// attempts remain and failed once they run out.
+const (
+	NotificationStatusPending  = "pending"
+	NotificationStatusSending  = "sending"
+	NotificationStatusSent     = "sent"
+	NotificationStatusRetrying = "retrying"
+	NotificationStatusFailed   = "failed"
+)
+
+const (
+	// notificationRetryBatch is how many due notifications one retry pass
+	// claims.
+	notificationRetryBatch = 50
+	// notificationRetryLease is how long a claimed notification is hidden
+	// from other workers.
+	notificationRetryLease = 5 * time.Minute
+)
+
+// RetryPolicy bounds how often a failed notification is retried. The delay
+// before attempt n+1 is BaseDelay * 2^(n-1), capped at MaxDelay.
+type RetryPolicy struct {
+	MaxAttempts int32
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// next returns when to retry after the given number of failed attempts, or
+// false when no attempts remain.
+func (p RetryPolicy) next(attempts int32, now time.Time) (time.Time, bool) {
+	if attempts >= p.MaxAttempts {
+		return time.Time{}, false
+	}
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempts-1)))
+	if delay > p.MaxDelay || delay <= 0 {
+		delay = p.MaxDelay
+	}
+	return now.Add(delay), true
+}
+
+// NotificationRequest asks for a template to be rendered with Data and
+// delivered to Recipient on Channel. UserID selects the sender's
+// credentials for channels that need them. TemplateVersion pins the
+// template version; Send fills it in so a queued request renders with the
+// text that was current when it was accepted.
+type NotificationRequest struct {
+	ID               string            `json:"id"`
+	NotificationType string            `json:"notification_type,omitempty"`
+	Channel          string            `json:"channel"`
+	UserID           string            `json:"user_id,omitempty"`
+	Recipient        string            `json:"recipient"`
+	TemplateName     string            `json:"template_name"`
+	TemplateVersion  int32             `json:"template_version,omitempty"`
+	Data             map[string]string `json:"data,omitempty"`
+}
+
+// NotificationResult describes what happened to a request.
+type NotificationResult struct {
+	ID        string
+	Status    string
+	Message   string
+	Timestamp time.Time
+}
+
+// MessageRenderer renders a template version (0 for the current one) for a
+// channel. It is implemented by TemplateService.
+type MessageRenderer interface {
+	Render(ctx context.Context, templateName, channel string, version int32, data map[string]string) (*RenderedTemplate, error)
+}
+
+type NotificationServiceInterface interface {
+	Send(ctx context.Context, req NotificationRequest, sync bool) (*NotificationResult, error)
+	HandleQueued(ctx context.Context, value []byte) error
+	RetryDue(ctx context.Context) (int, error)
+}
+
+type NotificationService struct {
+	queries  *db.Queries
+	senders  map[string]notify.Sender
+	renderer MessageRenderer
+	retry    RetryPolicy
+	kafka    *kafka.Producer
+}
+
+// NewNotificationService builds a service that renders with renderer,
+// delivers on the channels in senders, keyed by notify.Channel* name, and
+// records every attempt in the notification log.
+func NewNotificationService(queries *db.Queries, producer *kafka.Producer, renderer MessageRenderer, senders map[string]notify.Sender, retry RetryPolicy) *NotificationService {
+	return &NotificationService{queries: queries, senders: senders, renderer: renderer, retry: retry, kafka: producer}
+}
+
+// Send validates the request, records it in the delivery log and either
+// delivers it inline (sync) or enqueues it on Kafka for the notification
+// worker. The template is rendered up front either way so bad data is
+// reported to the caller.
+func (s *NotificationService) Send(ctx context.Context, req NotificationRequest, sync bool) (*NotificationResult, error) {
+	if err := s.validate(req); err != nil {
+		return nil, err
+	}
+	req.ID = newNotificationID()
+
+	rendered, err := s.renderer.Render(ctx, req.TemplateName, req.Channel, req.TemplateVersion, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	req.TemplateVersion = rendered.Version
+
+	data, err := json.Marshal(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.queries.CreateNotificationLog(ctx, db.CreateNotificationLogParams{
+		ID:               req.ID,
+		NotificationType: req.NotificationType,
+		Channel:          req.Channel,
+		UserID:           req.UserID,
+		Recipient:        req.Recipient,
+		TemplateName:     req.TemplateName,
+		TemplateVersion:  req.TemplateVersion,
+		Data:             data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record notification: %w", err)
+	}
+
+	if sync {
+		entry = s.attempt(ctx, entry, req, rendered)
+		return notificationResult(entry), nil
+	}
+
+	if err := s.kafka.Publish(ctx, kafka.TopicNotificationRequested, req.ID, req); err != nil {
+		// Leave it to the retry worker rather than losing the request.
+		entry = s.recordFailure(ctx, entry, req, fmt.Errorf("enqueue notification: %w", err))
+		return notificationResult(entry), nil
+	}
+	return &NotificationResult{ID: req.ID, Status: NotificationStatusPending, Message: "notification queued", Timestamp: entry.CreatedAt}, nil
+}
+
+// HandleQueued delivers a request read from the notification topic. The log
+// entry is claimed first, so requests that were already attempted or are
+// being attempted, e.g. redelivered messages, are skipped.
+func (s *NotificationService) HandleQueued(ctx context.Context, value []byte) error {
+	var req NotificationRequest
+	if err := json.Unmarshal(value, &req); err != nil {
+		return fmt.Errorf("decode notification request: %w", err)
+	}
+
+	entry, err := s.queries.ClaimPendingNotificationLog(ctx, db.ClaimPendingNotificationLogParams{
+		LeaseUntil: time.Now().Add(notificationRetryLease),
+		ID:         req.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("claim notification %s: %w", req.ID, err)
+	}
+
+	s.attempt(ctx, entry, req, nil)
+	return nil
+}
+
+// RetryDue re-attempts every notification whose retry time has passed and
+// returns how many were attempted.
+func (s *NotificationService) RetryDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := s.queries.ClaimDueNotificationLogs(ctx, db.ClaimDueNotificationLogsParams{
+		LeaseUntil: now.Add(notificationRetryLease),
+		AsOf:       now,
+		LimitCount: notificationRetryBatch,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range due {
+		req := NotificationRequest{
+			ID:               entry.ID,
+			NotificationType: entry.NotificationType,
+			Channel:          entry.Channel,
+			UserID:           entry.UserID,
+			Recipient:        entry.Recipient,
+			TemplateName:     entry.TemplateName,
+			TemplateVersion:  entry.TemplateVersion,
+		}
+		if err := json.Unmarshal(entry.Data, &req.Data); err != nil {
+			s.recordFailure(ctx, entry, req, fmt.Errorf("decode notification data: %w", err))
+			continue
+		}
+		s.attempt(ctx, entry, req, nil)
+	}
+	return len(due), nil
+}
+
+func (s *NotificationService) validate(req NotificationRequest) error {
+	if _, ok := s.senders[req.Channel]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownChannel, req.Channel)
+	}
+	if strings.TrimSpace(req.Recipient) == "" || strings.TrimSpace(req.TemplateName) == "" {
+		return ErrInvalidNotification
+	}
+	if req.Channel == notify.ChannelEmail && !isValidEmail(req.Recipient) {
+		return fmt.Errorf("%w: %q is not an email address", ErrInvalidNotification, req.Recipient)
+	}
+	return nil
+}
+
+// attempt delivers req once, rendering it first when rendered is nil, and
+// records the outcome. It returns the updated log entry.
+func (s *NotificationService) attempt(ctx context.Context, entry db.NotificationLog, req NotificationRequest, rendered *RenderedTemplate) db.NotificationLog {
+	if rendered == nil {
+		var err error
+		rendered, err = s.renderer.Render(ctx, req.TemplateName, req.Channel, req.TemplateVersion, req.Data)
+		if err != nil {
+			return s.recordFailure(ctx, entry, req, err)
+		}
+	}
+
+	sender, ok := s.senders[req.Channel]
+	if !ok {
+		return s.recordFailure(ctx, entry, req, fmt.Errorf("%w: %q", ErrUnknownChannel, req.Channel))
+	}
+
+	msg := notify.Message{
+		ID:        req.ID,
+		Channel:   req.Channel,
+		UserID:    req.UserID,
+		Recipient: req.Recipient,
+		Subject:   rendered.Subject,
+		Text:      rendered.Text,
+		HTML:      rendered.HTML,
+	}
+	if err := sender.Send(ctx, msg); err != nil {
+		return s.recordFailure(ctx, entry, req, fmt.Errorf("%w: %v", ErrDeliveryFailed, err))
+	}
+
+	updated, err := s.queries.MarkNotificationSent(ctx, req.ID)
+	if err != nil {
+		// The message went out; only the bookkeeping failed.
+		entry.Status = NotificationStatusSent
+		entry.Attempts++
+		updated = entry
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicNotificationSent, "notification_sent", notificationEvent(req, updated))
+	return updated
+}
+
+// recordFailure counts a failed attempt and schedules the next one, or marks
+// the notification failed when the retry policy is exhausted.
+func (s *NotificationService) recordFailure(ctx context.Context, entry db.NotificationLog, req NotificationRequest, cause error) db.NotificationLog {
+	params := db.MarkNotificationFailedParams{
+		ID:           entry.ID,
+		Status:       NotificationStatusFailed,
+		ErrorMessage: cause.Error(),
+	}
+	if next, ok := s.retry.next(entry.Attempts+1, time.Now()); ok {
+		params.Status = NotificationStatusRetrying
+		params.NextAttemptAt = sql.NullTime{Time: next, Valid: true}
+	}
+
+	updated, err := s.queries.MarkNotificationFailed(ctx, params)
+	if err != nil {
+		entry.Status = params.Status
+		entry.ErrorMessage = cause.Error()
+		entry.Attempts++
+		updated = entry
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicNotificationFailed, "notification_failed", notificationEvent(req, updated))
+	return updated
+}
+
+func notificationResult(entry db.NotificationLog) *NotificationResult {
+	result := &NotificationResult{ID: entry.ID, Status: entry.Status, Timestamp: entry.UpdatedAt}
+	switch entry.Status {
+	case NotificationStatusSent:
+		result.Message = "notification sent"
+	case NotificationStatusSending:
+		result.Message = "notification is being sent"
+	case NotificationStatusRetrying:
+		result.Message = "delivery failed, will retry: " + entry.ErrorMessage
+	default:
+		result.Message = entry.ErrorMessage
+	}
+	return result
+}
+
+func newNotificationID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+func notificationEvent(req NotificationRequest, entry db.NotificationLog) map[string]interface{} {
+	event := map[string]interface{}{
+		"id":                req.ID,
+		"notification_type": req.NotificationType,
+		"channel":           req.Channel,
+		"user_id":           req.UserID,
+		"recipient":         req.Recipient,
+		"template_name":     req.TemplateName,
+		"version":           req.TemplateVersion,
+		"status":            entry.Status,
+		"attempts":          entry.Attempts,
+	}
+	if entry.ErrorMessage != "" {
+		event["error"] = entry.ErrorMessage
+	}
+	return event
+}
@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrOpportunityClosed  = errors.New("opportunity is closed")
+	ErrInvalidLossReason  = errors.New("invalid loss reason")
+	ErrLossReasonNotFound = errors.New("loss reason not found")
+	ErrLossReasonExists   = errors.New("loss reason with this name already exists")
+)
+
+// DefaultLossReasons are offered to organizations that have not configured
+// loss reasons of their own.
+var DefaultLossReasons = []string{
+	"Price",
+	"Lost to competitor",
+	"No budget",
+	"No decision",
+	"Timing",
+	"Missing features",
+	"Other",
+}
+
+// CloseOpportunityRequest closes an opportunity as won or lost.
+type CloseOpportunityRequest struct {
+	ID int32
+	// Stage is the won or lost stage to move to; empty picks the first one
+	// of the opportunity's pipeline.
+	Stage string
+	// CloseDate is the actual close date; zero means today.
+	CloseDate time.Time
+	// Amount is the final amount; without it the current amount is kept.
+	// Opportunities with line items keep the sum of their line items.
+	Amount sql.NullFloat64
+	// LossReason is required when closing as lost and must be one of the
+	// organization's active loss reasons. It is ignored for won deals.
+	LossReason string
+	Competitor string
+}
+
+// CloseOpportunityWon moves an opportunity to its pipeline's won stage and
+// locks its amount.
+func (s *OpportunityService) CloseOpportunityWon(ctx context.Context, req CloseOpportunityRequest) (*db.Opportunity, error) {
+	return s.closeOpportunity(ctx, req, true)
+}
+
+// CloseOpportunityLost moves an opportunity to its pipeline's lost stage
+// with a loss reason and locks its amount.
+func (s *OpportunityService) CloseOpportunityLost(ctx context.Context, req CloseOpportunityRequest) (*db.Opportunity, error) {
+	return s.closeOpportunity(ctx, req, false)
+}
+
+// ListLossReasons returns the loss reasons of an organization in display
+// order. An organization without any gets DefaultLossReasons, which have no
+// ID.
+func (s *OpportunityService) ListLossReasons(ctx context.Context, organizationID int32) ([]db.LossReason, error) {
+	return lossReasons(ctx, s.queries, organizationID)
+}
+
+// CreateLossReason adds a loss reason to an organization's list. Once an
+// organization has loss reasons of its own DefaultLossReasons no longer
+// apply to it.
+func (s *OpportunityService) CreateLossReason(ctx context.Context, reason db.CreateLossReasonParams) (*db.LossReason, error) {
+	if reason.OrganizationID <= 0 {
+		return nil, fmt.Errorf("%w: organization_id is required", ErrInvalidLossReason)
+	}
+	reason.Name = strings.TrimSpace(reason.Name)
+	if err := validateLossReasonName(reason.Name); err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateLossReason(ctx, reason)
+	if err != nil {
+		if isUniqueViolation(err, "loss_reasons_org_name_key") {
+			return nil, ErrLossReasonExists
+		}
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateLossReason renames, reorders or (de)activates a loss reason.
+// Opportunities already lost with it keep the name they were closed with.
+func (s *OpportunityService) UpdateLossReason(ctx context.Context, reason db.UpdateLossReasonParams) (*db.LossReason, error) {
+	if reason.ID == 0 {
+		return nil, fmt.Errorf("%w: id is required", ErrInvalidLossReason)
+	}
+	reason.Name = strings.TrimSpace(reason.Name)
+	if err := validateLossReasonName(reason.Name); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.UpdateLossReason(ctx, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrLossReasonNotFound
+		case isUniqueViolation(err, "loss_reasons_org_name_key"):
+			return nil, ErrLossReasonExists
+		}
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteLossReason removes a loss reason from its organization's list.
+func (s *OpportunityService) DeleteLossReason(ctx context.Context, id int32) error {
+	deleted, err := s.queries.DeleteLossReason(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrLossReasonNotFound
+	}
+	return nil
+}
+
+// ----------------- Helpers -----------------
+
+// closedStageError rejects moving an opportunity into a won or lost stage
+// other than by closing it, which records the close date, loss reason and
+// final amount.
+func closedStageError(stage PipelineStage) error {
+	closeWith := "CloseOpportunityWon"
+	if stage.Lost {
+		closeWith = "CloseOpportunityLost"
+	}
+	return fmt.Errorf("%w: %q closes the opportunity, use %s", ErrInvalidOpportunityData, stage.Name, closeWith)
+}
+
+func (s *OpportunityService) closeOpportunity(ctx context.Context, req CloseOpportunityRequest, won bool) (*db.Opportunity, error) {
+	if req.ID == 0 {
+		return nil, ErrInvalidOpportunityData
+	}
+	if req.Amount.Valid && (req.Amount.Float64 < 0 || (won && req.Amount.Float64 == 0)) {
+		return nil, fmt.Errorf("%w: final amount must be positive", ErrInvalidOpportunityData)
+	}
+	if !won && strings.TrimSpace(req.LossReason) == "" {
+		return nil, fmt.Errorf("%w: a loss reason is required", ErrInvalidLossReason)
+	}
+
+	var closed db.Opportunity
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		current, err := q.GetOpportunityForUpdate(ctx, req.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrOpportunityNotFound
+			}
+			return err
+		}
+		if current.AmountLocked {
+			return fmt.Errorf("%w: closed as %s", ErrOpportunityClosed, current.Stage.String)
+		}
+
+		pipeline, err := loadPipeline(ctx, q, current.PipelineID)
+		if err != nil {
+			return err
+		}
+		stage, err := closingStage(pipeline, req.Stage, won)
+		if err != nil {
+			return err
+		}
+
+		amount := current.Amount
+		if req.Amount.Valid {
+			items, err := q.ListOpportunityLineItems(ctx, current.ID)
+			if err != nil {
+				return err
+			}
+			if len(items) > 0 && roundCents(req.Amount.Float64) != current.Amount {
+				return fmt.Errorf("%w: the amount of an opportunity with line items is their sum", ErrInvalidOpportunityData)
+			}
+			amount = roundCents(req.Amount.Float64)
+		}
+
+		var lossReason sql.NullString
+		if !won {
+			name, err := matchLossReason(ctx, q, opportunityOrganization(ctx, q, current, pipeline), req.LossReason)
+			if err != nil {
+				return err
+			}
+			lossReason = sql.NullString{String: name, Valid: true}
+		}
+
+		competitor := strings.TrimSpace(req.Competitor)
+		closeDate := req.CloseDate
+		if closeDate.IsZero() {
+			closeDate = time.Now().UTC().Truncate(24 * time.Hour)
+		}
+
+		closed, err = q.CloseOpportunity(ctx, db.CloseOpportunityParams{
+			ID:          current.ID,
+			Stage:       sql.NullString{String: stage.Name, Valid: true},
+			Amount:      amount,
+			Probability: stage.Probability,
+			CloseDate:   sql.NullTime{Time: closeDate, Valid: true},
+			LossReason:  lossReason,
+			Competitor:  sql.NullString{String: competitor, Valid: competitor != ""},
+		})
+		if err != nil {
+			return err
+		}
+		return recordOpportunityChanges(ctx, q, &current, closed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topic, event := kafka.TopicOpportunityLost, "opportunity_lost"
+	if won {
+		topic, event = kafka.TopicOpportunityWon, "opportunity_won"
+	}
+	// Kafka event
+	_ = s.kafka.Publish(ctx, topic, event, map[string]interface{}{
+		"id":          closed.ID,
+		"name":        closed.Name.String,
+		"amount":      closed.Amount,
+		"currency":    closed.Currency,
+		"stage":       closed.Stage.String,
+		"close_date":  closed.CloseDate.Time.Format("2006-01-02"),
+		"loss_reason": closed.LossReason.String,
+		"competitor":  closed.Competitor.String,
+		"owner_id":    closed.OwnerID.Int32,
+	})
+
+	return &closed, nil
+}
+
+// closingStage picks the won or lost stage an opportunity is closed in.
+func closingStage(pipeline *Pipeline, name string, won bool) (PipelineStage, error) {
+	kind := "lost"
+	if won {
+		kind = "won"
+	}
+	for _, stage := range pipeline.Stages {
+		if (won && !stage.Won) || (!won && !stage.Lost) {
+			continue
+		}
+		if name == "" || stage.Name == name {
+			return stage, nil
+		}
+	}
+	if name != "" {
+		return PipelineStage{}, fmt.Errorf("%w: %q is not a %s stage of pipeline %s", ErrUnknownStage, name, kind, pipeline.Name)
+	}
+	return PipelineStage{}, fmt.Errorf("%w: pipeline %s has no %s stage", ErrUnknownStage, pipeline.Name, kind)
+}
+
+// opportunityOrganization returns the organization an opportunity belongs
+// to: that of its pipeline, else that of its account, else 0.
+func opportunityOrganization(ctx context.Context, q *db.Queries, opportunity db.Opportunity, pipeline *Pipeline) int32 {
+	if !pipeline.BuiltIn {
+		return pipeline.OrganizationID
+	}
+	if opportunity.AccountID.Valid {
+		if company, err := q.GetCompany(ctx, opportunity.AccountID.Int32); err == nil {
+			return company.OrganizationID
+		}
+	}
+	return 0
+}
+
+func lossReasons(ctx context.Context, q *db.Queries, organizationID int32) ([]db.LossReason, error) {
+	reasons, err := q.ListLossReasons(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(reasons) > 0 {
+		return reasons, nil
+	}
+	for i, name := range DefaultLossReasons {
+		reasons = append(reasons, db.LossReason{
+			OrganizationID: organizationID,
+			Name:           name,
+			Active:         true,
+			Position:       int32(i),
+		})
+	}
+	return reasons, nil
+}
+
+// matchLossReason finds the active loss reason of an organization named
+// name, ignoring case, and returns its name as configured.
+func matchLossReason(ctx context.Context, q *db.Queries, organizationID int32, name string) (string, error) {
+	reasons, err := lossReasons(ctx, q, organizationID)
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, reason := range reasons {
+		if !reason.Active {
+			continue
+		}
+		if strings.EqualFold(reason.Name, strings.TrimSpace(name)) {
+			return reason.Name, nil
+		}
+		names = append(names, reason.Name)
+	}
+	return "", fmt.Errorf("%w: %q is not one of %s", ErrInvalidLossReason, name, strings.Join(names, ", "))
+}
+
+func validateLossReasonName(name string) error {
+	if name == "" || len(name) > 100 {
+		return fmt.Errorf("%w: name must be 1-100 characters", ErrInvalidLossReason)
+	}
+	return nil
+}
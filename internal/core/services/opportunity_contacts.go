@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidContactRole         = errors.New("invalid contact role")
+	ErrOpportunityContactNotFound = errors.New("contact has no such role on the opportunity")
+)
+
+// Roles a contact can have on an opportunity.
+const (
+	ContactRoleDecisionMaker = "decision_maker"
+	ContactRoleChampion      = "champion"
+	ContactRoleInfluencer    = "influencer"
+	ContactRoleBlocker       = "blocker"
+)
+
+var contactRoles = []string{ContactRoleDecisionMaker, ContactRoleChampion, ContactRoleInfluencer, ContactRoleBlocker}
+
+// ContactOpportunity is an opportunity a contact is involved in, with the
+// contact's roles on it.
+type ContactOpportunity struct {
+	Opportunity db.Opportunity
+	Roles       []string
+}
+
+// AddOpportunityContact gives a contact a role on an opportunity. Adding a
+// role the contact already has is not an error.
+func (s *OpportunityService) AddOpportunityContact(ctx context.Context, opportunityID, contactID int32, role string) (*db.OpportunityContact, error) {
+	role, err := normalizeContactRole(role)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.queries.GetOpportunity(ctx, opportunityID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOpportunityNotFound
+		}
+		return nil, err
+	}
+	if _, err := s.queries.GetContact(ctx, contactID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrContactNotFound
+		}
+		return nil, err
+	}
+
+	added, err := s.queries.AddOpportunityContact(ctx, db.AddOpportunityContactParams{
+		OpportunityID: opportunityID,
+		ContactID:     contactID,
+		Role:          role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &added, nil
+}
+
+// RemoveOpportunityContact takes a role on an opportunity away from a
+// contact, or all of the contact's roles on it when role is empty.
+func (s *OpportunityService) RemoveOpportunityContact(ctx context.Context, opportunityID, contactID int32, role string) error {
+	if role != "" {
+		var err error
+		if role, err = normalizeContactRole(role); err != nil {
+			return err
+		}
+	}
+	removed, err := s.queries.RemoveOpportunityContact(ctx, db.RemoveOpportunityContactParams{
+		OpportunityID: opportunityID,
+		ContactID:     contactID,
+		Role:          role,
+	})
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return ErrOpportunityContactNotFound
+	}
+	return nil
+}
+
+// ListOpportunityContacts returns the contacts of an opportunity with their
+// roles, one entry per role.
+func (s *OpportunityService) ListOpportunityContacts(ctx context.Context, opportunityID int32) ([]db.OpportunityContact, error) {
+	if _, err := s.queries.GetOpportunity(ctx, opportunityID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOpportunityNotFound
+		}
+		return nil, err
+	}
+	return s.queries.ListOpportunityContacts(ctx, opportunityID)
+}
+
+// ----------------- Helpers -----------------
+
+func normalizeContactRole(role string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	for _, r := range contactRoles {
+		if role == r {
+			return role, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q is not one of %s", ErrInvalidContactRole, role, strings.Join(contactRoles, ", "))
+}
+
+// contactOpportunities lists the opportunities a contact has roles on, most
+// recent first.
+func contactOpportunities(ctx context.Context, q *db.Queries, contactID int32) ([]ContactOpportunity, error) {
+	opportunities, err := q.ListContactOpportunities(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := q.ListContactOpportunityRoles(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+	byOpportunity := map[int32][]string{}
+	for _, r := range roles {
+		byOpportunity[r.OpportunityID] = append(byOpportunity[r.OpportunityID], r.Role)
+	}
+
+	result := make([]ContactOpportunity, 0, len(opportunities))
+	for _, o := range opportunities {
+		result = append(result, ContactOpportunity{Opportunity: o, Roles: byOpportunity[o.ID]})
+	}
+	return result, nil
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// Fields tracked in opportunity_history.
+const (
+	historyFieldStage       = "stage"
+	historyFieldAmount      = "amount"
+	historyFieldProbability = "probability"
+	historyFieldCloseDate   = "close_date"
+)
+
+// OpportunityMetricsFilter selects the opportunities metrics are computed
+// over.
+type OpportunityMetricsFilter struct {
+	// PipelineID 0 selects opportunities on the built-in pipeline.
+	PipelineID int32
+	// OwnerID 0 selects all owners.
+	OwnerID      int32
+	CreatedSince sql.NullTime
+}
+
+// OpportunityMetrics summarizes how opportunities of a pipeline move through
+// its stages.
+type OpportunityMetrics struct {
+	Pipeline *Pipeline
+	Stages   []StageMetrics
+	Owners   []OwnerWinRate
+}
+
+type StageMetrics struct {
+	Stage string
+	// Entered counts the opportunities that were in the stage at some point.
+	Entered int
+	// AverageDays is the average time spent in the stage, over the stays
+	// that ended; opportunities still in the stage are not counted.
+	AverageDays float64
+	// ConversionRate is the share of opportunities that entered the stage
+	// and later reached a later stage that is not lost.
+	ConversionRate float64
+}
+
+// OwnerWinRate counts the closed opportunities of an owner. OwnerID 0
+// stands for opportunities without an owner.
+type OwnerWinRate struct {
+	OwnerID int32
+	Won     int
+	Lost    int
+	WinRate float64
+}
+
+// GetOpportunityHistory lists the changes to an opportunity's stage, amount,
+// probability and close date, oldest first.
+func (s *OpportunityService) GetOpportunityHistory(ctx context.Context, id int32) ([]db.OpportunityHistory, error) {
+	if _, err := s.queries.GetOpportunity(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOpportunityNotFound
+		}
+		return nil, err
+	}
+	return s.queries.ListOpportunityHistory(ctx, id)
+}
+
+// GetOpportunityMetrics computes stage durations, stage conversion rates and
+// win rates per owner from the stage history of a pipeline's opportunities.
+func (s *OpportunityService) GetOpportunityMetrics(ctx context.Context, filter OpportunityMetricsFilter) (*OpportunityMetrics, error) {
+	pipelineID := sql.NullInt32{Int32: filter.PipelineID, Valid: filter.PipelineID != 0}
+	pipeline, err := loadPipeline(ctx, s.queries, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListStageHistory(ctx, db.ListStageHistoryParams{
+		PipelineID:   pipelineID,
+		OwnerID:      filter.OwnerID,
+		CreatedSince: filter.CreatedSince,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	position := map[string]int{}
+	for i, stage := range pipeline.Stages {
+		position[stage.Name] = i
+	}
+	entered := map[string]int{}
+	converted := map[string]int{}
+	stayDays := map[string]float64{}
+	stays := map[string]int{}
+	owners := map[int32]*OwnerWinRate{}
+
+	// Rows come grouped by opportunity, oldest change first.
+	for start := 0; start < len(rows); {
+		end := start
+		for end < len(rows) && rows[end].OpportunityID == rows[start].OpportunityID {
+			end++
+		}
+		visits := rows[start:end]
+		start = end
+
+		seen := map[string]bool{}
+		reachedLater := map[string]bool{}
+		for i, visit := range visits {
+			stage := visit.Stage.String
+			if _, ok := position[stage]; !ok {
+				continue
+			}
+			if i+1 < len(visits) {
+				stayDays[stage] += visits[i+1].ChangedAt.Sub(visit.ChangedAt).Hours() / 24
+				stays[stage]++
+			}
+			seen[stage] = true
+			for _, later := range visits[i+1:] {
+				pos, ok := position[later.Stage.String]
+				if ok && pos > position[stage] && !pipeline.Stages[pos].Lost {
+					reachedLater[stage] = true
+					break
+				}
+			}
+		}
+		for stage := range seen {
+			entered[stage]++
+			if reachedLater[stage] {
+				converted[stage]++
+			}
+		}
+
+		current, ok := pipeline.stage(visits[0].CurrentStage.String)
+		if !ok || !current.Closed() {
+			continue
+		}
+		ownerID := visits[0].OwnerID.Int32
+		owner := owners[ownerID]
+		if owner == nil {
+			owner = &OwnerWinRate{OwnerID: ownerID}
+			owners[ownerID] = owner
+		}
+		if current.Won {
+			owner.Won++
+		} else {
+			owner.Lost++
+		}
+	}
+
+	metrics := &OpportunityMetrics{Pipeline: pipeline}
+	for _, stage := range pipeline.Stages {
+		m := StageMetrics{Stage: stage.Name, Entered: entered[stage.Name]}
+		if stays[stage.Name] > 0 {
+			m.AverageDays = stayDays[stage.Name] / float64(stays[stage.Name])
+		}
+		if m.Entered > 0 && !stage.Closed() {
+			m.ConversionRate = float64(converted[stage.Name]) / float64(m.Entered)
+		}
+		metrics.Stages = append(metrics.Stages, m)
+	}
+	for _, owner := range owners {
+		owner.WinRate = float64(owner.Won) / float64(owner.Won+owner.Lost)
+		metrics.Owners = append(metrics.Owners, *owner)
+	}
+	sort.Slice(metrics.Owners, func(i, j int) bool {
+		return metrics.Owners[i].OwnerID < metrics.Owners[j].OwnerID
+	})
+	return metrics, nil
+}
+
+// ----------------- Helpers -----------------
+
+// recordOpportunityChanges appends the tracked fields that differ between
+// before and after to the opportunity's history. before is nil for a new
+// opportunity, whose initial values are all recorded.
+func recordOpportunityChanges(ctx context.Context, q *db.Queries, before *db.Opportunity, after db.Opportunity) error {
+	var old db.Opportunity
+	if before != nil {
+		old = *before
+	}
+	changes := []struct {
+		field    string
+		old, new sql.NullString
+	}{
+		{historyFieldStage, old.Stage, after.Stage},
+		{historyFieldAmount, historyNumber(old.Amount), historyNumber(after.Amount)},
+		{historyFieldProbability, historyNumber(old.Probability), historyNumber(after.Probability)},
+		{historyFieldCloseDate, historyDate(old.CloseDate), historyDate(after.CloseDate)},
+	}
+	for _, c := range changes {
+		if before == nil {
+			c.old = sql.NullString{}
+		} else if c.old == c.new {
+			continue
+		}
+		if err := q.CreateOpportunityHistory(ctx, db.CreateOpportunityHistoryParams{
+			OpportunityID: after.ID,
+			Field:         c.field,
+			OldValue:      c.old,
+			NewValue:      c.new,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func historyNumber(v float64) sql.NullString {
+	return sql.NullString{String: strconv.FormatFloat(v, 'f', 2, 64), Valid: true}
+}
+
+func historyDate(t sql.NullTime) sql.NullString {
+	if !t.Valid {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: t.Time.Format("2006-01-02"), Valid: true}
+}
@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrLineItemNotFound = errors.New("line item not found")
+	ErrInvalidLineItem  = errors.New("invalid line item")
+	ErrNoProductPrice   = errors.New("no price for product")
+)
+
+// LineItem is a product sold on an opportunity.
+type LineItem struct {
+	ProductID   int32
+	Description sql.NullString
+	Quantity    float64
+	// UnitPrice is taken from the opportunity's price book when not set: the
+	// book of its pipeline, else the standard book, in the opportunity's
+	// currency. Without either the product's list price is used if it is in
+	// the opportunity's currency.
+	UnitPrice       sql.NullFloat64
+	DiscountPercent float64
+	TaxPercent      float64
+}
+
+// LineItemChange is the result of changing a line item: the item, unless it
+// was removed, and the opportunity with its recomputed amount.
+type LineItemChange struct {
+	LineItem    *db.OpportunityLineItem
+	Opportunity *db.Opportunity
+}
+
+// ListLineItems returns the line items of an opportunity in the order they
+// were added.
+func (s *OpportunityService) ListLineItems(ctx context.Context, opportunityID int32) ([]db.OpportunityLineItem, error) {
+	if _, err := s.queries.GetOpportunity(ctx, opportunityID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOpportunityNotFound
+		}
+		return nil, err
+	}
+	return s.queries.ListOpportunityLineItems(ctx, opportunityID)
+}
+
+// AddLineItem adds a product to an opportunity and recomputes the
+// opportunity's amount.
+func (s *OpportunityService) AddLineItem(ctx context.Context, opportunityID int32, item LineItem) (*LineItemChange, error) {
+	if err := item.validate(); err != nil {
+		return nil, err
+	}
+
+	change := &LineItemChange{}
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		opportunity, err := q.GetOpportunityForUpdate(ctx, opportunityID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrOpportunityNotFound
+			}
+			return err
+		}
+		if opportunity.AmountLocked {
+			return fmt.Errorf("%w: its line items cannot be changed", ErrOpportunityClosed)
+		}
+		product, err := q.GetProduct(ctx, item.ProductID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		if !product.Active {
+			return fmt.Errorf("%w: product %s is inactive", ErrInvalidLineItem, product.Sku)
+		}
+
+		var priceBookID sql.NullInt32
+		if !item.UnitPrice.Valid {
+			entry, err := q.FindProductPrice(ctx, db.FindProductPriceParams{
+				ProductID:  product.ID,
+				Currency:   opportunity.Currency,
+				PipelineID: opportunity.PipelineID,
+			})
+			switch {
+			case err == nil:
+				priceBookID = sql.NullInt32{Int32: entry.PriceBookID, Valid: true}
+				item.UnitPrice = sql.NullFloat64{Float64: entry.UnitPrice, Valid: true}
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			case product.Currency == opportunity.Currency:
+				item.UnitPrice = sql.NullFloat64{Float64: product.ListPrice, Valid: true}
+			default:
+				return fmt.Errorf("%w: %s has no %s price, give unit_price", ErrNoProductPrice, product.Sku, opportunity.Currency)
+			}
+		}
+
+		created, err := q.CreateOpportunityLineItem(ctx, db.CreateOpportunityLineItemParams{
+			OpportunityID:   opportunity.ID,
+			ProductID:       product.ID,
+			PriceBookID:     priceBookID,
+			Description:     item.Description,
+			Quantity:        item.Quantity,
+			UnitPrice:       roundCents(item.UnitPrice.Float64),
+			DiscountPercent: item.DiscountPercent,
+			TaxPercent:      item.TaxPercent,
+			Total:           item.total(),
+		})
+		if err != nil {
+			return err
+		}
+		change.LineItem = &created
+		change.Opportunity, err = recomputeOpportunityAmount(ctx, q, opportunity)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishLineItemChange(ctx, change.Opportunity)
+	return change, nil
+}
+
+// UpdateLineItem changes the quantity, price, discount, tax and description
+// of a line item and recomputes the opportunity's amount. The product
+// cannot be changed; without a unit price the item keeps its current one.
+func (s *OpportunityService) UpdateLineItem(ctx context.Context, id int32, item LineItem) (*LineItemChange, error) {
+	if err := item.validate(); err != nil {
+		return nil, err
+	}
+
+	change := &LineItemChange{}
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		existing, err := q.GetOpportunityLineItem(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrLineItemNotFound
+			}
+			return err
+		}
+		if item.ProductID != 0 && item.ProductID != existing.ProductID {
+			return fmt.Errorf("%w: the product of a line item cannot be changed", ErrInvalidLineItem)
+		}
+		opportunity, err := q.GetOpportunityForUpdate(ctx, existing.OpportunityID)
+		if err != nil {
+			return err
+		}
+		if opportunity.AmountLocked {
+			return fmt.Errorf("%w: its line items cannot be changed", ErrOpportunityClosed)
+		}
+
+		if !item.UnitPrice.Valid {
+			item.UnitPrice = sql.NullFloat64{Float64: existing.UnitPrice, Valid: true}
+		}
+		updated, err := q.UpdateOpportunityLineItem(ctx, db.UpdateOpportunityLineItemParams{
+			ID:              existing.ID,
+			Description:     item.Description,
+			Quantity:        item.Quantity,
+			UnitPrice:       roundCents(item.UnitPrice.Float64),
+			DiscountPercent: item.DiscountPercent,
+			TaxPercent:      item.TaxPercent,
+			Total:           item.total(),
+		})
+		if err != nil {
+			return err
+		}
+		change.LineItem = &updated
+		change.Opportunity, err = recomputeOpportunityAmount(ctx, q, opportunity)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishLineItemChange(ctx, change.Opportunity)
+	return change, nil
+}
+
+// RemoveLineItem removes a line item and recomputes the opportunity's
+// amount.
+func (s *OpportunityService) RemoveLineItem(ctx context.Context, id int32) (*LineItemChange, error) {
+	change := &LineItemChange{}
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		existing, err := q.GetOpportunityLineItem(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrLineItemNotFound
+			}
+			return err
+		}
+		opportunity, err := q.GetOpportunityForUpdate(ctx, existing.OpportunityID)
+		if err != nil {
+			return err
+		}
+		if opportunity.AmountLocked {
+			return fmt.Errorf("%w: its line items cannot be changed", ErrOpportunityClosed)
+		}
+		if _, err := q.DeleteOpportunityLineItem(ctx, existing.ID); err != nil {
+			return err
+		}
+		change.Opportunity, err = recomputeOpportunityAmount(ctx, q, opportunity)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishLineItemChange(ctx, change.Opportunity)
+	return change, nil
+}
+
+// ----------------- Helpers -----------------
+
+func (item *LineItem) validate() error {
+	problems := lineItemProblems(item.Quantity, item.UnitPrice.Float64, item.DiscountPercent, item.TaxPercent)
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidLineItem, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (item *LineItem) total() float64 {
+	return lineItemTotal(item.Quantity, item.UnitPrice.Float64, item.DiscountPercent, item.TaxPercent)
+}
+
+// recomputeOpportunityAmount sets the amount of an opportunity to the sum of
+// its line items and records the change in its history.
+func recomputeOpportunityAmount(ctx context.Context, q *db.Queries, opportunity db.Opportunity) (*db.Opportunity, error) {
+	items, err := q.ListOpportunityLineItems(ctx, opportunity.ID)
+	if err != nil {
+		return nil, err
+	}
+	var amount float64
+	for _, item := range items {
+		amount += item.Total
+	}
+
+	updated, err := q.SetOpportunityAmount(ctx, db.SetOpportunityAmountParams{
+		ID:     opportunity.ID,
+		Amount: roundCents(amount),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := recordOpportunityChanges(ctx, q, &opportunity, updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (s *OpportunityService) publishLineItemChange(ctx context.Context, opportunity *db.Opportunity) {
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicOpportunityUpdated, "opportunity_updated", map[string]interface{}{
+		"id":       opportunity.ID,
+		"name":     opportunity.Name.String,
+		"amount":   opportunity.Amount,
+		"currency": opportunity.Currency,
+		"stage":    opportunity.Stage.String,
+	})
+}
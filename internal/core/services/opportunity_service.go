@@ -4,8 +4,12 @@ import (
 	"context"
 	"crm/internal/adapters/database/db"
 	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 )
 
 var (
@@ -18,16 +22,49 @@ type OpportunityServiceInterface interface {
 	GetOpportunity(ctx context.Context, id int32) (*db.Opportunity, error)
 	UpdateOpportunity(ctx context.Context, opportunity db.UpdateOpportunityParams) (*db.Opportunity, error)
 	DeleteOpportunity(ctx context.Context, id int32) error
-	ListOpportunities(ctx context.Context, ownerID int32) ([]db.Opportunity, error)
+	ListOpportunities(ctx context.Context, ownerID int32, opts ListOptions) (*Page[db.Opportunity], error)
+	TotalOpportunities(ctx context.Context, ownerID int32, where filter.Group, organizationID int32, currency string) (*OpportunityTotals, error)
+	GetOpportunityHistory(ctx context.Context, id int32) ([]db.OpportunityHistory, error)
+	GetOpportunityMetrics(ctx context.Context, filter OpportunityMetricsFilter) (*OpportunityMetrics, error)
+
+	ListLineItems(ctx context.Context, opportunityID int32) ([]db.OpportunityLineItem, error)
+	AddLineItem(ctx context.Context, opportunityID int32, item LineItem) (*LineItemChange, error)
+	UpdateLineItem(ctx context.Context, id int32, item LineItem) (*LineItemChange, error)
+	RemoveLineItem(ctx context.Context, id int32) (*LineItemChange, error)
+
+	CloseOpportunityWon(ctx context.Context, req CloseOpportunityRequest) (*db.Opportunity, error)
+	CloseOpportunityLost(ctx context.Context, req CloseOpportunityRequest) (*db.Opportunity, error)
+	ListLossReasons(ctx context.Context, organizationID int32) ([]db.LossReason, error)
+	CreateLossReason(ctx context.Context, reason db.CreateLossReasonParams) (*db.LossReason, error)
+	UpdateLossReason(ctx context.Context, reason db.UpdateLossReasonParams) (*db.LossReason, error)
+	DeleteLossReason(ctx context.Context, id int32) error
+
+	AddOpportunityContact(ctx context.Context, opportunityID, contactID int32, role string) (*db.OpportunityContact, error)
+	RemoveOpportunityContact(ctx context.Context, opportunityID, contactID int32, role string) error
+	ListOpportunityContacts(ctx context.Context, opportunityID int32) ([]db.OpportunityContact, error)
+
+	CreatePipeline(ctx context.Context, pipeline Pipeline) (*Pipeline, error)
+	GetPipeline(ctx context.Context, id int32) (*Pipeline, error)
+	UpdatePipeline(ctx context.Context, pipeline Pipeline) (*Pipeline, error)
+	DeletePipeline(ctx context.Context, id int32) error
+	ListPipelines(ctx context.Context, organizationID int32) ([]Pipeline, error)
+}
+
+// OpportunityTotals sums opportunity amounts converted to one currency.
+type OpportunityTotals struct {
+	Currency       string
+	Amount         float64
+	WeightedAmount float64
 }
 
 type OpportunityService struct {
+	conn    *sql.DB
 	queries *db.Queries
 	kafka   *kafka.Producer
 }
 
-func NewOpportunityService(queries *db.Queries, producer *kafka.Producer) *OpportunityService {
-	return &OpportunityService{queries: queries, kafka: producer}
+func NewOpportunityService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *OpportunityService {
+	return &OpportunityService{conn: conn, queries: queries, kafka: producer}
 }
 
 func (s *OpportunityService) CreateOpportunity(ctx context.Context, opportunity db.CreateOpportunityParams) (*db.Opportunity, error) {
@@ -36,11 +73,6 @@ func (s *OpportunityService) CreateOpportunity(ctx context.Context, opportunity
 		return nil, ErrInvalidOpportunityData
 	}
 
-	// Validate stage
-	if !opportunity.Stage.Valid || strings.TrimSpace(opportunity.Stage.String) == "" {
-		return nil, ErrInvalidOpportunityData
-	}
-
 	// Validate other required fields
 	if opportunity.Amount <= 0 {
 		return nil, ErrInvalidOpportunityData
@@ -51,17 +83,31 @@ func (s *OpportunityService) CreateOpportunity(ctx context.Context, opportunity
 		return nil, errors.New("probability must be between 0 and 100")
 	}
 
-	createdOpportunity, err := s.queries.CreateOpportunity(ctx, opportunity)
+	var createdOpportunity db.Opportunity
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		// Pipeline, stage and stage probability
+		if err := prepareOpportunity(ctx, q, &opportunity, sql.NullInt32{}); err != nil {
+			return err
+		}
+
+		var err error
+		createdOpportunity, err = q.CreateOpportunity(ctx, opportunity)
+		if err != nil {
+			return err
+		}
+		return recordOpportunityChanges(ctx, q, nil, createdOpportunity)
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Kafka event
 	_ = s.kafka.Publish(ctx, kafka.TopicOpportunityCreated, "opportunity_created", map[string]interface{}{
-		"id":     createdOpportunity.ID,
-		"name":   createdOpportunity.Name.String,
-		"amount": createdOpportunity.Amount,
-		"stage":  createdOpportunity.Stage.String,
+		"id":       createdOpportunity.ID,
+		"name":     createdOpportunity.Name.String,
+		"amount":   createdOpportunity.Amount,
+		"currency": createdOpportunity.Currency,
+		"stage":    createdOpportunity.Stage.String,
 	})
 
 	return &createdOpportunity, nil
@@ -76,23 +122,92 @@ func (s *OpportunityService) GetOpportunity(ctx context.Context, id int32) (*db.
 	return &opportunity, nil
 }
 
-// UpdateOpportunity validates and updates an opportunity
+// UpdateOpportunity validates and updates an opportunity. A new stage must
+// belong to the opportunity's pipeline; unless the probability was entered
+// by hand it is reset to the stage's. The amount of an opportunity with
+// line items is derived from them and cannot be set. A closed opportunity
+// cannot change at all.
 func (s *OpportunityService) UpdateOpportunity(ctx context.Context, opportunity db.UpdateOpportunityParams) (*db.Opportunity, error) {
 	if opportunity.ID == 0 {
 		return nil, ErrInvalidOpportunityData
 	}
+	if opportunity.Probability < 0 || opportunity.Probability > 100 {
+		return nil, fmt.Errorf("%w: probability must be between 0 and 100", ErrInvalidOpportunityData)
+	}
 
-	updatedOpportunity, err := s.queries.UpdateOpportunity(ctx, opportunity)
+	var updatedOpportunity db.Opportunity
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		current, err := q.GetOpportunityForUpdate(ctx, opportunity.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrOpportunityNotFound
+			}
+			return err
+		}
+		pipeline, err := loadPipeline(ctx, q, current.PipelineID)
+		if err != nil {
+			return err
+		}
+
+		// Opportunities may keep a stage the pipeline no longer has, e.g. one
+		// set before pipelines existed, but cannot move into one.
+		if !opportunity.Stage.Valid || strings.TrimSpace(opportunity.Stage.String) == "" {
+			opportunity.Stage = current.Stage
+		}
+		stage, ok := pipeline.stage(opportunity.Stage.String)
+		if opportunity.Stage != current.Stage {
+			if current.AmountLocked {
+				return fmt.Errorf("%w: its stage cannot be changed", ErrOpportunityClosed)
+			}
+			if !ok {
+				return fmt.Errorf("%w: %q is not a stage of pipeline %s", ErrUnknownStage, opportunity.Stage.String, pipeline.Name)
+			}
+			if stage.Closed() {
+				return closedStageError(stage)
+			}
+		}
+		// A closed opportunity keeps the close date and probability it was
+		// won or lost with.
+		switch {
+		case !current.AmountLocked:
+			if ok && !opportunity.ProbabilityOverridden {
+				opportunity.Probability = stage.Probability
+			}
+		case historyDate(opportunity.CloseDate) != historyDate(current.CloseDate):
+			return fmt.Errorf("%w: its close date cannot be changed", ErrOpportunityClosed)
+		case opportunity.Probability != current.Probability || opportunity.ProbabilityOverridden != current.ProbabilityOverridden:
+			return fmt.Errorf("%w: its probability cannot be changed", ErrOpportunityClosed)
+		}
+
+		// The amount of an opportunity with line items is their sum.
+		items, err := q.ListOpportunityLineItems(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+		if len(items) > 0 {
+			opportunity.Amount = current.Amount
+		}
+		if current.AmountLocked && opportunity.Amount != current.Amount {
+			return fmt.Errorf("%w: its amount cannot be changed", ErrOpportunityClosed)
+		}
+
+		updatedOpportunity, err = q.UpdateOpportunity(ctx, opportunity)
+		if err != nil {
+			return err
+		}
+		return recordOpportunityChanges(ctx, q, &current, updatedOpportunity)
+	})
 	if err != nil {
-		return nil, ErrOpportunityNotFound
+		return nil, err
 	}
 
 	// Kafka event
 	_ = s.kafka.Publish(ctx, kafka.TopicOpportunityUpdated, "opportunity_updated", map[string]interface{}{
-		"id":     updatedOpportunity.ID,
-		"name":   updatedOpportunity.Name,
-		"amount": updatedOpportunity.Amount,
-		"stage":  updatedOpportunity.Stage,
+		"id":       updatedOpportunity.ID,
+		"name":     updatedOpportunity.Name.String,
+		"amount":   updatedOpportunity.Amount,
+		"currency": updatedOpportunity.Currency,
+		"stage":    updatedOpportunity.Stage.String,
 	})
 
 	return &updatedOpportunity, nil
@@ -113,11 +228,63 @@ func (s *OpportunityService) DeleteOpportunity(ctx context.Context, id int32) er
 	return nil
 }
 
-// ListOpportunities returns opportunities for a given owner
-func (s *OpportunityService) ListOpportunities(ctx context.Context, ownerID int32) ([]db.Opportunity, error) {
-	opportunities, err := s.queries.ListOpportunities(ctx, ownerID)
+// ListOpportunities returns a filtered, sorted page of opportunities, of
+// one owner unless ownerID is 0.
+func (s *OpportunityService) ListOpportunities(ctx context.Context, ownerID int32, opts ListOptions) (*Page[db.Opportunity], error) {
+	return listPage(ctx, filter.Opportunities, opts, s.queries.FilterOpportunities, s.queries.CountOpportunities,
+		filter.Where(ownerConditions(ownerID)...))
+}
+
+// TotalOpportunities sums the amounts of all opportunities ListOpportunities
+// would page through, converted to currency, or to the organization's
+// reporting currency when currency is empty. The amounts are summed in the
+// database by currency and close date, and each sum is converted at the rate
+// in effect on its close date, or today for opportunities without one.
+func (s *OpportunityService) TotalOpportunities(ctx context.Context, ownerID int32, where filter.Group, organizationID int32, currency string) (*OpportunityTotals, error) {
+	currency, err := targetCurrency(ctx, s.queries, organizationID, currency)
+	if err != nil {
+		return nil, err
+	}
+	q, err := filter.Opportunities.Build(nil, where, filter.Where(ownerConditions(ownerID)...))
+	if err != nil {
+		return nil, err
+	}
+	sums, err := s.queries.SumOpportunities(ctx, q.ListQuery)
 	if err != nil {
 		return nil, err
 	}
-	return opportunities, nil
+
+	converter := newCurrencyConverter(s.queries)
+	totals := &OpportunityTotals{Currency: currency}
+	today := time.Now().UTC()
+	for _, sum := range sums {
+		on := today
+		if sum.CloseDate.Valid {
+			on = sum.CloseDate.Time
+		}
+		amount, err := converter.convert(ctx, sum.Amount, sum.Currency, currency, on)
+		if err != nil {
+			return nil, err
+		}
+		weighted, err := converter.convert(ctx, sum.WeightedAmount, sum.Currency, currency, on)
+		if err != nil {
+			return nil, err
+		}
+		totals.Amount += amount
+		totals.WeightedAmount += weighted
+	}
+	totals.Amount = roundCents(totals.Amount)
+	totals.WeightedAmount = roundCents(totals.WeightedAmount)
+	return totals, nil
+}
+
+// ----------------- Helpers -----------------
+
+// ownerConditions restricts a list of opportunities to an owner, unless
+// ownerID is 0.
+func ownerConditions(ownerID int32) []filter.Condition {
+	if ownerID == 0 {
+		return nil
+	}
+	return []filter.Condition{filter.Eq("owner_id", ownerID)}
 }
@@ -0,0 +1,422 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrPipelineNotFound = errors.New("pipeline not found")
+	ErrInvalidPipeline  = errors.New("invalid pipeline")
+	ErrPipelineExists   = errors.New("pipeline with this name already exists")
+	ErrPipelineInUse    = errors.New("pipeline is in use")
+	ErrUnknownStage     = errors.New("unknown opportunity stage")
+)
+
+// Pipeline is an ordered list of stages an opportunity moves through.
+type Pipeline struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	// IsDefault marks the pipeline new opportunities of the organization
+	// are put in when none is given.
+	IsDefault bool
+	Stages    []PipelineStage
+	// BuiltIn is set for DefaultPipeline, which has no row of its own.
+	BuiltIn   bool
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+// PipelineStage is a step of a pipeline. Probability is the win probability
+// opportunities in the stage get unless it was entered by hand.
+type PipelineStage struct {
+	Name        string
+	Probability float64
+	Won         bool
+	Lost        bool
+}
+
+// Closed reports whether the stage ends the opportunity.
+func (s PipelineStage) Closed() bool {
+	return s.Won || s.Lost
+}
+
+// DefaultPipeline is used by opportunities that are not bound to a pipeline,
+// i.e. those of organizations without a default pipeline of their own.
+var DefaultPipeline = Pipeline{
+	Name: "Default",
+	Stages: []PipelineStage{
+		{Name: "Prospecting", Probability: 10},
+		{Name: "Qualification", Probability: 25},
+		{Name: "Proposal", Probability: 50},
+		{Name: "Negotiation", Probability: 75},
+		{Name: "Closed Won", Probability: 100, Won: true},
+		{Name: "Closed Lost", Probability: 0, Lost: true},
+	},
+	BuiltIn: true,
+}
+
+func (p *Pipeline) stage(name string) (PipelineStage, bool) {
+	for _, s := range p.Stages {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return PipelineStage{}, false
+}
+
+// firstOpenStage returns the stage an opportunity created without one gets.
+func (p *Pipeline) firstOpenStage() PipelineStage {
+	for _, s := range p.Stages {
+		if !s.Closed() {
+			return s
+		}
+	}
+	return PipelineStage{}
+}
+
+// validate checks a pipeline before it is stored.
+func (p *Pipeline) validate() error {
+	var problems []string
+	if strings.TrimSpace(p.Name) == "" || len(p.Name) > 100 {
+		problems = append(problems, "name must be 1-100 characters")
+	}
+	if len(p.Stages) == 0 {
+		problems = append(problems, "at least one stage is required")
+	}
+	seen := map[string]bool{}
+	open := false
+	for _, s := range p.Stages {
+		name := strings.TrimSpace(s.Name)
+		switch {
+		case name == "" || name != s.Name || len(name) > 50:
+			problems = append(problems, fmt.Sprintf("stage %q must be 1-50 characters without surrounding spaces", s.Name))
+		case seen[name]:
+			problems = append(problems, fmt.Sprintf("stage %q is listed twice", name))
+		}
+		seen[name] = true
+		switch {
+		case s.Won && s.Lost:
+			problems = append(problems, fmt.Sprintf("stage %q cannot be both won and lost", s.Name))
+		case s.Won && s.Probability != 100:
+			problems = append(problems, fmt.Sprintf("won stage %q must have probability 100", s.Name))
+		case s.Lost && s.Probability != 0:
+			problems = append(problems, fmt.Sprintf("lost stage %q must have probability 0", s.Name))
+		case s.Probability < 0 || s.Probability > 100:
+			problems = append(problems, fmt.Sprintf("stage %q probability must be between 0 and 100", s.Name))
+		}
+		open = open || !s.Closed()
+	}
+	if len(p.Stages) > 0 && !open {
+		problems = append(problems, "at least one stage must be open")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidPipeline, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// CreatePipeline stores a new pipeline. Marking it as default unmarks the
+// organization's previous default pipeline.
+func (s *OpportunityService) CreatePipeline(ctx context.Context, pipeline Pipeline) (*Pipeline, error) {
+	if pipeline.OrganizationID <= 0 {
+		return nil, fmt.Errorf("%w: organization_id is required", ErrInvalidPipeline)
+	}
+	if err := pipeline.validate(); err != nil {
+		return nil, err
+	}
+
+	var id int32
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		created, err := q.CreatePipeline(ctx, db.CreatePipelineParams{
+			OrganizationID: pipeline.OrganizationID,
+			Name:           pipeline.Name,
+			IsDefault:      false,
+		})
+		if err != nil {
+			if isUniqueViolation(err, "pipelines_org_name_key") {
+				return ErrPipelineExists
+			}
+			return err
+		}
+		id = created.ID
+		return storePipeline(ctx, q, created, pipeline)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPipeline(ctx, id)
+}
+
+// UpdatePipeline renames a pipeline, changes whether it is the default and
+// replaces its stages. Stages still used by opportunities cannot be
+// removed.
+func (s *OpportunityService) UpdatePipeline(ctx context.Context, pipeline Pipeline) (*Pipeline, error) {
+	if pipeline.ID == 0 {
+		return nil, fmt.Errorf("%w: id is required", ErrInvalidPipeline)
+	}
+	if err := pipeline.validate(); err != nil {
+		return nil, err
+	}
+
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		existing, err := q.GetPipeline(ctx, pipeline.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPipelineNotFound
+			}
+			return err
+		}
+
+		inUse, err := q.ListPipelineStagesInUse(ctx, sql.NullInt32{Int32: pipeline.ID, Valid: true})
+		if err != nil {
+			return err
+		}
+		var removed []string
+		for _, stage := range inUse {
+			if _, ok := pipeline.stage(stage.String); !ok {
+				removed = append(removed, stage.String)
+			}
+		}
+		if len(removed) > 0 {
+			return fmt.Errorf("%w: opportunities are still in stages %s", ErrPipelineInUse, strings.Join(removed, ", "))
+		}
+
+		updated, err := q.UpdatePipeline(ctx, db.UpdatePipelineParams{
+			ID:        existing.ID,
+			Name:      pipeline.Name,
+			IsDefault: false,
+		})
+		if err != nil {
+			if isUniqueViolation(err, "pipelines_org_name_key") {
+				return ErrPipelineExists
+			}
+			return err
+		}
+		if err := q.DeletePipelineStages(ctx, updated.ID); err != nil {
+			return err
+		}
+		return storePipeline(ctx, q, updated, pipeline)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPipeline(ctx, pipeline.ID)
+}
+
+// GetPipeline returns a pipeline with its stages.
+func (s *OpportunityService) GetPipeline(ctx context.Context, id int32) (*Pipeline, error) {
+	row, err := s.queries.GetPipeline(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPipelineNotFound
+		}
+		return nil, err
+	}
+	return withPipelineStages(ctx, s.queries, row)
+}
+
+// ListPipelines returns the pipelines of an organization. An organization
+// without pipelines gets the built-in one.
+func (s *OpportunityService) ListPipelines(ctx context.Context, organizationID int32) ([]Pipeline, error) {
+	rows, err := s.queries.ListPipelines(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		pipeline := DefaultPipeline
+		pipeline.OrganizationID = organizationID
+		pipeline.IsDefault = true
+		return []Pipeline{pipeline}, nil
+	}
+
+	pipelines := make([]Pipeline, 0, len(rows))
+	for _, row := range rows {
+		pipeline, err := withPipelineStages(ctx, s.queries, row)
+		if err != nil {
+			return nil, err
+		}
+		pipelines = append(pipelines, *pipeline)
+	}
+	return pipelines, nil
+}
+
+// DeletePipeline removes a pipeline that has no opportunities.
+func (s *OpportunityService) DeletePipeline(ctx context.Context, id int32) error {
+	return withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		count, err := q.CountPipelineOpportunities(ctx, sql.NullInt32{Int32: id, Valid: true})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: %d opportunities", ErrPipelineInUse, count)
+		}
+		deleted, err := q.DeletePipeline(ctx, id)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return ErrPipelineNotFound
+		}
+		return nil
+	})
+}
+
+// ----------------- Helpers -----------------
+
+// storePipeline writes the stages of a pipeline and, when it is the
+// default, moves the organization's default flag to it.
+func storePipeline(ctx context.Context, q *db.Queries, row db.Pipeline, pipeline Pipeline) error {
+	for i, stage := range pipeline.Stages {
+		if _, err := q.CreatePipelineStage(ctx, db.CreatePipelineStageParams{
+			PipelineID:  row.ID,
+			Name:        stage.Name,
+			Position:    int32(i),
+			Probability: stage.Probability,
+			IsWon:       stage.Won,
+			IsLost:      stage.Lost,
+		}); err != nil {
+			return err
+		}
+	}
+	if !pipeline.IsDefault {
+		return nil
+	}
+	if err := q.ClearDefaultPipeline(ctx, db.ClearDefaultPipelineParams{
+		OrganizationID: row.OrganizationID,
+		KeepID:         row.ID,
+	}); err != nil {
+		return err
+	}
+	_, err := q.UpdatePipeline(ctx, db.UpdatePipelineParams{ID: row.ID, Name: row.Name, IsDefault: true})
+	return err
+}
+
+func withPipelineStages(ctx context.Context, q *db.Queries, row db.Pipeline) (*Pipeline, error) {
+	stages, err := q.ListPipelineStages(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := &Pipeline{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		Name:           row.Name,
+		IsDefault:      row.IsDefault,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+	for _, s := range stages {
+		pipeline.Stages = append(pipeline.Stages, PipelineStage{
+			Name:        s.Name,
+			Probability: s.Probability,
+			Won:         s.IsWon,
+			Lost:        s.IsLost,
+		})
+	}
+	return pipeline, nil
+}
+
+// loadPipeline returns the pipeline an opportunity is bound to, or the
+// built-in one when it is not bound to any.
+func loadPipeline(ctx context.Context, q *db.Queries, pipelineID sql.NullInt32) (*Pipeline, error) {
+	if !pipelineID.Valid {
+		pipeline := DefaultPipeline
+		return &pipeline, nil
+	}
+	row, err := q.GetPipeline(ctx, pipelineID.Int32)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPipelineNotFound
+		}
+		return nil, err
+	}
+	return withPipelineStages(ctx, q, row)
+}
+
+// prepareOpportunity checks the account of a new opportunity, binds it to a
+// pipeline and fills in its stage, probability and currency. Without a
+// pipeline the opportunity goes to the default pipeline of organizationID,
+// or of its account's organization when organizationID is not set; a
+// pipeline given must belong to those organizations. Without a stage it
+// starts in the first open stage, and unless the probability was entered by
+// hand it takes the stage's. Without a currency it gets the organization's
+// reporting currency.
+func prepareOpportunity(ctx context.Context, q *db.Queries, params *db.CreateOpportunityParams, organizationID sql.NullInt32) error {
+	var accountOrganization sql.NullInt32
+	if params.AccountID.Valid {
+		company, err := q.GetCompany(ctx, params.AccountID.Int32)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: account %d does not exist", ErrInvalidOpportunityData, params.AccountID.Int32)
+			}
+			return err
+		}
+		accountOrganization = sql.NullInt32{Int32: company.OrganizationID, Valid: true}
+		if !organizationID.Valid {
+			organizationID = sql.NullInt32{Int32: company.OrganizationID, Valid: true}
+		}
+	}
+
+	if !params.PipelineID.Valid && organizationID.Valid {
+		def, err := q.GetDefaultPipeline(ctx, organizationID.Int32)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil {
+			params.PipelineID = sql.NullInt32{Int32: def.ID, Valid: true}
+		}
+	}
+
+	params.Currency = strings.ToUpper(strings.TrimSpace(params.Currency))
+	if params.Currency == "" {
+		params.Currency = DefaultCurrency
+		if organizationID.Valid {
+			currency, err := reportingCurrency(ctx, q, organizationID.Int32)
+			if err != nil {
+				return err
+			}
+			params.Currency = currency
+		}
+	}
+	if err := validateCurrency(params.Currency); err != nil {
+		return err
+	}
+
+	pipeline, err := loadPipeline(ctx, q, params.PipelineID)
+	if err != nil {
+		return err
+	}
+	if !pipeline.BuiltIn {
+		for _, org := range []sql.NullInt32{organizationID, accountOrganization} {
+			if org.Valid && org.Int32 != pipeline.OrganizationID {
+				return fmt.Errorf("%w: pipeline %d belongs to another organization than %d", ErrInvalidOpportunityData, pipeline.ID, org.Int32)
+			}
+		}
+	}
+
+	var stage PipelineStage
+	if !params.Stage.Valid || strings.TrimSpace(params.Stage.String) == "" {
+		stage = pipeline.firstOpenStage()
+		params.Stage = sql.NullString{String: stage.Name, Valid: true}
+	} else {
+		var ok bool
+		if stage, ok = pipeline.stage(params.Stage.String); !ok {
+			return fmt.Errorf("%w: %q is not a stage of pipeline %s", ErrUnknownStage, params.Stage.String, pipeline.Name)
+		}
+		if stage.Closed() {
+			return closedStageError(stage)
+		}
+	}
+	if !params.ProbabilityOverridden {
+		params.Probability = stage.Probability
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrProductNotFound   = errors.New("product not found")
+	ErrInvalidProduct    = errors.New("invalid product")
+	ErrProductExists     = errors.New("product with this SKU already exists")
+	ErrProductInUse      = errors.New("product is in use")
+	ErrPriceBookNotFound = errors.New("price book not found")
+	ErrInvalidPriceBook  = errors.New("invalid price book")
+	ErrPriceBookExists   = errors.New("price book with this name already exists")
+)
+
+type ProductServiceInterface interface {
+	CreateProduct(ctx context.Context, product db.CreateProductParams) (*db.Product, error)
+	GetProduct(ctx context.Context, id int32) (*db.Product, error)
+	UpdateProduct(ctx context.Context, product db.UpdateProductParams) (*db.Product, error)
+	DeleteProduct(ctx context.Context, id int32) error
+	ListProducts(ctx context.Context, activeOnly bool, query string, opts ListOptions) (*Page[db.Product], error)
+
+	CreatePriceBook(ctx context.Context, priceBook db.CreatePriceBookParams) (*db.PriceBook, error)
+	GetPriceBook(ctx context.Context, id int32) (*db.PriceBook, error)
+	UpdatePriceBook(ctx context.Context, priceBook db.UpdatePriceBookParams) (*db.PriceBook, error)
+	DeletePriceBook(ctx context.Context, id int32) error
+	ListPriceBooks(ctx context.Context, pipelineID int32) ([]db.PriceBook, error)
+	SetPriceBookEntry(ctx context.Context, entry db.UpsertPriceBookEntryParams) (*db.PriceBookEntry, error)
+	RemovePriceBookEntry(ctx context.Context, priceBookID, productID int32) error
+	ListPriceBookEntries(ctx context.Context, priceBookID int32) ([]db.PriceBookEntry, error)
+}
+
+type ProductService struct {
+	conn    *sql.DB
+	queries *db.Queries
+}
+
+func NewProductService(conn *sql.DB, queries *db.Queries) *ProductService {
+	return &ProductService{conn: conn, queries: queries}
+}
+
+// CreateProduct adds a product to the catalog. The list price is in the
+// product's currency, which defaults to DefaultCurrency.
+func (s *ProductService) CreateProduct(ctx context.Context, product db.CreateProductParams) (*db.Product, error) {
+	product.Currency = strings.ToUpper(strings.TrimSpace(product.Currency))
+	if product.Currency == "" {
+		product.Currency = DefaultCurrency
+	}
+	if err := validateProduct(product.Sku, product.Name, product.ListPrice, product.Currency); err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateProduct(ctx, product)
+	if err != nil {
+		if isUniqueViolation(err, "products_sku_key") {
+			return nil, ErrProductExists
+		}
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetProduct retrieves a product by ID.
+func (s *ProductService) GetProduct(ctx context.Context, id int32) (*db.Product, error) {
+	product, err := s.queries.GetProduct(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// UpdateProduct replaces the fields of a product. Line items already on
+// opportunities keep the price they were added with.
+func (s *ProductService) UpdateProduct(ctx context.Context, product db.UpdateProductParams) (*db.Product, error) {
+	if product.ID == 0 {
+		return nil, fmt.Errorf("%w: id is required", ErrInvalidProduct)
+	}
+	product.Currency = strings.ToUpper(strings.TrimSpace(product.Currency))
+	if product.Currency == "" {
+		product.Currency = DefaultCurrency
+	}
+	if err := validateProduct(product.Sku, product.Name, product.ListPrice, product.Currency); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.UpdateProduct(ctx, product)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrProductNotFound
+		case isUniqueViolation(err, "products_sku_key"):
+			return nil, ErrProductExists
+		}
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteProduct removes a product that is not on any opportunity. Products
+// that have been sold fail with ErrProductInUse and should be deactivated
+// with UpdateProduct instead.
+func (s *ProductService) DeleteProduct(ctx context.Context, id int32) error {
+	return withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		count, err := q.CountProductLineItems(ctx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: on %d line items, deactivate it instead", ErrProductInUse, count)
+		}
+		deleted, err := q.DeleteProduct(ctx, id)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return ErrProductNotFound
+		}
+		return nil
+	})
+}
+
+// ListProducts returns a filtered, sorted page of products, by name by
+// default. query matches part of the SKU or name.
+func (s *ProductService) ListProducts(ctx context.Context, activeOnly bool, query string, opts ListOptions) (*Page[db.Product], error) {
+	var where []filter.Group
+	if activeOnly {
+		where = append(where, filter.Where(filter.Eq("active", true)))
+	}
+	if query = strings.TrimSpace(query); query != "" {
+		where = append(where, filter.Group{Logic: filter.Or, Conditions: []filter.Condition{
+			{Field: "sku", Operator: filter.OpContains, Values: []string{query}},
+			{Field: "name", Operator: filter.OpContains, Values: []string{query}},
+		}})
+	}
+	return listPage(ctx, filter.Products, opts, s.queries.FilterProducts, s.queries.CountProducts, where...)
+}
+
+// CreatePriceBook stores a price book. A book with a pipeline prices the
+// products of that pipeline's opportunities; one without is the standard
+// book for pipelines that have none of their own.
+func (s *ProductService) CreatePriceBook(ctx context.Context, priceBook db.CreatePriceBookParams) (*db.PriceBook, error) {
+	priceBook.Currency = strings.ToUpper(strings.TrimSpace(priceBook.Currency))
+	if priceBook.Currency == "" {
+		priceBook.Currency = DefaultCurrency
+	}
+	if err := s.validatePriceBook(ctx, priceBook.Name, priceBook.PipelineID, priceBook.Currency); err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreatePriceBook(ctx, priceBook)
+	if err != nil {
+		if isUniqueViolation(err, "price_books_name_key") {
+			return nil, ErrPriceBookExists
+		}
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetPriceBook retrieves a price book by ID.
+func (s *ProductService) GetPriceBook(ctx context.Context, id int32) (*db.PriceBook, error) {
+	priceBook, err := s.queries.GetPriceBook(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPriceBookNotFound
+		}
+		return nil, err
+	}
+	return &priceBook, nil
+}
+
+// UpdatePriceBook replaces the fields of a price book; its entries are kept.
+func (s *ProductService) UpdatePriceBook(ctx context.Context, priceBook db.UpdatePriceBookParams) (*db.PriceBook, error) {
+	if priceBook.ID == 0 {
+		return nil, fmt.Errorf("%w: id is required", ErrInvalidPriceBook)
+	}
+	priceBook.Currency = strings.ToUpper(strings.TrimSpace(priceBook.Currency))
+	if priceBook.Currency == "" {
+		priceBook.Currency = DefaultCurrency
+	}
+	if err := s.validatePriceBook(ctx, priceBook.Name, priceBook.PipelineID, priceBook.Currency); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.UpdatePriceBook(ctx, priceBook)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrPriceBookNotFound
+		case isUniqueViolation(err, "price_books_name_key"):
+			return nil, ErrPriceBookExists
+		}
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeletePriceBook removes a price book and its entries. Line items priced
+// from it keep their price.
+func (s *ProductService) DeletePriceBook(ctx context.Context, id int32) error {
+	deleted, err := s.queries.DeletePriceBook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrPriceBookNotFound
+	}
+	return nil
+}
+
+// ListPriceBooks returns all price books, or those of one pipeline.
+func (s *ProductService) ListPriceBooks(ctx context.Context, pipelineID int32) ([]db.PriceBook, error) {
+	return s.queries.ListPriceBooks(ctx, pipelineID)
+}
+
+// SetPriceBookEntry sets the price of a product in a price book.
+func (s *ProductService) SetPriceBookEntry(ctx context.Context, entry db.UpsertPriceBookEntryParams) (*db.PriceBookEntry, error) {
+	if entry.UnitPrice < 0 {
+		return nil, fmt.Errorf("%w: unit_price must not be negative", ErrInvalidPriceBook)
+	}
+	if _, err := s.GetPriceBook(ctx, entry.PriceBookID); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetProduct(ctx, entry.ProductID); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.queries.UpsertPriceBookEntry(ctx, db.UpsertPriceBookEntryParams{
+		PriceBookID: entry.PriceBookID,
+		ProductID:   entry.ProductID,
+		UnitPrice:   roundCents(entry.UnitPrice),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// RemovePriceBookEntry removes a product from a price book.
+func (s *ProductService) RemovePriceBookEntry(ctx context.Context, priceBookID, productID int32) error {
+	deleted, err := s.queries.DeletePriceBookEntry(ctx, db.DeletePriceBookEntryParams{
+		PriceBookID: priceBookID,
+		ProductID:   productID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: product %d is not in price book %d", ErrProductNotFound, productID, priceBookID)
+	}
+	return nil
+}
+
+// ListPriceBookEntries returns the prices of a price book.
+func (s *ProductService) ListPriceBookEntries(ctx context.Context, priceBookID int32) ([]db.PriceBookEntry, error) {
+	if _, err := s.GetPriceBook(ctx, priceBookID); err != nil {
+		return nil, err
+	}
+	return s.queries.ListPriceBookEntries(ctx, priceBookID)
+}
+
+// ----------------- Helpers -----------------
+
+func validateProduct(sku, name string, listPrice float64, currency string) error {
+	var problems []string
+	if strings.TrimSpace(sku) == "" || len(sku) > 64 {
+		problems = append(problems, "sku must be 1-64 characters")
+	}
+	if strings.TrimSpace(name) == "" || len(name) > 255 {
+		problems = append(problems, "name must be 1-255 characters")
+	}
+	if listPrice < 0 {
+		problems = append(problems, "list_price must not be negative")
+	}
+	if !currencyCode.MatchString(currency) {
+		problems = append(problems, fmt.Sprintf("currency %q is not an ISO 4217 code", currency))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidProduct, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (s *ProductService) validatePriceBook(ctx context.Context, name string, pipelineID sql.NullInt32, currency string) error {
+	var problems []string
+	if strings.TrimSpace(name) == "" || len(name) > 255 {
+		problems = append(problems, "name must be 1-255 characters")
+	}
+	if !currencyCode.MatchString(currency) {
+		problems = append(problems, fmt.Sprintf("currency %q is not an ISO 4217 code", currency))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidPriceBook, strings.Join(problems, "; "))
+	}
+
+	if pipelineID.Valid {
+		if _, err := s.queries.GetPipeline(ctx, pipelineID.Int32); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPipelineNotFound
+			}
+			return err
+		}
+	}
+	return nil
+}
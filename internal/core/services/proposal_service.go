@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	ErrProposalNotFound          = errors.New("proposal not found")
+	ErrInvalidProposalData       = errors.New("invalid proposal data")
+	ErrProposalNotEditable       = errors.New("only draft proposals can be changed")
+	ErrInvalidProposalTransition = errors.New("invalid proposal status transition")
+)
+
+// Proposal statuses. A proposal starts as a draft, is sent to the customer
+// and then ends up accepted, rejected or expired.
+const (
+	ProposalStatusDraft    = "draft"
+	ProposalStatusSent     = "sent"
+	ProposalStatusAccepted = "accepted"
+	ProposalStatusRejected = "rejected"
+	ProposalStatusExpired  = "expired"
+)
+
+// proposalTransitions lists the statuses each status may move to.
+var proposalTransitions = map[string][]string{
+	ProposalStatusDraft: {ProposalStatusSent},
+	ProposalStatusSent:  {ProposalStatusAccepted, ProposalStatusRejected, ProposalStatusExpired},
+}
+
+// proposalStatusTopics maps a target status to the topic its transition is
+// published on.
+var proposalStatusTopics = map[string]string{
+	ProposalStatusSent:     kafka.TopicProposalSent,
+	ProposalStatusAccepted: kafka.TopicProposalAccepted,
+	ProposalStatusRejected: kafka.TopicProposalRejected,
+	ProposalStatusExpired:  kafka.TopicProposalExpired,
+}
+
+// ProposalDetails bundles a proposal with its line items.
+type ProposalDetails struct {
+	Proposal  db.Proposal
+	LineItems []db.ProposalLineItem
+}
+
+type ProposalServiceInterface interface {
+	CreateProposal(ctx context.Context, proposal db.CreateProposalParams, lineItems []db.ProposalLineItem) (*ProposalDetails, error)
+	GetProposal(ctx context.Context, id int32) (*ProposalDetails, error)
+	UpdateProposal(ctx context.Context, proposal db.UpdateProposalParams, lineItems []db.ProposalLineItem) (*ProposalDetails, error)
+	DeleteProposal(ctx context.Context, id int32) error
+	ListProposals(ctx context.Context, opts ListOptions) (*Page[ProposalDetails], error)
+	UpdateProposalStatus(ctx context.Context, id int32, status string) (*ProposalDetails, error)
+	ExpireProposals(ctx context.Context, asOf time.Time) (int, error)
+}
+
+type ProposalService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	kafka   *kafka.Producer
+}
+
+func NewProposalService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *ProposalService {
+	return &ProposalService{conn: conn, queries: queries, kafka: producer}
+}
+
+// CreateProposal stores a new draft proposal with its line items and sets
+// its amount to the sum of the line item totals.
+func (s *ProposalService) CreateProposal(ctx context.Context, proposal db.CreateProposalParams, lineItems []db.ProposalLineItem) (*ProposalDetails, error) {
+	if strings.TrimSpace(proposal.Title) == "" || proposal.ContactID == 0 || proposal.CreatedBy == 0 {
+		return nil, ErrInvalidProposalData
+	}
+	if err := validateLineItems(lineItems); err != nil {
+		return nil, err
+	}
+	proposal.Status = ProposalStatusDraft
+
+	var details ProposalDetails
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		// Proposals are in the currency of their opportunity unless given one.
+		proposal.Currency = strings.ToUpper(strings.TrimSpace(proposal.Currency))
+		if proposal.Currency == "" {
+			proposal.Currency = DefaultCurrency
+			if proposal.OpportunityID.Valid {
+				opportunity, err := q.GetOpportunity(ctx, proposal.OpportunityID.Int32)
+				if err != nil {
+					return fmt.Errorf("%w: opportunity %d not found", ErrInvalidProposalData, proposal.OpportunityID.Int32)
+				}
+				proposal.Currency = opportunity.Currency
+			}
+		}
+		if err := validateCurrency(proposal.Currency); err != nil {
+			return err
+		}
+
+		created, err := q.CreateProposal(ctx, proposal)
+		if err != nil {
+			return err
+		}
+		return replaceProposalLineItems(ctx, q, created.ID, lineItems, &details)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicProposalCreated, "proposal_created", proposalEvent(&details.Proposal))
+
+	return &details, nil
+}
+
+// GetProposal retrieves a proposal and its line items by ID.
+func (s *ProposalService) GetProposal(ctx context.Context, id int32) (*ProposalDetails, error) {
+	proposal, err := s.queries.GetProposal(ctx, id)
+	if err != nil {
+		return nil, ErrProposalNotFound
+	}
+
+	lineItems, err := s.queries.ListProposalLineItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &ProposalDetails{Proposal: proposal, LineItems: lineItems}, nil
+}
+
+// UpdateProposal changes a draft proposal. When lineItems is nil the
+// existing line items are kept; otherwise they are replaced and the amount
+// is recomputed.
+func (s *ProposalService) UpdateProposal(ctx context.Context, proposal db.UpdateProposalParams, lineItems []db.ProposalLineItem) (*ProposalDetails, error) {
+	if proposal.ID == 0 || strings.TrimSpace(proposal.Title) == "" || proposal.ContactID == 0 {
+		return nil, ErrInvalidProposalData
+	}
+	if lineItems != nil {
+		if err := validateLineItems(lineItems); err != nil {
+			return nil, err
+		}
+	}
+
+	var details ProposalDetails
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		// Lock the row so the proposal cannot be sent while it is edited.
+		existing, err := q.GetProposalForUpdate(ctx, proposal.ID)
+		if err != nil {
+			return ErrProposalNotFound
+		}
+		if existing.Status != ProposalStatusDraft {
+			return ErrProposalNotEditable
+		}
+
+		updated, err := q.UpdateProposal(ctx, proposal)
+		if err != nil {
+			return err
+		}
+
+		if lineItems == nil {
+			details.Proposal = updated
+			details.LineItems, err = q.ListProposalLineItems(ctx, proposal.ID)
+			return err
+		}
+		return replaceProposalLineItems(ctx, q, proposal.ID, lineItems, &details)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicProposalUpdated, "proposal_updated", proposalEvent(&details.Proposal))
+
+	return &details, nil
+}
+
+// DeleteProposal removes a proposal and its line items. Accepted proposals
+// are kept as a record of the agreement.
+func (s *ProposalService) DeleteProposal(ctx context.Context, id int32) error {
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		proposal, err := q.GetProposalForUpdate(ctx, id)
+		if err != nil {
+			return ErrProposalNotFound
+		}
+		if proposal.Status == ProposalStatusAccepted {
+			return fmt.Errorf("%w: accepted proposals cannot be deleted", ErrInvalidProposalTransition)
+		}
+		return q.DeleteProposal(ctx, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicProposalDeleted, "proposal_deleted", map[string]interface{}{
+		"id": id,
+	})
+
+	return nil
+}
+
+// ListProposals returns a filtered, sorted page of proposals with their
+// line items, newest first by default.
+func (s *ProposalService) ListProposals(ctx context.Context, opts ListOptions) (*Page[ProposalDetails], error) {
+	page, err := listPage(ctx, filter.Proposals, opts, s.queries.FilterProposals, s.queries.CountProposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPage(page, func(p db.Proposal) (ProposalDetails, error) {
+		lineItems, err := s.queries.ListProposalLineItems(ctx, p.ID)
+		return ProposalDetails{Proposal: p, LineItems: lineItems}, err
+	})
+}
+
+// UpdateProposalStatus moves a proposal to status when the lifecycle allows
+// it and publishes the transition on the matching topic.
+func (s *ProposalService) UpdateProposalStatus(ctx context.Context, id int32, status string) (*ProposalDetails, error) {
+	if _, ok := proposalStatusTopics[status]; !ok {
+		return nil, fmt.Errorf("%w: unknown target status %q", ErrInvalidProposalData, status)
+	}
+
+	var (
+		details ProposalDetails
+		from    string
+	)
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		current, err := q.GetProposalForUpdate(ctx, id)
+		if err != nil {
+			return ErrProposalNotFound
+		}
+		from = current.Status
+
+		if !proposalTransitionAllowed(from, status) {
+			return fmt.Errorf("%w: %s -> %s", ErrInvalidProposalTransition, from, status)
+		}
+
+		lineItems, err := q.ListProposalLineItems(ctx, id)
+		if err != nil {
+			return err
+		}
+		if status == ProposalStatusSent && len(lineItems) == 0 {
+			return fmt.Errorf("%w: a proposal needs at least one line item before it is sent", ErrInvalidProposalTransition)
+		}
+		if (status == ProposalStatusSent || status == ProposalStatusAccepted) &&
+			current.ValidUntil.Valid && current.ValidUntil.Time.Before(time.Now()) {
+			return fmt.Errorf("%w: proposal was only valid until %s", ErrInvalidProposalTransition, current.ValidUntil.Time.Format(time.RFC3339))
+		}
+
+		updated, err := q.UpdateProposalStatus(ctx, db.UpdateProposalStatusParams{
+			Status:     status,
+			ID:         id,
+			FromStatus: from,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// Someone else moved the proposal since we read it.
+				return fmt.Errorf("%w: proposal status changed concurrently", ErrInvalidProposalTransition)
+			}
+			return err
+		}
+		details = ProposalDetails{Proposal: updated, LineItems: lineItems}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishProposalTransition(ctx, &details.Proposal, from)
+
+	return &details, nil
+}
+
+// ExpireProposals marks every sent proposal whose valid_until is before
+// asOf as expired and returns how many were expired.
+func (s *ProposalService) ExpireProposals(ctx context.Context, asOf time.Time) (int, error) {
+	expired, err := s.queries.ExpireSentProposals(ctx, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range expired {
+		s.publishProposalTransition(ctx, &expired[i], ProposalStatusSent)
+	}
+	return len(expired), nil
+}
+
+// ----------------- Helpers -----------------
+
+func (s *ProposalService) publishProposalTransition(ctx context.Context, proposal *db.Proposal, from string) {
+	event := proposalEvent(proposal)
+	event["from_status"] = from
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, proposalStatusTopics[proposal.Status], "proposal_"+proposal.Status, event)
+}
+
+func proposalTransitionAllowed(from, to string) bool {
+	for _, next := range proposalTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func validateLineItems(lineItems []db.ProposalLineItem) error {
+	for i, item := range lineItems {
+		problems := lineItemProblems(item.Quantity, item.UnitPrice, item.Discount, item.TaxRate)
+		if strings.TrimSpace(item.Description) == "" {
+			problems = append([]string{"description is required"}, problems...)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%w: line item %d: %s", ErrInvalidProposalData, i+1, strings.Join(problems, "; "))
+		}
+	}
+	return nil
+}
+
+// lineItemProblems describes what is wrong with the amounts of a proposal
+// or opportunity line item. Discount and tax are percentages.
+func lineItemProblems(quantity, unitPrice, discount, tax float64) []string {
+	var problems []string
+	if quantity <= 0 {
+		problems = append(problems, "quantity must be positive")
+	}
+	if unitPrice < 0 {
+		problems = append(problems, "unit price must not be negative")
+	}
+	if discount < 0 || discount > 100 {
+		problems = append(problems, "discount must be between 0 and 100")
+	}
+	if tax < 0 || tax > 100 {
+		problems = append(problems, "tax must be between 0 and 100")
+	}
+	return problems
+}
+
+// lineItemTotal is the total of a proposal or opportunity line item: the
+// quantity at the unit price rounded to cents, less the percentage
+// discount, plus the percentage tax on the discounted price, rounded to
+// cents.
+func lineItemTotal(quantity, unitPrice, discount, tax float64) float64 {
+	net := quantity * roundCents(unitPrice) * (1 - discount/100)
+	return roundCents(net * (1 + tax/100))
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// replaceProposalLineItems swaps the proposal's line items for lineItems,
+// rolls their totals up into the proposal amount and fills details.
+func replaceProposalLineItems(ctx context.Context, q *db.Queries, proposalID int32, lineItems []db.ProposalLineItem, details *ProposalDetails) error {
+	if err := q.DeleteProposalLineItems(ctx, proposalID); err != nil {
+		return err
+	}
+
+	var amount float64
+	details.LineItems = make([]db.ProposalLineItem, 0, len(lineItems))
+	for i, item := range lineItems {
+		row, err := q.AddProposalLineItem(ctx, db.AddProposalLineItemParams{
+			ProposalID:  proposalID,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   roundCents(item.UnitPrice),
+			Discount:    item.Discount,
+			TaxRate:     item.TaxRate,
+			Total:       lineItemTotal(item.Quantity, item.UnitPrice, item.Discount, item.TaxRate),
+			Position:    int32(i),
+		})
+		if err != nil {
+			return err
+		}
+		amount += row.Total
+		details.LineItems = append(details.LineItems, row)
+	}
+
+	updated, err := q.UpdateProposalAmount(ctx, db.UpdateProposalAmountParams{
+		ID:     proposalID,
+		Amount: roundCents(amount),
+	})
+	if err != nil {
+		return err
+	}
+	details.Proposal = updated
+	return nil
+}
+
+func proposalEvent(proposal *db.Proposal) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             proposal.ID,
+		"title":          proposal.Title,
+		"amount":         proposal.Amount,
+		"currency":       proposal.Currency,
+		"status":         proposal.Status,
+		"contact_id":     proposal.ContactID,
+		"opportunity_id": proposal.OpportunityID.Int32,
+		"created_by":     proposal.CreatedBy,
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"crm/internal/adapters/database/db"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The line item math is shared by proposal and opportunity line items; each
+// case is checked through both so they cannot drift apart.
+
+func TestLineItemTotal(t *testing.T) {
+	tests := []struct {
+		name                               string
+		quantity, unitPrice, discount, tax float64
+		want                               float64
+	}{
+		{"quantity times price", 2, 10, 0, 0, 20},
+		{"fractional quantity", 2.5, 4, 0, 0, 10},
+		{"discount", 3, 19.99, 10, 0, 53.97},
+		{"tax", 1, 100, 0, 20, 120},
+		{"tax on the discounted price", 2, 50, 10, 20, 108},
+		{"unit price is rounded to cents first", 3, 0.333, 0, 0, 0.99},
+		{"total is rounded to cents", 1, 100, 33.33, 0, 66.67},
+		{"full discount", 4, 25, 100, 20, 0},
+		{"free item", 5, 0, 0, 20, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lineItemTotal(tt.quantity, tt.unitPrice, tt.discount, tt.tax); got != tt.want {
+				t.Errorf("lineItemTotal = %v, want %v", got, tt.want)
+			}
+			item := LineItem{
+				Quantity:        tt.quantity,
+				UnitPrice:       sql.NullFloat64{Float64: tt.unitPrice, Valid: true},
+				DiscountPercent: tt.discount,
+				TaxPercent:      tt.tax,
+			}
+			if got := item.total(); got != tt.want {
+				t.Errorf("opportunity line item total = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineItemProblems(t *testing.T) {
+	tests := []struct {
+		name                               string
+		quantity, unitPrice, discount, tax float64
+		want                               []string
+	}{
+		{name: "valid", quantity: 1, unitPrice: 10, discount: 5, tax: 20},
+		{name: "full discount and tax are allowed", quantity: 1, unitPrice: 10, discount: 100, tax: 100},
+		{name: "free item", quantity: 1},
+		{name: "zero quantity", unitPrice: 10, want: []string{"quantity must be positive"}},
+		{name: "negative quantity", quantity: -1, unitPrice: 10, want: []string{"quantity must be positive"}},
+		{name: "negative unit price", quantity: 1, unitPrice: -0.01, want: []string{"unit price must not be negative"}},
+		{name: "negative discount", quantity: 1, discount: -1, want: []string{"discount must be between 0 and 100"}},
+		{name: "discount over 100", quantity: 1, discount: 100.01, want: []string{"discount must be between 0 and 100"}},
+		{name: "negative tax", quantity: 1, tax: -1, want: []string{"tax must be between 0 and 100"}},
+		{name: "tax over 100", quantity: 1, tax: 101, want: []string{"tax must be between 0 and 100"}},
+		{
+			name:      "every problem at once",
+			unitPrice: -1,
+			discount:  150,
+			tax:       -5,
+			want: []string{
+				"quantity must be positive",
+				"unit price must not be negative",
+				"discount must be between 0 and 100",
+				"tax must be between 0 and 100",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lineItemProblems(tt.quantity, tt.unitPrice, tt.discount, tt.tax); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lineItemProblems = %q, want %q", got, tt.want)
+			}
+
+			item := LineItem{
+				Quantity:        tt.quantity,
+				UnitPrice:       sql.NullFloat64{Float64: tt.unitPrice, Valid: true},
+				DiscountPercent: tt.discount,
+				TaxPercent:      tt.tax,
+			}
+			proposalItem := db.ProposalLineItem{
+				Description: "Licence",
+				Quantity:    tt.quantity,
+				UnitPrice:   tt.unitPrice,
+				Discount:    tt.discount,
+				TaxRate:     tt.tax,
+			}
+			itemErr, proposalErr := item.validate(), validateLineItems([]db.ProposalLineItem{proposalItem})
+			if len(tt.want) == 0 {
+				if itemErr != nil || proposalErr != nil {
+					t.Errorf("validate = %v, validateLineItems = %v, want no errors", itemErr, proposalErr)
+				}
+				return
+			}
+			problems := strings.Join(tt.want, "; ")
+			if !errors.Is(itemErr, ErrInvalidLineItem) || !strings.HasSuffix(itemErr.Error(), ": "+problems) {
+				t.Errorf("validate error = %v, want ErrInvalidLineItem with %q", itemErr, problems)
+			}
+			if !errors.Is(proposalErr, ErrInvalidProposalData) || !strings.HasSuffix(proposalErr.Error(), ": line item 1: "+problems) {
+				t.Errorf("validateLineItems error = %v, want ErrInvalidProposalData with %q", proposalErr, problems)
+			}
+		})
+	}
+}
+
+func TestValidateLineItems(t *testing.T) {
+	valid := db.ProposalLineItem{Description: "Licence", Quantity: 1, UnitPrice: 10}
+	tests := []struct {
+		name  string
+		items []db.ProposalLineItem
+		want  string // empty when the items are valid
+	}{
+		{name: "no items"},
+		{name: "valid items", items: []db.ProposalLineItem{valid, valid}},
+		{
+			name:  "description is required",
+			items: []db.ProposalLineItem{{Description: "  ", Quantity: 1}},
+			want:  "line item 1: description is required",
+		},
+		{
+			name:  "description comes before the amounts",
+			items: []db.ProposalLineItem{valid, {TaxRate: 200}},
+			want:  "line item 2: description is required; quantity must be positive; tax must be between 0 and 100",
+		},
+		{
+			name:  "first invalid item is reported",
+			items: []db.ProposalLineItem{valid, {Description: "A"}, {Description: "B", UnitPrice: -1}},
+			want:  "line item 2: quantity must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLineItems(tt.items)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("validateLineItems: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidProposalData) || !strings.HasSuffix(err.Error(), ": "+tt.want) {
+				t.Errorf("validateLineItems error = %v, want ErrInvalidProposalData with %q", err, tt.want)
+			}
+		})
+	}
+}
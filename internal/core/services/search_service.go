@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidSearch = errors.New("invalid search")
+
+// Types of records a search returns.
+const (
+	SearchTypeContact = "contact"
+	SearchTypeCompany = "company"
+	SearchTypeLead    = "lead"
+)
+
+var searchTypes = []string{SearchTypeContact, SearchTypeCompany, SearchTypeLead}
+
+// maxSearchMatches caps the matches a search pages through and counts facets
+// over. The total still counts every match.
+const maxSearchMatches = 1000
+
+type SearchServiceInterface interface {
+	Search(ctx context.Context, req SearchRequest) (*SearchResult, error)
+}
+
+type SearchService struct {
+	queries *db.Queries
+}
+
+func NewSearchService(queries *db.Queries) *SearchService {
+	return &SearchService{queries: queries}
+}
+
+// SearchRequest searches contacts, companies and leads.
+type SearchRequest struct {
+	Query string
+	// Types limits the search to some of searchTypes; empty searches all.
+	Types []string
+	// OrganizationID limits the search to one organization; contacts belong
+	// to the organization of their company.
+	OrganizationID int32
+	// Country, Industry and Status filter on the facets of the same name,
+	// ignoring case.
+	Country    string
+	Industry   string
+	Status     string
+	PageNumber int32
+	PageSize   int32
+}
+
+// FacetCount is the number of matches with a facet value.
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+// SearchFacets counts the best maxSearchMatches matches of a search by type,
+// country, industry and lead status, most frequent value first.
+type SearchFacets struct {
+	Type     []FacetCount
+	Country  []FacetCount
+	Industry []FacetCount
+	Status   []FacetCount
+}
+
+// SearchResult is a page of search matches, best first. Total counts every
+// match, including those beyond maxSearchMatches.
+type SearchResult struct {
+	Results []db.SearchRecordsRow
+	Total   int
+	Facets  SearchFacets
+}
+
+// Search finds records matching a query by full-text search, falling back
+// to trigram similarity so that misspelled terms still match. Results are
+// ranked by relevance and their matched words highlighted in HTML-escaped
+// text.
+func (s *SearchService) Search(ctx context.Context, req SearchRequest) (*SearchResult, error) {
+	query := strings.Join(strings.Fields(req.Query), " ")
+	if utf8.RuneCountInString(query) < 2 || len(query) > 200 {
+		return nil, fmt.Errorf("%w: query must be 2-200 characters", ErrInvalidSearch)
+	}
+	types, err := normalizeSearchTypes(req.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.queries.SearchRecords(ctx, db.SearchRecordsParams{
+		IncludeContacts:  types[SearchTypeContact],
+		IncludeCompanies: types[SearchTypeCompany],
+		IncludeLeads:     types[SearchTypeLead],
+		OrganizationID:   req.OrganizationID,
+		Query:            query,
+		Country:          strings.TrimSpace(req.Country),
+		Industry:         strings.TrimSpace(req.Industry),
+		Status:           strings.TrimSpace(req.Status),
+		LimitCount:       maxSearchMatches,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchResult{Facets: searchFacets(matches)}
+	if len(matches) > 0 {
+		result.Total = int(matches[0].Total)
+	}
+	limit, offset := paginate(req.PageNumber, req.PageSize)
+	if int(offset) < len(matches) {
+		end := min(int(offset)+int(limit), len(matches))
+		result.Results = matches[offset:end]
+	}
+	return result, nil
+}
+
+// ----------------- Helpers -----------------
+
+func normalizeSearchTypes(requested []string) (map[string]bool, error) {
+	types := map[string]bool{}
+	if len(requested) == 0 {
+		requested = searchTypes
+	}
+	for _, t := range requested {
+		t = strings.ToLower(strings.TrimSpace(t))
+		valid := false
+		for _, known := range searchTypes {
+			if t == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("%w: type %q is not one of %s", ErrInvalidSearch, t, strings.Join(searchTypes, ", "))
+		}
+		types[t] = true
+	}
+	return types, nil
+}
+
+func searchFacets(matches []db.SearchRecordsRow) SearchFacets {
+	byType, byCountry, byIndustry, byStatus := facetCounter{}, facetCounter{}, facetCounter{}, facetCounter{}
+	for _, m := range matches {
+		byType.add(m.EntityType)
+		byCountry.add(m.Country.String)
+		byIndustry.add(m.Industry.String)
+		byStatus.add(m.Status.String)
+	}
+	return SearchFacets{
+		Type:     byType.counts(),
+		Country:  byCountry.counts(),
+		Industry: byIndustry.counts(),
+		Status:   byStatus.counts(),
+	}
+}
+
+// facetCounter counts facet values case-insensitively, keeping the first
+// spelling seen. Empty values are not counted.
+type facetCounter map[string]*FacetCount
+
+func (c facetCounter) add(value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	key := strings.ToLower(value)
+	if f, ok := c[key]; ok {
+		f.Count++
+		return
+	}
+	c[key] = &FacetCount{Value: value, Count: 1}
+}
+
+func (c facetCounter) counts() []FacetCount {
+	counts := make([]FacetCount, 0, len(c))
+	for _, f := range c {
+		counts = append(counts, *f)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Value < counts[j].Value
+	})
+	return counts
+}
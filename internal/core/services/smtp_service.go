@@ -0,0 +1,340 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/adapters/notify"
+	"crm/internal/adapters/secrets"
+	"crm/internal/core/filter"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrSMTPNotFound          = errors.New("smtp credentials not found")
+	ErrInvalidSMTPData       = errors.New("invalid smtp credentials")
+	ErrSMTPExists            = errors.New("user already has smtp credentials")
+	ErrEncryptionUnavailable = errors.New("no encryption key configured for secrets")
+	ErrSMTPTestFailed        = errors.New("smtp test failed")
+)
+
+const (
+	smtpTestTimeout   = 10 * time.Second
+	smtpRotationBatch = 100
+)
+
+// SMTPSettings is the caller-supplied side of a stored SMTP credential.
+type SMTPSettings struct {
+	UserID    string
+	Host      string
+	Port      int32
+	Username  string
+	Password  string
+	FromEmail string
+}
+
+type SMTPServiceInterface interface {
+	CreateSMTP(ctx context.Context, settings SMTPSettings) (*db.SmtpCredential, error)
+	GetSMTP(ctx context.Context, id int32) (*db.SmtpCredential, error)
+	UpdateSMTP(ctx context.Context, id int32, settings SMTPSettings) (*db.SmtpCredential, error)
+	DeleteSMTP(ctx context.Context, id int32) error
+	ListSMTP(ctx context.Context, opts ListOptions) (*Page[db.SmtpCredential], error)
+	TestSMTP(ctx context.Context, id int32) (time.Duration, error)
+	RotateKeys(ctx context.Context) (int, error)
+}
+
+// SMTPService stores per-user SMTP credentials with the password encrypted
+// by keyring. It also serves those credentials to the email sender.
+type SMTPService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	keyring *secrets.Keyring
+	kafka   *kafka.Producer
+}
+
+// NewSMTPService builds the service. keyring may be nil when no keys are
+// configured, in which case nothing can be stored.
+func NewSMTPService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer, keyring *secrets.Keyring) *SMTPService {
+	return &SMTPService{conn: conn, queries: queries, keyring: keyring, kafka: producer}
+}
+
+// CreateSMTP stores credentials for a user who has none yet.
+func (s *SMTPService) CreateSMTP(ctx context.Context, settings SMTPSettings) (*db.SmtpCredential, error) {
+	if strings.TrimSpace(settings.UserID) == "" || settings.Password == "" {
+		return nil, ErrInvalidSMTPData
+	}
+	if err := validateSMTPSettings(settings); err != nil {
+		return nil, err
+	}
+	if s.keyring == nil {
+		return nil, ErrEncryptionUnavailable
+	}
+	if _, err := s.queries.GetSMTPCredentialByUser(ctx, settings.UserID); err == nil {
+		return nil, ErrSMTPExists
+	}
+
+	sealed, err := s.keyring.Seal([]byte(settings.Password))
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.queries.CreateSMTPCredential(ctx, db.CreateSMTPCredentialParams{
+		UserID:             settings.UserID,
+		SmtpHost:           settings.Host,
+		SmtpPort:           settings.Port,
+		SmtpUsername:       settings.Username,
+		FromEmail:          settings.FromEmail,
+		KeyID:              sealed.KeyID,
+		WrappedKey:         sealed.WrappedKey,
+		PasswordCiphertext: sealed.Ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicSMTPCreated, "smtp_created", smtpEvent(&created))
+
+	return &created, nil
+}
+
+// GetSMTP retrieves stored credentials by ID.
+func (s *SMTPService) GetSMTP(ctx context.Context, id int32) (*db.SmtpCredential, error) {
+	credential, err := s.queries.GetSMTPCredential(ctx, id)
+	if err != nil {
+		return nil, ErrSMTPNotFound
+	}
+	return &credential, nil
+}
+
+// UpdateSMTP changes the server settings. An empty password keeps the
+// stored one; otherwise it is re-encrypted with the primary key.
+func (s *SMTPService) UpdateSMTP(ctx context.Context, id int32, settings SMTPSettings) (*db.SmtpCredential, error) {
+	if err := validateSMTPSettings(settings); err != nil {
+		return nil, err
+	}
+	if settings.Password != "" && s.keyring == nil {
+		return nil, ErrEncryptionUnavailable
+	}
+
+	var sealed secrets.Sealed
+	if settings.Password != "" {
+		var err error
+		if sealed, err = s.keyring.Seal([]byte(settings.Password)); err != nil {
+			return nil, err
+		}
+	}
+
+	var updated db.SmtpCredential
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		var err error
+		updated, err = q.UpdateSMTPCredential(ctx, db.UpdateSMTPCredentialParams{
+			ID:           id,
+			SmtpHost:     settings.Host,
+			SmtpPort:     settings.Port,
+			SmtpUsername: settings.Username,
+			FromEmail:    settings.FromEmail,
+		})
+		if err != nil {
+			return ErrSMTPNotFound
+		}
+
+		if settings.Password == "" {
+			return nil
+		}
+		if err := q.UpdateSMTPCredentialSecret(ctx, db.UpdateSMTPCredentialSecretParams{
+			ID:                 id,
+			KeyID:              sealed.KeyID,
+			WrappedKey:         sealed.WrappedKey,
+			PasswordCiphertext: sealed.Ciphertext,
+		}); err != nil {
+			return err
+		}
+		updated.KeyID, updated.WrappedKey, updated.PasswordCiphertext = sealed.KeyID, sealed.WrappedKey, sealed.Ciphertext
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicSMTPUpdated, "smtp_updated", smtpEvent(&updated))
+
+	return &updated, nil
+}
+
+// DeleteSMTP removes stored credentials.
+func (s *SMTPService) DeleteSMTP(ctx context.Context, id int32) error {
+	n, err := s.queries.DeleteSMTPCredential(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrSMTPNotFound
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicSMTPDeleted, "smtp_deleted", map[string]interface{}{
+		"id": id,
+	})
+
+	return nil
+}
+
+// ListSMTP returns a filtered, sorted page of SMTP credentials.
+func (s *SMTPService) ListSMTP(ctx context.Context, opts ListOptions) (*Page[db.SmtpCredential], error) {
+	return listPage(ctx, filter.SMTPCredentials, opts, s.queries.FilterSMTPCredentials, s.queries.CountSMTPCredentials)
+}
+
+// TestSMTP connects to the stored server, negotiates STARTTLS when offered
+// and authenticates, then hangs up without sending mail. It returns how long
+// the handshake took.
+func (s *SMTPService) TestSMTP(ctx context.Context, id int32) (time.Duration, error) {
+	credential, err := s.queries.GetSMTPCredential(ctx, id)
+	if err != nil {
+		return 0, ErrSMTPNotFound
+	}
+	creds, err := s.decrypt(credential)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, smtpTestTimeout)
+	defer cancel()
+
+	start := time.Now()
+	client, err := notify.DialSMTP(ctx, creds, smtpTestTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrSMTPTestFailed, err)
+	}
+	defer client.Close()
+	if err := client.Quit(); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrSMTPTestFailed, err)
+	}
+	return time.Since(start), nil
+}
+
+// RotateKeys re-encrypts every stored password that is not under the
+// primary key and returns how many were rotated. Rows whose key is no
+// longer configured are skipped and reported in the error; rows updated
+// while they are rotated are left as they are.
+func (s *SMTPService) RotateKeys(ctx context.Context) (int, error) {
+	if s.keyring == nil {
+		return 0, ErrEncryptionUnavailable
+	}
+
+	var (
+		rotated int
+		afterID int32
+		failed  []string
+	)
+	for {
+		batch, err := s.queries.ListSMTPCredentialsNotUsingKey(ctx, db.ListSMTPCredentialsNotUsingKeyParams{
+			KeyID:      s.keyring.Primary(),
+			AfterID:    afterID,
+			LimitCount: smtpRotationBatch,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, credential := range batch {
+			afterID = credential.ID
+			sealed, err := s.keyring.Reseal(sealedPassword(credential))
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%d (%v)", credential.ID, err))
+				continue
+			}
+			n, err := s.queries.RotateSMTPCredentialSecret(ctx, db.RotateSMTPCredentialSecretParams{
+				KeyID:              sealed.KeyID,
+				WrappedKey:         sealed.WrappedKey,
+				PasswordCiphertext: sealed.Ciphertext,
+				ID:                 credential.ID,
+				OldKeyID:           credential.KeyID,
+				OldWrappedKey:      credential.WrappedKey,
+			})
+			if err != nil {
+				return rotated, err
+			}
+			if n == 0 {
+				// The password changed since the batch was read and is
+				// already sealed under the primary key.
+				continue
+			}
+			rotated++
+		}
+	}
+
+	if len(failed) > 0 {
+		return rotated, fmt.Errorf("could not rotate smtp credentials %s", strings.Join(failed, ", "))
+	}
+	return rotated, nil
+}
+
+// SMTPCredentials implements notify.CredentialStore for the email sender.
+func (s *SMTPService) SMTPCredentials(ctx context.Context, userID string) (notify.SMTPCredentials, error) {
+	if userID == "" {
+		return notify.SMTPCredentials{}, notify.ErrNoCredentials
+	}
+	credential, err := s.queries.GetSMTPCredentialByUser(ctx, userID)
+	if err != nil {
+		return notify.SMTPCredentials{}, notify.ErrNoCredentials
+	}
+	return s.decrypt(credential)
+}
+
+// ----------------- Helpers -----------------
+
+func (s *SMTPService) decrypt(credential db.SmtpCredential) (notify.SMTPCredentials, error) {
+	if s.keyring == nil {
+		return notify.SMTPCredentials{}, ErrEncryptionUnavailable
+	}
+	password, err := s.keyring.Open(sealedPassword(credential))
+	if err != nil {
+		return notify.SMTPCredentials{}, fmt.Errorf("smtp credentials %d: %w", credential.ID, err)
+	}
+	return notify.SMTPCredentials{
+		Host:     credential.SmtpHost,
+		Port:     credential.SmtpPort,
+		Username: credential.SmtpUsername,
+		Password: string(password),
+		From:     credential.FromEmail,
+	}, nil
+}
+
+func sealedPassword(credential db.SmtpCredential) secrets.Sealed {
+	return secrets.Sealed{
+		KeyID:      credential.KeyID,
+		WrappedKey: credential.WrappedKey,
+		Ciphertext: credential.PasswordCiphertext,
+	}
+}
+
+func validateSMTPSettings(settings SMTPSettings) error {
+	switch {
+	case strings.TrimSpace(settings.Host) == "":
+		return fmt.Errorf("%w: smtp_host is required", ErrInvalidSMTPData)
+	case settings.Port <= 0 || settings.Port > 65535:
+		return fmt.Errorf("%w: smtp_port must be between 1 and 65535", ErrInvalidSMTPData)
+	case !isValidEmail(settings.FromEmail):
+		return fmt.Errorf("%w: from_email is not an email address", ErrInvalidSMTPData)
+	}
+	return nil
+}
+
+// smtpEvent describes credentials for Kafka without any secret material.
+func smtpEvent(credential *db.SmtpCredential) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         credential.ID,
+		"user_id":    credential.UserID,
+		"smtp_host":  credential.SmtpHost,
+		"smtp_port":  credential.SmtpPort,
+		"from_email": credential.FromEmail,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crm/internal/adapters/database/db"
 	"crm/internal/adapters/kafka"
+	"crm/internal/core/filter"
 	"database/sql"
 	"errors"
 	"time"
@@ -21,7 +22,7 @@ type TaskService interface {
 	GetTask(ctx context.Context, id int32) (*db.Task, error)
 	UpdateTask(ctx context.Context, params db.UpdateTaskParams) (*db.Task, error)
 	DeleteTask(ctx context.Context, id int32) error
-	ListTasks(ctx context.Context, pageNumber, pageSize uint) ([]db.Task, error)
+	ListTasks(ctx context.Context, activityID int32, opts ListOptions) (*Page[db.Task], error)
 }
 
 type taskService struct {
@@ -153,22 +154,12 @@ func (s *taskService) DeleteTask(ctx context.Context, id int32) error {
 	return nil
 }
 
-// ListTasks retrieves tasks with pagination (basic since SQL is fixed).
-func (s *taskService) ListTasks(ctx context.Context, pageNumber, pageSize uint) ([]db.Task, error) {
-	if pageNumber == 0 {
-		pageNumber = 1
+// ListTasks retrieves a filtered, sorted page of tasks, of one activity
+// unless activityID is 0.
+func (s *taskService) ListTasks(ctx context.Context, activityID int32, opts ListOptions) (*Page[db.Task], error) {
+	var conditions []filter.Condition
+	if activityID != 0 {
+		conditions = append(conditions, filter.Eq("activity_id", activityID))
 	}
-	if pageSize == 0 {
-		pageSize = 10
-	}
-	offset := (pageNumber - 1) * pageSize
-
-	tasks, err := s.queries.ListTasks(ctx, db.ListTasksParams{
-		Limit:  int32(pageSize),
-		Offset: int32(offset),
-	})
-	if err != nil {
-		return nil, err
-	}
-	return tasks, nil
+	return listPage(ctx, filter.Tasks, opts, s.queries.FilterTasks, s.queries.CountTasks, filter.Where(conditions...))
 }
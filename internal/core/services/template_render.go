@@ -0,0 +1,226 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"regexp"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+)
+
+var templateVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// templateBody is one body of a template version, named for error messages.
+type templateBody struct {
+	field string
+	text  string
+	html  bool
+}
+
+// parseTemplateBody parses text as an html/template or text/template and
+// returns the trees of every template it defines by name. The body itself
+// is named body.field.
+func parseTemplateBody(body templateBody) (map[string]*parse.Tree, error) {
+	trees := map[string]*parse.Tree{}
+	if body.html {
+		t, err := htmltemplate.New(body.field).Option("missingkey=error").Parse(body.text)
+		if err != nil {
+			return nil, err
+		}
+		for _, sub := range t.Templates() {
+			if sub.Tree != nil {
+				trees[sub.Name()] = sub.Tree
+			}
+		}
+		return trees, nil
+	}
+
+	t, err := texttemplate.New(body.field).Option("missingkey=error").Parse(body.text)
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range t.Templates() {
+		if sub.Tree != nil {
+			trees[sub.Name()] = sub.Tree
+		}
+	}
+	return trees, nil
+}
+
+// executeTemplateBody renders body with data. A variable missing from data
+// is an error rather than "<no value>".
+func executeTemplateBody(body templateBody, data map[string]string) (string, error) {
+	var buf bytes.Buffer
+	if body.html {
+		t, err := htmltemplate.New(body.field).Option("missingkey=error").Parse(body.text)
+		if err != nil {
+			return "", err
+		}
+		if err := t.Execute(&buf, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+
+	t, err := texttemplate.New(body.field).Option("missingkey=error").Parse(body.text)
+	if err != nil {
+		return "", err
+	}
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// validateTemplateBodies checks that every body parses, references only the
+// declared variables and renders with the sample values.
+func validateTemplateBodies(bodies []templateBody, variables map[string]string) error {
+	var problems []string
+	for name := range variables {
+		if !templateVariablePattern.MatchString(name) {
+			problems = append(problems, fmt.Sprintf("variable %q is not a valid identifier", name))
+		}
+	}
+
+	for _, body := range bodies {
+		if body.text == "" {
+			continue
+		}
+		trees, err := parseTemplateBody(body)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", body.field, err))
+			continue
+		}
+
+		undefined := map[string]bool{}
+		walkTemplateFields(trees, body.field, func(name string) {
+			if _, ok := variables[name]; !ok {
+				undefined[name] = true
+			}
+		})
+		if len(undefined) > 0 {
+			names := make([]string, 0, len(undefined))
+			for name := range undefined {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			problems = append(problems, fmt.Sprintf("%s: undefined variables %s", body.field, strings.Join(names, ", ")))
+			continue
+		}
+
+		if _, err := executeTemplateBody(body, variables); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", body.field, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidTemplate, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// walkTemplateFields calls add for every top-level variable the template
+// name reads, i.e. {{.name}} and {{$.name}} where dot and $ hold the
+// template data. Inside range blocks, and with blocks over anything but
+// the data itself, dot is rebound, so only $.name counts there. Templates
+// are followed where {{template}} invokes them: dot and $ inside hold the
+// value passed, so their fields are top-level only when that is the data.
+// Defined templates that are never invoked are not walked.
+func walkTemplateFields(trees map[string]*parse.Tree, name string, add func(string)) {
+	w := &templateFieldWalker{trees: trees, add: add, called: map[templateCall]bool{}}
+	w.call(name, true)
+}
+
+// templateCall is a template invoked with (top true) or without the
+// template data as dot.
+type templateCall struct {
+	name string
+	top  bool
+}
+
+type templateFieldWalker struct {
+	trees  map[string]*parse.Tree
+	add    func(string)
+	called map[templateCall]bool
+}
+
+// call walks a template once per kind of dot, which also ends recursive
+// templates.
+func (w *templateFieldWalker) call(name string, top bool) {
+	tree, key := w.trees[name], templateCall{name: name, top: top}
+	if tree == nil || w.called[key] {
+		return
+	}
+	w.called[key] = true
+	w.walk(tree.Root, top, top)
+}
+
+// walk visits node. dot and dollar report whether . and $ hold the template
+// data there.
+func (w *templateFieldWalker) walk(node parse.Node, dot, dollar bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			w.walk(child, dot, dollar)
+		}
+	case *parse.ActionNode:
+		w.walk(n.Pipe, dot, dollar)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			w.walk(cmd, dot, dollar)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			w.walk(arg, dot, dollar)
+		}
+	case *parse.ChainNode:
+		w.walk(n.Node, dot, dollar)
+	case *parse.FieldNode:
+		if dot {
+			w.add(n.Ident[0])
+		}
+	case *parse.VariableNode:
+		if dollar && len(n.Ident) > 1 && n.Ident[0] == "$" {
+			w.add(n.Ident[1])
+		}
+	case *parse.IfNode:
+		w.walk(n.Pipe, dot, dollar)
+		w.walk(n.List, dot, dollar)
+		w.walk(n.ElseList, dot, dollar)
+	case *parse.RangeNode:
+		w.walk(n.Pipe, dot, dollar)
+		w.walk(n.List, false, dollar)
+		w.walk(n.ElseList, dot, dollar)
+	case *parse.WithNode:
+		w.walk(n.Pipe, dot, dollar)
+		w.walk(n.List, isTemplateData(n.Pipe, dot, dollar), dollar)
+		w.walk(n.ElseList, dot, dollar)
+	case *parse.TemplateNode:
+		w.walk(n.Pipe, dot, dollar)
+		w.call(n.Name, isTemplateData(n.Pipe, dot, dollar))
+	}
+}
+
+// isTemplateData reports whether pipe evaluates to the template data: it
+// is exactly . where dot holds the data, or $ where $ does.
+func isTemplateData(pipe *parse.PipeNode, dot, dollar bool) bool {
+	if pipe == nil || len(pipe.Decl) > 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.DotNode:
+		return dot
+	case *parse.VariableNode:
+		return dollar && len(arg.Ident) == 1 && arg.Ident[0] == "$"
+	}
+	return false
+}
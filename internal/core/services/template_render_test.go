@@ -0,0 +1,201 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestWalkTemplateFields(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "fields",
+			text: "Hi {{.first_name}} {{.last_name}}",
+			want: []string{"first_name", "last_name"},
+		},
+		{
+			name: "pipelines and arguments",
+			text: `{{.name | printf "%s"}} {{printf "%s %s" .a .b}} {{(.c).d}}`,
+			want: []string{"a", "b", "c", "name"},
+		},
+		{
+			name: "if and else",
+			text: "{{if .vip}}VIP{{else}}{{.name}}{{end}}",
+			want: []string{"name", "vip"},
+		},
+		{
+			name: "declared variables",
+			text: "{{$n := .name}}{{$n}}",
+			want: []string{"name"},
+		},
+		{
+			name: "range rebinds dot",
+			text: "{{range .items}}{{.title}} for {{$.name}}{{else}}{{.empty}}{{end}}",
+			want: []string{"empty", "items", "name"},
+		},
+		{
+			name: "with rebinds dot",
+			text: "{{with .company}}{{.name}} {{$.owner}}{{else}}{{.fallback}}{{end}}",
+			want: []string{"company", "fallback", "owner"},
+		},
+		{
+			name: "with over the data keeps dot",
+			text: "{{with $}}{{.a}}{{end}}{{with .}}{{.b}}{{end}}{{range .items}}{{with $}}{{.c}}{{end}}{{end}}",
+			want: []string{"a", "b", "c", "items"},
+		},
+		{
+			name: "define invoked with the data",
+			text: `{{define "sig"}}{{.sender}}{{end}}Hi {{.name}}{{template "sig" .}}`,
+			want: []string{"name", "sender"},
+		},
+		{
+			name: "define invoked with $ inside range",
+			text: `{{define "sig"}}{{.sender}}{{end}}{{range .items}}{{template "sig" $}}{{end}}`,
+			want: []string{"items", "sender"},
+		},
+		{
+			name: "define invoked with another dot",
+			text: `{{define "row"}}{{.title}} {{$.title}}{{end}}{{template "row" .first}}{{range .items}}{{template "row" .}}{{end}}`,
+			want: []string{"first", "items"},
+		},
+		{
+			name: "define invoked both ways",
+			text: `{{define "row"}}{{.title}}{{end}}{{template "row" .first}}{{template "row" .}}`,
+			want: []string{"first", "title"},
+		},
+		{
+			name: "define invoked without a dot",
+			text: `{{define "row"}}{{.title}}{{end}}{{template "row"}}`,
+		},
+		{
+			name: "define never invoked",
+			text: `{{define "unused"}}{{.secret}}{{end}}{{.name}}`,
+			want: []string{"name"},
+		},
+		{
+			name: "nested defines pass dot along",
+			text: `{{define "a"}}{{template "b" .}}{{end}}{{define "b"}}{{.name}}{{end}}{{template "a" .}}{{template "a" .other}}`,
+			want: []string{"name", "other"},
+		},
+		{
+			name: "block",
+			text: `{{block "greeting" .}}Hi {{.name}}{{end}}`,
+			want: []string{"name"},
+		},
+		{
+			name: "recursive define",
+			text: `{{define "tree"}}{{.label}}{{range .children}}{{template "tree" .}}{{end}}{{end}}{{template "tree" .root}}{{template "tree" .}}`,
+			want: []string{"children", "label", "root"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trees, err := parseTemplateBody(templateBody{field: "body", text: tt.text})
+			if err != nil {
+				t.Fatalf("parseTemplateBody: %v", err)
+			}
+			seen := map[string]bool{}
+			walkTemplateFields(trees, "body", func(name string) { seen[name] = true })
+			var got []string
+			for name := range seen {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fields = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTemplateBodies(t *testing.T) {
+	variables := map[string]string{"name": "Jane", "company": "Acme"}
+	text := func(s string) templateBody { return templateBody{field: "email_text", text: s} }
+	html := func(s string) templateBody { return templateBody{field: "email_html", text: s, html: true} }
+
+	tests := []struct {
+		name      string
+		bodies    []templateBody
+		variables map[string]string
+		problems  []string // empty when the bodies are valid
+	}{
+		{
+			name:   "valid text and html bodies",
+			bodies: []templateBody{text("Hi {{.name}}"), html("<p>{{.name}} at {{.company}}</p>")},
+		},
+		{
+			name:   "empty bodies are skipped",
+			bodies: []templateBody{text(""), html("")},
+		},
+		{
+			name:   "define invoked with a variable",
+			bodies: []templateBody{html(`{{define "b"}}<b>{{.}}</b>{{end}}{{template "b" .name}} at {{.company}}`)},
+		},
+		{
+			name:      "invalid variable name",
+			bodies:    []templateBody{text("Hi")},
+			variables: map[string]string{"first-name": "Jane"},
+			problems:  []string{`variable "first-name" is not a valid identifier`},
+		},
+		{
+			name:     "parse error",
+			bodies:   []templateBody{text("Hi {{.name")},
+			problems: []string{"email_text: template: email_text:1: unclosed action"},
+		},
+		{
+			name:     "undefined variables are sorted",
+			bodies:   []templateBody{text("{{.zip}} {{.age}} {{range .items}}{{.ignored}}{{end}}")},
+			problems: []string{"email_text: undefined variables age, items, zip"},
+		},
+		{
+			name:     "undefined variables inside invoked defines",
+			bodies:   []templateBody{html(`{{define "sig"}}{{.sender}}{{end}}{{range .name}}{{template "sig" $}}{{end}}`)},
+			problems: []string{"email_html: undefined variables sender"},
+		},
+		{
+			name:   "fields of a define invoked with a variable are not variables",
+			bodies: []templateBody{text(`{{define "row"}}{{.title}}{{end}}{{template "row" .name}}`)},
+			problems: []string{
+				`email_text: template: email_text:1:18: executing "row" at <.title>: can't evaluate field title in type string`,
+			},
+		},
+		{
+			name:   "every body is checked",
+			bodies: []templateBody{text("{{.missing}}"), html("{{.name"), text("{{.name}}")},
+			problems: []string{
+				"email_text: undefined variables missing",
+				"email_html: template: email_html:1: unclosed action",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars := tt.variables
+			if vars == nil {
+				vars = variables
+			}
+			err := validateTemplateBodies(tt.bodies, vars)
+			if len(tt.problems) == 0 {
+				if err != nil {
+					t.Fatalf("validateTemplateBodies: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidTemplate) {
+				t.Fatalf("validateTemplateBodies error = %v, want ErrInvalidTemplate", err)
+			}
+			want := ErrInvalidTemplate.Error() + ": " + strings.Join(tt.problems, "; ")
+			if err.Error() != want {
+				t.Errorf("validateTemplateBodies error =\n  %q\nwant\n  %q", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,418 @@
+package services
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/adapters/kafka"
+	"crm/internal/adapters/notify"
+	"crm/internal/core/filter"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrTemplateNotFound    = errors.New("template not found")
+	ErrInvalidTemplate     = errors.New("invalid template")
+	ErrTemplateChannel     = errors.New("template has no body for channel")
+	ErrMissingTemplateData = errors.New("missing template data")
+)
+
+// TemplateContent is everything stored in one template version. Variables
+// maps each variable the bodies may reference to a sample value.
+type TemplateContent struct {
+	EmailSubject string
+	EmailHTML    string
+	EmailText    string
+	SMSText      string
+	InAppText    string
+	Variables    map[string]string
+}
+
+// TemplateDetails bundles a template with one of its versions.
+type TemplateDetails struct {
+	Template db.NotificationTemplate
+	Version  db.TemplateVersion
+	Content  TemplateContent
+	Channels []string
+}
+
+// RenderedTemplate is a template version rendered for one channel.
+type RenderedTemplate struct {
+	Version int32
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type TemplateServiceInterface interface {
+	CreateTemplate(ctx context.Context, name string, url sql.NullString, channels []string, content TemplateContent) (*TemplateDetails, error)
+	GetTemplate(ctx context.Context, id, version int32) (*TemplateDetails, error)
+	UpdateTemplate(ctx context.Context, id int32, name string, url sql.NullString, channels []string, content *TemplateContent) (*TemplateDetails, error)
+	ListTemplates(ctx context.Context, opts ListOptions) (*Page[TemplateDetails], error)
+	PreviewTemplate(ctx context.Context, id int32, name string, version int32, channel string, data map[string]string) (*RenderedTemplate, error)
+	Render(ctx context.Context, templateName, channel string, version int32, data map[string]string) (*RenderedTemplate, error)
+}
+
+type TemplateService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	kafka   *kafka.Producer
+}
+
+func NewTemplateService(conn *sql.DB, queries *db.Queries, producer *kafka.Producer) *TemplateService {
+	return &TemplateService{conn: conn, queries: queries, kafka: producer}
+}
+
+// CreateTemplate validates the bodies and stores them as version 1.
+func (s *TemplateService) CreateTemplate(ctx context.Context, name string, url sql.NullString, channels []string, content TemplateContent) (*TemplateDetails, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	}
+	if err := validateTemplateContent(channels, content); err != nil {
+		return nil, err
+	}
+
+	var details *TemplateDetails
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		if _, err := q.GetTemplateByName(ctx, name); err == nil {
+			return fmt.Errorf("%w: a template named %q already exists", ErrInvalidTemplate, name)
+		}
+
+		created, err := q.CreateTemplate(ctx, db.CreateTemplateParams{Name: name, Url: url})
+		if err != nil {
+			return err
+		}
+		version, err := createTemplateVersion(ctx, q, created.ID, created.CurrentVersion, content)
+		if err != nil {
+			return err
+		}
+		details = newTemplateDetails(created, version, content)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicTemplateCreated, "template_created", templateEvent(details))
+
+	return details, nil
+}
+
+// GetTemplate returns a template with the given version, or its current
+// version when version is 0.
+func (s *TemplateService) GetTemplate(ctx context.Context, id, version int32) (*TemplateDetails, error) {
+	template, err := s.queries.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, ErrTemplateNotFound
+	}
+	return s.loadVersion(ctx, template, version)
+}
+
+// UpdateTemplate renames a template and, when content is non-nil, saves the
+// content as a new version that becomes current. Earlier versions are kept
+// so past notifications can be re-rendered exactly.
+func (s *TemplateService) UpdateTemplate(ctx context.Context, id int32, name string, url sql.NullString, channels []string, content *TemplateContent) (*TemplateDetails, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	}
+	if content != nil {
+		if err := validateTemplateContent(channels, *content); err != nil {
+			return nil, err
+		}
+	}
+
+	var details *TemplateDetails
+	err := withTx(ctx, s.conn, s.queries, func(q *db.Queries) error {
+		current, err := q.GetTemplateForUpdate(ctx, id)
+		if err != nil {
+			return ErrTemplateNotFound
+		}
+		if other, err := q.GetTemplateByName(ctx, name); err == nil && other.ID != id {
+			return fmt.Errorf("%w: a template named %q already exists", ErrInvalidTemplate, name)
+		}
+
+		nextVersion := current.CurrentVersion
+		if content != nil {
+			nextVersion++
+		}
+		updated, err := q.UpdateTemplate(ctx, db.UpdateTemplateParams{
+			ID:             id,
+			Name:           name,
+			Url:            url,
+			CurrentVersion: nextVersion,
+		})
+		if err != nil {
+			return err
+		}
+
+		var version db.TemplateVersion
+		if content != nil {
+			version, err = createTemplateVersion(ctx, q, id, nextVersion, *content)
+		} else {
+			version, err = q.GetTemplateVersion(ctx, db.GetTemplateVersionParams{TemplateID: id, Version: nextVersion})
+		}
+		if err != nil {
+			return err
+		}
+
+		stored, err := decodeTemplateContent(version)
+		if err != nil {
+			return err
+		}
+		details = newTemplateDetails(updated, version, stored)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Kafka event
+	_ = s.kafka.Publish(ctx, kafka.TopicTemplateUpdated, "template_updated", templateEvent(details))
+
+	return details, nil
+}
+
+// ListTemplates returns a filtered, sorted page of templates at their
+// current versions, by name by default.
+func (s *TemplateService) ListTemplates(ctx context.Context, opts ListOptions) (*Page[TemplateDetails], error) {
+	page, err := listPage(ctx, filter.Templates, opts, s.queries.FilterTemplates, s.queries.CountTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPage(page, func(t db.NotificationTemplate) (TemplateDetails, error) {
+		details, err := s.loadVersion(ctx, t, 0)
+		if err != nil {
+			return TemplateDetails{}, err
+		}
+		return *details, nil
+	})
+}
+
+// PreviewTemplate renders a template, found by id or else by name, for one
+// channel with data laid over the version's sample values.
+func (s *TemplateService) PreviewTemplate(ctx context.Context, id int32, name string, version int32, channel string, data map[string]string) (*RenderedTemplate, error) {
+	var (
+		template db.NotificationTemplate
+		err      error
+	)
+	if id != 0 {
+		template, err = s.queries.GetTemplate(ctx, id)
+	} else {
+		template, err = s.queries.GetTemplateByName(ctx, name)
+	}
+	if err != nil {
+		return nil, ErrTemplateNotFound
+	}
+
+	details, err := s.loadVersion(ctx, template, version)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]string, len(details.Content.Variables)+len(data))
+	for k, v := range details.Content.Variables {
+		merged[k] = v
+	}
+	for k, v := range data {
+		merged[k] = v
+	}
+	return renderTemplateVersion(details, channel, merged)
+}
+
+// Render renders the named template for channel with data. version 0 uses
+// the current version; the version used is returned so a send can be
+// reproduced later.
+func (s *TemplateService) Render(ctx context.Context, templateName, channel string, version int32, data map[string]string) (*RenderedTemplate, error) {
+	template, err := s.queries.GetTemplateByName(ctx, templateName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrTemplateNotFound, templateName)
+	}
+
+	details, err := s.loadVersion(ctx, template, version)
+	if err != nil {
+		return nil, err
+	}
+	return renderTemplateVersion(details, channel, data)
+}
+
+// ----------------- Helpers -----------------
+
+func (s *TemplateService) loadVersion(ctx context.Context, template db.NotificationTemplate, version int32) (*TemplateDetails, error) {
+	if version == 0 {
+		version = template.CurrentVersion
+	}
+	row, err := s.queries.GetTemplateVersion(ctx, db.GetTemplateVersionParams{
+		TemplateID: template.ID,
+		Version:    version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: version %d", ErrTemplateNotFound, version)
+	}
+
+	content, err := decodeTemplateContent(row)
+	if err != nil {
+		return nil, err
+	}
+	return newTemplateDetails(template, row, content), nil
+}
+
+func validateTemplateContent(channels []string, content TemplateContent) error {
+	available := templateChannels(content)
+	if len(available) == 0 {
+		return fmt.Errorf("%w: at least one channel body is required", ErrInvalidTemplate)
+	}
+	for _, channel := range channels {
+		switch channel {
+		case notify.ChannelEmail, notify.ChannelSMS, notify.ChannelInApp:
+		default:
+			return fmt.Errorf("%w: unknown channel %q", ErrInvalidTemplate, channel)
+		}
+		if !containsString(available, channel) {
+			return fmt.Errorf("%w: channel %q has no body", ErrInvalidTemplate, channel)
+		}
+	}
+	if (content.EmailHTML != "" || content.EmailText != "") && strings.TrimSpace(content.EmailSubject) == "" {
+		return fmt.Errorf("%w: email_subject is required for email bodies", ErrInvalidTemplate)
+	}
+
+	return validateTemplateBodies(templateBodies(content), content.Variables)
+}
+
+func templateBodies(content TemplateContent) []templateBody {
+	return []templateBody{
+		{field: "email_subject", text: content.EmailSubject},
+		{field: "email_html", text: content.EmailHTML, html: true},
+		{field: "email_text", text: content.EmailText},
+		{field: "sms_text", text: content.SMSText},
+		{field: "in_app_text", text: content.InAppText},
+	}
+}
+
+// templateChannels lists the channels content has a body for.
+func templateChannels(content TemplateContent) []string {
+	var channels []string
+	if content.EmailHTML != "" || content.EmailText != "" {
+		channels = append(channels, notify.ChannelEmail)
+	}
+	if content.SMSText != "" {
+		channels = append(channels, notify.ChannelSMS)
+	}
+	if content.InAppText != "" {
+		channels = append(channels, notify.ChannelInApp)
+	}
+	return channels
+}
+
+func renderTemplateVersion(details *TemplateDetails, channel string, data map[string]string) (*RenderedTemplate, error) {
+	if !containsString(details.Channels, channel) {
+		return nil, fmt.Errorf("%w: %q", ErrTemplateChannel, channel)
+	}
+
+	content := details.Content
+	rendered := &RenderedTemplate{Version: details.Version.Version}
+
+	type target struct {
+		body templateBody
+		dst  *string
+	}
+	var targets []target
+	switch channel {
+	case notify.ChannelEmail:
+		targets = []target{
+			{templateBody{field: "email_subject", text: content.EmailSubject}, &rendered.Subject},
+			{templateBody{field: "email_html", text: content.EmailHTML, html: true}, &rendered.HTML},
+			{templateBody{field: "email_text", text: content.EmailText}, &rendered.Text},
+		}
+	case notify.ChannelSMS:
+		targets = []target{
+			{templateBody{field: "sms_text", text: content.SMSText}, &rendered.Text},
+		}
+	case notify.ChannelInApp:
+		targets = []target{
+			{templateBody{field: "email_subject", text: content.EmailSubject}, &rendered.Subject},
+			{templateBody{field: "in_app_text", text: content.InAppText}, &rendered.Text},
+		}
+	}
+
+	for _, t := range targets {
+		if t.body.text == "" {
+			continue
+		}
+		out, err := executeTemplateBody(t.body, data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrMissingTemplateData, t.body.field, err)
+		}
+		*t.dst = out
+	}
+	return rendered, nil
+}
+
+func createTemplateVersion(ctx context.Context, q *db.Queries, templateID, version int32, content TemplateContent) (db.TemplateVersion, error) {
+	variables := content.Variables
+	if variables == nil {
+		variables = map[string]string{}
+	}
+	encoded, err := json.Marshal(variables)
+	if err != nil {
+		return db.TemplateVersion{}, err
+	}
+
+	return q.CreateTemplateVersion(ctx, db.CreateTemplateVersionParams{
+		TemplateID:   templateID,
+		Version:      version,
+		EmailSubject: content.EmailSubject,
+		EmailHtml:    content.EmailHTML,
+		EmailText:    content.EmailText,
+		SmsText:      content.SMSText,
+		InAppText:    content.InAppText,
+		Variables:    encoded,
+	})
+}
+
+func decodeTemplateContent(version db.TemplateVersion) (TemplateContent, error) {
+	content := TemplateContent{
+		EmailSubject: version.EmailSubject,
+		EmailHTML:    version.EmailHtml,
+		EmailText:    version.EmailText,
+		SMSText:      version.SmsText,
+		InAppText:    version.InAppText,
+	}
+	if len(version.Variables) > 0 {
+		if err := json.Unmarshal(version.Variables, &content.Variables); err != nil {
+			return TemplateContent{}, fmt.Errorf("decode template variables: %w", err)
+		}
+	}
+	return content, nil
+}
+
+func newTemplateDetails(template db.NotificationTemplate, version db.TemplateVersion, content TemplateContent) *TemplateDetails {
+	return &TemplateDetails{
+		Template: template,
+		Version:  version,
+		Content:  content,
+		Channels: templateChannels(content),
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func templateEvent(details *TemplateDetails) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       details.Template.ID,
+		"name":     details.Template.Name,
+		"version":  details.Template.CurrentVersion,
+		"channels": details.Channels,
+	}
+}
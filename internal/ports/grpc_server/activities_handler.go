@@ -4,8 +4,10 @@ import (
 	"context"
 	"crm/api/proto/pb"
 	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"crm/internal/core/services"
 	"database/sql"
+	"errors"
 	"log"
 	"time"
 
@@ -99,21 +101,26 @@ func (h *ActivityHandler) DeleteActivity(ctx context.Context, req *pb.DeleteActi
 }
 
 func (h *ActivityHandler) ListActivities(ctx context.Context, req *pb.ListActivitiesRequest) (*pb.ListActivitiesResponse, error) {
-	activities, err := h.activityService.ListActivities(ctx, uint(req.PageNumber), uint(req.PageSize))
+	page, err := h.activityService.ListActivities(ctx, int32(req.ContactId), listOptions(req))
 	if err != nil {
 		log.Printf("Error listing activities: %v", err)
-		if err == services.ErrInvalidActivityData {
+		if err == services.ErrInvalidActivityData || errors.Is(err, filter.ErrInvalid) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return nil, status.Error(codes.Internal, "failed to list activities")
 	}
 
 	var protoActivities []*pb.Activity
-	for _, activity := range activities {
+	for _, activity := range page.Items {
 		protoActivities = append(protoActivities, convertModelToProto(&activity))
 	}
 
-	return &pb.ListActivitiesResponse{Activities: protoActivities}, nil
+	return &pb.ListActivitiesResponse{
+		Activities:    protoActivities,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
 }
 
 func convertProtoToCreateParams(proto *pb.Activity) db.CreateActivityParams {
@@ -3,32 +3,37 @@ package handler
 import (
 	"context"
 	"crm/api/proto/pb"
-	"crm/internal/core/domain/models"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"crm/internal/core/services"
+	"errors"
 	"log"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type CompanyHandler struct {
-	companyService services.CompanyService
+	companyService services.CompanyServiceInterface
 	pb.UnimplementedCompanyServiceServer
 }
 
-func NewCompanyHandler(service services.CompanyService) *CompanyHandler {
+func NewCompanyHandler(service services.CompanyServiceInterface) *CompanyHandler {
 	return &CompanyHandler{companyService: service}
 }
 
 func (h *CompanyHandler) CreateCompany(ctx context.Context, req *pb.CreateCompanyRequest) (*pb.CreateCompanyResponse, error) {
 	log.Printf("Received CreateCompany request: %+v", req)
 
-	company := convertProtoToCompanyModel(req.Company)
+	company := convertProtoToCreateCompanyParams(req.Company)
 
-	created, err := h.companyService.CreateCompany(company)
+	created, err := h.companyService.CreateCompany(ctx, company)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Printf("Error creating company: %v", err)
+		if err == services.ErrInvalidCompanyData {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to create company")
 	}
 
 	return &pb.CreateCompanyResponse{
@@ -39,24 +44,46 @@ func (h *CompanyHandler) CreateCompany(ctx context.Context, req *pb.CreateCompan
 func (h *CompanyHandler) GetCompany(ctx context.Context, req *pb.GetCompanyRequest) (*pb.GetCompanyResponse, error) {
 	log.Printf("Received GetCompany request: %+v", req)
 
-	company, err := h.companyService.GetCompany(uint(req.Id))
+	company, err := h.companyService.GetCompany(ctx, int32(req.Id))
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		if err == services.ErrCompanyNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get company")
 	}
 
-	return &pb.GetCompanyResponse{
+	resp := &pb.GetCompanyResponse{
 		Company: convertCompanyModelToProto(company),
-	}, nil
+	}
+	if req.IncludeOpportunities {
+		opportunities, err := h.companyService.ListCompanyOpportunities(ctx, company.ID)
+		if err != nil {
+			log.Printf("Error listing opportunities of company %d: %v", company.ID, err)
+			return nil, status.Error(codes.Internal, "failed to list company opportunities")
+		}
+		for i := range opportunities {
+			resp.Opportunities = append(resp.Opportunities, convertOpportunityModelToProto(&opportunities[i]))
+		}
+	}
+	return resp, nil
 }
 
 func (h *CompanyHandler) UpdateCompany(ctx context.Context, req *pb.UpdateCompanyRequest) (*pb.UpdateCompanyResponse, error) {
 	log.Printf("Received UpdateCompany request: %+v", req)
 
-	company := convertProtoToCompanyModel(req.Company)
+	company := convertProtoToUpdateCompanyParams(req.Company)
 
-	updated, err := h.companyService.UpdateCompany(company)
+	updated, err := h.companyService.UpdateCompany(ctx, company)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Printf("Error updating company: %v", err)
+		switch err {
+		case services.ErrCompanyNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case services.ErrInvalidCompanyData:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to update company")
+		}
 	}
 
 	return &pb.UpdateCompanyResponse{
@@ -67,9 +94,12 @@ func (h *CompanyHandler) UpdateCompany(ctx context.Context, req *pb.UpdateCompan
 func (h *CompanyHandler) DeleteCompany(ctx context.Context, req *pb.DeleteCompanyRequest) (*pb.DeleteCompanyResponse, error) {
 	log.Printf("Received DeleteCompany request: %+v", req)
 
-	err := h.companyService.DeleteCompany(uint(req.Id))
+	err := h.companyService.DeleteCompany(ctx, int32(req.Id))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		if err == services.ErrCompanyNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to delete company")
 	}
 
 	return &pb.DeleteCompanyResponse{Success: true}, nil
@@ -78,64 +108,81 @@ func (h *CompanyHandler) DeleteCompany(ctx context.Context, req *pb.DeleteCompan
 func (h *CompanyHandler) ListCompanies(ctx context.Context, req *pb.ListCompaniesRequest) (*pb.ListCompaniesResponse, error) {
 	log.Printf("Received ListCompanies request: %+v", req)
 
-	companies, err := h.companyService.ListCompanies(
-		uint(req.OrganizationId),
-		uint(req.PageNumber),
-		uint(req.PageSize),
-		req.SortBy,
-		req.Ascending,
-	)
-
+	page, err := h.companyService.ListCompanies(ctx, int32(req.OrganizationId), listOptions(req))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Printf("Error listing companies: %v", err)
+		if errors.Is(err, filter.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list companies")
 	}
 
 	var protoCompanies []*pb.Company
-	for _, c := range companies {
+	for _, c := range page.Items {
 		protoCompanies = append(protoCompanies, convertCompanyModelToProto(&c))
 	}
 
 	return &pb.ListCompaniesResponse{
-		Companies: protoCompanies,
+		Companies:     protoCompanies,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
 	}, nil
 }
 
-func convertProtoToCompanyModel(pb *pb.Company) *models.Company {
-	return &models.Company{
-		ID:             uint(pb.Id),
-		Name:           pb.Name,
-		Industry:       pb.Industry,
-		Website:        pb.Website,
-		Phone:          pb.Phone,
-		Email:          pb.Email,
-		Address:        pb.Address,
-		City:           pb.City,
-		State:          pb.State,
-		Country:        pb.Country,
-		ZipCode:        pb.ZipCode,
-		CreatedBy:      uint(pb.CreatedBy),
-		OrganizationID: uint(pb.OrganizationId),
-		CreatedAt:      parseTime(pb.CreatedAt),
-		UpdatedAt:      parseTime(pb.UpdatedAt),
+// ---------- Proto → SQLC ----------
+
+func convertProtoToCreateCompanyParams(proto *pb.Company) db.CreateCompanyParams {
+	return db.CreateCompanyParams{
+		Name:           proto.Name,
+		Industry:       nullString(proto.Industry),
+		Website:        nullString(proto.Website),
+		Phone:          nullString(proto.Phone),
+		Email:          nullString(proto.Email),
+		Address:        nullString(proto.Address),
+		City:           nullString(proto.City),
+		State:          nullString(proto.State),
+		Country:        nullString(proto.Country),
+		Zipcode:        nullString(proto.ZipCode),
+		CreatedBy:      nullInt32(proto.CreatedBy),
+		OrganizationID: int32(proto.OrganizationId),
 	}
 }
 
-func convertCompanyModelToProto(m *models.Company) *pb.Company {
+func convertProtoToUpdateCompanyParams(proto *pb.Company) db.UpdateCompanyParams {
+	return db.UpdateCompanyParams{
+		ID:       int32(proto.Id),
+		Name:     proto.Name,
+		Industry: nullString(proto.Industry),
+		Website:  nullString(proto.Website),
+		Phone:    nullString(proto.Phone),
+		Email:    nullString(proto.Email),
+		Address:  nullString(proto.Address),
+		City:     nullString(proto.City),
+		State:    nullString(proto.State),
+		Country:  nullString(proto.Country),
+		Zipcode:  nullString(proto.ZipCode),
+	}
+}
+
+// ---------- SQLC Model → Proto ----------
+
+func convertCompanyModelToProto(m *db.Company) *pb.Company {
 	return &pb.Company{
 		Id:             uint32(m.ID),
 		Name:           m.Name,
-		Industry:       m.Industry,
-		Website:        m.Website,
-		Phone:          m.Phone,
-		Email:          m.Email,
-		Address:        m.Address,
-		City:           m.City,
-		State:          m.State,
-		Country:        m.Country,
-		ZipCode:        m.ZipCode,
-		CreatedBy:      uint32(m.CreatedBy),
+		Industry:       m.Industry.String,
+		Website:        m.Website.String,
+		Phone:          m.Phone.String,
+		Email:          m.Email.String,
+		Address:        m.Address.String,
+		City:           m.City.String,
+		State:          m.State.String,
+		Country:        m.Country.String,
+		ZipCode:        m.Zipcode.String,
+		CreatedBy:      uint32(m.CreatedBy.Int32),
 		OrganizationId: uint32(m.OrganizationID),
-		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:      m.UpdatedAt.Format(time.RFC3339),
+		CreatedAt:      formatNullTime(m.CreatedAt),
+		UpdatedAt:      formatNullTime(m.UpdatedAt),
 	}
 }
@@ -3,32 +3,33 @@ package handler
 import (
 	"context"
 	"crm/api/proto/pb"
-	"crm/internal/core/domain/models"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"crm/internal/core/services"
+	"errors"
 	"log"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type ContactHandler struct {
-	contactService services.ContactService
+	contactService services.ContactServiceInterface
 	pb.UnimplementedContactServiceServer
 }
 
-func NewContactHandler(service services.ContactService) *ContactHandler {
+func NewContactHandler(service services.ContactServiceInterface) *ContactHandler {
 	return &ContactHandler{contactService: service}
 }
 
 func (h *ContactHandler) CreateContact(ctx context.Context, req *pb.CreateContactRequest) (*pb.CreateContactResponse, error) {
 	log.Printf("Received CreateContact request: %+v", req)
 
-	// Convert Proto to Model (new unified model)
-	contact := convertProtoToModel(req.Contact)
+	// Convert Proto to sqlc params
+	contact := convertProtoToCreateContactParams(req.Contact)
 
 	// Validate and Create Contact
-	createdContact, err := h.contactService.CreateContact(contact)
+	createdContact, err := h.contactService.CreateContact(ctx, contact)
 	if err != nil {
 		log.Printf("Error creating contact: %v", err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -36,7 +37,7 @@ func (h *ContactHandler) CreateContact(ctx context.Context, req *pb.CreateContac
 
 	// Convert Model to Proto response
 	return &pb.CreateContactResponse{
-		Contact: convertModelToProto(createdContact),
+		Contact: convertContactModelToProto(createdContact),
 	}, nil
 }
 
@@ -44,34 +45,51 @@ func (h *ContactHandler) GetContact(ctx context.Context, req *pb.GetContactReque
 	log.Printf("Received GetContact request: %+v", req)
 
 	// Get Contact from service layer
-	contact, err := h.contactService.GetContact(uint(req.Id))
+	contact, err := h.contactService.GetContact(ctx, int32(req.Id))
 	if err != nil {
 		log.Printf("Error getting contact: %v", err)
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
 	// Convert Model to Proto response
-	return &pb.GetContactResponse{
-		Contact: convertModelToProto(contact),
-	}, nil
+	resp := &pb.GetContactResponse{
+		Contact: convertContactModelToProto(contact),
+	}
+	if req.IncludeOpportunities {
+		opportunities, err := h.contactService.ListContactOpportunities(ctx, contact.ID)
+		if err != nil {
+			log.Printf("Error listing opportunities of contact %d: %v", contact.ID, err)
+			return nil, status.Error(codes.Internal, "failed to list contact opportunities")
+		}
+		for i := range opportunities {
+			resp.Opportunities = append(resp.Opportunities, &pb.ContactOpportunity{
+				Opportunity: convertOpportunityModelToProto(&opportunities[i].Opportunity),
+				Roles:       opportunities[i].Roles,
+			})
+		}
+	}
+	return resp, nil
 }
 
 func (h *ContactHandler) UpdateContact(ctx context.Context, req *pb.UpdateContactRequest) (*pb.UpdateContactResponse, error) {
 	log.Printf("Received UpdateContact request: %+v", req)
 
-	// Convert Proto to Model
-	contact := convertProtoToModel(req.Contact)
+	// Convert Proto to sqlc params
+	contact := convertProtoToUpdateContactParams(req.Contact)
 
 	// Validate and Update Contact
-	updatedContact, err := h.contactService.UpdateContact(contact)
+	updatedContact, err := h.contactService.UpdateContact(ctx, contact)
 	if err != nil {
 		log.Printf("Error updating contact: %v", err)
+		if err == services.ErrContactNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Convert Model to Proto response
 	return &pb.UpdateContactResponse{
-		Contact: convertModelToProto(updatedContact),
+		Contact: convertContactModelToProto(updatedContact),
 	}, nil
 }
 
@@ -79,7 +97,7 @@ func (h *ContactHandler) DeleteContact(ctx context.Context, req *pb.DeleteContac
 	log.Printf("Received DeleteContact request: %+v", req)
 
 	// Delete the contact through the service layer
-	err := h.contactService.DeleteContact(uint(req.Id))
+	err := h.contactService.DeleteContact(ctx, int32(req.Id))
 	if err != nil {
 		if err == services.ErrContactNotFound {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -96,91 +114,99 @@ func (h *ContactHandler) DeleteContact(ctx context.Context, req *pb.DeleteContac
 func (h *ContactHandler) ListContacts(ctx context.Context, req *pb.ListContactsRequest) (*pb.ListContactsResponse, error) {
 	log.Printf("Received ListContacts request: %+v", req)
 
-	// List Contacts with example pagination parameters
-	contacts, err := h.contactService.ListContacts(1, 10, "created_at", true)
+	page, err := h.contactService.ListContacts(ctx, listOptions(req))
 	if err != nil {
 		log.Printf("Error listing contacts: %v", err)
+		if errors.Is(err, filter.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	// Convert Model slice to Proto slice
 	var protoContacts []*pb.Contact
-	for _, contact := range contacts {
-		protoContacts = append(protoContacts, convertModelToProto(&contact))
+	for _, contact := range page.Items {
+		protoContacts = append(protoContacts, convertContactModelToProto(&contact))
 	}
 
 	return &pb.ListContactsResponse{
-		Contacts: protoContacts,
+		Contacts:      protoContacts,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
 	}, nil
 }
 
 // --- Helper Functions ---
 
-// convertProtoToModel maps the proto Contact message to the unified Contact model.
-// It translates new fields like ContactType, CompanyName, and TaxationDetailID.
-func convertProtoToModel(protoContact *pb.Contact) *models.Contact {
-	var taxationDetailID *uint
-	if protoContact.TaxationDetailId != 0 {
-		temp := uint(protoContact.TaxationDetailId)
-		taxationDetailID = &temp
+// convertProtoToCreateContactParams maps the proto Contact message to sqlc insert params.
+func convertProtoToCreateContactParams(protoContact *pb.Contact) db.CreateContactParams {
+	return db.CreateContactParams{
+		ContactType:         protoContact.ContactType,
+		FirstName:           nullString(protoContact.FirstName),
+		LastName:            nullString(protoContact.LastName),
+		CompanyName:         nullString(protoContact.CompanyName),
+		CompanyID:           nullInt32(protoContact.GetCompanyId()),
+		Email:               protoContact.Email,
+		Phone:               nullString(protoContact.Phone),
+		Address:             nullString(protoContact.Address),
+		City:                nullString(protoContact.City),
+		State:               nullString(protoContact.State),
+		Country:             nullString(protoContact.Country),
+		Zipcode:             nullString(protoContact.ZipCode),
+		Position:            nullString(protoContact.Position),
+		SocialMediaProfiles: nullString(protoContact.SocialMediaProfiles),
+		Notes:               nullString(protoContact.Notes),
+		TaxationDetailID:    nullInt32(protoContact.TaxationDetailId),
 	}
+}
 
-	return &models.Contact{
-		ID:                  uint(protoContact.Id),
-		ContactType:         protoContact.ContactType,
-		FirstName:           protoContact.FirstName,
-		LastName:            protoContact.LastName,
-		CompanyName:         protoContact.CompanyName,
+// convertProtoToUpdateContactParams maps the proto Contact message to sqlc update params.
+func convertProtoToUpdateContactParams(protoContact *pb.Contact) db.UpdateContactParams {
+	return db.UpdateContactParams{
+		ID:                  int32(protoContact.Id),
+		FirstName:           nullString(protoContact.FirstName),
+		LastName:            nullString(protoContact.LastName),
 		Email:               protoContact.Email,
-		Phone:               protoContact.Phone,
-		Address:             protoContact.Address,
-		City:                protoContact.City,
-		State:               protoContact.State,
-		Country:             protoContact.Country,
-		ZipCode:             protoContact.ZipCode,
-		Position:            protoContact.Position,
-		SocialMediaProfiles: protoContact.SocialMediaProfiles,
-		Notes:               protoContact.Notes,
-		TaxationDetailID:    taxationDetailID,
-		CreatedAt:           parseTime(protoContact.CreatedAt),
-		UpdatedAt:           parseTime(protoContact.UpdatedAt),
+		Phone:               nullString(protoContact.Phone),
+		Address:             nullString(protoContact.Address),
+		City:                nullString(protoContact.City),
+		State:               nullString(protoContact.State),
+		Country:             nullString(protoContact.Country),
+		Zipcode:             nullString(protoContact.ZipCode),
+		Position:            nullString(protoContact.Position),
+		SocialMediaProfiles: nullString(protoContact.SocialMediaProfiles),
+		Notes:               nullString(protoContact.Notes),
 	}
 }
 
-// convertModelToProto maps the unified Contact model back to the proto Contact message.
-// func convertModelToProto(modelContact *models.Contact) *pb.Contact {
-// 	var taxationDetailId uint32
-// 	if modelContact.TaxationDetailID != nil {
-// 		taxationDetailId = uint32(*modelContact.TaxationDetailID)
-// 	}
-
-// 	return &pb.Contact{
-// 		Id:                  uint32(modelContact.ID),
-// 		ContactType:         modelContact.ContactType,
-// 		FirstName:           modelContact.FirstName,
-// 		LastName:            modelContact.LastName,
-// 		CompanyName:         modelContact.CompanyName,
-// 		Email:               modelContact.Email,
-// 		Phone:               modelContact.Phone,
-// 		Address:             modelContact.Address,
-// 		City:                modelContact.City,
-// 		State:               modelContact.State,
-// 		Country:             modelContact.Country,
-// 		ZipCode:             modelContact.ZipCode,
-// 		Position:            modelContact.Position,
-// 		SocialMediaProfiles: modelContact.SocialMediaProfiles,
-// 		Notes:               modelContact.Notes,
-// 		TaxationDetailId:    taxationDetailId,
-// 		CreatedAt:           modelContact.CreatedAt.Format(time.RFC3339),
-// 		UpdatedAt:           modelContact.UpdatedAt.Format(time.RFC3339),
-// 	}
-// }
-
-// parseTime converts an RFC3339 time string to a time.Time value.
-func parseTime(timeStr string) time.Time {
-	t, err := time.Parse(time.RFC3339, timeStr)
-	if err != nil {
-		return time.Time{}
+// convertContactModelToProto maps the sqlc Contact row back to the proto Contact message.
+func convertContactModelToProto(modelContact *db.Contact) *pb.Contact {
+	var companyID *uint32
+	if modelContact.CompanyID.Valid {
+		temp := uint32(modelContact.CompanyID.Int32)
+		companyID = &temp
+	}
+
+	return &pb.Contact{
+		Id:                  uint32(modelContact.ID),
+		ContactType:         modelContact.ContactType,
+		FirstName:           modelContact.FirstName.String,
+		LastName:            modelContact.LastName.String,
+		CompanyId:           companyID,
+		CompanyName:         modelContact.CompanyName.String,
+		Email:               modelContact.Email,
+		Phone:               modelContact.Phone.String,
+		Address:             modelContact.Address.String,
+		City:                modelContact.City.String,
+		State:               modelContact.State.String,
+		Country:             modelContact.Country.String,
+		ZipCode:             modelContact.Zipcode.String,
+		Position:            modelContact.Position.String,
+		SocialMediaProfiles: modelContact.SocialMediaProfiles.String,
+		Notes:               modelContact.Notes.String,
+		TaxationDetailId:    uint32(modelContact.TaxationDetailID.Int32),
+		CreatedAt:           formatNullTime(modelContact.CreatedAt),
+		UpdatedAt:           formatNullTime(modelContact.UpdatedAt),
 	}
-	return t
 }
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CurrencyHandler struct {
+	currencyService services.CurrencyServiceInterface
+	pb.UnimplementedCurrencyServiceServer
+}
+
+func NewCurrencyHandler(service services.CurrencyServiceInterface) *CurrencyHandler {
+	return &CurrencyHandler{currencyService: service}
+}
+
+func (h *CurrencyHandler) SetExchangeRates(ctx context.Context, req *pb.SetExchangeRatesRequest) (*pb.SetExchangeRatesResponse, error) {
+	log.Printf("Received SetExchangeRates request: %+v", req)
+
+	rates := make([]services.ExchangeRate, 0, len(req.Rates))
+	for _, rate := range req.Rates {
+		effective, err := time.Parse("2006-01-02", rate.EffectiveDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "effective_date %q must be YYYY-MM-DD", rate.EffectiveDate)
+		}
+		rates = append(rates, services.ExchangeRate{
+			From:          strings.ToUpper(rate.FromCurrency),
+			To:            strings.ToUpper(rate.ToCurrency),
+			Rate:          rate.Rate,
+			EffectiveDate: effective,
+		})
+	}
+
+	stored, err := h.currencyService.SetExchangeRates(ctx, rates)
+	if err != nil {
+		log.Printf("Error setting exchange rates: %v", err)
+		return nil, currencyError(err, "failed to set exchange rates")
+	}
+
+	resp := &pb.SetExchangeRatesResponse{}
+	for i := range stored {
+		resp.Rates = append(resp.Rates, convertExchangeRateToProto(&stored[i]))
+	}
+	return resp, nil
+}
+
+func (h *CurrencyHandler) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesResponse, error) {
+	log.Printf("Received ImportExchangeRates request: %d bytes", len(req.Csv))
+
+	imported, err := h.currencyService.ImportExchangeRates(ctx, bytes.NewReader(req.Csv))
+	if err != nil {
+		log.Printf("Error importing exchange rates: %v", err)
+		return nil, currencyError(err, "failed to import exchange rates")
+	}
+	return &pb.ImportExchangeRatesResponse{Imported: uint32(imported)}, nil
+}
+
+func (h *CurrencyHandler) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
+	page, err := h.currencyService.ListExchangeRates(ctx, req.Currency, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing exchange rates: %v", err)
+		return nil, currencyError(err, "failed to list exchange rates")
+	}
+
+	resp := &pb.ListExchangeRatesResponse{
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}
+	for i := range page.Items {
+		resp.Rates = append(resp.Rates, convertExchangeRateToProto(&page.Items[i]))
+	}
+	return resp, nil
+}
+
+func (h *CurrencyHandler) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	log.Printf("Received DeleteExchangeRate request: %+v", req)
+
+	if err := h.currencyService.DeleteExchangeRate(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting exchange rate: %v", err)
+		return nil, currencyError(err, "failed to delete exchange rate")
+	}
+	return &pb.DeleteExchangeRateResponse{Success: true}, nil
+}
+
+func (h *CurrencyHandler) GetReportingCurrency(ctx context.Context, req *pb.GetReportingCurrencyRequest) (*pb.ReportingCurrency, error) {
+	currency, err := h.currencyService.GetReportingCurrency(ctx, int32(req.OrganizationId))
+	if err != nil {
+		log.Printf("Error getting reporting currency: %v", err)
+		return nil, currencyError(err, "failed to get reporting currency")
+	}
+	return &pb.ReportingCurrency{OrganizationId: req.OrganizationId, Currency: currency}, nil
+}
+
+func (h *CurrencyHandler) SetReportingCurrency(ctx context.Context, req *pb.ReportingCurrency) (*pb.ReportingCurrency, error) {
+	log.Printf("Received SetReportingCurrency request: %+v", req)
+
+	currency, err := h.currencyService.SetReportingCurrency(ctx, int32(req.OrganizationId), req.Currency)
+	if err != nil {
+		log.Printf("Error setting reporting currency: %v", err)
+		return nil, currencyError(err, "failed to set reporting currency")
+	}
+	return &pb.ReportingCurrency{OrganizationId: req.OrganizationId, Currency: currency}, nil
+}
+
+func (h *CurrencyHandler) ConvertAmount(ctx context.Context, req *pb.ConvertAmountRequest) (*pb.ConvertAmountResponse, error) {
+	on := time.Now().UTC()
+	if req.Date != "" {
+		date, err := parseDate(req.Date)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		on = date
+	}
+
+	to := strings.ToUpper(req.ToCurrency)
+	amount, err := h.currencyService.Convert(ctx, req.Amount, strings.ToUpper(req.FromCurrency), to, on)
+	if err != nil {
+		return nil, currencyError(err, "failed to convert amount")
+	}
+	return &pb.ConvertAmountResponse{Amount: amount, Currency: to}, nil
+}
+
+func currencyError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrExchangeRateNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidCurrency),
+		errors.Is(err, services.ErrInvalidExchangeRate),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrNoExchangeRate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Model → Proto ----------
+
+func convertExchangeRateToProto(rate *db.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Id:            uint32(rate.ID),
+		FromCurrency:  rate.FromCurrency,
+		ToCurrency:    rate.ToCurrency,
+		Rate:          rate.Rate,
+		EffectiveDate: rate.EffectiveDate.Format("2006-01-02"),
+		Source:        rate.Source,
+		CreatedAt:     rate.CreatedAt.Format(time.RFC3339),
+	}
+}
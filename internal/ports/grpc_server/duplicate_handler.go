@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/services"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DuplicateHandler struct {
+	duplicateService services.DuplicateServiceInterface
+	pb.UnimplementedDuplicateServiceServer
+}
+
+func NewDuplicateHandler(service services.DuplicateServiceInterface) *DuplicateHandler {
+	return &DuplicateHandler{duplicateService: service}
+}
+
+func (h *DuplicateHandler) FindDuplicates(ctx context.Context, req *pb.FindDuplicatesRequest) (*pb.FindDuplicatesResponse, error) {
+	log.Printf("Received FindDuplicates request: %+v", req)
+
+	clusters, err := h.duplicateService.FindDuplicates(ctx, req.EntityType, nullInt32(req.OrganizationId), int(req.MaxClusters))
+	if err != nil {
+		log.Printf("Error finding duplicates: %v", err)
+		return nil, duplicateError(err, "failed to find duplicates")
+	}
+
+	resp := &pb.FindDuplicatesResponse{}
+	for i := range clusters {
+		resp.Clusters = append(resp.Clusters, convertDuplicateClusterToProto(&clusters[i]))
+	}
+	return resp, nil
+}
+
+func (h *DuplicateHandler) MergeContacts(ctx context.Context, req *pb.MergeRequest) (*pb.MergeContactsResponse, error) {
+	log.Printf("Received MergeContacts request: %+v", req)
+
+	merge, err := h.duplicateService.MergeContacts(ctx, int32(req.SurvivorId), int32IDs(req.MergedIds))
+	if err != nil {
+		log.Printf("Error merging contacts: %v", err)
+		return nil, duplicateError(err, "failed to merge contacts")
+	}
+
+	return &pb.MergeContactsResponse{
+		Contact: convertContactModelToProto(&merge.Contact),
+		Audits:  convertMergeAuditsToProto(merge.Audits),
+	}, nil
+}
+
+func (h *DuplicateHandler) MergeLeads(ctx context.Context, req *pb.MergeRequest) (*pb.MergeLeadsResponse, error) {
+	log.Printf("Received MergeLeads request: %+v", req)
+
+	merge, err := h.duplicateService.MergeLeads(ctx, int32(req.SurvivorId), int32IDs(req.MergedIds))
+	if err != nil {
+		log.Printf("Error merging leads: %v", err)
+		return nil, duplicateError(err, "failed to merge leads")
+	}
+
+	return &pb.MergeLeadsResponse{
+		Lead:   ConvertModelToProtoLead(&merge.Lead),
+		Audits: convertMergeAuditsToProto(merge.Audits),
+	}, nil
+}
+
+func (h *DuplicateHandler) ListMergeAudits(ctx context.Context, req *pb.ListMergeAuditsRequest) (*pb.ListMergeAuditsResponse, error) {
+	audits, err := h.duplicateService.ListMergeAudits(ctx, req.EntityType, int32(req.SurvivorId))
+	if err != nil {
+		log.Printf("Error listing merge audits: %v", err)
+		return nil, duplicateError(err, "failed to list merge audits")
+	}
+	return &pb.ListMergeAuditsResponse{Audits: convertMergeAuditsToProto(audits)}, nil
+}
+
+func duplicateError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrContactNotFound),
+		errors.Is(err, services.ErrLeadNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidMerge),
+		errors.Is(err, services.ErrUnknownEntityType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+func int32IDs(ids []uint32) []int32 {
+	out := make([]int32, len(ids))
+	for i, id := range ids {
+		out[i] = int32(id)
+	}
+	return out
+}
+
+// ---------- Service → Proto ----------
+
+func convertDuplicateClusterToProto(cluster *services.DuplicateCluster) *pb.DuplicateCluster {
+	resp := &pb.DuplicateCluster{Score: cluster.Score}
+	for _, r := range cluster.Records {
+		resp.Records = append(resp.Records, &pb.DuplicateCandidate{
+			Id:        uint32(r.ID),
+			FirstName: r.FirstName,
+			LastName:  r.LastName,
+			Email:     r.Email,
+			Phone:     r.Phone,
+		})
+	}
+	for _, m := range cluster.Matches {
+		resp.Matches = append(resp.Matches, &pb.DuplicateMatch{
+			IdA:     uint32(m.A),
+			IdB:     uint32(m.B),
+			Reasons: m.Reasons,
+			Score:   m.Score,
+		})
+	}
+	return resp
+}
+
+func convertMergeAuditsToProto(audits []db.MergeAudit) []*pb.MergeAudit {
+	var resp []*pb.MergeAudit
+	for _, a := range audits {
+		audit := &pb.MergeAudit{
+			Id:           uint32(a.ID),
+			EntityType:   a.EntityType,
+			SurvivorId:   uint32(a.SurvivorID),
+			MergedId:     uint32(a.MergedID),
+			MergedRecord: string(a.MergedRecord),
+			CreatedAt:    formatNullTime(a.CreatedAt),
+		}
+		if err := json.Unmarshal(a.FilledFields, &audit.FilledFields); err != nil {
+			log.Printf("Error decoding merge audit %d filled fields: %v", a.ID, err)
+		}
+		if err := json.Unmarshal(a.Repointed, &audit.Repointed); err != nil {
+			log.Printf("Error decoding merge audit %d repointed counts: %v", a.ID, err)
+		}
+		resp = append(resp, audit)
+	}
+	return resp
+}
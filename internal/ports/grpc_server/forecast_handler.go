@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ForecastHandler struct {
+	forecastService services.ForecastServiceInterface
+	pb.UnimplementedForecastServiceServer
+}
+
+func NewForecastHandler(service services.ForecastServiceInterface) *ForecastHandler {
+	return &ForecastHandler{forecastService: service}
+}
+
+func (h *ForecastHandler) GetForecast(ctx context.Context, req *pb.ForecastRequest) (*pb.Forecast, error) {
+	log.Printf("Received GetForecast request: %+v", req)
+
+	forecastReq, err := convertProtoToForecastRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	forecast, err := h.forecastService.Forecast(ctx, forecastReq)
+	if err != nil {
+		log.Printf("Error computing forecast: %v", err)
+		return nil, forecastError(err, "failed to compute forecast")
+	}
+	return convertForecastToProto(forecast), nil
+}
+
+func (h *ForecastHandler) CreateForecastSnapshot(ctx context.Context, req *pb.CreateForecastSnapshotRequest) (*pb.ForecastSnapshot, error) {
+	log.Printf("Received CreateForecastSnapshot request: %+v", req)
+	if req.Forecast == nil {
+		return nil, status.Error(codes.InvalidArgument, "forecast is required")
+	}
+
+	forecastReq, err := convertProtoToForecastRequest(req.Forecast)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := h.forecastService.CreateSnapshot(ctx, req.Name, forecastReq)
+	if err != nil {
+		log.Printf("Error creating forecast snapshot: %v", err)
+		return nil, forecastError(err, "failed to create forecast snapshot")
+	}
+	return convertForecastSnapshotToProto(snapshot), nil
+}
+
+func (h *ForecastHandler) GetForecastSnapshot(ctx context.Context, req *pb.GetForecastSnapshotRequest) (*pb.GetForecastSnapshotResponse, error) {
+	snapshot, err := h.forecastService.GetSnapshot(ctx, int32(req.Id))
+	if err != nil {
+		return nil, forecastError(err, "failed to get forecast snapshot")
+	}
+
+	resp := &pb.GetForecastSnapshotResponse{Snapshot: convertForecastSnapshotToProto(snapshot)}
+	if req.Compare {
+		current, err := h.forecastService.Forecast(ctx, snapshot.Forecast.ForecastRequest)
+		if err != nil {
+			log.Printf("Error recomputing forecast of snapshot %d: %v", snapshot.ID, err)
+			return nil, forecastError(err, "failed to recompute forecast")
+		}
+		resp.Current = convertForecastToProto(current)
+	}
+	return resp, nil
+}
+
+func (h *ForecastHandler) ListForecastSnapshots(ctx context.Context, req *pb.ListForecastSnapshotsRequest) (*pb.ListForecastSnapshotsResponse, error) {
+	page, err := h.forecastService.ListSnapshots(ctx, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing forecast snapshots: %v", err)
+		return nil, forecastError(err, "failed to list forecast snapshots")
+	}
+
+	resp := &pb.ListForecastSnapshotsResponse{
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}
+	for i := range page.Items {
+		resp.Snapshots = append(resp.Snapshots, convertForecastSnapshotToProto(&page.Items[i]))
+	}
+	return resp, nil
+}
+
+func (h *ForecastHandler) DeleteForecastSnapshot(ctx context.Context, req *pb.DeleteForecastSnapshotRequest) (*pb.DeleteForecastSnapshotResponse, error) {
+	log.Printf("Received DeleteForecastSnapshot request: %+v", req)
+
+	if err := h.forecastService.DeleteSnapshot(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting forecast snapshot: %v", err)
+		return nil, forecastError(err, "failed to delete forecast snapshot")
+	}
+	return &pb.DeleteForecastSnapshotResponse{Success: true}, nil
+}
+
+func forecastError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrForecastSnapshotNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidForecast),
+		errors.Is(err, services.ErrInvalidCurrency),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrNoExchangeRate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Proto → Service ----------
+
+func convertProtoToForecastRequest(req *pb.ForecastRequest) (services.ForecastRequest, error) {
+	forecastReq := services.ForecastRequest{
+		Period:         req.Period,
+		GroupBy:        req.GroupBy,
+		OwnerID:        int32(req.OwnerId),
+		PipelineID:     int32(req.PipelineId),
+		OrganizationID: int32(req.OrganizationId),
+		Currency:       req.Currency,
+	}
+	if req.From != "" {
+		from, err := parseDate(req.From)
+		if err != nil {
+			return services.ForecastRequest{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		forecastReq.From = from
+	}
+	if req.To != "" {
+		to, err := parseDate(req.To)
+		if err != nil {
+			return services.ForecastRequest{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		forecastReq.To = to
+	}
+	return forecastReq, nil
+}
+
+// ---------- Model → Proto ----------
+
+func convertForecastRequestToProto(req services.ForecastRequest) *pb.ForecastRequest {
+	return &pb.ForecastRequest{
+		Period:         req.Period,
+		GroupBy:        req.GroupBy,
+		From:           req.From.Format("2006-01-02"),
+		To:             req.To.Format("2006-01-02"),
+		OwnerId:        uint32(req.OwnerID),
+		PipelineId:     uint32(req.PipelineID),
+		OrganizationId: uint32(req.OrganizationID),
+		Currency:       req.Currency,
+	}
+}
+
+func convertForecastToProto(forecast *services.Forecast) *pb.Forecast {
+	resp := &pb.Forecast{Request: convertForecastRequestToProto(forecast.ForecastRequest)}
+	for _, row := range forecast.Rows {
+		resp.Rows = append(resp.Rows, &pb.ForecastRow{
+			Period:         row.Period,
+			PeriodStart:    row.PeriodStart.Format("2006-01-02"),
+			GroupKey:       row.GroupKey,
+			GroupLabel:     row.GroupLabel,
+			Count:          uint32(row.Count),
+			Amount:         row.Amount,
+			WeightedAmount: row.WeightedAmount,
+			Closed:         row.Closed,
+			Commit:         row.Commit,
+			BestCase:       row.BestCase,
+			Pipeline:       row.Pipeline,
+		})
+	}
+	return resp
+}
+
+func convertForecastSnapshotToProto(snapshot *services.ForecastSnapshot) *pb.ForecastSnapshot {
+	return &pb.ForecastSnapshot{
+		Id:        uint32(snapshot.ID),
+		Name:      snapshot.Name,
+		CreatedAt: snapshot.CreatedAt.Format(time.RFC3339),
+		Forecast:  convertForecastToProto(&snapshot.Forecast),
+	}
+}
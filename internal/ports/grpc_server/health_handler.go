@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/core/services"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	healthServing    = "SERVING"
+	healthNotServing = "NOT_SERVING"
+)
+
+type HealthHandler struct {
+	healthService services.HealthServiceInterface
+	pb.UnimplementedHealthServiceServer
+}
+
+func NewHealthHandler(service services.HealthServiceInterface) *HealthHandler {
+	return &HealthHandler{healthService: service}
+}
+
+func (h *HealthHandler) Check(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
+	report, err := h.healthService.Check(ctx, req.Probe)
+	if err != nil {
+		if errors.Is(err, services.ErrUnknownProbe) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to check health")
+	}
+
+	return convertHealthToProto(report), nil
+}
+
+// ServeLiveness serves /healthz.
+func (h *HealthHandler) ServeLiveness(w http.ResponseWriter, r *http.Request) {
+	h.serveProbe(w, r, services.ProbeLiveness)
+}
+
+// ServeReadiness serves /readyz.
+func (h *HealthHandler) ServeReadiness(w http.ResponseWriter, r *http.Request) {
+	h.serveProbe(w, r, services.ProbeReadiness)
+}
+
+// serveProbe answers 200 when the probe is healthy and 503 otherwise, with
+// the per-dependency report as JSON.
+func (h *HealthHandler) serveProbe(w http.ResponseWriter, r *http.Request, probe string) {
+	report, err := h.healthService.Check(r.Context(), probe)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !report.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(convertHealthToProto(report)); err != nil {
+		log.Printf("Error writing %s response: %v", probe, err)
+	}
+}
+
+// SyncGRPCHealth runs the readiness probe and publishes the result to the
+// standard grpc.health.v1 server for the overall status ("") and every
+// named service. It is meant to be run periodically and returns the
+// failing dependencies as its error.
+func (h *HealthHandler) SyncGRPCHealth(server *health.Server, serviceNames []string) func(context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		report, err := h.healthService.Check(ctx, services.ProbeReadiness)
+		if err != nil {
+			return 0, err
+		}
+
+		serving := healthpb.HealthCheckResponse_SERVING
+		if !report.Healthy {
+			serving = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		server.SetServingStatus("", serving)
+		for _, name := range serviceNames {
+			server.SetServingStatus(name, serving)
+		}
+		return 0, report.Err()
+	}
+}
+
+// ---------- Service → Proto ----------
+
+func convertHealthToProto(report *services.HealthReport) *pb.HealthCheckResponse {
+	resp := &pb.HealthCheckResponse{
+		Status:    healthStatus(report.Healthy),
+		CheckedAt: report.CheckedAt.Format(time.RFC3339),
+	}
+	for _, dep := range report.Dependencies {
+		resp.Dependencies = append(resp.Dependencies, &pb.DependencyStatus{
+			Name:      dep.Name,
+			Status:    healthStatus(dep.Healthy),
+			Message:   dep.Message,
+			LatencyMs: dep.Latency.Milliseconds(),
+		})
+	}
+	return resp
+}
+
+func healthStatus(healthy bool) string {
+	if healthy {
+		return healthServing
+	}
+	return healthNotServing
+}
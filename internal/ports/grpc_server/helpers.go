@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"crm/api/proto/pb"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"database/sql"
+	"time"
+)
+
+// ---------- Proto → SQLC helpers ----------
+
+// nullString maps an empty proto string to a NULL column value.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullInt32 maps a zero proto id to a NULL column value.
+func nullInt32(v uint32) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(v), Valid: v != 0}
+}
+
+// optionalBool returns the value of an optional proto bool, or def when it
+// is not set.
+func optionalBool(v *bool, def bool) bool {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// nullTime parses an RFC3339 or YYYY-MM-DD string into a nullable
+// timestamp. An empty string is NULL; any other string that does not parse
+// is an error, so a malformed date is never stored as NULL.
+func nullTime(s string) (sql.NullTime, error) {
+	if s == "" {
+		return sql.NullTime{}, nil
+	}
+	t, err := parseDate(s)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+	return sql.NullTime{Time: t, Valid: true}, nil
+}
+
+// listRequest is a List request with filter, sort and keyset paging.
+// Requests may also have sort_by/ascending, and a page_size with a
+// page_number, or a page, of either integer type.
+type listRequest interface {
+	GetFilter() *pb.FilterGroup
+	GetSort() []*pb.SortField
+	GetPageToken() string
+	GetIncludeTotalCount() bool
+}
+
+// listOptions collects the filter, sort and paging fields of a List
+// request. sort_by and ascending apply when sort is empty.
+func listOptions(req listRequest) services.ListOptions {
+	opts := services.ListOptions{
+		Filter:       convertFilterGroupFromProto(req.GetFilter()),
+		PageToken:    req.GetPageToken(),
+		IncludeTotal: req.GetIncludeTotalCount(),
+	}
+	switch r := req.(type) {
+	case interface{ GetPageSize() uint32 }:
+		opts.PageSize = int32(r.GetPageSize())
+	case interface{ GetPageSize() int32 }:
+		opts.PageSize = r.GetPageSize()
+	}
+	switch r := req.(type) {
+	case interface{ GetPageNumber() uint32 }:
+		opts.PageNumber = int32(r.GetPageNumber())
+	case interface{ GetPage() int32 }:
+		opts.PageNumber = r.GetPage()
+	}
+	for _, s := range req.GetSort() {
+		opts.Sort = append(opts.Sort, filter.Sort{Field: s.Field, Descending: s.Descending})
+	}
+	if r, ok := req.(interface {
+		GetSortBy() string
+		GetAscending() bool
+	}); ok && len(opts.Sort) == 0 {
+		opts.Sort = filter.SortBy(r.GetSortBy(), r.GetAscending())
+	}
+	return opts
+}
+
+func convertFilterGroupFromProto(group *pb.FilterGroup) filter.Group {
+	if group == nil {
+		return filter.Group{}
+	}
+	converted := filter.Group{Logic: group.Logic}
+	for _, c := range group.Conditions {
+		converted.Conditions = append(converted.Conditions, filter.Condition{
+			Field:    c.Field,
+			Operator: c.Operator,
+			Values:   c.Values,
+		})
+	}
+	for _, g := range group.Groups {
+		converted.Groups = append(converted.Groups, convertFilterGroupFromProto(g))
+	}
+	return converted
+}
+
+// ---------- SQLC → Proto helpers ----------
+
+// formatNullTime renders a nullable timestamp as RFC3339, or "" when NULL.
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
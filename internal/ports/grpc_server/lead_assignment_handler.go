@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LeadAssignmentHandler struct {
+	assignmentService services.LeadAssignmentServiceInterface
+	pb.UnimplementedLeadAssignmentServiceServer
+}
+
+func NewLeadAssignmentHandler(service services.LeadAssignmentServiceInterface) *LeadAssignmentHandler {
+	return &LeadAssignmentHandler{assignmentService: service}
+}
+
+func (h *LeadAssignmentHandler) CreateSalesTeam(ctx context.Context, req *pb.CreateSalesTeamRequest) (*pb.SalesTeam, error) {
+	log.Printf("Received CreateSalesTeam request: %+v", req)
+
+	team, err := h.assignmentService.CreateTeam(ctx, int32(req.OrganizationId), req.Name)
+	if err != nil {
+		log.Printf("Error creating sales team: %v", err)
+		return nil, assignmentError(err, "failed to create sales team")
+	}
+	return convertSalesTeamToProto(team), nil
+}
+
+func (h *LeadAssignmentHandler) ListSalesTeams(ctx context.Context, req *pb.ListSalesTeamsRequest) (*pb.ListSalesTeamsResponse, error) {
+	teams, err := h.assignmentService.ListTeams(ctx, int32(req.OrganizationId))
+	if err != nil {
+		log.Printf("Error listing sales teams: %v", err)
+		return nil, assignmentError(err, "failed to list sales teams")
+	}
+
+	resp := &pb.ListSalesTeamsResponse{}
+	for i := range teams {
+		resp.Teams = append(resp.Teams, convertSalesTeamToProto(&teams[i]))
+	}
+	return resp, nil
+}
+
+func (h *LeadAssignmentHandler) DeleteSalesTeam(ctx context.Context, req *pb.DeleteSalesTeamRequest) (*pb.DeleteSalesTeamResponse, error) {
+	if err := h.assignmentService.DeleteTeam(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting sales team: %v", err)
+		return nil, assignmentError(err, "failed to delete sales team")
+	}
+	return &pb.DeleteSalesTeamResponse{Success: true}, nil
+}
+
+func (h *LeadAssignmentHandler) SetSalesTeamMember(ctx context.Context, req *pb.SetSalesTeamMemberRequest) (*pb.SalesTeamMember, error) {
+	log.Printf("Received SetSalesTeamMember request: %+v", req)
+
+	member, err := h.assignmentService.SetTeamMember(ctx, int32(req.TeamId), int32(req.UserId), req.Active)
+	if err != nil {
+		log.Printf("Error setting sales team member: %v", err)
+		return nil, assignmentError(err, "failed to set sales team member")
+	}
+	return convertSalesTeamMemberToProto(member), nil
+}
+
+func (h *LeadAssignmentHandler) RemoveSalesTeamMember(ctx context.Context, req *pb.RemoveSalesTeamMemberRequest) (*pb.RemoveSalesTeamMemberResponse, error) {
+	if err := h.assignmentService.RemoveTeamMember(ctx, int32(req.TeamId), int32(req.UserId)); err != nil {
+		log.Printf("Error removing sales team member: %v", err)
+		return nil, assignmentError(err, "failed to remove sales team member")
+	}
+	return &pb.RemoveSalesTeamMemberResponse{Success: true}, nil
+}
+
+func (h *LeadAssignmentHandler) CreateAssignmentRule(ctx context.Context, req *pb.CreateAssignmentRuleRequest) (*pb.AssignmentRule, error) {
+	log.Printf("Received CreateAssignmentRule request: %+v", req)
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+
+	rule, err := h.assignmentService.CreateRule(ctx, convertProtoToAssignmentRule(req.Rule))
+	if err != nil {
+		log.Printf("Error creating assignment rule: %v", err)
+		return nil, assignmentError(err, "failed to create assignment rule")
+	}
+	return convertAssignmentRuleToProto(rule), nil
+}
+
+func (h *LeadAssignmentHandler) UpdateAssignmentRule(ctx context.Context, req *pb.UpdateAssignmentRuleRequest) (*pb.AssignmentRule, error) {
+	log.Printf("Received UpdateAssignmentRule request: %+v", req)
+	if req.Rule == nil || req.Rule.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rule id is required")
+	}
+
+	rule, err := h.assignmentService.UpdateRule(ctx, int32(req.Rule.Id), convertProtoToAssignmentRule(req.Rule))
+	if err != nil {
+		log.Printf("Error updating assignment rule: %v", err)
+		return nil, assignmentError(err, "failed to update assignment rule")
+	}
+	return convertAssignmentRuleToProto(rule), nil
+}
+
+func (h *LeadAssignmentHandler) DeleteAssignmentRule(ctx context.Context, req *pb.DeleteAssignmentRuleRequest) (*pb.DeleteAssignmentRuleResponse, error) {
+	if err := h.assignmentService.DeleteRule(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting assignment rule: %v", err)
+		return nil, assignmentError(err, "failed to delete assignment rule")
+	}
+	return &pb.DeleteAssignmentRuleResponse{Success: true}, nil
+}
+
+func (h *LeadAssignmentHandler) ListAssignmentRules(ctx context.Context, req *pb.ListAssignmentRulesRequest) (*pb.ListAssignmentRulesResponse, error) {
+	rules, err := h.assignmentService.ListRules(ctx, int32(req.OrganizationId))
+	if err != nil {
+		log.Printf("Error listing assignment rules: %v", err)
+		return nil, assignmentError(err, "failed to list assignment rules")
+	}
+
+	resp := &pb.ListAssignmentRulesResponse{}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, convertAssignmentRuleToProto(&rules[i]))
+	}
+	return resp, nil
+}
+
+func (h *LeadAssignmentHandler) AssignLead(ctx context.Context, req *pb.AssignLeadRequest) (*pb.AssignLeadResponse, error) {
+	log.Printf("Received AssignLead request: %+v", req)
+
+	lead, err := h.assignmentService.AssignLead(ctx, int32(req.LeadId))
+	if err != nil {
+		log.Printf("Error assigning lead: %v", err)
+		return nil, assignmentError(err, "failed to assign lead")
+	}
+	return &pb.AssignLeadResponse{Lead: ConvertModelToProtoLead(lead)}, nil
+}
+
+func assignmentError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrSalesTeamNotFound),
+		errors.Is(err, services.ErrAssignmentRuleNotFound),
+		errors.Is(err, services.ErrLeadNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidSalesTeam),
+		errors.Is(err, services.ErrInvalidAssignmentRule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrSalesTeamExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, services.ErrNoAssignmentMatch),
+		errors.Is(err, services.ErrLeadAlreadyConverted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Proto → Service ----------
+
+func convertProtoToAssignmentRule(rule *pb.AssignmentRule) services.AssignmentRule {
+	return services.AssignmentRule{
+		OrganizationID: int32(rule.OrganizationId),
+		Name:           rule.Name,
+		Priority:       rule.Priority,
+		Strategy:       rule.Strategy,
+		TeamID:         int32(rule.TeamId),
+		Country:        rule.Country,
+		State:          rule.State,
+		Industry:       rule.Industry,
+		Enabled:        rule.Enabled,
+	}
+}
+
+// ---------- Model → Proto ----------
+
+func convertSalesTeamToProto(details *services.SalesTeamDetails) *pb.SalesTeam {
+	team := &pb.SalesTeam{
+		Id:             uint32(details.Team.ID),
+		OrganizationId: uint32(details.Team.OrganizationID),
+		Name:           details.Team.Name,
+		CreatedAt:      formatNullTime(details.Team.CreatedAt),
+	}
+	for i := range details.Members {
+		team.Members = append(team.Members, convertSalesTeamMemberToProto(&details.Members[i]))
+	}
+	return team
+}
+
+func convertSalesTeamMemberToProto(member *db.SalesTeamMember) *pb.SalesTeamMember {
+	return &pb.SalesTeamMember{
+		UserId:         uint32(member.UserID),
+		Active:         member.Active,
+		LastAssignedAt: formatNullTime(member.LastAssignedAt),
+	}
+}
+
+func convertAssignmentRuleToProto(rule *db.LeadAssignmentRule) *pb.AssignmentRule {
+	return &pb.AssignmentRule{
+		Id:             uint32(rule.ID),
+		OrganizationId: uint32(rule.OrganizationID),
+		Name:           rule.Name,
+		Priority:       rule.Priority,
+		Strategy:       rule.Strategy,
+		TeamId:         uint32(rule.TeamID),
+		Country:        rule.Country,
+		State:          rule.State,
+		Industry:       rule.Industry,
+		Enabled:        rule.Enabled,
+		CreatedAt:      formatNullTime(rule.CreatedAt),
+		UpdatedAt:      formatNullTime(rule.UpdatedAt),
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LeadScoringHandler struct {
+	scoringService services.LeadScoringServiceInterface
+	pb.UnimplementedLeadScoringServiceServer
+}
+
+func NewLeadScoringHandler(service services.LeadScoringServiceInterface) *LeadScoringHandler {
+	return &LeadScoringHandler{scoringService: service}
+}
+
+func (h *LeadScoringHandler) CreateScoringRule(ctx context.Context, req *pb.CreateScoringRuleRequest) (*pb.ScoringRule, error) {
+	log.Printf("Received CreateScoringRule request: %+v", req)
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+
+	rule, err := h.scoringService.CreateRule(ctx, convertProtoToScoringRule(req.Rule))
+	if err != nil {
+		log.Printf("Error creating scoring rule: %v", err)
+		return nil, scoringError(err, "failed to create scoring rule")
+	}
+
+	return convertScoringRuleToProto(rule), nil
+}
+
+func (h *LeadScoringHandler) UpdateScoringRule(ctx context.Context, req *pb.UpdateScoringRuleRequest) (*pb.ScoringRule, error) {
+	log.Printf("Received UpdateScoringRule request: %+v", req)
+	if req.Rule == nil || req.Rule.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rule id is required")
+	}
+
+	rule, err := h.scoringService.UpdateRule(ctx, int32(req.Rule.Id), convertProtoToScoringRule(req.Rule))
+	if err != nil {
+		log.Printf("Error updating scoring rule: %v", err)
+		return nil, scoringError(err, "failed to update scoring rule")
+	}
+
+	return convertScoringRuleToProto(rule), nil
+}
+
+func (h *LeadScoringHandler) DeleteScoringRule(ctx context.Context, req *pb.DeleteScoringRuleRequest) (*pb.DeleteScoringRuleResponse, error) {
+	if err := h.scoringService.DeleteRule(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting scoring rule: %v", err)
+		return nil, scoringError(err, "failed to delete scoring rule")
+	}
+	return &pb.DeleteScoringRuleResponse{Success: true}, nil
+}
+
+func (h *LeadScoringHandler) ListScoringRules(ctx context.Context, req *pb.ListScoringRulesRequest) (*pb.ListScoringRulesResponse, error) {
+	page, err := h.scoringService.ListRules(ctx, nullInt32(req.OrganizationId), listOptions(req))
+	if err != nil {
+		log.Printf("Error listing scoring rules: %v", err)
+		return nil, scoringError(err, "failed to list scoring rules")
+	}
+
+	resp := &pb.ListScoringRulesResponse{
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}
+	for i := range page.Items {
+		resp.Rules = append(resp.Rules, convertScoringRuleToProto(&page.Items[i]))
+	}
+	return resp, nil
+}
+
+func (h *LeadScoringHandler) RecomputeLeadScores(ctx context.Context, req *pb.RecomputeLeadScoresRequest) (*pb.RecomputeLeadScoresResponse, error) {
+	log.Printf("Received RecomputeLeadScores request: %+v", req)
+
+	scored, err := h.scoringService.RecomputeScores(ctx, nullInt32(req.OrganizationId))
+	if err != nil {
+		log.Printf("Error recomputing lead scores after %d leads: %v", scored, err)
+		return nil, scoringError(err, "failed to recompute lead scores")
+	}
+	return &pb.RecomputeLeadScoresResponse{Scored: uint32(scored)}, nil
+}
+
+func scoringError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrScoringRuleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidScoringRule),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Proto → Service ----------
+
+func convertProtoToScoringRule(rule *pb.ScoringRule) services.ScoringRule {
+	return services.ScoringRule{
+		OrganizationID: nullInt32(rule.OrganizationId),
+		Name:           rule.Name,
+		RuleType:       rule.RuleType,
+		Field:          rule.Field,
+		Operator:       rule.Operator,
+		Value:          rule.Value,
+		Weight:         rule.Weight,
+		Enabled:        rule.Enabled,
+	}
+}
+
+// ---------- Model → Proto ----------
+
+func convertScoringRuleToProto(rule *db.LeadScoringRule) *pb.ScoringRule {
+	return &pb.ScoringRule{
+		Id:             uint32(rule.ID),
+		OrganizationId: uint32(rule.OrganizationID.Int32),
+		Name:           rule.Name,
+		RuleType:       rule.RuleType,
+		Field:          rule.Field.String,
+		Operator:       rule.Operator,
+		Value:          rule.Value,
+		Weight:         rule.Weight,
+		Enabled:        rule.Enabled,
+		CreatedAt:      formatNullTime(rule.CreatedAt),
+		UpdatedAt:      formatNullTime(rule.UpdatedAt),
+	}
+}
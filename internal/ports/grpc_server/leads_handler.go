@@ -3,8 +3,12 @@ package handler
 import (
 	"context"
 	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"crm/internal/core/services"
-	"crm/internal/middleware/websockets"
+	"crm/internal/transport/websockets"
+	"errors"
+	"time"
 
 	"log"
 
@@ -13,44 +17,37 @@ import (
 )
 
 type LeadHandler struct {
-	leadService services.LeadService
+	leadService services.LeadServiceInterface
 	wsServer    *websockets.Server
 	pb.UnimplementedLeadServiceServer
 }
 
-func NewLeadHandler(service services.LeadService, wsServer *websockets.Server) *LeadHandler {
+func NewLeadHandler(service services.LeadServiceInterface, wsServer *websockets.Server) *LeadHandler {
 	return &LeadHandler{leadService: service, wsServer: wsServer}
 }
 
 func (h *LeadHandler) CreateLead(ctx context.Context, req *pb.CreateLeadRequest) (*pb.CreateLeadResponse, error) {
 	log.Printf("Received CreateLead request: %+v", req)
 
-	// Convert the protobuf Lead to the internal model Lead
-	lead := ConvertProtoToModelLead(req.Lead)
-	if lead == nil {
-		log.Println("Error: ConvertProtoToModelLead returned nil")
+	if req.Lead == nil {
 		return nil, status.Error(codes.InvalidArgument, "Invalid lead data")
 	}
 
+	// Convert the protobuf Lead to sqlc params
+	lead := ConvertProtoToCreateLeadParams(req.Lead)
+
 	// Call the service layer to create the lead
-	createdLead, err := h.leadService.CreateLead(lead)
+	createdLead, err := h.leadService.CreateLead(ctx, lead)
 	if err != nil {
 		log.Printf("Error creating lead: %v", err)
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, leadError(err)
 	}
 
 	// Notify WebSocket clients
 	h.wsServer.BroadcastMessage([]byte("New lead created!"))
 
-	// Convert the internal model Lead back to the protobuf Lead
-	protoLead := ConvertModelToProtoLead(createdLead)
-	if protoLead == nil {
-		log.Println("Error: ConvertModelToProtoLead returned nil")
-		return nil, status.Error(codes.Internal, "Failed to convert lead")
-	}
-
 	response := &pb.CreateLeadResponse{
-		Lead: protoLead,
+		Lead: ConvertModelToProtoLead(createdLead),
 	}
 
 	log.Printf("Returning CreateLead response: %+v", response)
@@ -58,9 +55,9 @@ func (h *LeadHandler) CreateLead(ctx context.Context, req *pb.CreateLeadRequest)
 }
 
 func (h *LeadHandler) GetLead(ctx context.Context, req *pb.GetLeadRequest) (*pb.GetLeadResponse, error) {
-	lead, err := h.leadService.GetLead(uint(req.Id))
+	lead, err := h.leadService.GetLead(ctx, int32(req.Id))
 	if err != nil {
-		return nil, err
+		return nil, leadError(err)
 	}
 	return &pb.GetLeadResponse{
 		Lead: ConvertModelToProtoLead(lead),
@@ -68,50 +65,230 @@ func (h *LeadHandler) GetLead(ctx context.Context, req *pb.GetLeadRequest) (*pb.
 }
 
 func (h *LeadHandler) UpdateLead(ctx context.Context, req *pb.UpdateLeadRequest) (*pb.UpdateLeadResponse, error) {
-	// Convert the protobuf Lead to the internal model Lead
-	lead := ConvertProtoToModelLead(req.Lead)
+	if req.Lead == nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid lead data")
+	}
 
 	// Call the service layer to update the lead
-	updatedLead, err := h.leadService.UpdateLead(lead)
+	updatedLead, err := h.leadService.UpdateLead(ctx, db.UpdateLeadParams{
+		ID:         int32(req.Lead.Id),
+		Status:     req.Lead.Status,
+		AssignedTo: nullInt32(req.Lead.AssignedTo),
+	})
 	if err != nil {
-		return nil, err
+		return nil, leadError(err)
 	}
 
-	// Convert the internal model Lead back to the protobuf Lead
 	return &pb.UpdateLeadResponse{
 		Lead: ConvertModelToProtoLead(updatedLead),
 	}, nil
 }
 
 func (h *LeadHandler) DeleteLead(ctx context.Context, req *pb.DeleteLeadRequest) (*pb.DeleteLeadResponse, error) {
-	err := h.leadService.DeleteLead(uint(req.Id))
+	err := h.leadService.DeleteLead(ctx, int32(req.Id))
 	if err != nil {
-		return nil, err
+		return nil, leadError(err)
 	}
 	return &pb.DeleteLeadResponse{Success: true}, nil
 }
 
 func (h *LeadHandler) GetAllLeads(ctx context.Context, req *pb.GetAllLeadsRequest) (*pb.GetAllLeadsResponse, error) {
-	leads, err := h.leadService.GetAllLeads()
+	page, err := h.leadService.GetAllLeads(ctx, listOptions(req))
 	if err != nil {
-		return nil, err
+		return nil, leadError(err)
 	}
 
-	// Convert the list of model Leads to protobuf Leads
+	// Convert the list of sqlc Leads to protobuf Leads
 	var protoLeads []*pb.Lead
-	for _, lead := range leads {
+	for _, lead := range page.Items {
 		protoLeads = append(protoLeads, ConvertModelToProtoLead(&lead))
 	}
 
-	return &pb.GetAllLeadsResponse{Leads: protoLeads}, nil
+	return &pb.GetAllLeadsResponse{
+		Leads:         protoLeads,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
 }
 
 func (h *LeadHandler) GetLeadByEmail(ctx context.Context, req *pb.GetLeadByEmailRequest) (*pb.GetLeadByEmailResponse, error) {
-	lead, err := h.leadService.GetLeadByEmail(req.Email)
+	lead, err := h.leadService.GetLeadByEmail(ctx, req.Email)
 	if err != nil {
-		return nil, err
+		return nil, leadError(err)
 	}
 	return &pb.GetLeadByEmailResponse{
 		Lead: ConvertModelToProtoLead(lead),
 	}, nil
 }
+
+func (h *LeadHandler) ConvertLead(ctx context.Context, req *pb.ConvertLeadRequest) (*pb.ConvertLeadResponse, error) {
+	log.Printf("Received ConvertLead request: %+v", req)
+
+	conversion := services.LeadConversion{CompanyID: int32(req.CompanyId)}
+	if req.Contact != nil {
+		conversion.Contact = convertProtoToCreateContactParams(req.Contact)
+	}
+	if req.Company != nil {
+		company := convertProtoToCreateCompanyParams(req.Company)
+		conversion.Company = &company
+	}
+	if req.Opportunity != nil {
+		opportunity, err := convertProtoToCreateOpportunityParams(req.Opportunity)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		conversion.Opportunity = &opportunity
+	}
+
+	converted, err := h.leadService.ConvertLead(ctx, int32(req.Id), conversion)
+	if err != nil {
+		log.Printf("Error converting lead: %v", err)
+		return nil, leadError(err)
+	}
+
+	h.wsServer.BroadcastMessage([]byte("Lead converted!"))
+
+	response := &pb.ConvertLeadResponse{
+		Lead:    ConvertModelToProtoLead(&converted.Lead),
+		Contact: convertContactModelToProto(&converted.Contact),
+	}
+	if converted.Company != nil {
+		response.Company = convertCompanyModelToProto(converted.Company)
+	}
+	if converted.Opportunity != nil {
+		response.Opportunity = convertOpportunityModelToProto(converted.Opportunity)
+	}
+	return response, nil
+}
+
+func (h *LeadHandler) GetLeadWorkflow(ctx context.Context, req *pb.GetLeadWorkflowRequest) (*pb.LeadWorkflow, error) {
+	workflow, err := h.leadService.GetLeadWorkflow(ctx, int32(req.OrganizationId))
+	if err != nil {
+		log.Printf("Error getting lead workflow: %v", err)
+		return nil, leadError(err)
+	}
+	return convertLeadWorkflowToProto(workflow), nil
+}
+
+func (h *LeadHandler) SetLeadWorkflow(ctx context.Context, req *pb.SetLeadWorkflowRequest) (*pb.LeadWorkflow, error) {
+	log.Printf("Received SetLeadWorkflow request: %+v", req)
+
+	workflow := services.LeadWorkflow{OrganizationID: int32(req.OrganizationId)}
+	for _, status := range req.Statuses {
+		workflow.Statuses = append(workflow.Statuses, services.LeadStatusDefinition{Name: status.Name, Initial: status.Initial, Closed: status.Closed})
+	}
+	for _, t := range req.Transitions {
+		workflow.Transitions = append(workflow.Transitions, services.LeadStatusTransition{From: t.From, To: t.To})
+	}
+
+	updated, err := h.leadService.SetLeadWorkflow(ctx, workflow)
+	if err != nil {
+		log.Printf("Error setting lead workflow: %v", err)
+		return nil, leadError(err)
+	}
+	return convertLeadWorkflowToProto(updated), nil
+}
+
+func (h *LeadHandler) GetLeadStatusHistory(ctx context.Context, req *pb.GetLeadStatusHistoryRequest) (*pb.GetLeadStatusHistoryResponse, error) {
+	history, err := h.leadService.GetLeadStatusHistory(ctx, int32(req.Id))
+	if err != nil {
+		return nil, leadError(err)
+	}
+
+	var changes []*pb.LeadStatusChange
+	for _, entry := range history {
+		changes = append(changes, &pb.LeadStatusChange{
+			FromStatus: entry.FromStatus.String,
+			ToStatus:   entry.ToStatus,
+			EnteredAt:  entry.EnteredAt.Format(time.RFC3339),
+		})
+	}
+	return &pb.GetLeadStatusHistoryResponse{History: changes}, nil
+}
+
+// leadError maps lead service errors to gRPC status errors.
+func leadError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrLeadNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidLeadData),
+		errors.Is(err, services.ErrInvalidEmail),
+		errors.Is(err, services.ErrInvalidLeadConversion),
+		errors.Is(err, services.ErrUnknownStage),
+		errors.Is(err, services.ErrPipelineNotFound),
+		errors.Is(err, services.ErrInvalidCurrency),
+		errors.Is(err, services.ErrInvalidOpportunityData),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrUnknownLeadStatus),
+		errors.Is(err, services.ErrInvalidLeadWorkflow):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrLeadAlreadyConverted),
+		errors.Is(err, services.ErrIllegalLeadTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, services.ErrContactExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// ConvertProtoToCreateLeadParams maps the protobuf Lead to sqlc insert params.
+func ConvertProtoToCreateLeadParams(proto *pb.Lead) db.CreateLeadParams {
+	return db.CreateLeadParams{
+		FirstName:      proto.FirstName,
+		LastName:       proto.LastName,
+		Email:          proto.Email,
+		Phone:          nullString(proto.Phone),
+		Status:         proto.Status,
+		AssignedTo:     nullInt32(proto.AssignedTo),
+		OrganizationID: nullInt32(proto.OrganizationId),
+		Country:        nullString(proto.Country),
+		State:          nullString(proto.State),
+		CompanyID:      nullInt32(proto.CompanyId),
+	}
+}
+
+// ConvertModelToProtoLead maps a sqlc Lead row to the protobuf Lead.
+func ConvertModelToProtoLead(model *db.Lead) *pb.Lead {
+	return &pb.Lead{
+		Id:             uint32(model.ID),
+		FirstName:      model.FirstName,
+		LastName:       model.LastName,
+		Email:          model.Email,
+		Phone:          model.Phone.String,
+		Status:         model.Status,
+		AssignedTo:     uint32(model.AssignedTo.Int32),
+		OrganizationId: uint32(model.OrganizationID.Int32),
+		CreatedAt:      formatNullTime(model.CreatedAt),
+		UpdatedAt:      formatNullTime(model.UpdatedAt),
+
+		ConvertedAt:            formatNullTime(model.ConvertedAt),
+		ConvertedContactId:     uint32(model.ConvertedContactID.Int32),
+		ConvertedCompanyId:     uint32(model.ConvertedCompanyID.Int32),
+		ConvertedOpportunityId: uint32(model.ConvertedOpportunityID.Int32),
+		StatusEnteredAt:        formatNullTime(model.StatusEnteredAt),
+		Score:                  model.Score,
+		ScoreUpdatedAt:         formatNullTime(model.ScoreUpdatedAt),
+		Country:                model.Country.String,
+		State:                  model.State.String,
+		CompanyId:              uint32(model.CompanyID.Int32),
+		AssignedAt:             formatNullTime(model.AssignedAt),
+		AssignmentReason:       model.AssignmentReason.String,
+	}
+}
+
+func convertLeadWorkflowToProto(workflow *services.LeadWorkflow) *pb.LeadWorkflow {
+	resp := &pb.LeadWorkflow{
+		OrganizationId: uint32(workflow.OrganizationID),
+		Default:        workflow.Default,
+	}
+	for _, status := range workflow.Statuses {
+		resp.Statuses = append(resp.Statuses, &pb.LeadStatusDefinition{Name: status.Name, Initial: status.Initial, Closed: status.Closed})
+	}
+	for _, t := range workflow.Transitions {
+		resp.Transitions = append(resp.Transitions, &pb.LeadStatusTransition{From: t.From, To: t.To})
+	}
+	return resp
+}
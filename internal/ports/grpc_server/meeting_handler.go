@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MeetingHandler struct {
+	meetingService services.MeetingServiceInterface
+	pb.UnimplementedMeetingServiceServer
+}
+
+func NewMeetingHandler(service services.MeetingServiceInterface) *MeetingHandler {
+	return &MeetingHandler{meetingService: service}
+}
+
+func (h *MeetingHandler) ScheduleMeeting(ctx context.Context, req *pb.ScheduleMeetingRequest) (*pb.MeetingResponse, error) {
+	log.Printf("Received ScheduleMeeting request: %+v", req)
+
+	start, end, err := parseMeetingWindow(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	details, err := h.meetingService.ScheduleMeeting(ctx, db.CreateMeetingParams{
+		Title:       req.Title,
+		Description: nullString(req.Description),
+		StartTime:   start,
+		EndTime:     end,
+		MeetingLink: nullString(req.MeetingLink),
+		OrganizerID: int32(req.OrganizerId),
+	}, convertProtoToAttendees(req.Attendees))
+	if err != nil {
+		log.Printf("Error scheduling meeting: %v", err)
+		return nil, meetingError(err, "failed to schedule meeting")
+	}
+
+	return &pb.MeetingResponse{
+		MeetingId: uint32(details.Meeting.ID),
+		Status:    details.Meeting.Status,
+		Meeting:   convertMeetingToProto(details),
+	}, nil
+}
+
+func (h *MeetingHandler) GetMeeting(ctx context.Context, req *pb.GetMeetingRequest) (*pb.GetMeetingResponse, error) {
+	details, err := h.meetingService.GetMeeting(ctx, int32(req.Id))
+	if err != nil {
+		log.Printf("Error getting meeting: %v", err)
+		return nil, meetingError(err, "failed to get meeting")
+	}
+
+	return &pb.GetMeetingResponse{Meeting: convertMeetingToProto(details)}, nil
+}
+
+func (h *MeetingHandler) UpdateMeeting(ctx context.Context, req *pb.UpdateMeetingRequest) (*pb.UpdateMeetingResponse, error) {
+	log.Printf("Received UpdateMeeting request: %+v", req)
+
+	if req.Meeting == nil {
+		return nil, status.Error(codes.InvalidArgument, services.ErrInvalidMeetingData.Error())
+	}
+	start, end, err := parseMeetingWindow(req.Meeting.StartTime, req.Meeting.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// An empty attendee list keeps the current attendees.
+	var attendees []db.MeetingAttendee
+	if len(req.Meeting.Attendees) > 0 {
+		attendees = convertProtoToAttendees(req.Meeting.Attendees)
+	}
+
+	details, err := h.meetingService.UpdateMeeting(ctx, db.UpdateMeetingParams{
+		ID:          int32(req.Meeting.Id),
+		Title:       req.Meeting.Title,
+		Description: nullString(req.Meeting.Description),
+		StartTime:   start,
+		EndTime:     end,
+		MeetingLink: nullString(req.Meeting.MeetingLink),
+		Status:      req.Meeting.Status,
+	}, attendees)
+	if err != nil {
+		log.Printf("Error updating meeting: %v", err)
+		return nil, meetingError(err, "failed to update meeting")
+	}
+
+	return &pb.UpdateMeetingResponse{Meeting: convertMeetingToProto(details)}, nil
+}
+
+func (h *MeetingHandler) DeleteMeeting(ctx context.Context, req *pb.DeleteMeetingRequest) (*pb.DeleteMeetingResponse, error) {
+	log.Printf("Received DeleteMeeting request: %+v", req)
+
+	if err := h.meetingService.DeleteMeeting(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting meeting: %v", err)
+		return nil, meetingError(err, "failed to delete meeting")
+	}
+
+	return &pb.DeleteMeetingResponse{Success: true}, nil
+}
+
+func (h *MeetingHandler) ListMeetings(ctx context.Context, req *pb.ListMeetingsRequest) (*pb.ListMeetingsResponse, error) {
+	page, err := h.meetingService.ListMeetings(ctx, int32(req.OrganizerId), listOptions(req))
+	if err != nil {
+		log.Printf("Error listing meetings: %v", err)
+		if errors.Is(err, filter.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list meetings")
+	}
+
+	var protoMeetings []*pb.Meeting
+	for i := range page.Items {
+		protoMeetings = append(protoMeetings, convertMeetingToProto(&page.Items[i]))
+	}
+
+	return &pb.ListMeetingsResponse{
+		Meetings:      protoMeetings,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
+}
+
+// meetingError maps meeting service errors to gRPC status errors.
+func meetingError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrMeetingNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrMeetingConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, services.ErrInvalidMeetingData):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+func parseMeetingWindow(startStr, endStr string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, startStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("start_time must be RFC3339")
+	}
+	end, err := time.Parse(time.RFC3339, endStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("end_time must be RFC3339")
+	}
+	return start, end, nil
+}
+
+// ---------- Proto ↔ SQLC ----------
+
+func convertProtoToAttendees(protoAttendees []*pb.MeetingAttendee) []db.MeetingAttendee {
+	attendees := make([]db.MeetingAttendee, 0, len(protoAttendees))
+	for _, a := range protoAttendees {
+		attendees = append(attendees, db.MeetingAttendee{
+			ContactID: nullInt32(a.ContactId),
+			LeadID:    nullInt32(a.LeadId),
+		})
+	}
+	return attendees
+}
+
+func convertMeetingToProto(details *services.MeetingDetails) *pb.Meeting {
+	m := details.Meeting
+
+	attendees := make([]*pb.MeetingAttendee, 0, len(details.Attendees))
+	for _, a := range details.Attendees {
+		attendees = append(attendees, &pb.MeetingAttendee{
+			ContactId: uint32(a.ContactID.Int32),
+			LeadId:    uint32(a.LeadID.Int32),
+		})
+	}
+
+	return &pb.Meeting{
+		Id:          uint32(m.ID),
+		Title:       m.Title,
+		Description: m.Description.String,
+		StartTime:   m.StartTime.Format(time.RFC3339),
+		EndTime:     m.EndTime.Format(time.RFC3339),
+		MeetingLink: m.MeetingLink.String,
+		OrganizerId: uint32(m.OrganizerID),
+		Status:      m.Status,
+		Attendees:   attendees,
+		CreatedAt:   formatNullTime(m.CreatedAt),
+		UpdatedAt:   formatNullTime(m.UpdatedAt),
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/services"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMeetingErrorCodes(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	contact := db.MeetingAttendee{ContactID: sql.NullInt32{Int32: 1, Valid: true}}
+	schedule := func(meeting db.CreateMeetingParams, attendees ...db.MeetingAttendee) error {
+		_, err := services.NewMeetingService(nil, nil, nil).ScheduleMeeting(context.Background(), meeting, attendees)
+		return err
+	}
+	valid := db.CreateMeetingParams{Title: "Demo", OrganizerID: 1, StartTime: start, EndTime: start.Add(time.Hour)}
+	backwards := valid
+	backwards.EndTime = start
+
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"end before start", schedule(backwards), codes.InvalidArgument},
+		{"attendee without contact or lead", schedule(valid, db.MeetingAttendee{}), codes.InvalidArgument},
+		{"attendee listed twice", schedule(valid, contact, contact), codes.InvalidArgument},
+		{"overlap", fmt.Errorf("%w: contact 1 is booked in meeting 7", services.ErrMeetingConflict), codes.FailedPrecondition},
+		{"not found", services.ErrMeetingNotFound, codes.NotFound},
+		{"anything else", errors.New("connection reset"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := status.Code(meetingError(tt.err, "failed")); got != tt.want {
+				t.Errorf("code = %v, want %v (error %v)", got, tt.want, tt.err)
+			}
+		})
+	}
+}
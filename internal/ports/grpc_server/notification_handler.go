@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/notify"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationHandler struct {
+	notificationService services.NotificationServiceInterface
+	pb.UnimplementedNotificationServiceServer
+}
+
+func NewNotificationHandler(service services.NotificationServiceInterface) *NotificationHandler {
+	return &NotificationHandler{notificationService: service}
+}
+
+func (h *NotificationHandler) SendNotification(ctx context.Context, req *pb.SendNotificationRequest) (*pb.SendNotificationResponse, error) {
+	log.Printf("Received SendNotification request: channel=%s recipient=%s template=%s sync=%t",
+		req.Channel, req.Recipient, req.TemplateName, req.Sync)
+
+	channel := req.Channel
+	if channel == "" {
+		channel = notify.ChannelEmail
+	}
+	return h.send(ctx, services.NotificationRequest{
+		Channel:          channel,
+		UserID:           req.UserId,
+		Recipient:        req.Recipient,
+		TemplateName:     req.TemplateName,
+		Data:             req.Data,
+		NotificationType: req.NotificationType,
+	}, req.Sync)
+}
+
+func (h *NotificationHandler) SendNotificationWithSMTP(ctx context.Context, req *pb.SendNotificationWithSMTPRequest) (*pb.SendNotificationResponse, error) {
+	log.Printf("Received SendNotificationWithSMTP request: user=%s recipient=%s template=%s sync=%t",
+		req.UserId, req.Recipient, req.TemplateName, req.Sync)
+
+	return h.send(ctx, services.NotificationRequest{
+		Channel:          notify.ChannelEmail,
+		UserID:           req.UserId,
+		Recipient:        req.Recipient,
+		TemplateName:     req.TemplateName,
+		Data:             req.Data,
+		NotificationType: req.NotificationType,
+	}, req.Sync)
+}
+
+func (h *NotificationHandler) SendNotificationWithSMS(ctx context.Context, req *pb.SendNotificationWithSMSRequest) (*pb.SendNotificationResponse, error) {
+	log.Printf("Received SendNotificationWithSMS request: user=%s recipient=%s template=%s sync=%t",
+		req.UserId, req.Recipient, req.TemplateName, req.Sync)
+
+	return h.send(ctx, services.NotificationRequest{
+		Channel:          notify.ChannelSMS,
+		UserID:           req.UserId,
+		Recipient:        req.Recipient,
+		TemplateName:     req.TemplateName,
+		Data:             req.Data,
+		NotificationType: req.NotificationType,
+	}, req.Sync)
+}
+
+func (h *NotificationHandler) send(ctx context.Context, req services.NotificationRequest, sync bool) (*pb.SendNotificationResponse, error) {
+	result, err := h.notificationService.Send(ctx, req, sync)
+	if err != nil {
+		log.Printf("Error sending notification: %v", err)
+		return nil, notificationError(err)
+	}
+
+	return &pb.SendNotificationResponse{
+		Id:        result.ID,
+		Status:    result.Status,
+		Message:   result.Message,
+		Timestamp: result.Timestamp.Format(time.RFC3339),
+	}, nil
+}
+
+// notificationError maps notification service errors to gRPC status errors.
+// Message data is not echoed back since it may hold personal details.
+func notificationError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrTemplateNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidNotification),
+		errors.Is(err, services.ErrUnknownChannel),
+		errors.Is(err, services.ErrTemplateChannel),
+		errors.Is(err, services.ErrMissingTemplateData):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "failed to send notification")
+	}
+}
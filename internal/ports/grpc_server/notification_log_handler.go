@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationLogHandler struct {
+	logService services.NotificationLogServiceInterface
+	pb.UnimplementedNotificationLogServiceServer
+}
+
+func NewNotificationLogHandler(service services.NotificationLogServiceInterface) *NotificationLogHandler {
+	return &NotificationLogHandler{logService: service}
+}
+
+func (h *NotificationLogHandler) GetLog(ctx context.Context, req *pb.GetLogRequest) (*pb.NotificationLogResponse, error) {
+	entry, err := h.logService.GetLog(ctx, req.Id)
+	if err != nil {
+		log.Printf("Error getting notification log: %v", err)
+		if errors.Is(err, services.ErrNotificationLogNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get notification log")
+	}
+
+	return convertNotificationLogToProto(entry), nil
+}
+
+func (h *NotificationLogHandler) ListLogs(ctx context.Context, req *pb.ListLogsRequest) (*pb.ListLogsResponse, error) {
+	from, err := parseOptionalTime("created_from", req.CreatedFrom)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("created_to", req.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.logService.ListLogs(ctx, services.NotificationLogFilter{
+		Status:       req.Status,
+		Channel:      req.Channel,
+		Recipient:    req.Recipient,
+		TemplateName: req.TemplateName,
+		CreatedFrom:  from,
+		CreatedTo:    to,
+	}, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing notification logs: %v", err)
+		if errors.Is(err, services.ErrInvalidLogFilter) || errors.Is(err, filter.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list notification logs")
+	}
+
+	var logs []*pb.NotificationLogResponse
+	for i := range page.Items {
+		logs = append(logs, convertNotificationLogToProto(&page.Items[i]))
+	}
+
+	return &pb.ListLogsResponse{
+		Logs:          logs,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
+}
+
+// parseOptionalTime parses an RFC3339 filter bound, treating "" as unset.
+func parseOptionalTime(field, value string) (sql.NullTime, error) {
+	if value == "" {
+		return sql.NullTime{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return sql.NullTime{}, status.Errorf(codes.InvalidArgument, "%s must be RFC3339", field)
+	}
+	return sql.NullTime{Time: t, Valid: true}, nil
+}
+
+// ---------- SQLC → Proto ----------
+
+func convertNotificationLogToProto(entry *db.NotificationLog) *pb.NotificationLogResponse {
+	return &pb.NotificationLogResponse{
+		Id:               entry.ID,
+		NotificationType: entry.NotificationType,
+		TemplateName:     entry.TemplateName,
+		Recipient:        entry.Recipient,
+		Status:           entry.Status,
+		ErrorMessage:     entry.ErrorMessage,
+		SentAt:           formatNullTime(entry.SentAt),
+		Channel:          entry.Channel,
+		Attempts:         entry.Attempts,
+		TemplateVersion:  entry.TemplateVersion,
+		UserId:           entry.UserID,
+		NextAttemptAt:    formatNullTime(entry.NextAttemptAt),
+		CreatedAt:        entry.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -3,8 +3,11 @@ package handler
 import (
 	"context"
 	"crm/api/proto/pb"
-	"crm/internal/core/domain/models"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"crm/internal/core/services"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 
@@ -12,41 +15,39 @@ import (
 	"google.golang.org/grpc/status"
 
 	"time"
-	// "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type OpportunityHandler struct {
-	opportunityService services.OpportunityService
+	opportunityService services.OpportunityServiceInterface
 	pb.UnimplementedOpportunityServiceServer
 }
 
-func NewOpportunityHandler(service services.OpportunityService) *OpportunityHandler {
+func NewOpportunityHandler(service services.OpportunityServiceInterface) *OpportunityHandler {
 	return &OpportunityHandler{opportunityService: service}
 }
 
 func (h *OpportunityHandler) CreateOpportunity(ctx context.Context, req *pb.CreateOpportunityRequest) (*pb.CreateOpportunityResponse, error) {
 	log.Printf("Received CreateOpportunity request: %+v", req)
 
-	opportunity, err := convertProtoToModel(req.Opportunity)
-
+	opportunity, err := convertProtoToCreateOpportunityParams(req.Opportunity)
 	if err != nil {
-		fmt.Print("failed to convert proto to model %v", err)
-		return nil, err
+		log.Printf("failed to convert proto to params: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	createdOpportunity, err := h.opportunityService.CreateOpportunity(opportunity)
+	createdOpportunity, err := h.opportunityService.CreateOpportunity(ctx, opportunity)
 	if err != nil {
 		log.Printf("Error creating opportunity: %v", err)
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, opportunityError(err, codes.InvalidArgument)
 	}
 
 	return &pb.CreateOpportunityResponse{
-		Opportunity: convertModelToProto(createdOpportunity),
+		Opportunity: convertOpportunityModelToProto(createdOpportunity),
 	}, nil
 }
 
 func (h *OpportunityHandler) GetOpportunity(ctx context.Context, req *pb.GetOpportunityRequest) (*pb.GetOpportunityResponse, error) {
-	opportunity, err := h.opportunityService.GetOpportunity(uint(req.Id))
+	opportunity, err := h.opportunityService.GetOpportunity(ctx, int32(req.Id))
 	if err != nil {
 		if err == services.ErrOpportunityNotFound {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -55,16 +56,15 @@ func (h *OpportunityHandler) GetOpportunity(ctx context.Context, req *pb.GetOppo
 	}
 
 	return &pb.GetOpportunityResponse{
-		Opportunity: convertModelToProto(opportunity),
+		Opportunity: convertOpportunityModelToProto(opportunity),
 	}, nil
 }
 
-// Implement UpdateOpportunity, DeleteOpportunity, ListOpportunities similarly...
 func (h *OpportunityHandler) UpdateOpportunity(ctx context.Context, req *pb.UpdateOpportunityRequest) (*pb.UpdateOpportunityResponse, error) {
 	log.Printf("Received UpdateOpportunity request: %+v", req)
 
 	// Retrieve the existing opportunity from the database
-	existingOpportunity, err := h.opportunityService.GetOpportunity(uint(req.Opportunity.Id))
+	existingOpportunity, err := h.opportunityService.GetOpportunity(ctx, int32(req.Opportunity.Id))
 	if err != nil {
 		if err == services.ErrOpportunityNotFound {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -73,46 +73,44 @@ func (h *OpportunityHandler) UpdateOpportunity(ctx context.Context, req *pb.Upda
 	}
 
 	// Update fields only if they are provided (non-zero values)
-	if req.Opportunity.Name != "" {
-		existingOpportunity.Name = req.Opportunity.Name
-	}
-	if req.Opportunity.Description != "" {
-		existingOpportunity.Description = req.Opportunity.Description
+	params := db.UpdateOpportunityParams{
+		ID:                    existingOpportunity.ID,
+		Stage:                 existingOpportunity.Stage,
+		Amount:                existingOpportunity.Amount,
+		Probability:           existingOpportunity.Probability,
+		ProbabilityOverridden: existingOpportunity.ProbabilityOverridden,
+		CloseDate:             existingOpportunity.CloseDate,
 	}
 	if req.Opportunity.Stage != "" {
-		existingOpportunity.Stage = req.Opportunity.Stage
+		params.Stage = sql.NullString{String: req.Opportunity.Stage, Valid: true}
 	}
 	if req.Opportunity.Amount != 0 {
-		existingOpportunity.Amount = req.Opportunity.Amount
-	}
-	if req.Opportunity.CloseDate != "" {
-		parsedDate, _ := parseDate(req.Opportunity.CloseDate)
-		existingOpportunity.CloseDate = parsedDate
+		params.Amount = req.Opportunity.Amount
 	}
 	if req.Opportunity.Probability != 0 {
-		existingOpportunity.Probability = req.Opportunity.Probability
-	}
-	if req.Opportunity.LeadId != 0 {
-		tempLeadID := uint(req.Opportunity.LeadId)
-		existingOpportunity.LeadID = tempLeadID
+		params.Probability = req.Opportunity.Probability
+		params.ProbabilityOverridden = true
 	}
-	if req.Opportunity.AccountId != 0 {
-		tempAccountID := uint(req.Opportunity.AccountId)
-		existingOpportunity.AccountID = tempAccountID
+	if req.ResetProbability {
+		params.ProbabilityOverridden = false
 	}
-	if req.Opportunity.OwnerId != 0 {
-		tempOwnerID := uint(req.Opportunity.OwnerId)
-		existingOpportunity.OwnerID = tempOwnerID
+	if req.Opportunity.CloseDate != "" {
+		closeDate, err := parseDate(req.Opportunity.CloseDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		params.CloseDate = sql.NullTime{Time: closeDate, Valid: true}
 	}
 
 	// Save the updated opportunity
-	updatedOpportunity, err := h.opportunityService.UpdateOpportunity(existingOpportunity)
+	updatedOpportunity, err := h.opportunityService.UpdateOpportunity(ctx, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Printf("Error updating opportunity: %v", err)
+		return nil, opportunityError(err, codes.Internal)
 	}
 
 	return &pb.UpdateOpportunityResponse{
-		Opportunity: convertModelToProto(updatedOpportunity),
+		Opportunity: convertOpportunityModelToProto(updatedOpportunity),
 	}, nil
 }
 
@@ -120,7 +118,7 @@ func (h *OpportunityHandler) DeleteOpportunity(ctx context.Context, req *pb.Dele
 	log.Printf("Received DeleteOpportunity request: %+v", req)
 
 	// Call the service layer to delete the opportunity
-	err := h.opportunityService.DeleteOpportunity(uint(req.Id))
+	err := h.opportunityService.DeleteOpportunity(ctx, int32(req.Id))
 	if err != nil {
 		if err == services.ErrOpportunityNotFound {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -137,64 +135,540 @@ func (h *OpportunityHandler) DeleteOpportunity(ctx context.Context, req *pb.Dele
 func (h *OpportunityHandler) ListOpportunities(ctx context.Context, req *pb.ListOpportunitiesRequest) (*pb.ListOpportunitiesResponse, error) {
 	log.Printf("Received ListOpportunities request: %+v", req)
 
-	ownerID := uint(req.OwnerId)
-
 	// Call the service layer to list opportunities
-	opportunities, err := h.opportunityService.ListOpportunities(ownerID)
+	page, err := h.opportunityService.ListOpportunities(ctx, int32(req.OwnerId), listOptions(req))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, opportunityError(err, codes.Internal)
+	}
+
+	totals, err := h.opportunityService.TotalOpportunities(ctx, int32(req.OwnerId), convertFilterGroupFromProto(req.Filter), int32(req.OrganizationId), req.Currency)
+	if err != nil {
+		log.Printf("Error totalling opportunities: %v", err)
+		return nil, opportunityError(err, codes.Internal)
 	}
 
 	// Convert to protobuf opportunities
 	var protoOpps []*pb.Opportunity
-	for _, opp := range opportunities {
-		protoOpps = append(protoOpps, convertModelToProto(&opp))
+	for _, opp := range page.Items {
+		protoOpps = append(protoOpps, convertOpportunityModelToProto(&opp))
 	}
 
 	return &pb.ListOpportunitiesResponse{
-		Opportunities: protoOpps,
+		Opportunities:       protoOpps,
+		TotalAmount:         totals.Amount,
+		TotalWeightedAmount: totals.WeightedAmount,
+		Currency:            totals.Currency,
+		NextPageToken:       page.NextPageToken,
+		PrevPageToken:       page.PrevPageToken,
+		TotalCount:          uint32(page.TotalCount),
 	}, nil
 }
 
-// Conversion functions
-func convertProtoToModel(protoOpp *pb.Opportunity) (*models.Opportunity, error) {
-	parsedDate, err := parseDate(protoOpp.CloseDate)
+func (h *OpportunityHandler) GetOpportunityHistory(ctx context.Context, req *pb.GetOpportunityHistoryRequest) (*pb.GetOpportunityHistoryResponse, error) {
+	history, err := h.opportunityService.GetOpportunityHistory(ctx, int32(req.Id))
+	if err != nil {
+		return nil, opportunityError(err, codes.Internal)
+	}
+
+	resp := &pb.GetOpportunityHistoryResponse{}
+	for _, entry := range history {
+		resp.History = append(resp.History, &pb.OpportunityChange{
+			Field:     entry.Field,
+			OldValue:  entry.OldValue.String,
+			NewValue:  entry.NewValue.String,
+			ChangedAt: entry.ChangedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (h *OpportunityHandler) GetOpportunityMetrics(ctx context.Context, req *pb.GetOpportunityMetricsRequest) (*pb.OpportunityMetrics, error) {
+	log.Printf("Received GetOpportunityMetrics request: %+v", req)
+
+	filter := services.OpportunityMetricsFilter{
+		PipelineID: int32(req.PipelineId),
+		OwnerID:    int32(req.OwnerId),
+	}
+	if req.CreatedSince != "" {
+		since, err := parseDate(req.CreatedSince)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.CreatedSince = sql.NullTime{Time: since, Valid: true}
+	}
+
+	metrics, err := h.opportunityService.GetOpportunityMetrics(ctx, filter)
+	if err != nil {
+		log.Printf("Error computing opportunity metrics: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+
+	resp := &pb.OpportunityMetrics{Pipeline: convertPipelineToProto(metrics.Pipeline)}
+	for _, stage := range metrics.Stages {
+		resp.Stages = append(resp.Stages, &pb.StageMetrics{
+			Stage:          stage.Stage,
+			Entered:        uint32(stage.Entered),
+			AverageDays:    stage.AverageDays,
+			ConversionRate: stage.ConversionRate,
+		})
+	}
+	for _, owner := range metrics.Owners {
+		resp.Owners = append(resp.Owners, &pb.OwnerWinRate{
+			OwnerId: uint32(owner.OwnerID),
+			Won:     uint32(owner.Won),
+			Lost:    uint32(owner.Lost),
+			WinRate: owner.WinRate,
+		})
+	}
+	return resp, nil
+}
+
+func (h *OpportunityHandler) ListLineItems(ctx context.Context, req *pb.ListLineItemsRequest) (*pb.ListLineItemsResponse, error) {
+	items, err := h.opportunityService.ListLineItems(ctx, int32(req.OpportunityId))
+	if err != nil {
+		log.Printf("Error listing line items: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+
+	resp := &pb.ListLineItemsResponse{}
+	for i := range items {
+		resp.LineItems = append(resp.LineItems, convertLineItemToProto(&items[i]))
+	}
+	return resp, nil
+}
+
+func (h *OpportunityHandler) AddLineItem(ctx context.Context, req *pb.AddLineItemRequest) (*pb.LineItemResponse, error) {
+	log.Printf("Received AddLineItem request: %+v", req)
+	if req.LineItem == nil {
+		return nil, status.Error(codes.InvalidArgument, "line_item is required")
+	}
+
+	change, err := h.opportunityService.AddLineItem(ctx, int32(req.OpportunityId), convertProtoToLineItem(req.LineItem))
+	if err != nil {
+		log.Printf("Error adding line item: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertLineItemChangeToProto(change), nil
+}
+
+func (h *OpportunityHandler) UpdateLineItem(ctx context.Context, req *pb.UpdateLineItemRequest) (*pb.LineItemResponse, error) {
+	log.Printf("Received UpdateLineItem request: %+v", req)
+	if req.LineItem == nil || req.LineItem.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "line item id is required")
+	}
+
+	change, err := h.opportunityService.UpdateLineItem(ctx, int32(req.LineItem.Id), convertProtoToLineItem(req.LineItem))
+	if err != nil {
+		log.Printf("Error updating line item: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertLineItemChangeToProto(change), nil
+}
+
+func (h *OpportunityHandler) RemoveLineItem(ctx context.Context, req *pb.RemoveLineItemRequest) (*pb.LineItemResponse, error) {
+	log.Printf("Received RemoveLineItem request: %+v", req)
+
+	change, err := h.opportunityService.RemoveLineItem(ctx, int32(req.Id))
+	if err != nil {
+		log.Printf("Error removing line item: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertLineItemChangeToProto(change), nil
+}
+
+func (h *OpportunityHandler) CloseOpportunityWon(ctx context.Context, req *pb.CloseOpportunityWonRequest) (*pb.CloseOpportunityResponse, error) {
+	log.Printf("Received CloseOpportunityWon request: %+v", req)
+
+	closeReq, err := convertProtoToCloseOpportunityRequest(req.Id, req.Stage, req.CloseDate, req.Amount, "", req.Competitor)
+	if err != nil {
+		return nil, err
+	}
+	opportunity, err := h.opportunityService.CloseOpportunityWon(ctx, closeReq)
+	if err != nil {
+		log.Printf("Error closing opportunity as won: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return &pb.CloseOpportunityResponse{Opportunity: convertOpportunityModelToProto(opportunity)}, nil
+}
+
+func (h *OpportunityHandler) CloseOpportunityLost(ctx context.Context, req *pb.CloseOpportunityLostRequest) (*pb.CloseOpportunityResponse, error) {
+	log.Printf("Received CloseOpportunityLost request: %+v", req)
+
+	closeReq, err := convertProtoToCloseOpportunityRequest(req.Id, req.Stage, req.CloseDate, req.Amount, req.LossReason, req.Competitor)
+	if err != nil {
+		return nil, err
+	}
+	opportunity, err := h.opportunityService.CloseOpportunityLost(ctx, closeReq)
+	if err != nil {
+		log.Printf("Error closing opportunity as lost: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return &pb.CloseOpportunityResponse{Opportunity: convertOpportunityModelToProto(opportunity)}, nil
+}
+
+func (h *OpportunityHandler) ListLossReasons(ctx context.Context, req *pb.ListLossReasonsRequest) (*pb.ListLossReasonsResponse, error) {
+	reasons, err := h.opportunityService.ListLossReasons(ctx, int32(req.OrganizationId))
+	if err != nil {
+		log.Printf("Error listing loss reasons: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+
+	resp := &pb.ListLossReasonsResponse{}
+	for i := range reasons {
+		resp.LossReasons = append(resp.LossReasons, convertLossReasonToProto(&reasons[i]))
+	}
+	return resp, nil
+}
+
+func (h *OpportunityHandler) CreateLossReason(ctx context.Context, req *pb.CreateLossReasonRequest) (*pb.LossReason, error) {
+	log.Printf("Received CreateLossReason request: %+v", req)
+	if req.LossReason == nil {
+		return nil, status.Error(codes.InvalidArgument, "loss_reason is required")
+	}
+
+	reason, err := h.opportunityService.CreateLossReason(ctx, db.CreateLossReasonParams{
+		OrganizationID: int32(req.LossReason.OrganizationId),
+		Name:           req.LossReason.Name,
+		Active:         optionalBool(req.LossReason.Active, true),
+		Position:       int32(req.LossReason.Position),
+	})
+	if err != nil {
+		log.Printf("Error creating loss reason: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertLossReasonToProto(reason), nil
+}
+
+func (h *OpportunityHandler) UpdateLossReason(ctx context.Context, req *pb.UpdateLossReasonRequest) (*pb.LossReason, error) {
+	log.Printf("Received UpdateLossReason request: %+v", req)
+	if req.LossReason == nil || req.LossReason.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "loss reason id is required")
+	}
+
+	reason, err := h.opportunityService.UpdateLossReason(ctx, db.UpdateLossReasonParams{
+		ID:       int32(req.LossReason.Id),
+		Name:     req.LossReason.Name,
+		Active:   optionalBool(req.LossReason.Active, true),
+		Position: int32(req.LossReason.Position),
+	})
+	if err != nil {
+		log.Printf("Error updating loss reason: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertLossReasonToProto(reason), nil
+}
+
+func (h *OpportunityHandler) DeleteLossReason(ctx context.Context, req *pb.DeleteLossReasonRequest) (*pb.DeleteLossReasonResponse, error) {
+	log.Printf("Received DeleteLossReason request: %+v", req)
+
+	if err := h.opportunityService.DeleteLossReason(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting loss reason: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return &pb.DeleteLossReasonResponse{Success: true}, nil
+}
+
+func (h *OpportunityHandler) AddOpportunityContact(ctx context.Context, req *pb.AddOpportunityContactRequest) (*pb.OpportunityContact, error) {
+	log.Printf("Received AddOpportunityContact request: %+v", req)
+
+	added, err := h.opportunityService.AddOpportunityContact(ctx, int32(req.OpportunityId), int32(req.ContactId), req.Role)
+	if err != nil {
+		log.Printf("Error adding opportunity contact: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertOpportunityContactToProto(added), nil
+}
+
+func (h *OpportunityHandler) RemoveOpportunityContact(ctx context.Context, req *pb.RemoveOpportunityContactRequest) (*pb.RemoveOpportunityContactResponse, error) {
+	log.Printf("Received RemoveOpportunityContact request: %+v", req)
+
+	if err := h.opportunityService.RemoveOpportunityContact(ctx, int32(req.OpportunityId), int32(req.ContactId), req.Role); err != nil {
+		log.Printf("Error removing opportunity contact: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return &pb.RemoveOpportunityContactResponse{Success: true}, nil
+}
+
+func (h *OpportunityHandler) ListOpportunityContacts(ctx context.Context, req *pb.ListOpportunityContactsRequest) (*pb.ListOpportunityContactsResponse, error) {
+	contacts, err := h.opportunityService.ListOpportunityContacts(ctx, int32(req.OpportunityId))
+	if err != nil {
+		log.Printf("Error listing opportunity contacts: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+
+	resp := &pb.ListOpportunityContactsResponse{}
+	for i := range contacts {
+		resp.Contacts = append(resp.Contacts, convertOpportunityContactToProto(&contacts[i]))
+	}
+	return resp, nil
+}
+
+func (h *OpportunityHandler) CreatePipeline(ctx context.Context, req *pb.CreatePipelineRequest) (*pb.Pipeline, error) {
+	log.Printf("Received CreatePipeline request: %+v", req)
+	if req.Pipeline == nil {
+		return nil, status.Error(codes.InvalidArgument, "pipeline is required")
+	}
+
+	pipeline, err := h.opportunityService.CreatePipeline(ctx, convertProtoToPipeline(req.Pipeline))
+	if err != nil {
+		log.Printf("Error creating pipeline: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertPipelineToProto(pipeline), nil
+}
+
+func (h *OpportunityHandler) GetPipeline(ctx context.Context, req *pb.GetPipelineRequest) (*pb.Pipeline, error) {
+	pipeline, err := h.opportunityService.GetPipeline(ctx, int32(req.Id))
+	if err != nil {
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertPipelineToProto(pipeline), nil
+}
+
+func (h *OpportunityHandler) UpdatePipeline(ctx context.Context, req *pb.UpdatePipelineRequest) (*pb.Pipeline, error) {
+	log.Printf("Received UpdatePipeline request: %+v", req)
+	if req.Pipeline == nil || req.Pipeline.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pipeline id is required")
+	}
+
+	pipeline, err := h.opportunityService.UpdatePipeline(ctx, convertProtoToPipeline(req.Pipeline))
+	if err != nil {
+		log.Printf("Error updating pipeline: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return convertPipelineToProto(pipeline), nil
+}
+
+func (h *OpportunityHandler) DeletePipeline(ctx context.Context, req *pb.DeletePipelineRequest) (*pb.DeletePipelineResponse, error) {
+	log.Printf("Received DeletePipeline request: %+v", req)
+
+	if err := h.opportunityService.DeletePipeline(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting pipeline: %v", err)
+		return nil, opportunityError(err, codes.Internal)
+	}
+	return &pb.DeletePipelineResponse{Success: true}, nil
+}
+
+func (h *OpportunityHandler) ListPipelines(ctx context.Context, req *pb.ListPipelinesRequest) (*pb.ListPipelinesResponse, error) {
+	pipelines, err := h.opportunityService.ListPipelines(ctx, int32(req.OrganizationId))
 	if err != nil {
-		return nil, fmt.Errorf("invalid close date format: %s", protoOpp.CloseDate)
+		log.Printf("Error listing pipelines: %v", err)
+		return nil, opportunityError(err, codes.Internal)
 	}
 
-	// Convert protobuf Opportunity to models.Opportunity
-	return &models.Opportunity{
-		Id:          uint(protoOpp.Id), // Fixed casing to match struct
-		Name:        protoOpp.Name,
-		Description: protoOpp.Description,
-		Stage:       protoOpp.Stage,
+	resp := &pb.ListPipelinesResponse{}
+	for i := range pipelines {
+		resp.Pipelines = append(resp.Pipelines, convertPipelineToProto(&pipelines[i]))
+	}
+	return resp, nil
+}
+
+// opportunityError maps opportunity, line item and pipeline service errors to gRPC
+// status errors; other errors get the fallback code.
+func opportunityError(err error, fallback codes.Code) error {
+	switch {
+	case errors.Is(err, services.ErrOpportunityNotFound),
+		errors.Is(err, services.ErrPipelineNotFound),
+		errors.Is(err, services.ErrLineItemNotFound),
+		errors.Is(err, services.ErrProductNotFound),
+		errors.Is(err, services.ErrLossReasonNotFound),
+		errors.Is(err, services.ErrContactNotFound),
+		errors.Is(err, services.ErrOpportunityContactNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidOpportunityData),
+		errors.Is(err, services.ErrInvalidPipeline),
+		errors.Is(err, services.ErrUnknownStage),
+		errors.Is(err, services.ErrInvalidCurrency),
+		errors.Is(err, services.ErrInvalidLineItem),
+		errors.Is(err, services.ErrInvalidLossReason),
+		errors.Is(err, services.ErrInvalidContactRole),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrPipelineExists),
+		errors.Is(err, services.ErrLossReasonExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, services.ErrPipelineInUse),
+		errors.Is(err, services.ErrNoExchangeRate),
+		errors.Is(err, services.ErrNoProductPrice),
+		errors.Is(err, services.ErrOpportunityClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(fallback, err.Error())
+	}
+}
+
+// Conversion functions
+func convertProtoToCreateOpportunityParams(protoOpp *pb.Opportunity) (db.CreateOpportunityParams, error) {
+	var closeDate sql.NullTime
+	if protoOpp.CloseDate != "" {
+		parsedDate, err := parseDate(protoOpp.CloseDate)
+		if err != nil {
+			return db.CreateOpportunityParams{}, fmt.Errorf("invalid close date format: %s", protoOpp.CloseDate)
+		}
+		closeDate = sql.NullTime{Time: parsedDate, Valid: true}
+	}
+
+	return db.CreateOpportunityParams{
+		Name:        nullString(protoOpp.Name),
+		Description: nullString(protoOpp.Description),
+		Stage:       nullString(protoOpp.Stage),
 		Amount:      protoOpp.Amount,
-		CloseDate:   parsedDate, // Using the parsed date
+		CloseDate:   closeDate,
 		Probability: protoOpp.Probability,
-		LeadID:      uint(protoOpp.LeadId),
-		AccountID:   uint(protoOpp.AccountId),
-		OwnerID:     uint(protoOpp.OwnerId),
+		LeadID:      nullInt32(protoOpp.LeadId),
+		AccountID:   nullInt32(protoOpp.AccountId),
+		OwnerID:     nullInt32(protoOpp.OwnerId),
+		PipelineID:  nullInt32(protoOpp.PipelineId),
+		Currency:    protoOpp.Currency,
+		// A probability given with the opportunity overrides the stage's.
+		ProbabilityOverridden: protoOpp.ProbabilityOverridden || protoOpp.Probability != 0,
 	}, nil
 }
 
-// func convertModelToProto(modelOpp *models.Opportunity) *pb.Opportunity {
-// 	// Convert models.Opportunity to protobuf Opportunity
-// 	return &pb.Opportunity{
-// 		Id:          uint32(modelOpp.Id),
-// 		Name:        modelOpp.Name,
-// 		Description: modelOpp.Description,
-// 		Stage:       modelOpp.Stage,
-// 		Amount:      modelOpp.Amount,
-// 		CloseDate:   modelOpp.CloseDate.Format(time.RFC3339),
-// 		Probability: modelOpp.Probability,
-// 		LeadId:      uint32(modelOpp.LeadID),
-// 		AccountId:   uint32(modelOpp.AccountID),
-// 		OwnerId:     uint32(modelOpp.OwnerID),
-// 		CreatedAt:   modelOpp.CreatedAt.Format(time.RFC3339),
-// 		UpdatedAt:   modelOpp.UpdatedAt.Format(time.RFC3339),
-// 	}
-// }
+func convertOpportunityModelToProto(modelOpp *db.Opportunity) *pb.Opportunity {
+	return &pb.Opportunity{
+		Id:          uint32(modelOpp.ID),
+		Name:        modelOpp.Name.String,
+		Description: modelOpp.Description.String,
+		Stage:       modelOpp.Stage.String,
+		Amount:      modelOpp.Amount,
+		CloseDate:   formatNullTime(modelOpp.CloseDate),
+		Probability: modelOpp.Probability,
+		LeadId:      uint32(modelOpp.LeadID.Int32),
+		AccountId:   uint32(modelOpp.AccountID.Int32),
+		OwnerId:     uint32(modelOpp.OwnerID.Int32),
+		CreatedAt:   formatNullTime(modelOpp.CreatedAt),
+		UpdatedAt:   formatNullTime(modelOpp.UpdatedAt),
+		PipelineId:  uint32(modelOpp.PipelineID.Int32),
+		Currency:    modelOpp.Currency,
+		LossReason:  modelOpp.LossReason.String,
+		Competitor:  modelOpp.Competitor.String,
+		ClosedAt:    formatNullTime(modelOpp.ClosedAt),
+
+		ProbabilityOverridden: modelOpp.ProbabilityOverridden,
+		AmountLocked:          modelOpp.AmountLocked,
+	}
+}
+
+func convertProtoToPipeline(proto *pb.Pipeline) services.Pipeline {
+	pipeline := services.Pipeline{
+		ID:             int32(proto.Id),
+		OrganizationID: int32(proto.OrganizationId),
+		Name:           proto.Name,
+		IsDefault:      proto.IsDefault,
+	}
+	for _, stage := range proto.Stages {
+		pipeline.Stages = append(pipeline.Stages, services.PipelineStage{
+			Name:        stage.Name,
+			Probability: stage.Probability,
+			Won:         stage.Won,
+			Lost:        stage.Lost,
+		})
+	}
+	return pipeline
+}
+
+func convertPipelineToProto(pipeline *services.Pipeline) *pb.Pipeline {
+	resp := &pb.Pipeline{
+		Id:             uint32(pipeline.ID),
+		OrganizationId: uint32(pipeline.OrganizationID),
+		Name:           pipeline.Name,
+		IsDefault:      pipeline.IsDefault,
+		BuiltIn:        pipeline.BuiltIn,
+		CreatedAt:      formatNullTime(pipeline.CreatedAt),
+		UpdatedAt:      formatNullTime(pipeline.UpdatedAt),
+	}
+	for _, stage := range pipeline.Stages {
+		resp.Stages = append(resp.Stages, &pb.PipelineStage{
+			Name:        stage.Name,
+			Probability: stage.Probability,
+			Won:         stage.Won,
+			Lost:        stage.Lost,
+		})
+	}
+	return resp
+}
+
+func convertProtoToLineItem(proto *pb.OpportunityLineItem) services.LineItem {
+	item := services.LineItem{
+		ProductID:       int32(proto.ProductId),
+		Description:     nullString(proto.Description),
+		Quantity:        proto.Quantity,
+		DiscountPercent: proto.DiscountPercent,
+		TaxPercent:      proto.TaxPercent,
+	}
+	if proto.UnitPrice != nil {
+		item.UnitPrice = sql.NullFloat64{Float64: *proto.UnitPrice, Valid: true}
+	}
+	return item
+}
+
+func convertLineItemToProto(item *db.OpportunityLineItem) *pb.OpportunityLineItem {
+	unitPrice := item.UnitPrice
+	return &pb.OpportunityLineItem{
+		Id:              uint32(item.ID),
+		OpportunityId:   uint32(item.OpportunityID),
+		ProductId:       uint32(item.ProductID),
+		PriceBookId:     uint32(item.PriceBookID.Int32),
+		Description:     item.Description.String,
+		Quantity:        item.Quantity,
+		UnitPrice:       &unitPrice,
+		DiscountPercent: item.DiscountPercent,
+		TaxPercent:      item.TaxPercent,
+		Total:           item.Total,
+		CreatedAt:       formatNullTime(item.CreatedAt),
+		UpdatedAt:       formatNullTime(item.UpdatedAt),
+	}
+}
+
+func convertLineItemChangeToProto(change *services.LineItemChange) *pb.LineItemResponse {
+	resp := &pb.LineItemResponse{Opportunity: convertOpportunityModelToProto(change.Opportunity)}
+	if change.LineItem != nil {
+		resp.LineItem = convertLineItemToProto(change.LineItem)
+	}
+	return resp
+}
+
+func convertProtoToCloseOpportunityRequest(id uint32, stage, closeDate string, amount *float64, lossReason, competitor string) (services.CloseOpportunityRequest, error) {
+	req := services.CloseOpportunityRequest{
+		ID:         int32(id),
+		Stage:      stage,
+		LossReason: lossReason,
+		Competitor: competitor,
+	}
+	if closeDate != "" {
+		date, err := parseDate(closeDate)
+		if err != nil {
+			return services.CloseOpportunityRequest{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		req.CloseDate = date
+	}
+	if amount != nil {
+		req.Amount = sql.NullFloat64{Float64: *amount, Valid: true}
+	}
+	return req, nil
+}
+
+func convertLossReasonToProto(reason *db.LossReason) *pb.LossReason {
+	return &pb.LossReason{
+		Id:             uint32(reason.ID),
+		OrganizationId: uint32(reason.OrganizationID),
+		Name:           reason.Name,
+		Active:         &reason.Active,
+		Position:       uint32(reason.Position),
+		CreatedAt:      formatNullTime(reason.CreatedAt),
+		UpdatedAt:      formatNullTime(reason.UpdatedAt),
+	}
+}
+
+func convertOpportunityContactToProto(contact *db.OpportunityContact) *pb.OpportunityContact {
+	return &pb.OpportunityContact{
+		OpportunityId: uint32(contact.OpportunityID),
+		ContactId:     uint32(contact.ContactID),
+		Role:          contact.Role,
+		CreatedAt:     formatNullTime(contact.CreatedAt),
+	}
+}
 
 func parseDate(dateStr string) (time.Time, error) {
 	// Try parsing in YYYY-MM-DD format
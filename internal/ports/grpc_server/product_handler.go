@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProductHandler struct {
+	productService services.ProductServiceInterface
+	pb.UnimplementedProductServiceServer
+}
+
+func NewProductHandler(service services.ProductServiceInterface) *ProductHandler {
+	return &ProductHandler{productService: service}
+}
+
+func (h *ProductHandler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	log.Printf("Received CreateProduct request: %+v", req)
+	if req.Product == nil {
+		return nil, status.Error(codes.InvalidArgument, "product is required")
+	}
+
+	product, err := h.productService.CreateProduct(ctx, db.CreateProductParams{
+		Sku:         req.Product.Sku,
+		Name:        req.Product.Name,
+		Description: nullString(req.Product.Description),
+		ListPrice:   req.Product.ListPrice,
+		Currency:    req.Product.Currency,
+		Active:      optionalBool(req.Product.Active, true),
+	})
+	if err != nil {
+		log.Printf("Error creating product: %v", err)
+		return nil, productError(err, "failed to create product")
+	}
+	return convertProductToProto(product), nil
+}
+
+func (h *ProductHandler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
+	product, err := h.productService.GetProduct(ctx, int32(req.Id))
+	if err != nil {
+		return nil, productError(err, "failed to get product")
+	}
+	return convertProductToProto(product), nil
+}
+
+func (h *ProductHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	log.Printf("Received UpdateProduct request: %+v", req)
+	if req.Product == nil || req.Product.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+
+	product, err := h.productService.UpdateProduct(ctx, db.UpdateProductParams{
+		ID:          int32(req.Product.Id),
+		Sku:         req.Product.Sku,
+		Name:        req.Product.Name,
+		Description: nullString(req.Product.Description),
+		ListPrice:   req.Product.ListPrice,
+		Currency:    req.Product.Currency,
+		Active:      optionalBool(req.Product.Active, true),
+	})
+	if err != nil {
+		log.Printf("Error updating product: %v", err)
+		return nil, productError(err, "failed to update product")
+	}
+	return convertProductToProto(product), nil
+}
+
+func (h *ProductHandler) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	log.Printf("Received DeleteProduct request: %+v", req)
+
+	if err := h.productService.DeleteProduct(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting product: %v", err)
+		return nil, productError(err, "failed to delete product")
+	}
+	return &pb.DeleteProductResponse{Success: true}, nil
+}
+
+func (h *ProductHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	page, err := h.productService.ListProducts(ctx, req.ActiveOnly, req.Query, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing products: %v", err)
+		return nil, productError(err, "failed to list products")
+	}
+
+	resp := &pb.ListProductsResponse{
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}
+	for i := range page.Items {
+		resp.Products = append(resp.Products, convertProductToProto(&page.Items[i]))
+	}
+	return resp, nil
+}
+
+func (h *ProductHandler) CreatePriceBook(ctx context.Context, req *pb.CreatePriceBookRequest) (*pb.PriceBook, error) {
+	log.Printf("Received CreatePriceBook request: %+v", req)
+	if req.PriceBook == nil {
+		return nil, status.Error(codes.InvalidArgument, "price_book is required")
+	}
+
+	priceBook, err := h.productService.CreatePriceBook(ctx, db.CreatePriceBookParams{
+		Name:       req.PriceBook.Name,
+		PipelineID: nullInt32(req.PriceBook.PipelineId),
+		Currency:   req.PriceBook.Currency,
+		Active:     optionalBool(req.PriceBook.Active, true),
+	})
+	if err != nil {
+		log.Printf("Error creating price book: %v", err)
+		return nil, productError(err, "failed to create price book")
+	}
+	return convertPriceBookToProto(priceBook), nil
+}
+
+func (h *ProductHandler) GetPriceBook(ctx context.Context, req *pb.GetPriceBookRequest) (*pb.PriceBook, error) {
+	priceBook, err := h.productService.GetPriceBook(ctx, int32(req.Id))
+	if err != nil {
+		return nil, productError(err, "failed to get price book")
+	}
+	return convertPriceBookToProto(priceBook), nil
+}
+
+func (h *ProductHandler) UpdatePriceBook(ctx context.Context, req *pb.UpdatePriceBookRequest) (*pb.PriceBook, error) {
+	log.Printf("Received UpdatePriceBook request: %+v", req)
+	if req.PriceBook == nil || req.PriceBook.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "price book id is required")
+	}
+
+	priceBook, err := h.productService.UpdatePriceBook(ctx, db.UpdatePriceBookParams{
+		ID:         int32(req.PriceBook.Id),
+		Name:       req.PriceBook.Name,
+		PipelineID: nullInt32(req.PriceBook.PipelineId),
+		Currency:   req.PriceBook.Currency,
+		Active:     optionalBool(req.PriceBook.Active, true),
+	})
+	if err != nil {
+		log.Printf("Error updating price book: %v", err)
+		return nil, productError(err, "failed to update price book")
+	}
+	return convertPriceBookToProto(priceBook), nil
+}
+
+func (h *ProductHandler) DeletePriceBook(ctx context.Context, req *pb.DeletePriceBookRequest) (*pb.DeletePriceBookResponse, error) {
+	log.Printf("Received DeletePriceBook request: %+v", req)
+
+	if err := h.productService.DeletePriceBook(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting price book: %v", err)
+		return nil, productError(err, "failed to delete price book")
+	}
+	return &pb.DeletePriceBookResponse{Success: true}, nil
+}
+
+func (h *ProductHandler) ListPriceBooks(ctx context.Context, req *pb.ListPriceBooksRequest) (*pb.ListPriceBooksResponse, error) {
+	priceBooks, err := h.productService.ListPriceBooks(ctx, int32(req.PipelineId))
+	if err != nil {
+		log.Printf("Error listing price books: %v", err)
+		return nil, productError(err, "failed to list price books")
+	}
+
+	resp := &pb.ListPriceBooksResponse{}
+	for i := range priceBooks {
+		resp.PriceBooks = append(resp.PriceBooks, convertPriceBookToProto(&priceBooks[i]))
+	}
+	return resp, nil
+}
+
+func (h *ProductHandler) SetPriceBookEntry(ctx context.Context, req *pb.PriceBookEntry) (*pb.PriceBookEntry, error) {
+	log.Printf("Received SetPriceBookEntry request: %+v", req)
+
+	entry, err := h.productService.SetPriceBookEntry(ctx, db.UpsertPriceBookEntryParams{
+		PriceBookID: int32(req.PriceBookId),
+		ProductID:   int32(req.ProductId),
+		UnitPrice:   req.UnitPrice,
+	})
+	if err != nil {
+		log.Printf("Error setting price book entry: %v", err)
+		return nil, productError(err, "failed to set price book entry")
+	}
+	return convertPriceBookEntryToProto(entry), nil
+}
+
+func (h *ProductHandler) RemovePriceBookEntry(ctx context.Context, req *pb.RemovePriceBookEntryRequest) (*pb.RemovePriceBookEntryResponse, error) {
+	log.Printf("Received RemovePriceBookEntry request: %+v", req)
+
+	if err := h.productService.RemovePriceBookEntry(ctx, int32(req.PriceBookId), int32(req.ProductId)); err != nil {
+		log.Printf("Error removing price book entry: %v", err)
+		return nil, productError(err, "failed to remove price book entry")
+	}
+	return &pb.RemovePriceBookEntryResponse{Success: true}, nil
+}
+
+func (h *ProductHandler) ListPriceBookEntries(ctx context.Context, req *pb.ListPriceBookEntriesRequest) (*pb.ListPriceBookEntriesResponse, error) {
+	entries, err := h.productService.ListPriceBookEntries(ctx, int32(req.PriceBookId))
+	if err != nil {
+		log.Printf("Error listing price book entries: %v", err)
+		return nil, productError(err, "failed to list price book entries")
+	}
+
+	resp := &pb.ListPriceBookEntriesResponse{}
+	for i := range entries {
+		resp.Entries = append(resp.Entries, convertPriceBookEntryToProto(&entries[i]))
+	}
+	return resp, nil
+}
+
+func productError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrProductNotFound),
+		errors.Is(err, services.ErrPriceBookNotFound),
+		errors.Is(err, services.ErrPipelineNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidProduct),
+		errors.Is(err, services.ErrInvalidPriceBook),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrProductExists),
+		errors.Is(err, services.ErrPriceBookExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, services.ErrProductInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Model → Proto ----------
+
+func convertProductToProto(product *db.Product) *pb.Product {
+	return &pb.Product{
+		Id:          uint32(product.ID),
+		Sku:         product.Sku,
+		Name:        product.Name,
+		Description: product.Description.String,
+		ListPrice:   product.ListPrice,
+		Currency:    product.Currency,
+		Active:      &product.Active,
+		CreatedAt:   formatNullTime(product.CreatedAt),
+		UpdatedAt:   formatNullTime(product.UpdatedAt),
+	}
+}
+
+func convertPriceBookToProto(priceBook *db.PriceBook) *pb.PriceBook {
+	return &pb.PriceBook{
+		Id:         uint32(priceBook.ID),
+		Name:       priceBook.Name,
+		PipelineId: uint32(priceBook.PipelineID.Int32),
+		Currency:   priceBook.Currency,
+		Active:     &priceBook.Active,
+		CreatedAt:  formatNullTime(priceBook.CreatedAt),
+		UpdatedAt:  formatNullTime(priceBook.UpdatedAt),
+	}
+}
+
+func convertPriceBookEntryToProto(entry *db.PriceBookEntry) *pb.PriceBookEntry {
+	return &pb.PriceBookEntry{
+		PriceBookId: uint32(entry.PriceBookID),
+		ProductId:   uint32(entry.ProductID),
+		UnitPrice:   entry.UnitPrice,
+	}
+}
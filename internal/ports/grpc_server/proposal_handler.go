@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProposalHandler struct {
+	proposalService services.ProposalServiceInterface
+	pb.UnimplementedProposalServiceServer
+}
+
+func NewProposalHandler(service services.ProposalServiceInterface) *ProposalHandler {
+	return &ProposalHandler{proposalService: service}
+}
+
+func (h *ProposalHandler) CreateProposal(ctx context.Context, req *pb.CreateProposalRequest) (*pb.CreateProposalResponse, error) {
+	log.Printf("Received CreateProposal request: %+v", req)
+
+	if req.Proposal == nil {
+		return nil, status.Error(codes.InvalidArgument, services.ErrInvalidProposalData.Error())
+	}
+	validUntil, err := nullTime(req.Proposal.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "valid_until: "+err.Error())
+	}
+
+	details, err := h.proposalService.CreateProposal(ctx, db.CreateProposalParams{
+		Title:         req.Proposal.Title,
+		Description:   nullString(req.Proposal.Description),
+		ContactID:     int32(req.Proposal.ContactId),
+		OpportunityID: nullInt32(req.Proposal.OpportunityId),
+		CreatedBy:     int32(req.Proposal.CreatedBy),
+		ValidUntil:    validUntil,
+		Currency:      req.Proposal.Currency,
+	}, convertProtoToLineItems(req.Proposal.LineItems))
+	if err != nil {
+		log.Printf("Error creating proposal: %v", err)
+		return nil, proposalError(err, "failed to create proposal")
+	}
+
+	return &pb.CreateProposalResponse{Proposal: convertProposalToProto(details)}, nil
+}
+
+func (h *ProposalHandler) GetProposal(ctx context.Context, req *pb.GetProposalRequest) (*pb.GetProposalResponse, error) {
+	details, err := h.proposalService.GetProposal(ctx, int32(req.Id))
+	if err != nil {
+		log.Printf("Error getting proposal: %v", err)
+		return nil, proposalError(err, "failed to get proposal")
+	}
+
+	return &pb.GetProposalResponse{Proposal: convertProposalToProto(details)}, nil
+}
+
+func (h *ProposalHandler) UpdateProposal(ctx context.Context, req *pb.UpdateProposalRequest) (*pb.UpdateProposalResponse, error) {
+	log.Printf("Received UpdateProposal request: %+v", req)
+
+	if req.Proposal == nil {
+		return nil, status.Error(codes.InvalidArgument, services.ErrInvalidProposalData.Error())
+	}
+	validUntil, err := nullTime(req.Proposal.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "valid_until: "+err.Error())
+	}
+
+	// An empty line item list keeps the current line items.
+	var lineItems []db.ProposalLineItem
+	if len(req.Proposal.LineItems) > 0 {
+		lineItems = convertProtoToLineItems(req.Proposal.LineItems)
+	}
+
+	details, err := h.proposalService.UpdateProposal(ctx, db.UpdateProposalParams{
+		ID:            int32(req.Proposal.Id),
+		Title:         req.Proposal.Title,
+		Description:   nullString(req.Proposal.Description),
+		ContactID:     int32(req.Proposal.ContactId),
+		OpportunityID: nullInt32(req.Proposal.OpportunityId),
+		ValidUntil:    validUntil,
+	}, lineItems)
+	if err != nil {
+		log.Printf("Error updating proposal: %v", err)
+		return nil, proposalError(err, "failed to update proposal")
+	}
+
+	return &pb.UpdateProposalResponse{Proposal: convertProposalToProto(details)}, nil
+}
+
+func (h *ProposalHandler) DeleteProposal(ctx context.Context, req *pb.DeleteProposalRequest) (*pb.DeleteProposalResponse, error) {
+	log.Printf("Received DeleteProposal request: %+v", req)
+
+	if err := h.proposalService.DeleteProposal(ctx, int32(req.Id)); err != nil {
+		log.Printf("Error deleting proposal: %v", err)
+		return nil, proposalError(err, "failed to delete proposal")
+	}
+
+	return &pb.DeleteProposalResponse{Success: true}, nil
+}
+
+func (h *ProposalHandler) ListProposals(ctx context.Context, req *pb.ListProposalsRequest) (*pb.ListProposalsResponse, error) {
+	page, err := h.proposalService.ListProposals(ctx, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing proposals: %v", err)
+		return nil, proposalError(err, "failed to list proposals")
+	}
+
+	var protoProposals []*pb.Proposal
+	for i := range page.Items {
+		protoProposals = append(protoProposals, convertProposalToProto(&page.Items[i]))
+	}
+
+	return &pb.ListProposalsResponse{
+		Proposals:     protoProposals,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
+}
+
+func (h *ProposalHandler) UpdateProposalStatus(ctx context.Context, req *pb.UpdateProposalStatusRequest) (*pb.UpdateProposalStatusResponse, error) {
+	log.Printf("Received UpdateProposalStatus request: %+v", req)
+
+	details, err := h.proposalService.UpdateProposalStatus(ctx, int32(req.Id), req.Status)
+	if err != nil {
+		log.Printf("Error updating proposal status: %v", err)
+		return nil, proposalError(err, "failed to update proposal status")
+	}
+
+	return &pb.UpdateProposalStatusResponse{Proposal: convertProposalToProto(details)}, nil
+}
+
+// proposalError maps proposal service errors to gRPC status errors.
+func proposalError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrProposalNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidProposalData),
+		errors.Is(err, services.ErrInvalidCurrency),
+		errors.Is(err, filter.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrProposalNotEditable),
+		errors.Is(err, services.ErrInvalidProposalTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Proto ↔ SQLC ----------
+
+func convertProtoToLineItems(protoItems []*pb.ProposalLineItem) []db.ProposalLineItem {
+	items := make([]db.ProposalLineItem, 0, len(protoItems))
+	for _, item := range protoItems {
+		items = append(items, db.ProposalLineItem{
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Discount:    item.Discount,
+			TaxRate:     item.TaxRate,
+		})
+	}
+	return items
+}
+
+func convertProposalToProto(details *services.ProposalDetails) *pb.Proposal {
+	p := details.Proposal
+
+	lineItems := make([]*pb.ProposalLineItem, 0, len(details.LineItems))
+	for _, item := range details.LineItems {
+		lineItems = append(lineItems, &pb.ProposalLineItem{
+			Id:          uint32(item.ID),
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Discount:    item.Discount,
+			TaxRate:     item.TaxRate,
+			Total:       item.Total,
+		})
+	}
+
+	return &pb.Proposal{
+		Id:            uint32(p.ID),
+		Title:         p.Title,
+		Description:   p.Description.String,
+		Amount:        p.Amount,
+		Status:        p.Status,
+		ContactId:     uint32(p.ContactID),
+		CreatedBy:     uint32(p.CreatedBy),
+		CreatedAt:     formatNullTime(p.CreatedAt),
+		UpdatedAt:     formatNullTime(p.UpdatedAt),
+		OpportunityId: uint32(p.OpportunityID.Int32),
+		ValidUntil:    formatNullTime(p.ValidUntil),
+		LineItems:     lineItems,
+		SentAt:        formatNullTime(p.SentAt),
+		RespondedAt:   formatNullTime(p.RespondedAt),
+		Currency:      p.Currency,
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SearchHandler struct {
+	searchService services.SearchServiceInterface
+	pb.UnimplementedSearchServiceServer
+}
+
+func NewSearchHandler(service services.SearchServiceInterface) *SearchHandler {
+	return &SearchHandler{searchService: service}
+}
+
+func (h *SearchHandler) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	result, err := h.searchService.Search(ctx, services.SearchRequest{
+		Query:          req.Query,
+		Types:          req.Types,
+		OrganizationID: int32(req.OrganizationId),
+		Country:        req.Country,
+		Industry:       req.Industry,
+		Status:         req.Status,
+		PageNumber:     int32(req.PageNumber),
+		PageSize:       int32(req.PageSize),
+	})
+	if err != nil {
+		log.Printf("Error searching: %v", err)
+		return nil, searchError(err, "failed to search")
+	}
+
+	resp := &pb.SearchResponse{
+		TotalCount: uint32(result.Total),
+		Facets: &pb.SearchFacets{
+			Type:     convertFacetCountsToProto(result.Facets.Type),
+			Country:  convertFacetCountsToProto(result.Facets.Country),
+			Industry: convertFacetCountsToProto(result.Facets.Industry),
+			Status:   convertFacetCountsToProto(result.Facets.Status),
+		},
+	}
+	for i := range result.Results {
+		resp.Results = append(resp.Results, convertSearchHitToProto(&result.Results[i]))
+	}
+	return resp, nil
+}
+
+func searchError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidSearch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+// ---------- Model → Proto ----------
+
+func convertSearchHitToProto(hit *db.SearchRecordsRow) *pb.SearchHit {
+	return &pb.SearchHit{
+		Type:      hit.EntityType,
+		Id:        uint32(hit.ID),
+		Title:     hit.Title,
+		Subtitle:  hit.Subtitle,
+		Highlight: hit.Highlight,
+		Score:     hit.Rank,
+		Country:   hit.Country.String,
+		Industry:  hit.Industry.String,
+		Status:    hit.Status.String,
+	}
+}
+
+func convertFacetCountsToProto(counts []services.FacetCount) []*pb.FacetCount {
+	result := make([]*pb.FacetCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &pb.FacetCount{Value: c.Value, Count: uint32(c.Count)})
+	}
+	return result
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SMTPHandler struct {
+	smtpService services.SMTPServiceInterface
+	keyID       string
+	pb.UnimplementedSMTPServiceServer
+}
+
+// NewSMTPHandler builds the handler. keyID is the primary secrets key,
+// reported back by RotateSMTPKeys.
+func NewSMTPHandler(service services.SMTPServiceInterface, keyID string) *SMTPHandler {
+	return &SMTPHandler{smtpService: service, keyID: keyID}
+}
+
+// Requests carry a password, so they are never logged with %+v.
+
+func (h *SMTPHandler) CreateSMTP(ctx context.Context, req *pb.CreateSMTPRequest) (*pb.SMTPResponse, error) {
+	log.Printf("Received CreateSMTP request: user=%s host=%s port=%d", req.UserId, req.SmtpHost, req.SmtpPort)
+
+	credential, err := h.smtpService.CreateSMTP(ctx, services.SMTPSettings{
+		UserID:    req.UserId,
+		Host:      req.SmtpHost,
+		Port:      req.SmtpPort,
+		Username:  req.SmtpUsername,
+		Password:  req.SmtpPassword,
+		FromEmail: req.FromEmail,
+	})
+	if err != nil {
+		log.Printf("Error creating SMTP credentials: %v", err)
+		return nil, smtpError(err, "failed to create smtp credentials")
+	}
+
+	return convertSMTPToProto(credential), nil
+}
+
+func (h *SMTPHandler) GetSMTP(ctx context.Context, req *pb.GetSMTPRequest) (*pb.SMTPResponse, error) {
+	id, err := parseSMTPID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := h.smtpService.GetSMTP(ctx, id)
+	if err != nil {
+		log.Printf("Error getting SMTP credentials: %v", err)
+		return nil, smtpError(err, "failed to get smtp credentials")
+	}
+
+	return convertSMTPToProto(credential), nil
+}
+
+func (h *SMTPHandler) UpdateSMTP(ctx context.Context, req *pb.UpdateSMTPRequest) (*pb.SMTPResponse, error) {
+	log.Printf("Received UpdateSMTP request: id=%s host=%s port=%d password_changed=%t",
+		req.Id, req.SmtpHost, req.SmtpPort, req.SmtpPassword != "")
+
+	id, err := parseSMTPID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := h.smtpService.UpdateSMTP(ctx, id, services.SMTPSettings{
+		Host:      req.SmtpHost,
+		Port:      req.SmtpPort,
+		Username:  req.SmtpUsername,
+		Password:  req.SmtpPassword,
+		FromEmail: req.FromEmail,
+	})
+	if err != nil {
+		log.Printf("Error updating SMTP credentials: %v", err)
+		return nil, smtpError(err, "failed to update smtp credentials")
+	}
+
+	return convertSMTPToProto(credential), nil
+}
+
+func (h *SMTPHandler) DeleteSMTP(ctx context.Context, req *pb.DeleteSMTPRequest) (*pb.DeleteSMTPResponse, error) {
+	id, err := parseSMTPID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.smtpService.DeleteSMTP(ctx, id); err != nil {
+		log.Printf("Error deleting SMTP credentials: %v", err)
+		return nil, smtpError(err, "failed to delete smtp credentials")
+	}
+
+	return &pb.DeleteSMTPResponse{Id: req.Id, Message: "SMTP credentials deleted successfully"}, nil
+}
+
+func (h *SMTPHandler) ListSMTP(ctx context.Context, req *pb.ListSMTPRequest) (*pb.ListSMTPResponse, error) {
+	page, err := h.smtpService.ListSMTP(ctx, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing SMTP credentials: %v", err)
+		if errors.Is(err, filter.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list smtp credentials")
+	}
+
+	var protoCredentials []*pb.SMTPResponse
+	for i := range page.Items {
+		protoCredentials = append(protoCredentials, convertSMTPToProto(&page.Items[i]))
+	}
+
+	return &pb.ListSMTPResponse{
+		Credentials:   protoCredentials,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
+}
+
+// TestSMTP reports a failed handshake in the response rather than as an RPC
+// error; only unknown IDs and missing keys fail the call.
+func (h *SMTPHandler) TestSMTP(ctx context.Context, req *pb.TestSMTPRequest) (*pb.TestSMTPResponse, error) {
+	id, err := parseSMTPID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	latency, err := h.smtpService.TestSMTP(ctx, id)
+	if errors.Is(err, services.ErrSMTPTestFailed) {
+		return &pb.TestSMTPResponse{Success: false, Message: err.Error()}, nil
+	}
+	if err != nil {
+		log.Printf("Error testing SMTP credentials: %v", err)
+		return nil, smtpError(err, "failed to test smtp credentials")
+	}
+
+	return &pb.TestSMTPResponse{
+		Success:   true,
+		Message:   "connected and authenticated",
+		LatencyMs: latency.Milliseconds(),
+	}, nil
+}
+
+func (h *SMTPHandler) RotateSMTPKeys(ctx context.Context, req *pb.RotateSMTPKeysRequest) (*pb.RotateSMTPKeysResponse, error) {
+	log.Printf("Received RotateSMTPKeys request")
+
+	rotated, err := h.smtpService.RotateKeys(ctx)
+	if err != nil {
+		log.Printf("Error rotating SMTP keys after %d credentials: %v", rotated, err)
+		return nil, smtpError(err, "failed to rotate smtp keys")
+	}
+
+	return &pb.RotateSMTPKeysResponse{Rotated: int32(rotated), KeyId: h.keyID}, nil
+}
+
+// smtpError maps SMTP service errors to gRPC status errors.
+func smtpError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrSMTPNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidSMTPData):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrSMTPExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, services.ErrEncryptionUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+func parseSMTPID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid smtp id %q", id)
+	}
+	return int32(n), nil
+}
+
+// ---------- SQLC → Proto ----------
+
+// convertSMTPToProto never includes the password.
+func convertSMTPToProto(c *db.SmtpCredential) *pb.SMTPResponse {
+	return &pb.SMTPResponse{
+		Id:           strconv.Itoa(int(c.ID)),
+		UserId:       c.UserID,
+		SmtpHost:     c.SmtpHost,
+		SmtpPort:     c.SmtpPort,
+		SmtpUsername: c.SmtpUsername,
+		FromEmail:    c.FromEmail,
+		RepeatedAt:   formatNullTime(c.CreatedAt),
+		UpdatedAt:    formatNullTime(c.UpdatedAt),
+	}
+}
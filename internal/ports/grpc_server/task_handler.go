@@ -3,10 +3,12 @@ package handler
 import (
 	"context"
 	"crm/api/proto/pb"
-
+	"crm/internal/adapters/database/db"
+	"crm/internal/core/filter"
 	"crm/internal/core/services"
+	"errors"
+	"fmt"
 	"log"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,11 +27,14 @@ func NewTaskHandler(service services.TaskService) *TaskHandler {
 func (h *TaskHandler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
 	log.Printf("Received CreateTask request: %+v", req)
 
-	// Convert Proto to Model
-	task := convertProtoToModelTask(req.Task)
+	// Convert Proto to sqlc params
+	task, err := convertProtoToCreateTaskParams(req.Task)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// Create Task via Service
-	createdTask, err := h.taskService.CreateTask(task)
+	createdTask, err := h.taskService.CreateTask(ctx, &task)
 	if err != nil {
 		log.Printf("Error creating task: %v", err)
 		switch err {
@@ -52,7 +57,7 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 
 // GetTask handles retrieval of a task by ID.
 func (h *TaskHandler) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
-	task, err := h.taskService.GetTask(uint(req.Id))
+	task, err := h.taskService.GetTask(ctx, int32(req.Id))
 	if err != nil {
 		log.Printf("Error getting task: %v", err)
 		switch err {
@@ -72,11 +77,14 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.
 func (h *TaskHandler) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	log.Printf("Received UpdateTask request: %+v", req)
 
-	// Convert Proto to Model
-	task := convertProtoToModelTask(req.Task)
+	// Convert Proto to sqlc params
+	task, err := convertProtoToUpdateTaskParams(req.Task)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// Update Task via Service
-	updatedTask, err := h.taskService.UpdateTask(task)
+	updatedTask, err := h.taskService.UpdateTask(ctx, task)
 	if err != nil {
 		log.Printf("Error updating task: %v", err)
 		switch err {
@@ -102,7 +110,7 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 	log.Printf("Received DeleteTask request: %+v", req)
 
 	// Delete Task via Service
-	err := h.taskService.DeleteTask(uint(req.Id))
+	err := h.taskService.DeleteTask(ctx, int32(req.Id))
 	if err != nil {
 		log.Printf("Error deleting task: %v", err)
 		switch err {
@@ -121,11 +129,11 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 
 // ListTasks handles listing tasks with pagination and optional filtering.
 func (h *TaskHandler) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
-	tasks, err := h.taskService.ListTasks(uint(req.PageNumber), uint(req.PageSize), req.SortBy, req.Ascending, uint(req.ActivityId))
+	page, err := h.taskService.ListTasks(ctx, int32(req.ActivityId), listOptions(req))
 	if err != nil {
 		log.Printf("Error listing tasks: %v", err)
-		switch err {
-		case services.ErrInvalidTaskData:
+		switch {
+		case err == services.ErrInvalidTaskData, errors.Is(err, filter.ErrInvalid):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "failed to list tasks")
@@ -134,45 +142,59 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (
 
 	// Convert Models to Proto
 	var protoTasks []*pb.Task
-	for _, task := range tasks {
+	for _, task := range page.Items {
 		protoTasks = append(protoTasks, convertTaskModelToProto(&task))
 	}
 
 	return &pb.ListTasksResponse{
-		Tasks: protoTasks,
+		Tasks:         protoTasks,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
 	}, nil
 }
 
 // Conversion Functions
 
-
-func convertProtoToModelTask(protoTask *pb.Task) *models.Task {
-	dueDate, _ := time.Parse(time.RFC3339, protoTask.DueDate)
-	return &models.Task{
-		Id:          uint(protoTask.Id),
+func convertProtoToCreateTaskParams(protoTask *pb.Task) (db.CreateTaskParams, error) {
+	dueDate, err := nullTime(protoTask.DueDate)
+	if err != nil {
+		return db.CreateTaskParams{}, fmt.Errorf("due_date: %w", err)
+	}
+	return db.CreateTaskParams{
 		Title:       protoTask.Title,
-		Description: protoTask.Description,
+		Description: nullString(protoTask.Description),
 		Status:      protoTask.Status,
 		Priority:    protoTask.Priority,
 		DueDate:     dueDate,
-		ActivityID:  uint(protoTask.ActivityId),
-	}
+		ActivityID:  int32(protoTask.ActivityId),
+	}, nil
 }
 
-func convertTaskModelToProto(modelTask *models.Task) *pb.Task {
-	dueDate := ""
-	if !modelTask.DueDate.IsZero() {
-		dueDate = modelTask.DueDate.Format(time.RFC3339)
+func convertProtoToUpdateTaskParams(protoTask *pb.Task) (db.UpdateTaskParams, error) {
+	dueDate, err := nullTime(protoTask.DueDate)
+	if err != nil {
+		return db.UpdateTaskParams{}, fmt.Errorf("due_date: %w", err)
 	}
+	return db.UpdateTaskParams{
+		ID:          int32(protoTask.Id),
+		Description: nullString(protoTask.Description),
+		Status:      protoTask.Status,
+		Priority:    protoTask.Priority,
+		DueDate:     dueDate,
+	}, nil
+}
+
+func convertTaskModelToProto(modelTask *db.Task) *pb.Task {
 	return &pb.Task{
-		Id:          uint32(modelTask.Id),
+		Id:          uint32(modelTask.ID),
 		Title:       modelTask.Title,
-		Description: modelTask.Description,
+		Description: modelTask.Description.String,
 		Status:      modelTask.Status,
 		Priority:    modelTask.Priority,
-		DueDate:     dueDate,
-		CreatedAt:   modelTask.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   modelTask.UpdatedAt.Format(time.RFC3339),
+		DueDate:     formatNullTime(modelTask.DueDate),
+		CreatedAt:   formatNullTime(modelTask.CreatedAt),
+		UpdatedAt:   formatNullTime(modelTask.UpdatedAt),
 		ActivityId:  uint32(modelTask.ActivityID),
 	}
 }
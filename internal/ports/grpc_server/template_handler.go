@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"crm/api/proto/pb"
+	"crm/internal/core/filter"
+	"crm/internal/core/services"
+	"errors"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TemplateHandler struct {
+	templateService services.TemplateServiceInterface
+	pb.UnimplementedTemplateServiceServer
+}
+
+func NewTemplateHandler(service services.TemplateServiceInterface) *TemplateHandler {
+	return &TemplateHandler{templateService: service}
+}
+
+func (h *TemplateHandler) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.TemplateResponse, error) {
+	log.Printf("Received CreateTemplate request: %+v", req)
+
+	details, err := h.templateService.CreateTemplate(ctx, req.Name, nullString(req.Url), req.Channels, services.TemplateContent{
+		EmailSubject: req.EmailSubject,
+		EmailHTML:    req.EmailHtml,
+		EmailText:    req.EmailText,
+		SMSText:      req.SmsText,
+		InAppText:    req.InAppText,
+		Variables:    req.Data,
+	})
+	if err != nil {
+		log.Printf("Error creating template: %v", err)
+		return nil, templateError(err, "failed to create template")
+	}
+
+	return convertTemplateToProto(details), nil
+}
+
+func (h *TemplateHandler) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (*pb.TemplateResponse, error) {
+	id, err := parseTemplateID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := h.templateService.GetTemplate(ctx, id, req.Version)
+	if err != nil {
+		log.Printf("Error getting template: %v", err)
+		return nil, templateError(err, "failed to get template")
+	}
+
+	return convertTemplateToProto(details), nil
+}
+
+func (h *TemplateHandler) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.TemplateResponse, error) {
+	log.Printf("Received UpdateTemplate request: %+v", req)
+
+	id, err := parseTemplateID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without bodies or data the current version is kept.
+	var content *services.TemplateContent
+	if req.EmailSubject != "" || req.EmailHtml != "" || req.EmailText != "" ||
+		req.SmsText != "" || req.InAppText != "" || len(req.Data) > 0 {
+		content = &services.TemplateContent{
+			EmailSubject: req.EmailSubject,
+			EmailHTML:    req.EmailHtml,
+			EmailText:    req.EmailText,
+			SMSText:      req.SmsText,
+			InAppText:    req.InAppText,
+			Variables:    req.Data,
+		}
+	}
+
+	details, err := h.templateService.UpdateTemplate(ctx, id, req.Name, nullString(req.Url), req.Channels, content)
+	if err != nil {
+		log.Printf("Error updating template: %v", err)
+		return nil, templateError(err, "failed to update template")
+	}
+
+	return convertTemplateToProto(details), nil
+}
+
+func (h *TemplateHandler) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	page, err := h.templateService.ListTemplates(ctx, listOptions(req))
+	if err != nil {
+		log.Printf("Error listing templates: %v", err)
+		if errors.Is(err, filter.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list templates")
+	}
+
+	var protoTemplates []*pb.TemplateResponse
+	for i := range page.Items {
+		protoTemplates = append(protoTemplates, convertTemplateToProto(&page.Items[i]))
+	}
+
+	return &pb.ListTemplatesResponse{
+		Templates:     protoTemplates,
+		NextPageToken: page.NextPageToken,
+		PrevPageToken: page.PrevPageToken,
+		TotalCount:    uint32(page.TotalCount),
+	}, nil
+}
+
+func (h *TemplateHandler) PreviewTemplate(ctx context.Context, req *pb.PreviewTemplateRequest) (*pb.PreviewTemplateResponse, error) {
+	var id int32
+	if req.Id != "" {
+		var err error
+		if id, err = parseTemplateID(req.Id); err != nil {
+			return nil, err
+		}
+	} else if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "id or name is required")
+	}
+
+	rendered, err := h.templateService.PreviewTemplate(ctx, id, req.Name, req.Version, req.Channel, req.Data)
+	if err != nil {
+		log.Printf("Error previewing template: %v", err)
+		return nil, templateError(err, "failed to preview template")
+	}
+
+	return &pb.PreviewTemplateResponse{
+		Channel: req.Channel,
+		Version: rendered.Version,
+		Subject: rendered.Subject,
+		Html:    rendered.HTML,
+		Text:    rendered.Text,
+	}, nil
+}
+
+// templateError maps template service errors to gRPC status errors.
+func templateError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, services.ErrTemplateNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrInvalidTemplate),
+		errors.Is(err, services.ErrTemplateChannel),
+		errors.Is(err, services.ErrMissingTemplateData):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+func parseTemplateID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid template id %q", id)
+	}
+	return int32(n), nil
+}
+
+// ---------- SQLC → Proto ----------
+
+func convertTemplateToProto(details *services.TemplateDetails) *pb.TemplateResponse {
+	t := details.Template
+	content := details.Content
+
+	return &pb.TemplateResponse{
+		Id:           strconv.Itoa(int(t.ID)),
+		Name:         t.Name,
+		Url:          t.Url.String,
+		Channels:     details.Channels,
+		Data:         content.Variables,
+		CreatedAt:    formatNullTime(t.CreatedAt),
+		UpdatedAt:    formatNullTime(t.UpdatedAt),
+		Version:      details.Version.Version,
+		EmailSubject: content.EmailSubject,
+		EmailHtml:    content.EmailHTML,
+		EmailText:    content.EmailText,
+		SmsText:      content.SMSText,
+		InAppText:    content.InAppText,
+	}
+}
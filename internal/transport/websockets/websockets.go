@@ -1,36 +1,68 @@
 package websockets
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gorilla/websocket"
 )
 
-var upgrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		// Allow all connections by returning true, adjust this for your CORS policy
-		return true
-	},
+var (
+	ErrNoConnection   = errors.New("user has no websocket connection")
+	ErrMessageDropped = errors.New("websocket message dropped")
+)
+
+// outbound is a message waiting for the broadcast loop. Messages with a user
+// go only to that user's connections.
+type outbound struct {
+	user    string
+	message []byte
 }
 
 // Server represents the WebSocket server
 type Server struct {
-	clients   map[*websocket.Conn]bool // connected clients
-	broadcast chan []byte              // broadcast channel
+	clients   map[*websocket.Conn]string // connected clients and their user
+	broadcast chan outbound              // broadcast channel
+	upgrader  websocket.Upgrader
+	running   atomic.Bool // set while the broadcast loop runs
+
+	// mu guards clients and closed, and is held while sending on
+	// broadcast so that Shutdown cannot close it under a sender.
+	mu     sync.Mutex
+	closed bool
 }
 
-// NewServer initializes a new WebSocket server
-func NewServer() *Server {
+// NewServer initializes a new WebSocket server. Only requests whose Origin
+// header is in allowedOrigins are upgraded; with no origins every request is
+// accepted.
+func NewServer(allowedOrigins ...string) *Server {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[origin] = true
+	}
+
 	return &Server{
-		clients:   make(map[*websocket.Conn]bool),
-		broadcast: make(chan []byte, 100),
+		clients:   make(map[*websocket.Conn]string),
+		broadcast: make(chan outbound, 100),
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				if len(allowed) == 0 {
+					return true
+				}
+				return allowed[r.Header.Get("Origin")]
+			},
+		},
 	}
 }
 
-// HandleConnections handles incoming WebSocket connections
+// HandleConnections handles incoming WebSocket connections. A client that
+// passes a user query parameter also receives the messages sent to that user.
 func (s *Server) HandleConnections(w http.ResponseWriter, r *http.Request) {
-	ws, err := upgrader.Upgrade(w, r, nil)
+	ws, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Printf("Error upgrading connection to WebSocket: %v", err)
 		return
@@ -38,59 +70,140 @@ func (s *Server) HandleConnections(w http.ResponseWriter, r *http.Request) {
 	defer ws.Close()
 
 	// Register the new client
-	s.clients[ws] = true
-	log.Printf("Client connected: %v", ws.RemoteAddr())
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	user := r.URL.Query().Get("user")
+	s.clients[ws] = user
+	s.mu.Unlock()
+	log.Printf("Client connected: %v (user %q)", ws.RemoteAddr(), user)
 
 	// Listen for incoming messages from the client
 	for {
 		_, message, err := ws.ReadMessage()
 		if err != nil {
 			log.Printf("Error reading message: %v", err)
+			s.mu.Lock()
 			delete(s.clients, ws)
+			s.mu.Unlock()
 			break
 		}
 		// Send the received message to the broadcast channel
-		s.broadcast <- message
+		s.BroadcastMessage(message)
 	}
 }
 
 // Start runs the broadcast loop to send messages to all connected clients
 func (s *Server) Start() {
+	s.running.Store(true)
+	defer s.running.Store(false)
 	log.Println("WebSocket server started.")
 	for {
 		log.Println("Waiting for messages on the broadcast channel...")
 		// Wait for a message on the broadcast channel
-		message := <-s.broadcast
-		if len(s.clients) == 0 {
+		out, ok := <-s.broadcast
+		if !ok {
+			log.Println("Broadcast channel closed, stopping WebSocket server.")
+			return
+		}
+		clients := s.connectedClients(out.user)
+		if len(clients) == 0 {
 			log.Println("No clients connected, skipping broadcast.")
 			continue
 		}
-		log.Printf("Received message to broadcast: %s", message)
-		// Send message to all connected clients
-		for client := range s.clients {
-			err := client.WriteMessage(websocket.TextMessage, message)
+		if out.user == "" {
+			log.Printf("Received message to broadcast: %s", out.message)
+		} else {
+			log.Printf("Received message for user %q", out.user)
+		}
+		// Send message to the addressed clients
+		for _, client := range clients {
+			err := client.WriteMessage(websocket.TextMessage, out.message)
 			if err != nil {
 				log.Printf("Error writing message to WebSocket: %v", err)
 				client.Close()
+				s.mu.Lock()
 				delete(s.clients, client)
+				s.mu.Unlock()
 			}
 		}
 	}
 }
 
-// BroadcastMessage sends a message to all connected clients
+// connectedClients returns the clients connected now, only those of user
+// when user is set.
+func (s *Server) connectedClients(user string) []*websocket.Conn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clients := make([]*websocket.Conn, 0, len(s.clients))
+	for client, u := range s.clients {
+		if user == "" || u == user {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// Running reports whether the broadcast loop started by Start is running.
+func (s *Server) Running() bool {
+	return s.running.Load()
+}
+
+// BroadcastMessage sends a message to all connected clients. Messages sent
+// after Shutdown are dropped.
 func (s *Server) BroadcastMessage(message []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		log.Println("WebSocket server is shut down, message dropped.")
+		return
+	}
 	log.Printf("Broadcasting message: %s", message)
 	select {
-	case s.broadcast <- message:
+	case s.broadcast <- outbound{message: message}:
 		log.Println("Message sent to broadcast channel.")
 	default:
 		log.Println("Broadcast channel is full, message dropped.")
 	}
 }
 
+// SendToUser queues a message for the connections of user only. It returns
+// ErrNoConnection when user is not connected and ErrMessageDropped when the
+// message cannot be queued.
+func (s *Server) SendToUser(user string, message []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return fmt.Errorf("%w: server is shut down", ErrMessageDropped)
+	}
+	connected := false
+	for _, u := range s.clients {
+		if u == user {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		return fmt.Errorf("%w: %s", ErrNoConnection, user)
+	}
+	select {
+	case s.broadcast <- outbound{user: user, message: message}:
+		return nil
+	default:
+		return fmt.Errorf("%w: broadcast channel is full", ErrMessageDropped)
+	}
+}
+
 // Shutdown gracefully closes all WebSocket connections
 func (s *Server) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
 	for client := range s.clients {
 		client.Close()
 	}
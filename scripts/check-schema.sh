@@ -0,0 +1,13 @@
+#!/bin/sh
+# Fails when the sqlc-generated code in internal/adapters/database/db no
+# longer matches the migrations and queries it is generated from, or when
+# the migration files are malformed. Needs sqlc v1.29.0 on PATH.
+#
+# The repository has no CI configuration, so nothing runs this
+# automatically: run it by hand after changing migrations or queries.
+# Running it in CI is not part of this change.
+set -eu
+cd "$(dirname "$0")/.."
+
+sqlc diff
+go run ./cmd/service migrate check